@@ -0,0 +1,188 @@
+// Package archive reads and writes party archives.
+//
+// An archive is either a single JSON document holding a models.PartyArchive or
+// a ZIP file that splits the same data into one JSON file per record type, next
+// to a manifest naming the format version. Read accepts both.
+package archive
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/sipgate/eurovision-vote-party/server/models"
+)
+
+// Format names an archive encoding.
+type Format string
+
+const (
+	// FormatJSON encodes the archive as a single JSON document.
+	FormatJSON Format = "json"
+	// FormatZIP encodes the archive as a ZIP file of JSON documents.
+	FormatZIP Format = "zip"
+)
+
+// IsValid reports whether the format is supported.
+func (f Format) IsValid() bool {
+	return f == FormatJSON || f == FormatZIP
+}
+
+// ContentType returns the media type of archives in the format.
+func (f Format) ContentType() string {
+	if f == FormatZIP {
+		return "application/zip"
+	}
+	return "application/json"
+}
+
+// Files of a ZIP archive.
+const (
+	manifestFile    = "manifest.json"
+	partyFile       = "party.json"
+	guestsFile      = "guests.json"
+	votesFile       = "votes.json"
+	predictionsFile = "predictions.json"
+	outcomeFile     = "outcome.json"
+	actsFile        = "acts.json"
+)
+
+// maxFileSize bounds the uncompressed size of a single file in a ZIP archive.
+const maxFileSize = 64 << 20
+
+// manifest describes the ZIP archive as a whole.
+type manifest struct {
+	Version    int       `json:"version"`
+	ExportedAt time.Time `json:"exportedAt"`
+}
+
+// zipFile is a file to write into a ZIP archive.
+type zipFile struct {
+	name string
+	data any
+}
+
+// ErrInvalid is returned by Read for data that is not a readable party archive.
+var ErrInvalid = errors.New("invalid party archive")
+
+// Write encodes the archive in the given format.
+func Write(w io.Writer, a *models.PartyArchive, format Format) error {
+	switch format {
+	case FormatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(a)
+	case FormatZIP:
+		return writeZIP(w, a)
+	default:
+		return fmt.Errorf("unsupported archive format %q", format)
+	}
+}
+
+func writeZIP(w io.Writer, a *models.PartyArchive) error {
+	zw := zip.NewWriter(w)
+	files := []zipFile{
+		{manifestFile, manifest{Version: a.Version, ExportedAt: a.ExportedAt}},
+		{partyFile, a.Party},
+		{guestsFile, a.Guests},
+		{votesFile, a.Votes},
+		{predictionsFile, a.Predictions},
+		{actsFile, a.Acts},
+	}
+	if a.Outcome != nil {
+		files = append(files, zipFile{outcomeFile, a.Outcome})
+	}
+
+	for _, file := range files {
+		fw, err := zw.CreateHeader(&zip.FileHeader{Name: file.name, Method: zip.Deflate, Modified: a.ExportedAt})
+		if err != nil {
+			return err
+		}
+		enc := json.NewEncoder(fw)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(file.data); err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
+
+// Read decodes an archive in either format and validates it.
+// Returns an error wrapping ErrInvalid if the data is not a valid archive.
+func Read(data []byte) (*models.PartyArchive, error) {
+	var a *models.PartyArchive
+	var err error
+	if bytes.HasPrefix(data, []byte("PK\x03\x04")) {
+		a, err = readZIP(data)
+	} else {
+		a = &models.PartyArchive{}
+		err = json.Unmarshal(data, a)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+
+	if err := a.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+	return a, nil
+}
+
+func readZIP(data []byte) (*models.PartyArchive, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+
+	var m manifest
+	a := &models.PartyArchive{}
+	targets := map[string]any{
+		manifestFile:    &m,
+		partyFile:       &a.Party,
+		guestsFile:      &a.Guests,
+		votesFile:       &a.Votes,
+		predictionsFile: &a.Predictions,
+		outcomeFile:     &a.Outcome,
+		actsFile:        &a.Acts,
+	}
+
+	seen := make(map[string]bool, len(targets))
+	for _, f := range zr.File {
+		target, ok := targets[f.Name]
+		if !ok || seen[f.Name] {
+			continue
+		}
+		seen[f.Name] = true
+		if err := readZIPFile(f, target); err != nil {
+			return nil, fmt.Errorf("%s: %w", f.Name, err)
+		}
+	}
+	if !seen[manifestFile] || !seen[partyFile] {
+		return nil, fmt.Errorf("%s and %s are required", manifestFile, partyFile)
+	}
+
+	a.Version = m.Version
+	a.ExportedAt = m.ExportedAt
+	return a, nil
+}
+
+func readZIPFile(f *zip.File, target any) error {
+	rc, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	data, err := io.ReadAll(io.LimitReader(rc, maxFileSize+1))
+	if err != nil {
+		return err
+	}
+	if len(data) > maxFileSize {
+		return fmt.Errorf("file exceeds %d bytes", maxFileSize)
+	}
+	return json.Unmarshal(data, target)
+}
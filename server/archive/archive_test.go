@@ -0,0 +1,108 @@
+package archive_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sipgate/eurovision-vote-party/server/archive"
+	"github.com/sipgate/eurovision-vote-party/server/models"
+)
+
+func testArchive() *models.PartyArchive {
+	now := time.Date(2025, 5, 17, 21, 0, 0, 0, time.UTC)
+	return &models.PartyArchive{
+		Version:    models.ArchiveVersion,
+		ExportedAt: now,
+		Party: models.Party{
+			ID:            "party-1",
+			Name:          "Grand Final Party",
+			Code:          "ABC234",
+			EventType:     models.EventGrandFinal,
+			AdminID:       "admin-1",
+			Status:        models.PartyStatusClosed,
+			CreatedAt:     now,
+			Contest:       models.DefaultContest,
+			ScoringSystem: models.ScoringTop3,
+		},
+		Guests: []models.Guest{
+			{ID: "guest-1", PartyID: "party-1", Username: "alice", Status: models.GuestStatusApproved, CreatedAt: now},
+		},
+		Votes: []models.Vote{
+			{ID: "party-1_guest-1", GuestID: "guest-1", PartyID: "party-1", Votes: map[int]string{3: "act-1", 2: "act-2", 1: "act-3"}, CreatedAt: now},
+		},
+		Predictions: []models.Prediction{
+			{ID: "party-1_guest-1", PartyID: "party-1", GuestID: "guest-1", Picks: []string{"act-1", "act-2", "act-3"}, UpdatedAt: now},
+		},
+		Outcome: &models.PredictionOutcome{PartyID: "party-1", Picks: []string{"act-2", "act-1", "act-3"}, RecordedAt: now},
+		Acts: []models.Act{
+			{ID: "act-1", Contest: models.DefaultContest, Country: "Sweden", Artist: "KAJ", Song: "Bara bada bastu", RunningOrder: 1, EventType: models.EventGrandFinal},
+		},
+	}
+}
+
+func TestWriteRead_RoundTripsEveryFormat(t *testing.T) {
+	for _, format := range []archive.Format{archive.FormatJSON, archive.FormatZIP} {
+		t.Run(string(format), func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, archive.Write(&buf, testArchive(), format))
+
+			got, err := archive.Read(buf.Bytes())
+			require.NoError(t, err)
+			assert.Equal(t, testArchive(), got)
+		})
+	}
+}
+
+func TestWrite_ZIPSplitsRecordTypesIntoFiles(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, archive.Write(&buf, testArchive(), archive.FormatZIP))
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+
+	var names []string
+	for _, f := range zr.File {
+		names = append(names, f.Name)
+	}
+	assert.ElementsMatch(t, []string{
+		"manifest.json", "party.json", "guests.json", "votes.json", "predictions.json", "outcome.json", "acts.json",
+	}, names)
+}
+
+func TestWrite_RejectsUnknownFormat(t *testing.T) {
+	var buf bytes.Buffer
+	assert.Error(t, archive.Write(&buf, testArchive(), "tar"))
+}
+
+func TestRead_RejectsInvalidArchives(t *testing.T) {
+	newer := testArchive()
+	newer.Version = models.ArchiveVersion + 1
+	var newerJSON bytes.Buffer
+	require.NoError(t, archive.Write(&newerJSON, newer, archive.FormatJSON))
+
+	var zipWithoutParty bytes.Buffer
+	zw := zip.NewWriter(&zipWithoutParty)
+	w, err := zw.Create("manifest.json")
+	require.NoError(t, err)
+	w.Write([]byte(`{"version":1}`))
+	require.NoError(t, zw.Close())
+
+	tests := map[string][]byte{
+		"malformed JSON":      []byte("{"),
+		"newer version":       newerJSON.Bytes(),
+		"ZIP without a party": zipWithoutParty.Bytes(),
+		"truncated ZIP":       []byte("PK\x03\x04"),
+	}
+
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := archive.Read(data)
+			assert.ErrorIs(t, err, archive.ErrInvalid)
+		})
+	}
+}
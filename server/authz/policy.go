@@ -0,0 +1,154 @@
+package authz
+
+import (
+	"slices"
+
+	"github.com/sipgate/eurovision-vote-party/server/models"
+)
+
+// Action is something a principal may want to do with a party.
+type Action string
+
+const (
+	// ViewParty covers reading the party's details and its members.
+	ViewParty Action = "party.view"
+	// DeleteParty covers deleting the party with all of its data.
+	DeleteParty Action = "party.delete"
+	// ExportParty covers downloading the party's archive.
+	ExportParty Action = "party.export"
+	// ManageMembers covers inviting co-hosts and moderators, changing their
+	// roles and removing them. Members may always leave on their own.
+	ManageMembers Action = "party.members.manage"
+
+	// ListGuests covers listing the party's approved guests.
+	ListGuests Action = "guests.list"
+	// ManageGuests covers listing join requests, approving, rejecting and
+	// removing guests and revoking their sessions.
+	ManageGuests Action = "guests.manage"
+
+	// ControlVoting covers opening and closing voting.
+	ControlVoting Action = "voting.control"
+	// CastBallot covers filling in, changing and finalizing one's own ballot.
+	CastBallot Action = "ballot.cast"
+	// CastBallotForGuest covers doing so on behalf of any guest of the party.
+	CastBallotForGuest Action = "ballot.castForGuest"
+	// ViewBallot covers reading one's own ballot.
+	ViewBallot Action = "ballot.view"
+	// ViewBallots covers reading the ballot of any guest of the party.
+	ViewBallots Action = "ballots.view"
+
+	// SubmitPrediction covers submitting one's own prediction.
+	SubmitPrediction Action = "prediction.submit"
+	// SubmitPredictionForGuest covers doing so on behalf of any guest of the party.
+	SubmitPredictionForGuest Action = "prediction.submitForGuest"
+	// ViewPrediction covers reading one's own prediction.
+	ViewPrediction Action = "prediction.view"
+	// ViewPredictions covers reading the prediction of any guest of the party.
+	ViewPredictions Action = "predictions.view"
+	// SetPredictionOutcome covers entering the official outcome of the show.
+	SetPredictionOutcome Action = "predictions.outcome"
+	// ViewLeaderboard covers reading the prediction game's leaderboard.
+	ViewLeaderboard Action = "predictions.leaderboard"
+
+	// ViewResults covers reading the results of a closed party, their
+	// comparison with the official scoreboard, the voting statistics and the
+	// state of the reveal.
+	ViewResults Action = "results.view"
+	// ViewResultsDuringReveal covers reading the final ranking before a
+	// started reveal has finished.
+	ViewResultsDuringReveal Action = "results.viewDuringReveal"
+	// RunReveal covers announcing the results step by step and starting over.
+	RunReveal Action = "results.reveal"
+
+	// SubscribeEvents covers streaming the party's activity.
+	SubscribeEvents Action = "events.subscribe"
+)
+
+// relation is how a principal relates to a party. A principal may have
+// several relations to the same party, and may perform an action if any of
+// them is granted it.
+type relation string
+
+const (
+	owner     = relation(models.PartyRoleOwner)
+	coHost    = relation(models.PartyRoleCoHost)
+	moderator = relation(models.PartyRoleModerator)
+	// guest holds a guest session for the party.
+	guest relation = "guest"
+	// outsider is signed in but neither the owner nor an active member.
+	outsider relation = "outsider"
+	// anonymous is not signed in, whether or not they hold a guest session.
+	anonymous relation = "anonymous"
+)
+
+// policy lists the relations granted each action.
+var policy = map[Action][]relation{
+	ViewParty:     {owner, coHost, moderator},
+	DeleteParty:   {owner},
+	ExportParty:   {owner},
+	ManageMembers: {owner},
+
+	ListGuests:   {owner, coHost, moderator, guest},
+	ManageGuests: {owner, coHost, moderator},
+
+	ControlVoting:      {owner, coHost},
+	CastBallot:         {guest},
+	CastBallotForGuest: {owner, coHost},
+	ViewBallot:         {guest},
+	ViewBallots:        {owner, coHost, moderator},
+
+	SubmitPrediction:         {guest},
+	SubmitPredictionForGuest: {owner, coHost},
+	ViewPrediction:           {guest},
+	ViewPredictions:          {owner, coHost, moderator},
+	SetPredictionOutcome:     {owner, coHost},
+	ViewLeaderboard:          {owner, coHost, moderator, guest},
+
+	ViewResults:             {owner, coHost, moderator, guest, anonymous},
+	ViewResultsDuringReveal: {owner, coHost, moderator},
+	RunReveal:               {owner, coHost},
+
+	SubscribeEvents: {owner, coHost, moderator, guest},
+}
+
+// Actions returns every action the policy knows, sorted by name.
+func Actions() []Action {
+	actions := make([]Action, 0, len(policy))
+	for action := range policy {
+		actions = append(actions, action)
+	}
+	slices.Sort(actions)
+	return actions
+}
+
+// Can reports whether the principal may perform the action on the party.
+// Unknown actions and a nil party are always refused.
+func Can(p Principal, action Action, party *models.Party) bool {
+	if party == nil {
+		return false
+	}
+	granted := policy[action]
+	for _, r := range relationsOf(p, party) {
+		if slices.Contains(granted, r) {
+			return true
+		}
+	}
+	return false
+}
+
+// relationsOf returns every relation the principal has to the party.
+func relationsOf(p Principal, party *models.Party) []relation {
+	var relations []relation
+	switch role, ok := party.RoleOf(p.UserID); {
+	case ok:
+		relations = append(relations, relation(role))
+	case p.IsUser():
+		relations = append(relations, outsider)
+	default:
+		relations = append(relations, anonymous)
+	}
+	if p.IsGuestOf(party.ID) {
+		relations = append(relations, guest)
+	}
+	return relations
+}
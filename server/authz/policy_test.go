@@ -0,0 +1,127 @@
+package authz_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sipgate/eurovision-vote-party/server/authz"
+	"github.com/sipgate/eurovision-vote-party/server/models"
+)
+
+func policyParty() *models.Party {
+	invitedAt := time.Date(2025, 5, 17, 18, 0, 0, 0, time.UTC)
+	return &models.Party{
+		ID:      "party-1",
+		AdminID: "owner-1",
+		Members: []models.PartyMember{
+			{UserID: "cohost-1", Role: models.PartyRoleCoHost, Status: models.MemberStatusActive, InvitedAt: invitedAt},
+			{UserID: "moderator-1", Role: models.PartyRoleModerator, Status: models.MemberStatusActive, InvitedAt: invitedAt},
+			{UserID: "invitee-1", Role: models.PartyRoleCoHost, Status: models.MemberStatusInvited, InvitedAt: invitedAt},
+		},
+	}
+}
+
+// principals are the callers every action is checked for.
+var principals = map[string]authz.Principal{
+	"owner":       authz.User("owner-1"),
+	"cohost":      authz.User("cohost-1"),
+	"moderator":   authz.User("moderator-1"),
+	"invitee":     authz.User("invitee-1"),
+	"outsider":    authz.User("outsider-1"),
+	"guest":       authz.Guest("guest-1", "party-1"),
+	"other guest": authz.Guest("guest-2", "party-2"),
+	"anonymous":   authz.Anonymous(),
+}
+
+func TestCan(t *testing.T) {
+	// allowed lists, for every action, the principals that may perform it.
+	allowed := map[authz.Action][]string{
+		authz.ViewParty:     {"owner", "cohost", "moderator"},
+		authz.DeleteParty:   {"owner"},
+		authz.ExportParty:   {"owner"},
+		authz.ManageMembers: {"owner"},
+
+		authz.ListGuests:   {"owner", "cohost", "moderator", "guest"},
+		authz.ManageGuests: {"owner", "cohost", "moderator"},
+
+		authz.ControlVoting:      {"owner", "cohost"},
+		authz.CastBallot:         {"guest"},
+		authz.CastBallotForGuest: {"owner", "cohost"},
+		authz.ViewBallot:         {"guest"},
+		authz.ViewBallots:        {"owner", "cohost", "moderator"},
+
+		authz.SubmitPrediction:         {"guest"},
+		authz.SubmitPredictionForGuest: {"owner", "cohost"},
+		authz.ViewPrediction:           {"guest"},
+		authz.ViewPredictions:          {"owner", "cohost", "moderator"},
+		authz.SetPredictionOutcome:     {"owner", "cohost"},
+		authz.ViewLeaderboard:          {"owner", "cohost", "moderator", "guest"},
+
+		authz.ViewResults:             {"owner", "cohost", "moderator", "guest", "other guest", "anonymous"},
+		authz.ViewResultsDuringReveal: {"owner", "cohost", "moderator"},
+		authz.RunReveal:               {"owner", "cohost"},
+
+		authz.SubscribeEvents: {"owner", "cohost", "moderator", "guest"},
+	}
+
+	var tested []authz.Action
+	for action := range allowed {
+		tested = append(tested, action)
+	}
+	slices.Sort(tested)
+	assert.Equal(t, authz.Actions(), tested, "every action of the policy must be tested")
+
+	party := policyParty()
+	for action, names := range allowed {
+		for name, p := range principals {
+			t.Run(string(action)+"/"+name, func(t *testing.T) {
+				assert.Equal(t, slices.Contains(names, name), authz.Can(p, action, party))
+			})
+		}
+	}
+}
+
+func TestCan_CombinesUserAndGuestSession(t *testing.T) {
+	party := policyParty()
+
+	moderatorAsGuest := authz.User("moderator-1")
+	moderatorAsGuest.GuestID = "guest-1"
+	moderatorAsGuest.GuestPartyID = "party-1"
+	assert.True(t, authz.Can(moderatorAsGuest, authz.CastBallot, party), "the guest session lets moderators vote")
+	assert.True(t, authz.Can(moderatorAsGuest, authz.ManageGuests, party), "the role still applies")
+
+	outsiderAsGuest := authz.User("outsider-1")
+	outsiderAsGuest.GuestID = "guest-1"
+	outsiderAsGuest.GuestPartyID = "party-1"
+	assert.True(t, authz.Can(outsiderAsGuest, authz.ViewResults, party))
+	assert.False(t, authz.Can(outsiderAsGuest, authz.ViewBallots, party))
+}
+
+func TestCan_RefusesUnknownActionsAndMissingParties(t *testing.T) {
+	assert.False(t, authz.Can(authz.User("owner-1"), authz.Action("party.rename"), policyParty()))
+	assert.False(t, authz.Can(authz.User("owner-1"), authz.ViewResults, nil))
+}
+
+func TestPrincipal(t *testing.T) {
+	assert.True(t, authz.Anonymous().IsAnonymous())
+	assert.False(t, authz.User("user-1").IsAnonymous())
+	assert.True(t, authz.User("user-1").IsUser())
+	assert.False(t, authz.Guest("guest-1", "party-1").IsAnonymous())
+	assert.False(t, authz.Guest("guest-1", "party-1").IsUser())
+
+	assert.True(t, authz.Guest("guest-1", "party-1").IsGuestOf("party-1"))
+	assert.False(t, authz.Guest("guest-1", "party-1").IsGuestOf("party-2"))
+	assert.False(t, authz.User("user-1").IsGuestOf("party-1"))
+	assert.False(t, authz.Anonymous().IsGuestOf(""))
+}
+
+func TestFromContext(t *testing.T) {
+	assert.Equal(t, authz.Anonymous(), authz.FromContext(context.Background()))
+
+	ctx := authz.WithPrincipal(context.Background(), authz.Guest("guest-1", "party-1"))
+	assert.Equal(t, authz.Guest("guest-1", "party-1"), authz.FromContext(ctx))
+}
@@ -0,0 +1,63 @@
+// Package authz decides what the caller of a request may do with a party.
+//
+// The HTTP middleware resolves the caller into a Principal and carries it in
+// the request context; services look it up with FromContext and ask Can
+// whether it may perform an Action on the party at hand.
+package authz
+
+import "context"
+
+// Principal identifies the caller of a request. A signed-in user has a
+// UserID, a guest holding a session token has a GuestID and the party the
+// token was issued for. A caller may be both, for example a co-host who also
+// joined their party as a guest, or neither, in which case it is anonymous.
+type Principal struct {
+	UserID       string
+	GuestID      string
+	GuestPartyID string
+}
+
+// User returns the principal of a signed-in user.
+func User(userID string) Principal {
+	return Principal{UserID: userID}
+}
+
+// Guest returns the principal of a guest holding a session for a party.
+func Guest(guestID, partyID string) Principal {
+	return Principal{GuestID: guestID, GuestPartyID: partyID}
+}
+
+// Anonymous returns the principal of a caller who is neither signed in nor a guest.
+func Anonymous() Principal {
+	return Principal{}
+}
+
+// IsAnonymous reports whether the caller is neither signed in nor a guest.
+func (p Principal) IsAnonymous() bool {
+	return p.UserID == "" && p.GuestID == ""
+}
+
+// IsUser reports whether the caller is a signed-in user.
+func (p Principal) IsUser() bool {
+	return p.UserID != ""
+}
+
+// IsGuestOf reports whether the caller holds a guest session for the party.
+func (p Principal) IsGuestOf(partyID string) bool {
+	return p.GuestID != "" && partyID != "" && p.GuestPartyID == partyID
+}
+
+// principalContextKey avoids collisions with other context values.
+type principalContextKey struct{}
+
+// WithPrincipal returns a context carrying the principal.
+func WithPrincipal(ctx context.Context, p Principal) context.Context {
+	return context.WithValue(ctx, principalContextKey{}, p)
+}
+
+// FromContext returns the principal carried by the context, or the anonymous
+// principal if there is none.
+func FromContext(ctx context.Context) Principal {
+	p, _ := ctx.Value(principalContextKey{}).(Principal)
+	return p
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/sipgate/eurovision-vote-party/server/services"
+)
+
+const validateActsUsage = "validate-acts [-output table|json] [path]"
+
+var validateActsCommand = command{
+	usage:       validateActsUsage,
+	description: "check the acts catalogue (default: ACTS_PATH)",
+	offline:     true,
+	run:         runValidateActs,
+}
+
+// runValidateActs checks a catalogue file or directory without connecting to
+// the persistence backend and fails if any file has problems.
+func runValidateActs(_ context.Context, _ *app, args []string, stdout io.Writer) error {
+	fs := newFlagSet("validate-acts", validateActsUsage)
+	output := outputFlag(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := checkOutput(*output); err != nil {
+		return err
+	}
+	if fs.NArg() > 1 {
+		fs.Usage()
+		return errors.New("validate-acts takes at most one path")
+	}
+	path := actsPath()
+	if fs.NArg() == 1 {
+		path = fs.Arg(0)
+	}
+
+	reports, err := services.ValidateCatalogue(path)
+	if err != nil {
+		return err
+	}
+
+	invalid := 0
+	for _, report := range reports {
+		if len(report.Problems) > 0 {
+			invalid++
+		}
+	}
+
+	err = render(stdout, *output, reports, func(w io.Writer) {
+		fmt.Fprintln(w, "FILE\tCONTEST\tACTS\tPROBLEMS")
+		for _, report := range reports {
+			fmt.Fprintf(w, "%s\t%s\t%d\t%d\n", report.File, report.Contest.ID, report.Acts, len(report.Problems))
+		}
+		for _, report := range reports {
+			for _, problem := range report.Problems {
+				fmt.Fprintf(w, "%s: %s\n", report.File, problem)
+			}
+		}
+	})
+	if err != nil {
+		return err
+	}
+	if invalid > 0 {
+		return fmt.Errorf("%d of %d catalogue files have problems", invalid, len(reports))
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateActs(t *testing.T) {
+	var out bytes.Buffer
+	require.NoError(t, runValidateActs(context.Background(), nil, []string{filepath.Join("..", "..", "data", "contests")}, &out))
+	assert.Contains(t, out.String(), "esc-2025")
+}
+
+func TestValidateActs_ReportsProblems(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "esc-2026.json")
+	require.NoError(t, os.WriteFile(file, []byte(`{"acts": [{"id": "se-2026", "country": "Sweden", "runningOrder": 1, "eventType": "grandfinal"}]}`), 0o600))
+
+	var out bytes.Buffer
+	err := runValidateActs(context.Background(), nil, []string{file}, &out)
+	assert.ErrorContains(t, err, "1 of 1 catalogue files have problems")
+	assert.Contains(t, out.String(), `act "se-2026": invalid act`)
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/sipgate/eurovision-vote-party/server/archive"
+)
+
+const (
+	exportUsage = "export [-format json|zip] [-o file] <partyID>"
+	importUsage = "import [-admin userID] <file|->"
+)
+
+var exportCommand = command{
+	usage:       exportUsage,
+	description: "write the archive of a party",
+	run:         runExport,
+}
+
+var importCommand = command{
+	usage:       importUsage,
+	description: "recreate a party from an archive",
+	run:         runImport,
+}
+
+// runExport writes the archive of a party to a file or stdout. The export is
+// made on behalf of the party's admin.
+func runExport(ctx context.Context, a *app, args []string, stdout io.Writer) error {
+	fs := newFlagSet("export", exportUsage)
+	format := fs.String("format", string(archive.FormatJSON), "archive format: json or zip")
+	output := fs.String("o", "", "write the archive to this file instead of stdout")
+	partyID, err := oneArg(fs, args, "party ID")
+	if err != nil {
+		return err
+	}
+	if !archive.Format(*format).IsValid() {
+		return fmt.Errorf("unknown archive format %q", *format)
+	}
+
+	party, err := getParty(ctx, a, partyID)
+	if err != nil {
+		return err
+	}
+
+	exported, err := a.archive.ExportParty(asAdmin(ctx, party), party.ID)
+	if err != nil {
+		return err
+	}
+
+	if *output == "" {
+		return archive.Write(stdout, exported, archive.Format(*format))
+	}
+
+	f, err := os.Create(*output)
+	if err != nil {
+		return err
+	}
+	if err := archive.Write(f, exported, archive.Format(*format)); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// runImport recreates a party from an archive file, or from stdin when the
+// file is "-". The party is owned by the given admin, defaulting to the admin
+// of the archived party.
+func runImport(ctx context.Context, a *app, args []string, stdout io.Writer) error {
+	fs := newFlagSet("import", importUsage)
+	adminID := fs.String("admin", "", "user ID of the admin owning the imported party (default: the archived admin)")
+	file, err := oneArg(fs, args, "archive file")
+	if err != nil {
+		return err
+	}
+
+	var data []byte
+	if file == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(file)
+	}
+	if err != nil {
+		return err
+	}
+
+	imported, err := archive.Read(data)
+	if err != nil {
+		return err
+	}
+	owner := *adminID
+	if owner == "" {
+		owner = imported.Party.AdminID
+	}
+
+	party, err := a.archive.ImportParty(ctx, owner, imported)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(stdout, "imported party %q as %s with code %s\n", party.Name, party.ID, party.Code)
+	return nil
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/persistence/backend"
+)
+
+// newTestApp sets up an app on an empty in-memory backend with the acts
+// catalogue shipped with the server.
+func newTestApp(t *testing.T) *app {
+	t.Helper()
+	ctx := context.Background()
+
+	daos, closeDAOs, err := backend.Open(ctx, backend.Config{Backend: "memory"})
+	require.NoError(t, err)
+	t.Cleanup(closeDAOs)
+
+	a, err := newApp(ctx, daos, filepath.Join("..", "..", "data", "contests"))
+	require.NoError(t, err)
+	return a
+}
+
+func createTestParty(t *testing.T, a *app) *models.Party {
+	t.Helper()
+	ctx := context.Background()
+
+	party := &models.Party{
+		ID: "party-1", Name: "Party", Code: "ABC234", EventType: models.EventGrandFinal,
+		AdminID: "admin-1", Status: models.PartyStatusActive, CreatedAt: time.Now(),
+	}
+	require.NoError(t, a.daos.Party.Create(ctx, party))
+	require.NoError(t, a.daos.Guest.Create(ctx, &models.Guest{
+		ID: "guest-1", PartyID: party.ID, Username: "alice", Status: models.GuestStatusApproved, CreatedAt: time.Now(),
+	}))
+	return party
+}
+
+func TestExportImport(t *testing.T) {
+	ctx := context.Background()
+	a := newTestApp(t)
+	party := createTestParty(t, a)
+	file := filepath.Join(t.TempDir(), "party.zip")
+
+	var out bytes.Buffer
+	require.NoError(t, runExport(ctx, a, []string{"-format", "zip", "-o", file, party.ID}, &out))
+	assert.Empty(t, out.String())
+
+	require.NoError(t, runImport(ctx, a, []string{"-admin", "admin-2", file}, &out))
+	assert.Contains(t, out.String(), `imported party "Party" as `)
+
+	parties, err := a.daos.Party.ListByAdminID(ctx, "admin-2")
+	require.NoError(t, err)
+	require.Len(t, parties, 1)
+	assert.NotEqual(t, party.ID, parties[0].ID, "the copy should get a new ID")
+
+	guests, err := a.daos.Guest.ListByPartyID(ctx, parties[0].ID)
+	require.NoError(t, err)
+	require.Len(t, guests, 1)
+	assert.Equal(t, "alice", guests[0].Username)
+}
+
+func TestExport_WritesJSONToStdout(t *testing.T) {
+	a := newTestApp(t)
+	party := createTestParty(t, a)
+
+	var out bytes.Buffer
+	require.NoError(t, runExport(context.Background(), a, []string{party.ID}, &out))
+
+	assert.Contains(t, out.String(), `"version": 1`)
+	assert.Contains(t, out.String(), `"username": "alice"`)
+}
+
+func TestExport_Errors(t *testing.T) {
+	a := newTestApp(t)
+	createTestParty(t, a)
+
+	for name, args := range map[string][]string{
+		"no party":       {},
+		"unknown party":  {"missing"},
+		"unknown format": {"-format", "tar", "party-1"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var out bytes.Buffer
+			assert.Error(t, runExport(context.Background(), a, args, &out))
+		})
+	}
+}
+
+func TestImport_RejectsInvalidArchives(t *testing.T) {
+	a := newTestApp(t)
+	file := filepath.Join(t.TempDir(), "party.json")
+	require.NoError(t, os.WriteFile(file, []byte(`{"version": 1}`), 0o600))
+
+	var out bytes.Buffer
+	assert.Error(t, runImport(context.Background(), a, []string{file}, &out))
+}
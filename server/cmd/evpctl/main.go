@@ -0,0 +1,191 @@
+// Command evpctl operates on the data of a Eurovision Vote Party server.
+//
+// It connects to the same persistence backend as the server, configured by the
+// same environment variables (PERSISTENCE_BACKEND, DATABASE_URL,
+// FIREBASE_PROJECT_ID and ACTS_PATH), and works through the services layer.
+//
+// Usage:
+//
+//	evpctl <command> [flags] [arguments]
+//
+// Run evpctl without arguments to list the commands.
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+
+	"cloud.google.com/go/firestore"
+	firebase "firebase.google.com/go/v4"
+
+	"github.com/sipgate/eurovision-vote-party/server/persistence/backend"
+	"github.com/sipgate/eurovision-vote-party/server/services"
+)
+
+// command is a subcommand of evpctl.
+type command struct {
+	usage       string
+	description string
+	// offline commands do not connect to the persistence backend and get a nil app.
+	offline bool
+	run     func(ctx context.Context, a *app, args []string, stdout io.Writer) error
+}
+
+var commands = map[string]command{
+	"parties":       partiesCommand,
+	"guests":        guestsCommand,
+	"votes":         votesCommand,
+	"results":       resultsCommand,
+	"end-voting":    endVotingCommand,
+	"delete":        deleteCommand,
+	"seed-demo":     seedDemoCommand,
+	"validate-acts": validateActsCommand,
+	"export":        exportCommand,
+	"import":        importCommand,
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("evpctl: ")
+
+	if len(os.Args) < 2 {
+		printUsage(os.Stderr)
+		os.Exit(2)
+	}
+	cmd, ok := commands[os.Args[1]]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "evpctl: unknown command %q\n\n", os.Args[1])
+		printUsage(os.Stderr)
+		os.Exit(2)
+	}
+
+	ctx := context.Background()
+	if cmd.offline {
+		if err := cmd.run(ctx, nil, os.Args[2:], os.Stdout); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	a, err := openApp(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer a.close()
+
+	if err := cmd.run(ctx, a, os.Args[2:], os.Stdout); err != nil {
+		a.close()
+		log.Fatal(err)
+	}
+}
+
+func printUsage(w io.Writer) {
+	fmt.Fprintln(w, "Usage: evpctl <command> [flags] [arguments]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Commands:")
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(w, "  %-48s %s\n", commands[name].usage, commands[name].description)
+	}
+}
+
+// newFlagSet creates the flag set of a command with the given usage line.
+// Parse errors are returned rather than ending the process.
+func newFlagSet(name, usage string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: evpctl %s\n", usage)
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+// app holds the DAOs and services commands work with.
+type app struct {
+	daos    *backend.DAOs
+	parties services.PartyService
+	guests  services.GuestService
+	votes   services.VoteService
+	acts    services.ActsService
+	archive services.ArchiveService
+	close   func()
+}
+
+// openApp connects to the configured persistence backend and sets up the services.
+func openApp(ctx context.Context) (*app, error) {
+	cfg := backend.ConfigFromEnv()
+	cfg.Firestore = firestoreClient
+	daos, closeDAOs, err := backend.Open(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := newApp(ctx, daos, actsPath())
+	if err != nil {
+		closeDAOs()
+		return nil, err
+	}
+	a.close = closeDAOs
+	return a, nil
+}
+
+// newApp sets up the services on top of the given DAOs, loading the acts
+// catalogue from actsPath. Commands act on behalf of the admin of the party
+// they work on.
+func newApp(ctx context.Context, daos *backend.DAOs, actsPath string) (*app, error) {
+	actsService, err := services.NewActsService(ctx, daos.Act, actsPath, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load acts data: %w", err)
+	}
+
+	// Guest session tokens issued by the CLI are never handed out, so a
+	// random signing key will do.
+	guestTokenKey := make([]byte, 32)
+	if _, err := rand.Read(guestTokenKey); err != nil {
+		return nil, err
+	}
+
+	return &app{
+		daos:    daos,
+		parties: services.NewPartyService(daos.Party, actsService),
+		guests:  services.NewGuestService(daos.Guest, daos.Party, guestTokenKey, nil),
+		votes:   services.NewVoteService(daos.Vote, daos.Party, daos.Guest, actsService, nil),
+		acts:    actsService,
+		archive: services.NewArchiveService(daos.Party, daos.Guest, daos.Vote, daos.Prediction, actsService),
+		close:   func() {},
+	}, nil
+}
+
+// firestoreClient connects to the Firestore database of FIREBASE_PROJECT_ID,
+// or of the default credentials' project when it is not set.
+func firestoreClient(ctx context.Context) (*firestore.Client, error) {
+	var cfg *firebase.Config
+	if projectID := os.Getenv("FIREBASE_PROJECT_ID"); projectID != "" {
+		cfg = &firebase.Config{ProjectID: projectID}
+	}
+
+	fb, err := firebase.NewApp(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return fb.Firestore(ctx)
+}
+
+// actsPath returns the acts catalogue location: ACTS_PATH if set, otherwise the
+// directory of per-edition files shipped with the server.
+func actsPath() string {
+	if path := os.Getenv("ACTS_PATH"); path != "" {
+		return path
+	}
+	return "data/contests"
+}
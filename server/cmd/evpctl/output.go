@@ -0,0 +1,68 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+)
+
+// Output formats of the commands that print records.
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+)
+
+// outputFlag registers the -output flag of a command that prints records.
+func outputFlag(fs *flag.FlagSet) *string {
+	return fs.String("output", outputTable, "output format: table or json")
+}
+
+// checkOutput rejects unknown output formats before a command changes anything.
+func checkOutput(format string) error {
+	if format != outputTable && format != outputJSON {
+		return fmt.Errorf("unknown output format %q", format)
+	}
+	return nil
+}
+
+// render prints v as indented JSON, or as a table whose header and rows are
+// written by table as tab-separated cells.
+func render(w io.Writer, format string, v any, table func(tw io.Writer)) error {
+	switch format {
+	case outputJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	case outputTable:
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		table(tw)
+		return tw.Flush()
+	default:
+		return checkOutput(format)
+	}
+}
+
+// formatTime formats timestamps for tables; the zero time is shown as "-".
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+	return t.Local().Format(time.DateTime)
+}
+
+// oneArg parses the flags of a command that takes exactly one argument, such
+// as a party ID, and returns the argument.
+func oneArg(fs *flag.FlagSet, args []string, name string) (string, error) {
+	if err := fs.Parse(args); err != nil {
+		return "", err
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return "", errors.New(fs.Name() + " needs exactly one " + name)
+	}
+	return fs.Arg(0), nil
+}
@@ -0,0 +1,297 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/sipgate/eurovision-vote-party/server/authz"
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/persistence"
+	"github.com/sipgate/eurovision-vote-party/server/services"
+)
+
+const (
+	partiesUsage   = "parties [-output table|json] <adminID>"
+	guestsUsage    = "guests [-output table|json] <partyID>"
+	votesUsage     = "votes [-output table|json] <partyID>"
+	resultsUsage   = "results [-output table|json] <partyID>"
+	endVotingUsage = "end-voting [-output table|json] <partyID>"
+	deleteUsage    = "delete -yes <partyID>"
+)
+
+var partiesCommand = command{
+	usage:       partiesUsage,
+	description: "list the parties of an admin",
+	run:         runParties,
+}
+
+var guestsCommand = command{
+	usage:       guestsUsage,
+	description: "list the guests of a party",
+	run:         runGuests,
+}
+
+var votesCommand = command{
+	usage:       votesUsage,
+	description: "list the ballots of a party, including drafts",
+	run:         runVotes,
+}
+
+var resultsCommand = command{
+	usage:       resultsUsage,
+	description: "tally the results of a closed party",
+	run:         runResults,
+}
+
+var endVotingCommand = command{
+	usage:       endVotingUsage,
+	description: "close voting of a party",
+	run:         runEndVoting,
+}
+
+var deleteCommand = command{
+	usage:       deleteUsage,
+	description: "delete a party with its guests, ballots and predictions",
+	run:         runDelete,
+}
+
+// getParty loads a party so that commands can act on behalf of its admin.
+func getParty(ctx context.Context, a *app, partyID string) (*models.Party, error) {
+	party, err := a.daos.Party.GetByID(ctx, partyID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNotFound) {
+			return nil, fmt.Errorf("party %q not found", partyID)
+		}
+		return nil, err
+	}
+	return party, nil
+}
+
+// asAdmin returns a context in which services see the party's admin as the caller.
+func asAdmin(ctx context.Context, party *models.Party) context.Context {
+	return authz.WithPrincipal(ctx, authz.User(party.AdminID))
+}
+
+func runParties(ctx context.Context, a *app, args []string, stdout io.Writer) error {
+	fs := newFlagSet("parties", partiesUsage)
+	output := outputFlag(fs)
+	adminID, err := oneArg(fs, args, "admin ID")
+	if err != nil {
+		return err
+	}
+	if err := checkOutput(*output); err != nil {
+		return err
+	}
+
+	parties, err := a.parties.ListPartiesByAdmin(ctx, adminID)
+	if err != nil {
+		return err
+	}
+	sort.Slice(parties, func(i, j int) bool { return parties[i].CreatedAt.After(parties[j].CreatedAt) })
+
+	return render(stdout, *output, parties, func(w io.Writer) {
+		fmt.Fprintln(w, "ID\tCODE\tNAME\tCONTEST\tEVENT\tSCORING\tSTATUS\tCREATED")
+		for _, p := range parties {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+				p.ID, p.Code, p.Name, p.Edition(), p.EventType, p.Scoring(), p.Status, formatTime(p.CreatedAt))
+		}
+	})
+}
+
+func runGuests(ctx context.Context, a *app, args []string, stdout io.Writer) error {
+	fs := newFlagSet("guests", guestsUsage)
+	output := outputFlag(fs)
+	partyID, err := oneArg(fs, args, "party ID")
+	if err != nil {
+		return err
+	}
+	if err := checkOutput(*output); err != nil {
+		return err
+	}
+
+	party, err := getParty(ctx, a, partyID)
+	if err != nil {
+		return err
+	}
+	guests, err := a.guests.ListGuests(asAdmin(ctx, party), party.ID)
+	if err != nil {
+		return err
+	}
+
+	return render(stdout, *output, guests, func(w io.Writer) {
+		fmt.Fprintln(w, "ID\tUSERNAME\tSTATUS\tJOINED")
+		for _, g := range guests {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", g.ID, g.Username, g.Status, formatTime(g.CreatedAt))
+		}
+	})
+}
+
+// guestBallot pairs a guest with their ballot for the votes command.
+type guestBallot struct {
+	Guest *models.Guest `json:"guest"`
+	Vote  *models.Vote  `json:"vote"`
+}
+
+func runVotes(ctx context.Context, a *app, args []string, stdout io.Writer) error {
+	fs := newFlagSet("votes", votesUsage)
+	output := outputFlag(fs)
+	partyID, err := oneArg(fs, args, "party ID")
+	if err != nil {
+		return err
+	}
+	if err := checkOutput(*output); err != nil {
+		return err
+	}
+
+	party, err := getParty(ctx, a, partyID)
+	if err != nil {
+		return err
+	}
+	guests, err := a.guests.ListGuests(asAdmin(ctx, party), party.ID)
+	if err != nil {
+		return err
+	}
+
+	ballots := make([]guestBallot, 0, len(guests))
+	for _, g := range guests {
+		vote, err := a.votes.GetVotes(asAdmin(ctx, party), party.ID, g.ID)
+		if errors.Is(err, services.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		ballots = append(ballots, guestBallot{Guest: g, Vote: vote})
+	}
+
+	return render(stdout, *output, ballots, func(w io.Writer) {
+		fmt.Fprintln(w, "GUEST\tUSERNAME\tSTATE\tBALLOT")
+		for _, b := range ballots {
+			state := "final"
+			if b.Vote.Draft {
+				state = "draft"
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", b.Guest.ID, b.Guest.Username, state, formatBallot(b.Vote))
+		}
+	})
+}
+
+// formatBallot summarizes a ballot as its points or ratings, highest first.
+func formatBallot(v *models.Vote) string {
+	var parts []string
+	if len(v.Ratings) > 0 {
+		actIDs := make([]string, 0, len(v.Ratings))
+		for actID := range v.Ratings {
+			actIDs = append(actIDs, actID)
+		}
+		sort.Slice(actIDs, func(i, j int) bool {
+			if v.Ratings[actIDs[i]] != v.Ratings[actIDs[j]] {
+				return v.Ratings[actIDs[i]] > v.Ratings[actIDs[j]]
+			}
+			return actIDs[i] < actIDs[j]
+		})
+		for _, actID := range actIDs {
+			parts = append(parts, fmt.Sprintf("%s=%d", actID, v.Ratings[actID]))
+		}
+		return strings.Join(parts, " ")
+	}
+
+	points := make([]int, 0, len(v.Votes))
+	for p := range v.Votes {
+		points = append(points, p)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(points)))
+	for _, p := range points {
+		parts = append(parts, fmt.Sprintf("%d:%s", p, v.Votes[p]))
+	}
+	return strings.Join(parts, " ")
+}
+
+func runResults(ctx context.Context, a *app, args []string, stdout io.Writer) error {
+	fs := newFlagSet("results", resultsUsage)
+	output := outputFlag(fs)
+	partyID, err := oneArg(fs, args, "party ID")
+	if err != nil {
+		return err
+	}
+	if err := checkOutput(*output); err != nil {
+		return err
+	}
+
+	party, err := getParty(ctx, a, partyID)
+	if err != nil {
+		return err
+	}
+	results, err := a.votes.GetResults(asAdmin(ctx, party), party.ID)
+	if err != nil {
+		return err
+	}
+
+	return render(stdout, *output, results, func(w io.Writer) {
+		fmt.Fprintf(w, "%s: %d voters, %d incomplete ballots\n", results.PartyName, results.TotalVoters, results.IncompleteBallots)
+		fmt.Fprintln(w, "RANK\tACT\tCOUNTRY\tPOINTS\tVOTERS\tTIE-BREAK")
+		for _, r := range results.Results {
+			points := fmt.Sprint(r.TotalPoints)
+			if r.AverageScore != 0 {
+				points = fmt.Sprintf("%.2f", r.AverageScore)
+			}
+			tieBreak := "-"
+			if r.TieBreak != nil {
+				tieBreak = string(r.TieBreak.Rule)
+			}
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%d\t%s\n", r.Rank, r.ActID, r.Country, points, r.Voters, tieBreak)
+		}
+	})
+}
+
+func runEndVoting(ctx context.Context, a *app, args []string, stdout io.Writer) error {
+	fs := newFlagSet("end-voting", endVotingUsage)
+	output := outputFlag(fs)
+	partyID, err := oneArg(fs, args, "party ID")
+	if err != nil {
+		return err
+	}
+	if err := checkOutput(*output); err != nil {
+		return err
+	}
+
+	party, err := getParty(ctx, a, partyID)
+	if err != nil {
+		return err
+	}
+	closed, err := a.votes.EndVoting(asAdmin(ctx, party), party.ID)
+	if err != nil {
+		return err
+	}
+
+	return render(stdout, *output, closed, func(w io.Writer) {
+		fmt.Fprintf(w, "voting of party %q (%s) is %s\n", closed.Name, closed.ID, closed.Status)
+	})
+}
+
+func runDelete(ctx context.Context, a *app, args []string, stdout io.Writer) error {
+	fs := newFlagSet("delete", deleteUsage)
+	yes := fs.Bool("yes", false, "confirm that the party and all of its data should be deleted")
+	partyID, err := oneArg(fs, args, "party ID")
+	if err != nil {
+		return err
+	}
+	if !*yes {
+		return errors.New("refusing to delete without -yes")
+	}
+
+	party, err := getParty(ctx, a, partyID)
+	if err != nil {
+		return err
+	}
+	if err := a.parties.DeleteParty(asAdmin(ctx, party), party.ID); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(stdout, "deleted party %q (%s)\n", party.Name, party.ID)
+	return nil
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sipgate/eurovision-vote-party/server/models"
+)
+
+func TestParties(t *testing.T) {
+	a := newTestApp(t)
+	party := createTestParty(t, a)
+
+	var out bytes.Buffer
+	require.NoError(t, runParties(context.Background(), a, []string{"admin-1"}, &out))
+	assert.Contains(t, out.String(), "CODE")
+	assert.Contains(t, out.String(), party.Code)
+
+	out.Reset()
+	require.NoError(t, runParties(context.Background(), a, []string{"-output", "json", "admin-2"}, &out))
+	assert.JSONEq(t, `[]`, out.String())
+}
+
+func TestGuests(t *testing.T) {
+	a := newTestApp(t)
+	party := createTestParty(t, a)
+
+	var out bytes.Buffer
+	require.NoError(t, runGuests(context.Background(), a, []string{"-output", "json", party.ID}, &out))
+
+	var guests []models.Guest
+	require.NoError(t, json.Unmarshal(out.Bytes(), &guests))
+	require.Len(t, guests, 1)
+	assert.Equal(t, "alice", guests[0].Username)
+}
+
+func TestVotes(t *testing.T) {
+	ctx := context.Background()
+	a := newTestApp(t)
+	party := createTestParty(t, a)
+	require.NoError(t, a.daos.Guest.Create(ctx, &models.Guest{
+		ID: "guest-2", PartyID: party.ID, Username: "bob", Status: models.GuestStatusApproved, CreatedAt: time.Now(),
+	}))
+	require.NoError(t, a.daos.Vote.Create(ctx, &models.Vote{
+		ID: models.VoteIDFor(party.ID, "guest-1"), GuestID: "guest-1", PartyID: party.ID,
+		Votes: map[int]string{12: "se-2025"}, Draft: true, CreatedAt: time.Now(),
+	}))
+
+	var out bytes.Buffer
+	require.NoError(t, runVotes(ctx, a, []string{party.ID}, &out))
+
+	assert.Contains(t, out.String(), "alice")
+	assert.Contains(t, out.String(), "draft")
+	assert.Contains(t, out.String(), "12:se-2025")
+	assert.NotContains(t, out.String(), "bob", "guests without a ballot are left out")
+}
+
+func TestEndVotingAndResults(t *testing.T) {
+	ctx := context.Background()
+	a := newTestApp(t)
+	party := createTestParty(t, a)
+
+	var out bytes.Buffer
+	assert.Error(t, runResults(ctx, a, []string{party.ID}, &out), "results need closed voting")
+
+	require.NoError(t, runEndVoting(ctx, a, []string{party.ID}, &out))
+	assert.Contains(t, out.String(), "is closed")
+
+	out.Reset()
+	require.NoError(t, runResults(ctx, a, []string{party.ID}, &out))
+	assert.Contains(t, out.String(), "Party: 0 voters")
+	assert.Contains(t, out.String(), "RANK")
+}
+
+func TestDelete(t *testing.T) {
+	ctx := context.Background()
+	a := newTestApp(t)
+	party := createTestParty(t, a)
+
+	var out bytes.Buffer
+	assert.Error(t, runDelete(ctx, a, []string{party.ID}, &out), "deleting needs -yes")
+
+	require.NoError(t, runDelete(ctx, a, []string{"-yes", party.ID}, &out))
+	assert.Contains(t, out.String(), "deleted party")
+
+	guests, err := a.daos.Guest.ListByPartyID(ctx, party.ID)
+	require.NoError(t, err)
+	assert.Empty(t, guests)
+
+	assert.ErrorContains(t, runDelete(ctx, a, []string{"-yes", party.ID}, &out), "not found")
+}
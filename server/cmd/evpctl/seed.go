@@ -0,0 +1,127 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/services"
+)
+
+const seedDemoUsage = "seed-demo [-guests n] [-scoring s] [-closed] <adminID>"
+
+var seedDemoCommand = command{
+	usage:       seedDemoUsage,
+	description: "create a grand final party with guests and random ballots",
+	run:         runSeedDemo,
+}
+
+// demoGuests are the usernames of seeded guests, numbered when more are needed.
+var demoGuests = []string{"alice", "bob", "carol", "dave", "erin", "frank", "grace", "heidi", "ivan", "judy"}
+
+func runSeedDemo(ctx context.Context, a *app, args []string, stdout io.Writer) error {
+	fs := newFlagSet("seed-demo", seedDemoUsage)
+	name := fs.String("name", "Demo Party", "name of the party")
+	guests := fs.Int("guests", 5, "number of guests casting a ballot")
+	scoring := fs.String("scoring", string(models.DefaultScoringSystem), "scoring system of the party")
+	closed := fs.Bool("closed", false, "close voting once the ballots are cast")
+	output := outputFlag(fs)
+	adminID, err := oneArg(fs, args, "admin ID")
+	if err != nil {
+		return err
+	}
+	if err := checkOutput(*output); err != nil {
+		return err
+	}
+	if *guests < 0 {
+		return errors.New("the number of guests must not be negative")
+	}
+	if !models.ScoringSystem(*scoring).IsValid() {
+		return fmt.Errorf("unknown scoring system %q", *scoring)
+	}
+
+	party, err := a.parties.CreateParty(ctx, adminID, services.CreatePartyRequest{
+		Name:          *name,
+		EventType:     models.EventGrandFinal,
+		ScoringSystem: models.ScoringSystem(*scoring),
+	})
+	if err != nil {
+		return err
+	}
+
+	acts, err := a.acts.ListActs(ctx, party.Edition(), string(party.EventType))
+	if err != nil {
+		return err
+	}
+	actIDs := make([]string, 0, len(acts))
+	for _, act := range acts {
+		actIDs = append(actIDs, act.ID)
+	}
+
+	for i := range *guests {
+		username := demoGuests[i%len(demoGuests)]
+		if i >= len(demoGuests) {
+			username = fmt.Sprintf("%s%d", username, i/len(demoGuests)+1)
+		}
+		guest, _, err := a.guests.JoinParty(ctx, party.Code, username)
+		if err != nil {
+			return err
+		}
+		if err := a.guests.ApproveGuest(asAdmin(ctx, party), party.ID, guest.ID); err != nil {
+			return err
+		}
+
+		req := randomBallot(party.Scoring(), actIDs)
+		req.GuestID = guest.ID
+		if _, err := a.votes.SubmitVote(asAdmin(ctx, party), party.ID, req); err != nil {
+			return fmt.Errorf("failed to vote as %s: %w", username, err)
+		}
+	}
+
+	if *closed {
+		if party, err = a.votes.EndVoting(asAdmin(ctx, party), party.ID); err != nil {
+			return err
+		}
+	}
+
+	return render(stdout, *output, party, func(w io.Writer) {
+		fmt.Fprintf(w, "created party %q (%s) with code %s, %d ballots, %s\n",
+			party.Name, party.ID, party.Code, *guests, party.Status)
+	})
+}
+
+// randomBallot fills a complete ballot for the scoring system with randomly
+// chosen acts.
+func randomBallot(scoring models.ScoringSystem, actIDs []string) services.SubmitVoteRequest {
+	shuffled := append([]string(nil), actIDs...)
+	rand.Shuffle(len(shuffled), func(i, j int) { shuffled[i], shuffled[j] = shuffled[j], shuffled[i] })
+
+	var points []int
+	switch scoring {
+	case models.ScoringRating:
+		ratings := make(map[string]int, len(actIDs))
+		for _, actID := range actIDs {
+			ratings[actID] = models.MinRating + rand.IntN(models.MaxRating-models.MinRating+1)
+		}
+		return services.SubmitVoteRequest{Ratings: ratings}
+	case models.ScoringBorda:
+		for p := len(shuffled); p >= 1; p-- {
+			points = append(points, p)
+		}
+	case models.ScoringTop3:
+		points = models.Top3PointValues
+	default:
+		points = models.ValidPointValues
+	}
+
+	votes := make(map[int]string, len(points))
+	for i, p := range points {
+		if i < len(shuffled) {
+			votes[p] = shuffled[i]
+		}
+	}
+	return services.SubmitVoteRequest{Votes: votes}
+}
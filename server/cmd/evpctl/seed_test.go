@@ -0,0 +1,51 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sipgate/eurovision-vote-party/server/models"
+)
+
+func TestSeedDemo(t *testing.T) {
+	for _, scoring := range models.ScoringSystems() {
+		t.Run(string(scoring), func(t *testing.T) {
+			ctx := context.Background()
+			a := newTestApp(t)
+
+			var out bytes.Buffer
+			require.NoError(t, runSeedDemo(ctx, a, []string{"-guests", "12", "-scoring", string(scoring), "-closed", "-output", "json", "admin-1"}, &out))
+
+			var party models.Party
+			require.NoError(t, json.Unmarshal(out.Bytes(), &party))
+			assert.Equal(t, scoring, party.ScoringSystem)
+			assert.Equal(t, models.PartyStatusClosed, party.Status)
+
+			results, err := a.votes.GetResults(asAdmin(ctx, &party), party.ID)
+			require.NoError(t, err)
+			assert.Equal(t, 12, results.TotalVoters)
+			assert.Zero(t, results.IncompleteBallots)
+		})
+	}
+}
+
+func TestSeedDemo_Errors(t *testing.T) {
+	a := newTestApp(t)
+
+	for name, args := range map[string][]string{
+		"no admin":        {},
+		"unknown scoring": {"-scoring", "plurality", "admin-1"},
+		"negative guests": {"-guests", "-1", "admin-1"},
+		"unknown output":  {"-output", "yaml", "admin-1"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var out bytes.Buffer
+			assert.Error(t, runSeedDemo(context.Background(), a, args, &out))
+		})
+	}
+}
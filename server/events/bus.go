@@ -0,0 +1,207 @@
+// Package events distributes party activity to live subscribers.
+//
+// The Bus is an in-process broadcaster: services publish events for a party and
+// every subscriber of that party receives them in order. While a party has
+// subscribers it keeps a bounded history so that reconnecting clients can
+// resume after the last event they have seen.
+package events
+
+import (
+	"sync"
+	"time"
+)
+
+// Type identifies the kind of party activity an event describes.
+type Type string
+
+const (
+	// GuestJoined is published when a guest requests to join a party.
+	GuestJoined Type = "guest.joined"
+	// GuestApproved is published when the admin approves a guest.
+	GuestApproved Type = "guest.approved"
+	// GuestRejected is published when the admin rejects a guest.
+	GuestRejected Type = "guest.rejected"
+	// GuestRemoved is published when the admin removes a guest.
+	GuestRemoved Type = "guest.removed"
+	// VoteSubmitted is published when a guest submits a ballot.
+	VoteSubmitted Type = "vote.submitted"
+	// VoteUpdated is published when a guest changes a ballot.
+	VoteUpdated Type = "vote.updated"
+	// VotingStarted is published when the admin opens voting, locking predictions.
+	VotingStarted Type = "voting.started"
+	// VotingEnded is published when the admin closes voting.
+	VotingEnded Type = "voting.ended"
+	// ResultsAvailable is published once the results of a party can be fetched.
+	ResultsAvailable Type = "results.available"
+	// RevealUpdated is published when the admin advances or resets the results reveal.
+	RevealUpdated Type = "reveal.updated"
+	// PredictionSubmitted is published when a guest submits or changes a prediction.
+	PredictionSubmitted Type = "prediction.submitted"
+	// PredictionOutcomeRecorded is published when the admin records the official outcome.
+	PredictionOutcomeRecorded Type = "prediction.outcome"
+	// Resync tells a subscriber that events were missed and its state should be reloaded.
+	Resync Type = "resync"
+)
+
+// DefaultHistory is the number of events kept per party for resuming subscribers.
+const DefaultHistory = 256
+
+// subscriberBuffer is the number of events a subscriber may lag behind before it is dropped.
+const subscriberBuffer = 64
+
+// Event describes a single piece of party activity.
+// IDs increase across all parties of a bus, starting at 1.
+type Event struct {
+	ID        uint64    `json:"id"`
+	PartyID   string    `json:"partyId"`
+	Type      Type      `json:"type"`
+	Data      any       `json:"data,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// Bus fans out published events to the subscribers of a party.
+type Bus struct {
+	mu      sync.Mutex
+	history int
+	lastID  uint64
+	parties map[string]*stream
+}
+
+// stream holds the state of a party with subscribers. It is dropped when the
+// last subscriber leaves, so the bus only keeps state for watched parties.
+type stream struct {
+	// since is the ID of the last event published before history; every
+	// later event of the party is in history.
+	since       uint64
+	history     []Event
+	subscribers map[*Subscription]struct{}
+}
+
+// NewBus creates a Bus that keeps up to history events per party.
+func NewBus(history int) *Bus {
+	if history < 1 {
+		history = DefaultHistory
+	}
+	return &Bus{history: history, parties: make(map[string]*stream)}
+}
+
+// Publish records an event for the party and delivers it to all current subscribers.
+// Events of parties without subscribers are not kept.
+// Subscribers that cannot keep up are dropped; their channel is closed so they can
+// reconnect and resume from the last event they received.
+func (b *Bus) Publish(partyID string, typ Type, data any) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.lastID++
+	s, ok := b.parties[partyID]
+	if !ok {
+		return
+	}
+	event := Event{
+		ID:        b.lastID,
+		PartyID:   partyID,
+		Type:      typ,
+		Data:      data,
+		CreatedAt: time.Now(),
+	}
+
+	s.history = append(s.history, event)
+	if dropped := len(s.history) - b.history; dropped > 0 {
+		s.since = s.history[dropped-1].ID
+		s.history = s.history[dropped:]
+	}
+
+	for sub := range s.subscribers {
+		select {
+		case sub.events <- event:
+		default:
+			delete(s.subscribers, sub)
+			close(sub.events)
+		}
+	}
+	if len(s.subscribers) == 0 {
+		delete(b.parties, partyID)
+	}
+}
+
+// Subscribe registers a subscriber for the party.
+// lastEventID is the ID of the last event the subscriber has seen, or 0 for a new subscriber.
+// The returned backlog contains the buffered events after lastEventID. If events
+// after lastEventID are no longer buffered, for example because the party had
+// no subscribers for a while, or lastEventID is unknown to this bus, the
+// backlog consists of a single Resync event instead.
+func (b *Bus) Subscribe(partyID string, lastEventID uint64) (*Subscription, []Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	s, ok := b.parties[partyID]
+	if !ok {
+		s = &stream{since: b.lastID, subscribers: make(map[*Subscription]struct{})}
+		b.parties[partyID] = s
+	}
+	sub := &Subscription{
+		bus:     b,
+		partyID: partyID,
+		stream:  s,
+		events:  make(chan Event, subscriberBuffer),
+	}
+	s.subscribers[sub] = struct{}{}
+
+	if lastEventID == 0 {
+		return sub, nil
+	}
+
+	if lastEventID < s.since || lastEventID > b.lastID {
+		return sub, []Event{{
+			ID:        b.lastID,
+			PartyID:   partyID,
+			Type:      Resync,
+			CreatedAt: time.Now(),
+		}}
+	}
+
+	first := len(s.history)
+	for i, event := range s.history {
+		if event.ID > lastEventID {
+			first = i
+			break
+		}
+	}
+	if first == len(s.history) {
+		return sub, nil
+	}
+	backlog := make([]Event, len(s.history)-first)
+	copy(backlog, s.history[first:])
+	return sub, backlog
+}
+
+// Subscription receives the events of a single party.
+type Subscription struct {
+	bus     *Bus
+	partyID string
+	stream  *stream
+	events  chan Event
+}
+
+// Events returns the channel on which events are delivered.
+// The channel is closed when the subscription ends.
+func (s *Subscription) Events() <-chan Event {
+	return s.events
+}
+
+// Close ends the subscription, dropping the party's state if it was the last
+// subscriber. It is safe to call Close more than once.
+func (s *Subscription) Close() {
+	s.bus.mu.Lock()
+	defer s.bus.mu.Unlock()
+
+	if _, ok := s.stream.subscribers[s]; !ok {
+		return
+	}
+	delete(s.stream.subscribers, s)
+	close(s.events)
+	if len(s.stream.subscribers) == 0 && s.bus.parties[s.partyID] == s.stream {
+		delete(s.bus.parties, s.partyID)
+	}
+}
@@ -0,0 +1,31 @@
+package events
+
+import "testing"
+
+func TestBusDropsPartiesWithoutSubscribers(t *testing.T) {
+	bus := NewBus(DefaultHistory)
+	first, _ := bus.Subscribe("party-1", 0)
+	second, _ := bus.Subscribe("party-1", 0)
+	bus.Publish("party-1", GuestJoined, nil)
+	bus.Publish("party-2", GuestJoined, nil)
+
+	first.Close()
+	if len(bus.parties) != 1 {
+		t.Fatalf("parties = %d while party-1 is watched, want 1", len(bus.parties))
+	}
+	second.Close()
+	second.Close()
+	if len(bus.parties) != 0 {
+		t.Fatalf("parties = %d after the last subscriber left, want 0", len(bus.parties))
+	}
+
+	// Subscribers dropped for falling behind count as leaving.
+	slow, _ := bus.Subscribe("party-1", 0)
+	for i := 0; i <= subscriberBuffer; i++ {
+		bus.Publish("party-1", VoteSubmitted, nil)
+	}
+	if len(bus.parties) != 0 {
+		t.Fatalf("parties = %d after the slow subscriber was dropped, want 0", len(bus.parties))
+	}
+	slow.Close()
+}
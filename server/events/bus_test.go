@@ -0,0 +1,162 @@
+package events_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sipgate/eurovision-vote-party/server/events"
+)
+
+func receive(t *testing.T, sub *events.Subscription) events.Event {
+	t.Helper()
+	select {
+	case event, ok := <-sub.Events():
+		require.True(t, ok, "subscription closed unexpectedly")
+		return event
+	default:
+		t.Fatal("expected an event")
+		return events.Event{}
+	}
+}
+
+func TestBus_PublishDeliversToSubscribersOfTheParty(t *testing.T) {
+	bus := events.NewBus(events.DefaultHistory)
+	sub, backlog := bus.Subscribe("party-1", 0)
+	defer sub.Close()
+	other, _ := bus.Subscribe("party-2", 0)
+	defer other.Close()
+
+	bus.Publish("party-1", events.GuestJoined, map[string]string{"guestId": "guest-1"})
+
+	assert.Empty(t, backlog)
+	event := receive(t, sub)
+	assert.Equal(t, uint64(1), event.ID)
+	assert.Equal(t, "party-1", event.PartyID)
+	assert.Equal(t, events.GuestJoined, event.Type)
+	assert.False(t, event.CreatedAt.IsZero())
+	assert.Empty(t, other.Events())
+}
+
+func TestBus_EventIDsIncreaseAcrossParties(t *testing.T) {
+	bus := events.NewBus(events.DefaultHistory)
+	sub, _ := bus.Subscribe("party-1", 0)
+	defer sub.Close()
+
+	bus.Publish("party-1", events.GuestJoined, nil)
+	bus.Publish("party-2", events.GuestJoined, nil)
+	bus.Publish("party-1", events.GuestApproved, nil)
+
+	assert.Equal(t, uint64(1), receive(t, sub).ID)
+	assert.Equal(t, uint64(3), receive(t, sub).ID)
+}
+
+func TestBus_SubscribeResumesAfterLastEventID(t *testing.T) {
+	bus := events.NewBus(events.DefaultHistory)
+	watcher, _ := bus.Subscribe("party-1", 0)
+	defer watcher.Close()
+	for i := 0; i < 5; i++ {
+		bus.Publish("party-1", events.VoteSubmitted, nil)
+		bus.Publish("party-2", events.VoteSubmitted, nil)
+	}
+
+	sub, backlog := bus.Subscribe("party-1", 5)
+	defer sub.Close()
+
+	require.Len(t, backlog, 2)
+	assert.Equal(t, uint64(7), backlog[0].ID)
+	assert.Equal(t, uint64(9), backlog[1].ID)
+}
+
+func TestBus_SubscribeUpToDateHasNoBacklog(t *testing.T) {
+	bus := events.NewBus(events.DefaultHistory)
+	watcher, _ := bus.Subscribe("party-1", 0)
+	defer watcher.Close()
+	bus.Publish("party-1", events.VoteSubmitted, nil)
+	bus.Publish("party-2", events.VoteSubmitted, nil)
+
+	for _, lastEventID := range []uint64{1, 2} {
+		sub, backlog := bus.Subscribe("party-1", lastEventID)
+		assert.Empty(t, backlog, "last event ID %d", lastEventID)
+		sub.Close()
+	}
+}
+
+func TestBus_SubscribeRequestsResyncWhenHistoryIsGone(t *testing.T) {
+	bus := events.NewBus(2)
+	watcher, _ := bus.Subscribe("party-1", 0)
+	defer watcher.Close()
+	for i := 0; i < 5; i++ {
+		bus.Publish("party-1", events.VoteSubmitted, nil)
+	}
+
+	sub, backlog := bus.Subscribe("party-1", 1)
+	defer sub.Close()
+
+	require.Len(t, backlog, 1)
+	assert.Equal(t, events.Resync, backlog[0].Type)
+	assert.Equal(t, uint64(5), backlog[0].ID)
+}
+
+func TestBus_SubscribeRequestsResyncAfterPartyWasUnwatched(t *testing.T) {
+	bus := events.NewBus(events.DefaultHistory)
+	sub, _ := bus.Subscribe("party-1", 0)
+	bus.Publish("party-1", events.GuestJoined, nil)
+	sub.Close()
+
+	// Nobody keeps events while the party has no subscribers.
+	bus.Publish("party-1", events.GuestApproved, nil)
+
+	sub, backlog := bus.Subscribe("party-1", 1)
+	defer sub.Close()
+
+	require.Len(t, backlog, 1)
+	assert.Equal(t, events.Resync, backlog[0].Type)
+	assert.Equal(t, uint64(2), backlog[0].ID)
+
+	// Resuming from the resync does not resync again.
+	again, backlog := bus.Subscribe("party-1", 2)
+	defer again.Close()
+	assert.Empty(t, backlog)
+}
+
+func TestBus_SubscribeRequestsResyncForUnknownEventID(t *testing.T) {
+	bus := events.NewBus(events.DefaultHistory)
+	bus.Publish("party-1", events.VoteSubmitted, nil)
+
+	// An ID from before a server restart is ahead of the fresh sequence.
+	sub, backlog := bus.Subscribe("party-1", 42)
+	defer sub.Close()
+
+	require.Len(t, backlog, 1)
+	assert.Equal(t, events.Resync, backlog[0].Type)
+}
+
+func TestBus_DropsSubscribersThatFallBehind(t *testing.T) {
+	bus := events.NewBus(events.DefaultHistory)
+	sub, _ := bus.Subscribe("party-1", 0)
+	defer sub.Close()
+
+	for i := 0; i < 100; i++ {
+		bus.Publish("party-1", events.VoteSubmitted, nil)
+	}
+
+	received := 0
+	for range sub.Events() {
+		received++
+	}
+	assert.Less(t, received, 100)
+}
+
+func TestSubscription_CloseStopsDeliveryAndIsIdempotent(t *testing.T) {
+	bus := events.NewBus(events.DefaultHistory)
+	sub, _ := bus.Subscribe("party-1", 0)
+
+	sub.Close()
+	sub.Close()
+	bus.Publish("party-1", events.VotingEnded, nil)
+
+	_, ok := <-sub.Events()
+	assert.False(t, ok)
+}
@@ -1,16 +1,25 @@
 package handlers
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"net/http"
+	"strings"
 
+	"github.com/sipgate/eurovision-vote-party/server/middleware"
 	"github.com/sipgate/eurovision-vote-party/server/models"
 	"github.com/sipgate/eurovision-vote-party/server/services"
 )
 
 // ActsService defines the operations needed by the acts handler.
 type ActsService interface {
-	ListActs(eventType string) ([]models.Act, error)
+	ListActs(ctx context.Context, contest, eventType string) ([]models.Act, error)
+	CreateAct(ctx context.Context, userID string, act models.Act) (*models.Act, error)
+	UpdateAct(ctx context.Context, userID string, act models.Act) (*models.Act, error)
+	DeleteAct(ctx context.Context, userID, contest, actID string) error
+	ReorderActs(ctx context.Context, userID string, req services.ReorderActsRequest) ([]models.Act, error)
+	RecordQualifiers(ctx context.Context, userID string, req services.RecordQualifiersRequest) ([]models.Act, error)
 }
 
 // ActsHandler handles HTTP requests for acts.
@@ -29,22 +38,70 @@ type actsResponse struct {
 	Acts []models.Act `json:"acts"`
 }
 
-// ServeHTTP handles requests to /api/acts.
+// showActsRequest represents the request body for listing acts of one show,
+// either in their new running order or as the show's qualifiers.
+type showActsRequest struct {
+	Contest   string           `json:"contest"`
+	EventType models.EventType `json:"eventType"`
+	ActIDs    []string         `json:"actIds"`
+}
+
+// ServeHTTP routes requests to the appropriate handler method.
+//
+//	GET    /api/acts                    list acts (public)
+//	POST   /api/acts                    create an act
+//	PUT    /api/acts/order              set the running order of a show
+//	PUT    /api/acts/qualifiers         record the qualifiers of a semifinal
+//	PUT    /api/acts/{contest}/{actID}  update an act
+//	DELETE /api/acts/{contest}/{actID}  delete an act
 func (h *ActsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodGet {
-		writeError(w, http.StatusMethodNotAllowed)
-		return
+	path := strings.TrimPrefix(r.URL.Path, "/api/acts")
+	path = strings.TrimPrefix(path, "/")
+	segments := strings.Split(path, "/")
+
+	switch {
+	case path == "":
+		switch r.Method {
+		case http.MethodGet:
+			h.handleList(w, r)
+			return
+		case http.MethodPost:
+			h.handleCreate(w, r)
+			return
+		}
+	case path == "order":
+		if r.Method == http.MethodPut {
+			h.handleReorder(w, r)
+			return
+		}
+	case path == "qualifiers":
+		if r.Method == http.MethodPut {
+			h.handleRecordQualifiers(w, r)
+			return
+		}
+	case len(segments) == 2 && segments[0] != "" && segments[1] != "":
+		switch r.Method {
+		case http.MethodPut:
+			h.handleUpdate(w, r, segments[0], segments[1])
+			return
+		case http.MethodDelete:
+			h.handleDelete(w, r, segments[0], segments[1])
+			return
+		}
 	}
 
+	writeError(w, http.StatusMethodNotAllowed)
+}
+
+// handleList handles GET /api/acts.
+// The optional contest and event query parameters select a contest edition and one of its shows.
+func (h *ActsHandler) handleList(w http.ResponseWriter, r *http.Request) {
+	contest := r.URL.Query().Get("contest")
 	eventType := r.URL.Query().Get("event")
 
-	acts, err := h.service.ListActs(eventType)
+	acts, err := h.service.ListActs(r.Context(), contest, eventType)
 	if err != nil {
-		if errors.Is(err, services.ErrInvalidEventType) {
-			writeError(w, http.StatusBadRequest)
-			return
-		}
-		writeError(w, http.StatusInternalServerError)
+		writeActsError(w, err)
 		return
 	}
 
@@ -54,3 +111,137 @@ func (h *ActsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	writeJSON(w, http.StatusOK, actsResponse{Acts: acts})
 }
+
+// handleCreate handles POST /api/acts.
+func (h *ActsHandler) handleCreate(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized)
+		return
+	}
+
+	var act models.Act
+	if err := json.NewDecoder(r.Body).Decode(&act); err != nil {
+		writeError(w, http.StatusBadRequest)
+		return
+	}
+
+	created, err := h.service.CreateAct(r.Context(), userID, act)
+	if err != nil {
+		writeActsError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, created)
+}
+
+// handleUpdate handles PUT /api/acts/{contest}/{actID}.
+func (h *ActsHandler) handleUpdate(w http.ResponseWriter, r *http.Request, contest, actID string) {
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized)
+		return
+	}
+
+	var act models.Act
+	if err := json.NewDecoder(r.Body).Decode(&act); err != nil {
+		writeError(w, http.StatusBadRequest)
+		return
+	}
+	act.Contest = contest
+	act.ID = actID
+
+	updated, err := h.service.UpdateAct(r.Context(), userID, act)
+	if err != nil {
+		writeActsError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, updated)
+}
+
+// handleDelete handles DELETE /api/acts/{contest}/{actID}.
+func (h *ActsHandler) handleDelete(w http.ResponseWriter, r *http.Request, contest, actID string) {
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized)
+		return
+	}
+
+	if err := h.service.DeleteAct(r.Context(), userID, contest, actID); err != nil {
+		writeActsError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleReorder handles PUT /api/acts/order.
+func (h *ActsHandler) handleReorder(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized)
+		return
+	}
+
+	var req showActsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest)
+		return
+	}
+
+	acts, err := h.service.ReorderActs(r.Context(), userID, services.ReorderActsRequest{
+		Contest:   req.Contest,
+		EventType: req.EventType,
+		ActIDs:    req.ActIDs,
+	})
+	if err != nil {
+		writeActsError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, actsResponse{Acts: acts})
+}
+
+// handleRecordQualifiers handles PUT /api/acts/qualifiers.
+func (h *ActsHandler) handleRecordQualifiers(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized)
+		return
+	}
+
+	var req showActsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest)
+		return
+	}
+
+	finalists, err := h.service.RecordQualifiers(r.Context(), userID, services.RecordQualifiersRequest{
+		Contest:   req.Contest,
+		EventType: req.EventType,
+		ActIDs:    req.ActIDs,
+	})
+	if err != nil {
+		writeActsError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, actsResponse{Acts: finalists})
+}
+
+// writeActsError maps acts service errors to HTTP responses.
+func writeActsError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, services.ErrInvalidEventType), errors.Is(err, services.ErrInvalidAct):
+		writeError(w, http.StatusBadRequest)
+	case errors.Is(err, services.ErrUnauthorized):
+		writeError(w, http.StatusForbidden)
+	case errors.Is(err, services.ErrUnknownContest), errors.Is(err, services.ErrActNotFound):
+		writeError(w, http.StatusNotFound)
+	case errors.Is(err, services.ErrActExists):
+		writeError(w, http.StatusConflict)
+	default:
+		writeError(w, http.StatusInternalServerError)
+	}
+}
@@ -1,27 +1,70 @@
 package handlers_test
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/sipgate/eurovision-vote-party/server/handlers"
+	"github.com/sipgate/eurovision-vote-party/server/middleware"
 	"github.com/sipgate/eurovision-vote-party/server/models"
 	"github.com/sipgate/eurovision-vote-party/server/services"
 )
 
 type mockActsService struct {
-	listActsFunc func(eventType string) ([]models.Act, error)
+	listActsFunc    func(ctx context.Context, contest, eventType string) ([]models.Act, error)
+	createActFunc   func(ctx context.Context, userID string, act models.Act) (*models.Act, error)
+	updateActFunc   func(ctx context.Context, userID string, act models.Act) (*models.Act, error)
+	deleteActFunc   func(ctx context.Context, userID, contest, actID string) error
+	reorderActsFunc func(ctx context.Context, userID string, req services.ReorderActsRequest) ([]models.Act, error)
+	qualifiersFunc  func(ctx context.Context, userID string, req services.RecordQualifiersRequest) ([]models.Act, error)
 }
 
-func (m *mockActsService) ListActs(eventType string) ([]models.Act, error) {
+func (m *mockActsService) ListActs(ctx context.Context, contest, eventType string) ([]models.Act, error) {
 	if m.listActsFunc != nil {
-		return m.listActsFunc(eventType)
+		return m.listActsFunc(ctx, contest, eventType)
+	}
+	return []models.Act{}, nil
+}
+
+func (m *mockActsService) CreateAct(ctx context.Context, userID string, act models.Act) (*models.Act, error) {
+	if m.createActFunc != nil {
+		return m.createActFunc(ctx, userID, act)
+	}
+	return &act, nil
+}
+
+func (m *mockActsService) UpdateAct(ctx context.Context, userID string, act models.Act) (*models.Act, error) {
+	if m.updateActFunc != nil {
+		return m.updateActFunc(ctx, userID, act)
+	}
+	return &act, nil
+}
+
+func (m *mockActsService) DeleteAct(ctx context.Context, userID, contest, actID string) error {
+	if m.deleteActFunc != nil {
+		return m.deleteActFunc(ctx, userID, contest, actID)
+	}
+	return nil
+}
+
+func (m *mockActsService) ReorderActs(ctx context.Context, userID string, req services.ReorderActsRequest) ([]models.Act, error) {
+	if m.reorderActsFunc != nil {
+		return m.reorderActsFunc(ctx, userID, req)
+	}
+	return []models.Act{}, nil
+}
+
+func (m *mockActsService) RecordQualifiers(ctx context.Context, userID string, req services.RecordQualifiersRequest) ([]models.Act, error) {
+	if m.qualifiersFunc != nil {
+		return m.qualifiersFunc(ctx, userID, req)
 	}
 	return []models.Act{}, nil
 }
@@ -47,7 +90,7 @@ func TestActsHandler_GET_Returns200WithActsList(t *testing.T) {
 	}
 
 	svc := &mockActsService{
-		listActsFunc: func(eventType string) ([]models.Act, error) {
+		listActsFunc: func(ctx context.Context, contest, eventType string) ([]models.Act, error) {
 			return acts, nil
 		},
 	}
@@ -78,7 +121,7 @@ func TestActsHandler_GET_WithEventQueryParam(t *testing.T) {
 	var capturedEventType string
 
 	svc := &mockActsService{
-		listActsFunc: func(eventType string) ([]models.Act, error) {
+		listActsFunc: func(ctx context.Context, contest, eventType string) ([]models.Act, error) {
 			capturedEventType = eventType
 			return []models.Act{}, nil
 		},
@@ -97,7 +140,7 @@ func TestActsHandler_GET_WithEventQueryParam(t *testing.T) {
 
 func TestActsHandler_GET_EmptyResultsReturnsEmptyArray(t *testing.T) {
 	svc := &mockActsService{
-		listActsFunc: func(eventType string) ([]models.Act, error) {
+		listActsFunc: func(ctx context.Context, contest, eventType string) ([]models.Act, error) {
 			return []models.Act{}, nil
 		},
 	}
@@ -122,7 +165,7 @@ func TestActsHandler_GET_EmptyResultsReturnsEmptyArray(t *testing.T) {
 
 func TestActsHandler_GET_InvalidEventReturns400(t *testing.T) {
 	svc := &mockActsService{
-		listActsFunc: func(eventType string) ([]models.Act, error) {
+		listActsFunc: func(ctx context.Context, contest, eventType string) ([]models.Act, error) {
 			return nil, services.ErrInvalidEventType
 		},
 	}
@@ -137,15 +180,202 @@ func TestActsHandler_GET_InvalidEventReturns400(t *testing.T) {
 	assert.Equal(t, http.StatusBadRequest, rec.Code)
 }
 
-func TestActsHandler_POST_Returns405(t *testing.T) {
+func TestActsHandler_GET_PassesContestQueryParam(t *testing.T) {
+	var capturedContest, capturedEventType string
+
+	svc := &mockActsService{
+		listActsFunc: func(ctx context.Context, contest, eventType string) ([]models.Act, error) {
+			capturedContest, capturedEventType = contest, eventType
+			return []models.Act{}, nil
+		},
+	}
+
+	handler := handlers.NewActsHandler(svc)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/acts?contest=esc-2026&event=grandfinal", nil)
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "esc-2026", capturedContest)
+	assert.Equal(t, "grandfinal", capturedEventType)
+}
+
+func TestActsHandler_GET_UnknownContestReturns404(t *testing.T) {
+	svc := &mockActsService{
+		listActsFunc: func(ctx context.Context, contest, eventType string) ([]models.Act, error) {
+			return nil, services.ErrUnknownContest
+		},
+	}
+
+	handler := handlers.NewActsHandler(svc)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/acts?contest=esc-1956", nil)
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestActsHandler_POST_RequiresAuthentication(t *testing.T) {
 	handler := handlers.NewActsHandler(&mockActsService{})
 
-	req := httptest.NewRequest(http.MethodPost, "/api/acts", nil)
+	req := httptest.NewRequest(http.MethodPost, "/api/acts", strings.NewReader(`{}`))
 	rec := httptest.NewRecorder()
 
 	handler.ServeHTTP(rec, req)
 
-	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestActsHandler_POST_CreatesAct(t *testing.T) {
+	var capturedUserID string
+	var capturedAct models.Act
+	svc := &mockActsService{
+		createActFunc: func(ctx context.Context, userID string, act models.Act) (*models.Act, error) {
+			capturedUserID, capturedAct = userID, act
+			return &act, nil
+		},
+	}
+	handler := handlers.NewActsHandler(svc)
+
+	body := `{"id":"se-2026","contest":"esc-2026","country":"Sweden","artist":"A","song":"S","runningOrder":3,"eventType":"semifinal1"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/acts", strings.NewReader(body))
+	req = req.WithContext(middleware.WithUserID(req.Context(), "editor-1"))
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	assert.Equal(t, "editor-1", capturedUserID)
+	assert.Equal(t, "se-2026", capturedAct.ID)
+	assert.Equal(t, "esc-2026", capturedAct.Contest)
+	assert.Equal(t, 3, capturedAct.RunningOrder)
+}
+
+func TestActsHandler_POST_MapsServiceErrors(t *testing.T) {
+	tests := map[string]struct {
+		err  error
+		code int
+	}{
+		"not an editor":   {services.ErrUnauthorized, http.StatusForbidden},
+		"invalid act":     {services.ErrInvalidAct, http.StatusBadRequest},
+		"unknown contest": {services.ErrUnknownContest, http.StatusNotFound},
+		"duplicate act":   {services.ErrActExists, http.StatusConflict},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			svc := &mockActsService{
+				createActFunc: func(ctx context.Context, userID string, act models.Act) (*models.Act, error) {
+					return nil, tt.err
+				},
+			}
+			handler := handlers.NewActsHandler(svc)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/acts", strings.NewReader(`{"id":"se-2026"}`))
+			req = req.WithContext(middleware.WithUserID(req.Context(), "user-1"))
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.code, rec.Code)
+		})
+	}
+}
+
+func TestActsHandler_PUT_UpdatesActFromPath(t *testing.T) {
+	var capturedAct models.Act
+	svc := &mockActsService{
+		updateActFunc: func(ctx context.Context, userID string, act models.Act) (*models.Act, error) {
+			capturedAct = act
+			return &act, nil
+		},
+	}
+	handler := handlers.NewActsHandler(svc)
+
+	body := `{"id":"ignored","contest":"ignored","country":"Sweden","artist":"A","song":"S","runningOrder":9,"eventType":"grandfinal"}`
+	req := httptest.NewRequest(http.MethodPut, "/api/acts/esc-2026/se-2026", strings.NewReader(body))
+	req = req.WithContext(middleware.WithUserID(req.Context(), "editor-1"))
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "se-2026", capturedAct.ID)
+	assert.Equal(t, "esc-2026", capturedAct.Contest)
+	assert.Equal(t, 9, capturedAct.RunningOrder)
+}
+
+func TestActsHandler_PUT_MissingActReturns404(t *testing.T) {
+	svc := &mockActsService{
+		updateActFunc: func(ctx context.Context, userID string, act models.Act) (*models.Act, error) {
+			return nil, services.ErrActNotFound
+		},
+	}
+	handler := handlers.NewActsHandler(svc)
+
+	req := httptest.NewRequest(http.MethodPut, "/api/acts/esc-2026/xx-2026", strings.NewReader(`{}`))
+	req = req.WithContext(middleware.WithUserID(req.Context(), "editor-1"))
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestActsHandler_DELETE_RemovesAct(t *testing.T) {
+	var capturedContest, capturedActID string
+	svc := &mockActsService{
+		deleteActFunc: func(ctx context.Context, userID, contest, actID string) error {
+			capturedContest, capturedActID = contest, actID
+			return nil
+		},
+	}
+	handler := handlers.NewActsHandler(svc)
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/acts/esc-2026/se-2026", nil)
+	req = req.WithContext(middleware.WithUserID(req.Context(), "editor-1"))
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Equal(t, "esc-2026", capturedContest)
+	assert.Equal(t, "se-2026", capturedActID)
+}
+
+func TestActsHandler_PUT_OrderReordersShow(t *testing.T) {
+	var captured services.ReorderActsRequest
+	svc := &mockActsService{
+		reorderActsFunc: func(ctx context.Context, userID string, req services.ReorderActsRequest) ([]models.Act, error) {
+			captured = req
+			return []models.Act{{ID: "no-2026", RunningOrder: 1}, {ID: "se-2026", RunningOrder: 2}}, nil
+		},
+	}
+	handler := handlers.NewActsHandler(svc)
+
+	body := `{"contest":"esc-2026","eventType":"grandfinal","actIds":["no-2026","se-2026"]}`
+	req := httptest.NewRequest(http.MethodPut, "/api/acts/order", strings.NewReader(body))
+	req = req.WithContext(middleware.WithUserID(req.Context(), "editor-1"))
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, services.ReorderActsRequest{
+		Contest:   "esc-2026",
+		EventType: models.EventGrandFinal,
+		ActIDs:    []string{"no-2026", "se-2026"},
+	}, captured)
+
+	var response struct {
+		Acts []models.Act `json:"acts"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Len(t, response.Acts, 2)
 }
 
 func TestActsHandler_DELETE_Returns405(t *testing.T) {
@@ -161,7 +391,7 @@ func TestActsHandler_DELETE_Returns405(t *testing.T) {
 
 func TestActsHandler_GET_ServiceErrorReturns500(t *testing.T) {
 	svc := &mockActsService{
-		listActsFunc: func(eventType string) ([]models.Act, error) {
+		listActsFunc: func(ctx context.Context, contest, eventType string) ([]models.Act, error) {
 			return nil, errors.New("something")
 		},
 	}
@@ -175,3 +405,52 @@ func TestActsHandler_GET_ServiceErrorReturns500(t *testing.T) {
 
 	assert.Equal(t, http.StatusInternalServerError, rec.Code)
 }
+
+func TestActsHandler_PUT_QualifiersRecordsQualifiers(t *testing.T) {
+	var captured services.RecordQualifiersRequest
+	svc := &mockActsService{
+		qualifiersFunc: func(ctx context.Context, userID string, req services.RecordQualifiersRequest) ([]models.Act, error) {
+			captured = req
+			return []models.Act{{ID: "se-2026", EventType: models.EventGrandFinal, Qualification: models.QualificationQualified}}, nil
+		},
+	}
+	handler := handlers.NewActsHandler(svc)
+
+	body := `{"contest":"esc-2026","eventType":"semifinal1","actIds":["se-2026"]}`
+	req := httptest.NewRequest(http.MethodPut, "/api/acts/qualifiers", strings.NewReader(body))
+	req = req.WithContext(middleware.WithUserID(req.Context(), "editor-1"))
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, services.RecordQualifiersRequest{
+		Contest:   "esc-2026",
+		EventType: models.EventSemifinal1,
+		ActIDs:    []string{"se-2026"},
+	}, captured)
+
+	var response struct {
+		Acts []models.Act `json:"acts"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Len(t, response.Acts, 1)
+	assert.Equal(t, models.QualificationQualified, response.Acts[0].Qualification)
+}
+
+func TestActsHandler_PUT_QualifiersForGrandFinalReturns400(t *testing.T) {
+	svc := &mockActsService{
+		qualifiersFunc: func(ctx context.Context, userID string, req services.RecordQualifiersRequest) ([]models.Act, error) {
+			return nil, services.ErrInvalidEventType
+		},
+	}
+	handler := handlers.NewActsHandler(svc)
+
+	req := httptest.NewRequest(http.MethodPut, "/api/acts/qualifiers", strings.NewReader(`{"eventType":"grandfinal"}`))
+	req = req.WithContext(middleware.WithUserID(req.Context(), "editor-1"))
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
@@ -0,0 +1,144 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/sipgate/eurovision-vote-party/server/archive"
+	"github.com/sipgate/eurovision-vote-party/server/middleware"
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/services"
+)
+
+// maxArchiveSize bounds the size of an uploaded party archive.
+const maxArchiveSize = 32 << 20
+
+// ArchiveServiceHandler defines the operations needed by the archive handler.
+type ArchiveServiceHandler interface {
+	ExportParty(ctx context.Context, partyID string) (*models.PartyArchive, error)
+	ImportParty(ctx context.Context, adminID string, archive *models.PartyArchive) (*models.Party, error)
+}
+
+// ArchiveHandler handles HTTP requests for party archives.
+type ArchiveHandler struct {
+	service ArchiveServiceHandler
+}
+
+// NewArchiveHandler creates a new ArchiveHandler.
+func NewArchiveHandler(service ArchiveServiceHandler) *ArchiveHandler {
+	return &ArchiveHandler{service: service}
+}
+
+// ServeHTTP routes requests to the appropriate handler method.
+//
+//	GET  /api/parties/{partyID}/archive  download the party archive
+//	POST /api/parties/import             recreate a party from an archive
+func (h *ArchiveHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimPrefix(r.URL.Path, "/api/parties/")
+	segments := strings.Split(path, "/")
+
+	switch {
+	case len(segments) == 1 && segments[0] == "import":
+		if r.Method == http.MethodPost {
+			h.handleImport(w, r)
+			return
+		}
+	case len(segments) == 2 && segments[1] == "archive":
+		if r.Method == http.MethodGet {
+			h.handleExport(w, r, segments[0])
+			return
+		}
+	}
+
+	writeError(w, http.StatusMethodNotAllowed)
+}
+
+// handleExport handles GET /api/parties/{partyID}/archive.
+// The format query parameter selects a JSON document (the default) or a ZIP file.
+func (h *ArchiveHandler) handleExport(w http.ResponseWriter, r *http.Request, partyID string) {
+	if _, ok := middleware.UserIDFromContext(r.Context()); !ok {
+		writeError(w, http.StatusUnauthorized)
+		return
+	}
+
+	format := archive.Format(r.URL.Query().Get("format"))
+	if format == "" {
+		format = archive.FormatJSON
+	}
+	if !format.IsValid() {
+		writeError(w, http.StatusBadRequest)
+		return
+	}
+
+	a, err := h.service.ExportParty(r.Context(), partyID)
+	if err != nil {
+		writeArchiveError(w, err)
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := archive.Write(&buf, a, format); err != nil {
+		writeError(w, http.StatusInternalServerError)
+		return
+	}
+
+	filename := partyID + "-archive." + string(format)
+	w.Header().Set("Content-Type", format.ContentType())
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": filename}))
+	w.WriteHeader(http.StatusOK)
+	w.Write(buf.Bytes())
+}
+
+// handleImport handles POST /api/parties/import.
+// The body is an archive in either format.
+func (h *ArchiveHandler) handleImport(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized)
+		return
+	}
+
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxArchiveSize))
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			writeError(w, http.StatusRequestEntityTooLarge)
+			return
+		}
+		writeError(w, http.StatusBadRequest)
+		return
+	}
+
+	a, err := archive.Read(data)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	party, err := h.service.ImportParty(r.Context(), userID, a)
+	if err != nil {
+		writeArchiveError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, party)
+}
+
+// writeArchiveError maps archive service errors to HTTP responses.
+func writeArchiveError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, services.ErrNotFound):
+		writeError(w, http.StatusNotFound)
+	case errors.Is(err, services.ErrUnauthorized):
+		writeError(w, http.StatusForbidden)
+	case errors.Is(err, services.ErrInvalidArchive):
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+	default:
+		writeError(w, http.StatusInternalServerError)
+	}
+}
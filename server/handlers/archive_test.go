@@ -0,0 +1,212 @@
+package handlers_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sipgate/eurovision-vote-party/server/archive"
+	"github.com/sipgate/eurovision-vote-party/server/authz"
+	"github.com/sipgate/eurovision-vote-party/server/handlers"
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/services"
+)
+
+type mockArchiveService struct {
+	exportPartyFunc func(ctx context.Context, partyID string) (*models.PartyArchive, error)
+	importPartyFunc func(ctx context.Context, adminID string, archive *models.PartyArchive) (*models.Party, error)
+}
+
+func (m *mockArchiveService) ExportParty(ctx context.Context, partyID string) (*models.PartyArchive, error) {
+	if m.exportPartyFunc != nil {
+		return m.exportPartyFunc(ctx, partyID)
+	}
+	return nil, nil
+}
+
+func (m *mockArchiveService) ImportParty(ctx context.Context, adminID string, archive *models.PartyArchive) (*models.Party, error) {
+	if m.importPartyFunc != nil {
+		return m.importPartyFunc(ctx, adminID, archive)
+	}
+	return nil, nil
+}
+
+func handlerArchive() *models.PartyArchive {
+	now := time.Date(2025, 5, 17, 21, 0, 0, 0, time.UTC)
+	return &models.PartyArchive{
+		Version:    models.ArchiveVersion,
+		ExportedAt: now,
+		Party: models.Party{
+			ID: "party-1", Name: "Party", Code: "ABC234", EventType: models.EventGrandFinal,
+			AdminID: "admin-1", Status: models.PartyStatusClosed, CreatedAt: now,
+		},
+		Guests:      []models.Guest{},
+		Votes:       []models.Vote{},
+		Predictions: []models.Prediction{},
+		Acts:        []models.Act{},
+	}
+}
+
+func TestArchiveHandler_Export(t *testing.T) {
+	for _, format := range []archive.Format{archive.FormatJSON, archive.FormatZIP} {
+		t.Run(string(format), func(t *testing.T) {
+			svc := &mockArchiveService{
+				exportPartyFunc: func(ctx context.Context, partyID string) (*models.PartyArchive, error) {
+					assert.Equal(t, "admin-1", authz.FromContext(ctx).UserID)
+					assert.Equal(t, "party-1", partyID)
+					return handlerArchive(), nil
+				},
+			}
+			handler := handlers.NewArchiveHandler(svc)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/parties/party-1/archive?format="+string(format), nil)
+			req = requestWithUserID(req, "admin-1")
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, req)
+
+			require.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, format.ContentType(), rec.Header().Get("Content-Type"))
+			assert.Equal(t, "attachment; filename=party-1-archive."+string(format), rec.Header().Get("Content-Disposition"))
+
+			got, err := archive.Read(rec.Body.Bytes())
+			require.NoError(t, err)
+			assert.Equal(t, handlerArchive(), got)
+		})
+	}
+}
+
+func TestArchiveHandler_Export_DefaultsToJSON(t *testing.T) {
+	svc := &mockArchiveService{
+		exportPartyFunc: func(ctx context.Context, partyID string) (*models.PartyArchive, error) {
+			return handlerArchive(), nil
+		},
+	}
+	handler := handlers.NewArchiveHandler(svc)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/parties/party-1/archive", nil)
+	req = requestWithUserID(req, "admin-1")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+}
+
+func TestArchiveHandler_Export_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		target string
+		userID string
+		err    error
+		status int
+	}{
+		{name: "unauthenticated", target: "/api/parties/party-1/archive", status: http.StatusUnauthorized},
+		{name: "unknown format", target: "/api/parties/party-1/archive?format=tar", userID: "admin-1", status: http.StatusBadRequest},
+		{name: "not found", target: "/api/parties/party-1/archive", userID: "admin-1", err: services.ErrNotFound, status: http.StatusNotFound},
+		{name: "not the admin", target: "/api/parties/party-1/archive", userID: "other", err: services.ErrUnauthorized, status: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &mockArchiveService{
+				exportPartyFunc: func(ctx context.Context, partyID string) (*models.PartyArchive, error) {
+					return nil, tt.err
+				},
+			}
+			handler := handlers.NewArchiveHandler(svc)
+
+			req := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			if tt.userID != "" {
+				req = requestWithUserID(req, tt.userID)
+			}
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.status, rec.Code)
+		})
+	}
+}
+
+func TestArchiveHandler_Import(t *testing.T) {
+	for _, format := range []archive.Format{archive.FormatJSON, archive.FormatZIP} {
+		t.Run(string(format), func(t *testing.T) {
+			svc := &mockArchiveService{
+				importPartyFunc: func(ctx context.Context, adminID string, a *models.PartyArchive) (*models.Party, error) {
+					assert.Equal(t, "admin-2", adminID)
+					assert.Equal(t, handlerArchive(), a)
+					party := a.Party
+					party.ID = "party-2"
+					party.AdminID = adminID
+					return &party, nil
+				},
+			}
+			handler := handlers.NewArchiveHandler(svc)
+
+			var body bytes.Buffer
+			require.NoError(t, archive.Write(&body, handlerArchive(), format))
+			req := httptest.NewRequest(http.MethodPost, "/api/parties/import", &body)
+			req = requestWithUserID(req, "admin-2")
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, req)
+
+			require.Equal(t, http.StatusCreated, rec.Code)
+			var party models.Party
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &party))
+			assert.Equal(t, "party-2", party.ID)
+			assert.Equal(t, "admin-2", party.AdminID)
+		})
+	}
+}
+
+func TestArchiveHandler_Import_Errors(t *testing.T) {
+	valid := func() *bytes.Buffer {
+		var body bytes.Buffer
+		require.NoError(t, archive.Write(&body, handlerArchive(), archive.FormatJSON))
+		return &body
+	}
+
+	tests := []struct {
+		name   string
+		body   *bytes.Buffer
+		userID string
+		err    error
+		status int
+	}{
+		{name: "unauthenticated", body: valid(), status: http.StatusUnauthorized},
+		{name: "malformed archive", body: bytes.NewBufferString("{"), userID: "admin-1", status: http.StatusBadRequest},
+		{name: "rejected archive", body: valid(), userID: "admin-1", err: services.ErrInvalidArchive, status: http.StatusBadRequest},
+		{name: "service failure", body: valid(), userID: "admin-1", err: assert.AnError, status: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &mockArchiveService{
+				importPartyFunc: func(ctx context.Context, adminID string, a *models.PartyArchive) (*models.Party, error) {
+					return nil, tt.err
+				},
+			}
+			handler := handlers.NewArchiveHandler(svc)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/parties/import", tt.body)
+			if tt.userID != "" {
+				req = requestWithUserID(req, tt.userID)
+			}
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.status, rec.Code)
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/sipgate/eurovision-vote-party/server/services"
+)
+
+// AuthServiceHandler defines the operations needed by the auth handler.
+type AuthServiceHandler interface {
+	Register(ctx context.Context, email, password string) (*services.AuthTokens, error)
+	Login(ctx context.Context, email, password string) (*services.AuthTokens, error)
+	Refresh(ctx context.Context, refreshToken string) (*services.AuthTokens, error)
+	Logout(ctx context.Context, refreshToken string) error
+}
+
+// AuthHandler handles HTTP requests for signing in with a local account.
+type AuthHandler struct {
+	service AuthServiceHandler
+}
+
+// NewAuthHandler creates a new AuthHandler.
+func NewAuthHandler(service AuthServiceHandler) *AuthHandler {
+	return &AuthHandler{service: service}
+}
+
+// credentialsRequest is the body of a sign-up or login.
+type credentialsRequest struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+// refreshRequest is the body of a token refresh or logout.
+type refreshRequest struct {
+	RefreshToken string `json:"refreshToken"`
+}
+
+// ServeHTTP routes requests to the appropriate handler method. None of the
+// routes require an authenticated user.
+//
+//	POST /api/auth/register  create an account and sign in
+//	POST /api/auth/login     sign in with email and password
+//	POST /api/auth/refresh   exchange a refresh token for new tokens
+//	POST /api/auth/logout    revoke the refresh tokens of the account
+func (h *AuthHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed)
+		return
+	}
+
+	switch strings.TrimPrefix(r.URL.Path, "/api/auth/") {
+	case "register":
+		h.handleRegister(w, r)
+	case "login":
+		h.handleLogin(w, r)
+	case "refresh":
+		h.handleRefresh(w, r)
+	case "logout":
+		h.handleLogout(w, r)
+	default:
+		writeError(w, http.StatusNotFound)
+	}
+}
+
+// handleRegister handles POST /api/auth/register.
+func (h *AuthHandler) handleRegister(w http.ResponseWriter, r *http.Request) {
+	var req credentialsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	tokens, err := h.service.Register(r.Context(), req.Email, req.Password)
+	if err != nil {
+		writeAuthError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, tokens)
+}
+
+// handleLogin handles POST /api/auth/login.
+func (h *AuthHandler) handleLogin(w http.ResponseWriter, r *http.Request) {
+	var req credentialsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	tokens, err := h.service.Login(r.Context(), req.Email, req.Password)
+	if err != nil {
+		writeAuthError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, tokens)
+}
+
+// handleRefresh handles POST /api/auth/refresh.
+func (h *AuthHandler) handleRefresh(w http.ResponseWriter, r *http.Request) {
+	var req refreshRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	tokens, err := h.service.Refresh(r.Context(), req.RefreshToken)
+	if err != nil {
+		writeAuthError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, tokens)
+}
+
+// handleLogout handles POST /api/auth/logout.
+func (h *AuthHandler) handleLogout(w http.ResponseWriter, r *http.Request) {
+	var req refreshRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if err := h.service.Logout(r.Context(), req.RefreshToken); err != nil {
+		writeAuthError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// writeAuthError maps account service errors to HTTP responses.
+func writeAuthError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, services.ErrInvalidEmail):
+		writeJSONError(w, http.StatusBadRequest, "Invalid email address")
+	case errors.Is(err, services.ErrInvalidPassword):
+		writeJSONError(w, http.StatusBadRequest, "Invalid password: must be 8-72 characters")
+	case errors.Is(err, services.ErrEmailTaken):
+		writeJSONError(w, http.StatusConflict, "Email address already registered")
+	case errors.Is(err, services.ErrInvalidCredentials):
+		writeJSONError(w, http.StatusUnauthorized, "Invalid email or password")
+	case errors.Is(err, services.ErrInvalidAuthToken):
+		writeJSONError(w, http.StatusUnauthorized, "Invalid or expired refresh token")
+	default:
+		writeError(w, http.StatusInternalServerError)
+	}
+}
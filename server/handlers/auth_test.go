@@ -0,0 +1,204 @@
+package handlers_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sipgate/eurovision-vote-party/server/handlers"
+	"github.com/sipgate/eurovision-vote-party/server/services"
+)
+
+type mockAuthService struct {
+	registerFunc func(ctx context.Context, email, password string) (*services.AuthTokens, error)
+	loginFunc    func(ctx context.Context, email, password string) (*services.AuthTokens, error)
+	refreshFunc  func(ctx context.Context, refreshToken string) (*services.AuthTokens, error)
+	logoutFunc   func(ctx context.Context, refreshToken string) error
+}
+
+func (m *mockAuthService) Register(ctx context.Context, email, password string) (*services.AuthTokens, error) {
+	if m.registerFunc != nil {
+		return m.registerFunc(ctx, email, password)
+	}
+	return nil, nil
+}
+
+func (m *mockAuthService) Login(ctx context.Context, email, password string) (*services.AuthTokens, error) {
+	if m.loginFunc != nil {
+		return m.loginFunc(ctx, email, password)
+	}
+	return nil, nil
+}
+
+func (m *mockAuthService) Refresh(ctx context.Context, refreshToken string) (*services.AuthTokens, error) {
+	if m.refreshFunc != nil {
+		return m.refreshFunc(ctx, refreshToken)
+	}
+	return nil, nil
+}
+
+func (m *mockAuthService) Logout(ctx context.Context, refreshToken string) error {
+	if m.logoutFunc != nil {
+		return m.logoutFunc(ctx, refreshToken)
+	}
+	return nil
+}
+
+func testAuthTokens() *services.AuthTokens {
+	return &services.AuthTokens{AccessToken: "access", RefreshToken: "refresh", TokenType: "Bearer", ExpiresIn: 900}
+}
+
+func postJSON(path string, body any) *http.Request {
+	payload, _ := json.Marshal(body)
+	return httptest.NewRequest(http.MethodPost, path, bytes.NewReader(payload))
+}
+
+func TestAuthHandler_Register(t *testing.T) {
+	t.Run("returns tokens for the new account", func(t *testing.T) {
+		var gotEmail, gotPassword string
+		handler := handlers.NewAuthHandler(&mockAuthService{
+			registerFunc: func(ctx context.Context, email, password string) (*services.AuthTokens, error) {
+				gotEmail, gotPassword = email, password
+				return testAuthTokens(), nil
+			},
+		})
+		rec := httptest.NewRecorder()
+
+		handler.ServeHTTP(rec, postJSON("/api/auth/register", map[string]string{"email": "alice@example.com", "password": "correct horse"}))
+
+		require.Equal(t, http.StatusCreated, rec.Code)
+		assert.Equal(t, "alice@example.com", gotEmail)
+		assert.Equal(t, "correct horse", gotPassword)
+		var tokens services.AuthTokens
+		require.NoError(t, json.NewDecoder(rec.Body).Decode(&tokens))
+		assert.Equal(t, *testAuthTokens(), tokens)
+	})
+
+	errorCases := map[string]struct {
+		err    error
+		status int
+	}{
+		"invalid email":    {services.ErrInvalidEmail, http.StatusBadRequest},
+		"invalid password": {services.ErrInvalidPassword, http.StatusBadRequest},
+		"email taken":      {services.ErrEmailTaken, http.StatusConflict},
+		"service failure":  {errors.New("boom"), http.StatusInternalServerError},
+	}
+	for name, tc := range errorCases {
+		t.Run(name, func(t *testing.T) {
+			handler := handlers.NewAuthHandler(&mockAuthService{
+				registerFunc: func(ctx context.Context, email, password string) (*services.AuthTokens, error) {
+					return nil, tc.err
+				},
+			})
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, postJSON("/api/auth/register", map[string]string{"email": "alice@example.com", "password": "pw"}))
+
+			assert.Equal(t, tc.status, rec.Code)
+		})
+	}
+
+	t.Run("rejects a malformed body", func(t *testing.T) {
+		handler := handlers.NewAuthHandler(&mockAuthService{})
+		rec := httptest.NewRecorder()
+
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/auth/register", strings.NewReader("{")))
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestAuthHandler_Login(t *testing.T) {
+	t.Run("returns tokens", func(t *testing.T) {
+		handler := handlers.NewAuthHandler(&mockAuthService{
+			loginFunc: func(ctx context.Context, email, password string) (*services.AuthTokens, error) {
+				return testAuthTokens(), nil
+			},
+		})
+		rec := httptest.NewRecorder()
+
+		handler.ServeHTTP(rec, postJSON("/api/auth/login", map[string]string{"email": "alice@example.com", "password": "correct horse"}))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("refuses invalid credentials", func(t *testing.T) {
+		handler := handlers.NewAuthHandler(&mockAuthService{
+			loginFunc: func(ctx context.Context, email, password string) (*services.AuthTokens, error) {
+				return nil, services.ErrInvalidCredentials
+			},
+		})
+		rec := httptest.NewRecorder()
+
+		handler.ServeHTTP(rec, postJSON("/api/auth/login", map[string]string{"email": "alice@example.com", "password": "wrong"}))
+
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+}
+
+func TestAuthHandler_RefreshAndLogout(t *testing.T) {
+	t.Run("refresh passes the refresh token", func(t *testing.T) {
+		var got string
+		handler := handlers.NewAuthHandler(&mockAuthService{
+			refreshFunc: func(ctx context.Context, refreshToken string) (*services.AuthTokens, error) {
+				got = refreshToken
+				return testAuthTokens(), nil
+			},
+		})
+		rec := httptest.NewRecorder()
+
+		handler.ServeHTTP(rec, postJSON("/api/auth/refresh", map[string]string{"refreshToken": "refresh-1"}))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "refresh-1", got)
+	})
+
+	t.Run("refresh refuses revoked tokens", func(t *testing.T) {
+		handler := handlers.NewAuthHandler(&mockAuthService{
+			refreshFunc: func(ctx context.Context, refreshToken string) (*services.AuthTokens, error) {
+				return nil, services.ErrInvalidAuthToken
+			},
+		})
+		rec := httptest.NewRecorder()
+
+		handler.ServeHTTP(rec, postJSON("/api/auth/refresh", map[string]string{"refreshToken": "revoked"}))
+
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("logout returns no content", func(t *testing.T) {
+		var got string
+		handler := handlers.NewAuthHandler(&mockAuthService{
+			logoutFunc: func(ctx context.Context, refreshToken string) error {
+				got = refreshToken
+				return nil
+			},
+		})
+		rec := httptest.NewRecorder()
+
+		handler.ServeHTTP(rec, postJSON("/api/auth/logout", map[string]string{"refreshToken": "refresh-1"}))
+
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		assert.Equal(t, "refresh-1", got)
+	})
+}
+
+func TestAuthHandler_Routing(t *testing.T) {
+	handler := handlers.NewAuthHandler(&mockAuthService{})
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/auth/login", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, postJSON("/api/auth/unknown", map[string]string{}))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
@@ -0,0 +1,42 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/sipgate/eurovision-vote-party/server/models"
+)
+
+// ContestsService defines the operations needed by the contests handler.
+type ContestsService interface {
+	ListContests() []models.Contest
+}
+
+// ContestsHandler handles HTTP requests for contest editions.
+type ContestsHandler struct {
+	service ContestsService
+}
+
+// NewContestsHandler creates a new ContestsHandler.
+func NewContestsHandler(service ContestsService) *ContestsHandler {
+	return &ContestsHandler{service: service}
+}
+
+// contestsResponse wraps the contests list for JSON serialization.
+type contestsResponse struct {
+	Contests []models.Contest `json:"contests"`
+}
+
+// ServeHTTP handles requests to /api/contests.
+func (h *ContestsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed)
+		return
+	}
+
+	contests := h.service.ListContests()
+	if contests == nil {
+		contests = []models.Contest{}
+	}
+
+	writeJSON(w, http.StatusOK, contestsResponse{Contests: contests})
+}
@@ -0,0 +1,65 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sipgate/eurovision-vote-party/server/handlers"
+	"github.com/sipgate/eurovision-vote-party/server/models"
+)
+
+type mockContestsService struct {
+	contests []models.Contest
+}
+
+func (m *mockContestsService) ListContests() []models.Contest {
+	return m.contests
+}
+
+func TestContestsHandler_GET_ReturnsContests(t *testing.T) {
+	handler := handlers.NewContestsHandler(&mockContestsService{contests: []models.Contest{
+		{ID: "esc-2025", Name: "Eurovision Song Contest 2025", Year: 2025, Events: []models.EventType{models.EventGrandFinal}},
+	}})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/contests", nil)
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response struct {
+		Contests []models.Contest `json:"contests"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Len(t, response.Contests, 1)
+	assert.Equal(t, "esc-2025", response.Contests[0].ID)
+}
+
+func TestContestsHandler_GET_EmptyCatalogueReturnsEmptyArray(t *testing.T) {
+	handler := handlers.NewContestsHandler(&mockContestsService{})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/contests", nil)
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"contests": []}`, rec.Body.String())
+}
+
+func TestContestsHandler_POST_Returns405(t *testing.T) {
+	handler := handlers.NewContestsHandler(&mockContestsService{})
+
+	req := httptest.NewRequest(http.MethodPost, "/api/contests", nil)
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
@@ -0,0 +1,139 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sipgate/eurovision-vote-party/server/events"
+	"github.com/sipgate/eurovision-vote-party/server/services"
+)
+
+// DefaultHeartbeatInterval is how often an idle event stream sends a keep-alive comment.
+const DefaultHeartbeatInterval = 15 * time.Second
+
+// EventService defines the operations needed by the events handler.
+type EventService interface {
+	Subscribe(ctx context.Context, partyID string, lastEventID uint64) (*events.Subscription, []events.Event, error)
+}
+
+// EventsHandler streams party events to clients using Server-Sent Events.
+type EventsHandler struct {
+	service   EventService
+	heartbeat time.Duration
+}
+
+// NewEventsHandler creates a new EventsHandler that sends a heartbeat comment
+// whenever the stream has been idle for the given interval.
+func NewEventsHandler(service EventService, heartbeat time.Duration) *EventsHandler {
+	if heartbeat <= 0 {
+		heartbeat = DefaultHeartbeatInterval
+	}
+	return &EventsHandler{service: service, heartbeat: heartbeat}
+}
+
+// ServeHTTP routes requests to the appropriate handler method.
+func (h *EventsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimPrefix(r.URL.Path, "/api/parties/")
+	segments := strings.Split(path, "/")
+
+	if len(segments) == 2 && segments[1] == "events" && r.Method == http.MethodGet {
+		h.handleStream(w, r, segments[0])
+		return
+	}
+
+	http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+}
+
+// handleStream handles GET /api/parties/:id/events.
+// The party's hosts and guests may subscribe. Since EventSource cannot set
+// headers, guests pass their session token in the guestToken query parameter
+// instead. Clients resume after a reconnect by sending the ID of the last
+// event they received in the Last-Event-ID header.
+func (h *EventsHandler) handleStream(w http.ResponseWriter, r *http.Request, partyID string) {
+	if !requireCaller(w, r) {
+		return
+	}
+
+	var lastEventID uint64
+	if header := r.Header.Get("Last-Event-ID"); header != "" {
+		id, err := strconv.ParseUint(header, 10, 64)
+		if err != nil {
+			writeError(w, http.StatusBadRequest)
+			return
+		}
+		lastEventID = id
+	}
+
+	sub, backlog, err := h.service.Subscribe(r.Context(), partyID, lastEventID)
+	if err != nil {
+		if errors.Is(err, services.ErrUnauthorized) {
+			writeError(w, http.StatusForbidden)
+			return
+		}
+		if errors.Is(err, services.ErrNotFound) {
+			writeError(w, http.StatusNotFound)
+			return
+		}
+		writeError(w, http.StatusInternalServerError)
+		return
+	}
+	defer sub.Close()
+
+	rc := http.NewResponseController(w)
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	for _, event := range backlog {
+		if err := writeEvent(w, event); err != nil {
+			return
+		}
+	}
+	if err := rc.Flush(); err != nil {
+		return
+	}
+
+	heartbeat := time.NewTicker(h.heartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case event, ok := <-sub.Events():
+			if !ok {
+				// The subscriber fell behind; the client reconnects and resumes.
+				return
+			}
+			if err := writeEvent(w, event); err != nil {
+				return
+			}
+			heartbeat.Reset(h.heartbeat)
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": heartbeat\n\n"); err != nil {
+				return
+			}
+		}
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+// writeEvent writes a single event in the Server-Sent Events wire format.
+func writeEvent(w http.ResponseWriter, event events.Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data)
+	return err
+}
@@ -0,0 +1,206 @@
+package handlers_test
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sipgate/eurovision-vote-party/server/authz"
+	"github.com/sipgate/eurovision-vote-party/server/events"
+	"github.com/sipgate/eurovision-vote-party/server/handlers"
+	"github.com/sipgate/eurovision-vote-party/server/services"
+)
+
+type mockEventService struct {
+	subscribeFunc func(ctx context.Context, partyID string, lastEventID uint64) (*events.Subscription, []events.Event, error)
+}
+
+func (m *mockEventService) Subscribe(ctx context.Context, partyID string, lastEventID uint64) (*events.Subscription, []events.Event, error) {
+	if m.subscribeFunc != nil {
+		return m.subscribeFunc(ctx, partyID, lastEventID)
+	}
+	return nil, nil, services.ErrNotFound
+}
+
+// busEventService subscribes every caller directly to the bus.
+func busEventService(bus *events.Bus) *mockEventService {
+	return &mockEventService{
+		subscribeFunc: func(ctx context.Context, partyID string, lastEventID uint64) (*events.Subscription, []events.Event, error) {
+			sub, backlog := bus.Subscribe(partyID, lastEventID)
+			return sub, backlog, nil
+		},
+	}
+}
+
+// startEventStream serves the handler over HTTP with the given context decorator and opens the stream.
+func startEventStream(t *testing.T, handler http.Handler, withAuth func(*http.Request) *http.Request, lastEventID string) (*http.Response, *bufio.Reader) {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler.ServeHTTP(w, withAuth(r))
+	}))
+	t.Cleanup(server.Close)
+
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/api/parties/party-1/events", nil)
+	require.NoError(t, err)
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	t.Cleanup(func() { resp.Body.Close() })
+	return resp, bufio.NewReader(resp.Body)
+}
+
+// readFrame reads one Server-Sent Events frame, i.e. the lines up to the next blank line.
+func readFrame(t *testing.T, reader *bufio.Reader) []string {
+	t.Helper()
+
+	var lines []string
+	for {
+		line, err := reader.ReadString('\n')
+		require.NoError(t, err)
+		line = strings.TrimSuffix(line, "\n")
+		if line == "" {
+			return lines
+		}
+		lines = append(lines, line)
+	}
+}
+
+func TestEventsHandler_StreamsPublishedEventsToAdmin(t *testing.T) {
+	bus := events.NewBus(events.DefaultHistory)
+	svc := busEventService(bus)
+	subscribed := make(chan struct{})
+	inner := svc.subscribeFunc
+	svc.subscribeFunc = func(ctx context.Context, partyID string, lastEventID uint64) (*events.Subscription, []events.Event, error) {
+		assert.Equal(t, "user-123", authz.FromContext(ctx).UserID)
+		assert.Equal(t, "", authz.FromContext(ctx).GuestID)
+		assert.Equal(t, "party-1", partyID)
+		defer close(subscribed)
+		return inner(ctx, partyID, lastEventID)
+	}
+
+	handler := handlers.NewEventsHandler(svc, time.Minute)
+	resp, reader := startEventStream(t, handler, func(r *http.Request) *http.Request {
+		return requestWithUserID(r, "user-123")
+	}, "")
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+	assert.Equal(t, "no-cache", resp.Header.Get("Cache-Control"))
+
+	<-subscribed
+	bus.Publish("party-1", events.GuestJoined, map[string]string{"guestId": "guest-1"})
+
+	frame := readFrame(t, reader)
+	require.Len(t, frame, 3)
+	assert.Equal(t, "id: 1", frame[0])
+	assert.Equal(t, "event: guest.joined", frame[1])
+	assert.True(t, strings.HasPrefix(frame[2], "data: "))
+	assert.Contains(t, frame[2], `"guestId":"guest-1"`)
+	assert.Contains(t, frame[2], `"type":"guest.joined"`)
+}
+
+func TestEventsHandler_ResumesAfterLastEventID(t *testing.T) {
+	bus := events.NewBus(events.DefaultHistory)
+	watcher, _ := bus.Subscribe("party-1", 0)
+	defer watcher.Close()
+	bus.Publish("party-1", events.GuestJoined, nil)
+	bus.Publish("party-1", events.GuestApproved, nil)
+	bus.Publish("party-1", events.VoteSubmitted, nil)
+
+	handler := handlers.NewEventsHandler(busEventService(bus), time.Minute)
+	_, reader := startEventStream(t, handler, func(r *http.Request) *http.Request {
+		return requestWithGuest(r, "guest-1", "party-1")
+	}, "1")
+
+	assert.Equal(t, "id: 2", readFrame(t, reader)[0])
+	assert.Equal(t, "id: 3", readFrame(t, reader)[0])
+}
+
+func TestEventsHandler_SendsHeartbeatComments(t *testing.T) {
+	bus := events.NewBus(events.DefaultHistory)
+
+	handler := handlers.NewEventsHandler(busEventService(bus), 10*time.Millisecond)
+	_, reader := startEventStream(t, handler, func(r *http.Request) *http.Request {
+		return requestWithGuest(r, "guest-1", "party-1")
+	}, "")
+
+	assert.Equal(t, []string{": heartbeat"}, readFrame(t, reader))
+}
+
+func TestEventsHandler_PassesGuestSessionToService(t *testing.T) {
+	svc := &mockEventService{
+		subscribeFunc: func(ctx context.Context, partyID string, lastEventID uint64) (*events.Subscription, []events.Event, error) {
+			assert.Equal(t, "", authz.FromContext(ctx).UserID)
+			assert.Equal(t, "guest-1", authz.FromContext(ctx).GuestID)
+			return nil, nil, services.ErrUnauthorized
+		},
+	}
+
+	handler := handlers.NewEventsHandler(svc, time.Minute)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/parties/party-1/events", nil)
+	req = requestWithGuest(req, "guest-1", "party-1")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestEventsHandler_ReturnsUnauthorizedWithoutAuth(t *testing.T) {
+	handler := handlers.NewEventsHandler(&mockEventService{}, time.Minute)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/parties/party-1/events", nil)
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestEventsHandler_ReturnsBadRequestWithInvalidLastEventID(t *testing.T) {
+	handler := handlers.NewEventsHandler(&mockEventService{}, time.Minute)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/parties/party-1/events", nil)
+	req.Header.Set("Last-Event-ID", "not-a-number")
+	req = requestWithUserID(req, "user-123")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestEventsHandler_ReturnsNotFoundWhenPartyNotFound(t *testing.T) {
+	handler := handlers.NewEventsHandler(&mockEventService{}, time.Minute)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/parties/party-1/events", nil)
+	req = requestWithUserID(req, "user-123")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestEventsHandler_ReturnsMethodNotAllowedForWrongMethod(t *testing.T) {
+	handler := handlers.NewEventsHandler(&mockEventService{}, time.Minute)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/parties/party-1/events", nil)
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
@@ -14,14 +14,14 @@ import (
 
 // GuestService defines the operations needed by the guest handler.
 type GuestService interface {
-	JoinParty(ctx context.Context, code, username string) (*models.Guest, error)
-	ListGuests(ctx context.Context, adminID, partyID string) ([]*models.Guest, error)
-	ListGuestsAsGuest(ctx context.Context, guestID, partyID string) ([]*models.Guest, error)
-	ListJoinRequests(ctx context.Context, adminID, partyID string) ([]*models.Guest, error)
-	ApproveGuest(ctx context.Context, adminID, partyID, guestID string) error
-	RejectGuest(ctx context.Context, adminID, partyID, guestID string) error
-	RemoveGuest(ctx context.Context, adminID, partyID, guestID string) error
+	JoinParty(ctx context.Context, code, username string) (*models.Guest, string, error)
+	ListGuests(ctx context.Context, partyID string) ([]*models.Guest, error)
+	ListJoinRequests(ctx context.Context, partyID string) ([]*models.Guest, error)
+	ApproveGuest(ctx context.Context, partyID, guestID string) error
+	RejectGuest(ctx context.Context, partyID, guestID string) error
+	RemoveGuest(ctx context.Context, partyID, guestID string) error
 	GetGuestStatus(ctx context.Context, code, guestID string) (*models.Guest, error)
+	RevokeGuestSession(ctx context.Context, partyID, guestID string) error
 }
 
 // GuestHandler handles HTTP requests for guest management.
@@ -39,6 +39,18 @@ type joinPartyRequest struct {
 	Username string `json:"username"`
 }
 
+// joinPartyResponse is the guest created by joining a party together with its session token.
+// Clients send the token in the X-Guest-Token header to act as that guest.
+type joinPartyResponse struct {
+	*models.Guest
+	Token string `json:"token"`
+}
+
+// joinQueueRetryAfter is the Retry-After, in seconds, sent to guests turned
+// away because too many join requests are pending; the host has to work
+// through them first.
+const joinQueueRetryAfter = "60"
+
 // ServeHTTP routes requests to the appropriate handler method.
 func (h *GuestHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	path := strings.TrimPrefix(r.URL.Path, "/api/parties/")
@@ -86,6 +98,11 @@ func (h *GuestHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					h.handleRejectGuest(w, r, segments[0], segments[2])
 					return
 				}
+			case "session":
+				if r.Method == http.MethodDelete {
+					h.handleRevokeGuestSession(w, r, segments[0], segments[2])
+					return
+				}
 			}
 		}
 	}
@@ -106,7 +123,7 @@ func (h *GuestHandler) handleJoinParty(w http.ResponseWriter, r *http.Request, c
 		return
 	}
 
-	guest, err := h.service.JoinParty(r.Context(), code, req.Username)
+	guest, token, err := h.service.JoinParty(r.Context(), code, req.Username)
 	if err != nil {
 		if errors.Is(err, services.ErrNotFound) {
 			writeError(w, http.StatusNotFound)
@@ -116,67 +133,50 @@ func (h *GuestHandler) handleJoinParty(w http.ResponseWriter, r *http.Request, c
 			writeError(w, http.StatusConflict)
 			return
 		}
+		if errors.Is(err, services.ErrJoinQueueFull) {
+			w.Header().Set("Retry-After", joinQueueRetryAfter)
+			writeError(w, http.StatusTooManyRequests)
+			return
+		}
 		writeError(w, http.StatusInternalServerError)
 		return
 	}
 
-	writeJSON(w, http.StatusCreated, guest)
+	writeJSON(w, http.StatusCreated, joinPartyResponse{Guest: guest, Token: token})
 }
 
 // handleListGuests handles GET /api/parties/:id/guests.
 func (h *GuestHandler) handleListGuests(w http.ResponseWriter, r *http.Request, partyID string) {
-	// Try admin auth first (OptionalAuthMiddleware may have set it).
-	userID, ok := middleware.UserIDFromContext(r.Context())
-	if ok {
-		guests, err := h.service.ListGuests(r.Context(), userID, partyID)
-		if err != nil {
-			if errors.Is(err, services.ErrUnauthorized) {
-				writeError(w, http.StatusForbidden)
-				return
-			}
-			if errors.Is(err, services.ErrNotFound) {
-				writeError(w, http.StatusNotFound)
-				return
-			}
-			writeError(w, http.StatusInternalServerError)
-			return
-		}
-		writeJSON(w, http.StatusOK, guests)
+	// Hosts and approved guests may list guests; the service tells them apart.
+	if !requireCaller(w, r) {
 		return
 	}
 
-	// Fall back to guest auth via query parameter.
-	guestID := r.URL.Query().Get("guestId")
-	if guestID != "" {
-		guests, err := h.service.ListGuestsAsGuest(r.Context(), guestID, partyID)
-		if err != nil {
-			if errors.Is(err, services.ErrUnauthorized) {
-				writeError(w, http.StatusForbidden)
-				return
-			}
-			if errors.Is(err, services.ErrNotFound) {
-				writeError(w, http.StatusNotFound)
-				return
-			}
-			writeError(w, http.StatusInternalServerError)
+	guests, err := h.service.ListGuests(r.Context(), partyID)
+	if err != nil {
+		if errors.Is(err, services.ErrUnauthorized) {
+			writeError(w, http.StatusForbidden)
 			return
 		}
-		writeJSON(w, http.StatusOK, guests)
+		if errors.Is(err, services.ErrNotFound) {
+			writeError(w, http.StatusNotFound)
+			return
+		}
+		writeError(w, http.StatusInternalServerError)
 		return
 	}
 
-	writeError(w, http.StatusUnauthorized)
+	writeJSON(w, http.StatusOK, guests)
 }
 
 // handleListJoinRequests handles GET /api/parties/:id/join-requests.
 func (h *GuestHandler) handleListJoinRequests(w http.ResponseWriter, r *http.Request, partyID string) {
-	userID, ok := middleware.UserIDFromContext(r.Context())
-	if !ok {
+	if _, ok := middleware.UserIDFromContext(r.Context()); !ok {
 		writeError(w, http.StatusUnauthorized)
 		return
 	}
 
-	guests, err := h.service.ListJoinRequests(r.Context(), userID, partyID)
+	guests, err := h.service.ListJoinRequests(r.Context(), partyID)
 	if err != nil {
 		if errors.Is(err, services.ErrUnauthorized) {
 			writeError(w, http.StatusForbidden)
@@ -195,13 +195,12 @@ func (h *GuestHandler) handleListJoinRequests(w http.ResponseWriter, r *http.Req
 
 // handleApproveGuest handles PUT /api/parties/:id/guests/:guestId/approve.
 func (h *GuestHandler) handleApproveGuest(w http.ResponseWriter, r *http.Request, partyID, guestID string) {
-	userID, ok := middleware.UserIDFromContext(r.Context())
-	if !ok {
+	if _, ok := middleware.UserIDFromContext(r.Context()); !ok {
 		writeError(w, http.StatusUnauthorized)
 		return
 	}
 
-	err := h.service.ApproveGuest(r.Context(), userID, partyID, guestID)
+	err := h.service.ApproveGuest(r.Context(), partyID, guestID)
 	if err != nil {
 		if errors.Is(err, services.ErrUnauthorized) {
 			writeError(w, http.StatusForbidden)
@@ -220,13 +219,12 @@ func (h *GuestHandler) handleApproveGuest(w http.ResponseWriter, r *http.Request
 
 // handleRejectGuest handles PUT /api/parties/:id/guests/:guestId/reject.
 func (h *GuestHandler) handleRejectGuest(w http.ResponseWriter, r *http.Request, partyID, guestID string) {
-	userID, ok := middleware.UserIDFromContext(r.Context())
-	if !ok {
+	if _, ok := middleware.UserIDFromContext(r.Context()); !ok {
 		writeError(w, http.StatusUnauthorized)
 		return
 	}
 
-	err := h.service.RejectGuest(r.Context(), userID, partyID, guestID)
+	err := h.service.RejectGuest(r.Context(), partyID, guestID)
 	if err != nil {
 		if errors.Is(err, services.ErrUnauthorized) {
 			writeError(w, http.StatusForbidden)
@@ -245,13 +243,36 @@ func (h *GuestHandler) handleRejectGuest(w http.ResponseWriter, r *http.Request,
 
 // handleRemoveGuest handles DELETE /api/parties/:id/guests/:guestId.
 func (h *GuestHandler) handleRemoveGuest(w http.ResponseWriter, r *http.Request, partyID, guestID string) {
-	userID, ok := middleware.UserIDFromContext(r.Context())
-	if !ok {
+	if _, ok := middleware.UserIDFromContext(r.Context()); !ok {
+		writeError(w, http.StatusUnauthorized)
+		return
+	}
+
+	err := h.service.RemoveGuest(r.Context(), partyID, guestID)
+	if err != nil {
+		if errors.Is(err, services.ErrUnauthorized) {
+			writeError(w, http.StatusForbidden)
+			return
+		}
+		if errors.Is(err, services.ErrNotFound) {
+			writeError(w, http.StatusNotFound)
+			return
+		}
+		writeError(w, http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleRevokeGuestSession handles DELETE /api/parties/:id/guests/:guestId/session.
+func (h *GuestHandler) handleRevokeGuestSession(w http.ResponseWriter, r *http.Request, partyID, guestID string) {
+	if _, ok := middleware.UserIDFromContext(r.Context()); !ok {
 		writeError(w, http.StatusUnauthorized)
 		return
 	}
 
-	err := h.service.RemoveGuest(r.Context(), userID, partyID, guestID)
+	err := h.service.RevokeGuestSession(r.Context(), partyID, guestID)
 	if err != nil {
 		if errors.Is(err, services.ErrUnauthorized) {
 			writeError(w, http.StatusForbidden)
@@ -269,10 +290,11 @@ func (h *GuestHandler) handleRemoveGuest(w http.ResponseWriter, r *http.Request,
 }
 
 // handleGetGuestStatus handles GET /api/parties/:code/guest-status.
+// The guest is identified by its session token.
 func (h *GuestHandler) handleGetGuestStatus(w http.ResponseWriter, r *http.Request, code string) {
-	guestID := r.URL.Query().Get("guestId")
-	if guestID == "" {
-		writeError(w, http.StatusBadRequest)
+	guestID, _, ok := middleware.GuestFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized)
 		return
 	}
 
@@ -12,67 +12,62 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/sipgate/eurovision-vote-party/server/authz"
 	"github.com/sipgate/eurovision-vote-party/server/handlers"
+	"github.com/sipgate/eurovision-vote-party/server/middleware"
 	"github.com/sipgate/eurovision-vote-party/server/models"
 	"github.com/sipgate/eurovision-vote-party/server/services"
 )
 
 type mockGuestService struct {
-	joinPartyFunc        func(ctx context.Context, code, username string) (*models.Guest, error)
-	listGuestsFunc       func(ctx context.Context, adminID, partyID string) ([]*models.Guest, error)
-	listGuestsAsGuestFunc func(ctx context.Context, guestID, partyID string) ([]*models.Guest, error)
-	listJoinRequestsFunc func(ctx context.Context, adminID, partyID string) ([]*models.Guest, error)
-	approveGuestFunc     func(ctx context.Context, adminID, partyID, guestID string) error
-	rejectGuestFunc      func(ctx context.Context, adminID, partyID, guestID string) error
-	removeGuestFunc      func(ctx context.Context, adminID, partyID, guestID string) error
+	joinPartyFunc        func(ctx context.Context, code, username string) (*models.Guest, string, error)
+	listGuestsFunc       func(ctx context.Context, partyID string) ([]*models.Guest, error)
+	listJoinRequestsFunc func(ctx context.Context, partyID string) ([]*models.Guest, error)
+	approveGuestFunc     func(ctx context.Context, partyID, guestID string) error
+	rejectGuestFunc      func(ctx context.Context, partyID, guestID string) error
+	removeGuestFunc      func(ctx context.Context, partyID, guestID string) error
 	getGuestStatusFunc   func(ctx context.Context, code, guestID string) (*models.Guest, error)
+	revokeGuestSessionFunc func(ctx context.Context, partyID, guestID string) error
 }
 
-func (m *mockGuestService) JoinParty(ctx context.Context, code, username string) (*models.Guest, error) {
+func (m *mockGuestService) JoinParty(ctx context.Context, code, username string) (*models.Guest, string, error) {
 	if m.joinPartyFunc != nil {
 		return m.joinPartyFunc(ctx, code, username)
 	}
-	return nil, nil
+	return nil, "", nil
 }
 
-func (m *mockGuestService) ListGuests(ctx context.Context, adminID, partyID string) ([]*models.Guest, error) {
+func (m *mockGuestService) ListGuests(ctx context.Context, partyID string) ([]*models.Guest, error) {
 	if m.listGuestsFunc != nil {
-		return m.listGuestsFunc(ctx, adminID, partyID)
-	}
-	return nil, nil
-}
-
-func (m *mockGuestService) ListGuestsAsGuest(ctx context.Context, guestID, partyID string) ([]*models.Guest, error) {
-	if m.listGuestsAsGuestFunc != nil {
-		return m.listGuestsAsGuestFunc(ctx, guestID, partyID)
+		return m.listGuestsFunc(ctx, partyID)
 	}
 	return nil, nil
 }
 
-func (m *mockGuestService) ListJoinRequests(ctx context.Context, adminID, partyID string) ([]*models.Guest, error) {
+func (m *mockGuestService) ListJoinRequests(ctx context.Context, partyID string) ([]*models.Guest, error) {
 	if m.listJoinRequestsFunc != nil {
-		return m.listJoinRequestsFunc(ctx, adminID, partyID)
+		return m.listJoinRequestsFunc(ctx, partyID)
 	}
 	return nil, nil
 }
 
-func (m *mockGuestService) ApproveGuest(ctx context.Context, adminID, partyID, guestID string) error {
+func (m *mockGuestService) ApproveGuest(ctx context.Context, partyID, guestID string) error {
 	if m.approveGuestFunc != nil {
-		return m.approveGuestFunc(ctx, adminID, partyID, guestID)
+		return m.approveGuestFunc(ctx, partyID, guestID)
 	}
 	return nil
 }
 
-func (m *mockGuestService) RejectGuest(ctx context.Context, adminID, partyID, guestID string) error {
+func (m *mockGuestService) RejectGuest(ctx context.Context, partyID, guestID string) error {
 	if m.rejectGuestFunc != nil {
-		return m.rejectGuestFunc(ctx, adminID, partyID, guestID)
+		return m.rejectGuestFunc(ctx, partyID, guestID)
 	}
 	return nil
 }
 
-func (m *mockGuestService) RemoveGuest(ctx context.Context, adminID, partyID, guestID string) error {
+func (m *mockGuestService) RemoveGuest(ctx context.Context, partyID, guestID string) error {
 	if m.removeGuestFunc != nil {
-		return m.removeGuestFunc(ctx, adminID, partyID, guestID)
+		return m.removeGuestFunc(ctx, partyID, guestID)
 	}
 	return nil
 }
@@ -84,6 +79,18 @@ func (m *mockGuestService) GetGuestStatus(ctx context.Context, code, guestID str
 	return nil, services.ErrNotFound
 }
 
+func (m *mockGuestService) RevokeGuestSession(ctx context.Context, partyID, guestID string) error {
+	if m.revokeGuestSessionFunc != nil {
+		return m.revokeGuestSessionFunc(ctx, partyID, guestID)
+	}
+	return nil
+}
+
+func requestWithGuest(req *http.Request, guestID, partyID string) *http.Request {
+	ctx := middleware.WithGuest(req.Context(), guestID, partyID)
+	return req.WithContext(ctx)
+}
+
 // --- Join Party Tests ---
 
 func TestGuestHandler_JoinParty_ReturnsCreatedWithValidRequest(t *testing.T) {
@@ -96,10 +103,10 @@ func TestGuestHandler_JoinParty_ReturnsCreatedWithValidRequest(t *testing.T) {
 	}
 
 	svc := &mockGuestService{
-		joinPartyFunc: func(ctx context.Context, code, username string) (*models.Guest, error) {
+		joinPartyFunc: func(ctx context.Context, code, username string) (*models.Guest, string, error) {
 			assert.Equal(t, "ABC234", code)
 			assert.Equal(t, "alice", username)
-			return createdGuest, nil
+			return createdGuest, "guest-token", nil
 		},
 	}
 
@@ -114,12 +121,16 @@ func TestGuestHandler_JoinParty_ReturnsCreatedWithValidRequest(t *testing.T) {
 	assert.Equal(t, http.StatusCreated, rec.Code)
 	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
 
-	var response models.Guest
+	var response struct {
+		models.Guest
+		Token string `json:"token"`
+	}
 	err := json.Unmarshal(rec.Body.Bytes(), &response)
 	require.NoError(t, err)
 	assert.Equal(t, "guest-1", response.ID)
 	assert.Equal(t, "alice", response.Username)
 	assert.Equal(t, models.GuestStatusPending, response.Status)
+	assert.Equal(t, "guest-token", response.Token)
 }
 
 func TestGuestHandler_JoinParty_ReturnsBadRequestWithMissingUsername(t *testing.T) {
@@ -148,8 +159,8 @@ func TestGuestHandler_JoinParty_ReturnsBadRequestWithBlankUsername(t *testing.T)
 
 func TestGuestHandler_JoinParty_ReturnsNotFoundWhenPartyNotFound(t *testing.T) {
 	svc := &mockGuestService{
-		joinPartyFunc: func(ctx context.Context, code, username string) (*models.Guest, error) {
-			return nil, services.ErrNotFound
+		joinPartyFunc: func(ctx context.Context, code, username string) (*models.Guest, string, error) {
+			return nil, "", services.ErrNotFound
 		},
 	}
 
@@ -166,8 +177,8 @@ func TestGuestHandler_JoinParty_ReturnsNotFoundWhenPartyNotFound(t *testing.T) {
 
 func TestGuestHandler_JoinParty_ReturnsConflictOnDuplicateUsername(t *testing.T) {
 	svc := &mockGuestService{
-		joinPartyFunc: func(ctx context.Context, code, username string) (*models.Guest, error) {
-			return nil, services.ErrDuplicateUsername
+		joinPartyFunc: func(ctx context.Context, code, username string) (*models.Guest, string, error) {
+			return nil, "", services.ErrDuplicateUsername
 		},
 	}
 
@@ -182,6 +193,25 @@ func TestGuestHandler_JoinParty_ReturnsConflictOnDuplicateUsername(t *testing.T)
 	assert.Equal(t, http.StatusConflict, rec.Code)
 }
 
+func TestGuestHandler_JoinParty_ReturnsTooManyRequestsWhenJoinQueueIsFull(t *testing.T) {
+	svc := &mockGuestService{
+		joinPartyFunc: func(ctx context.Context, code, username string) (*models.Guest, string, error) {
+			return nil, "", services.ErrJoinQueueFull
+		},
+	}
+
+	handler := handlers.NewGuestHandler(svc)
+
+	body := `{"username": "alice"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/parties/ABC234/join", bytes.NewBufferString(body))
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Equal(t, "60", rec.Header().Get("Retry-After"))
+}
+
 // --- List Guests Tests ---
 
 func TestGuestHandler_ListGuests_ReturnsGuestsForAdmin(t *testing.T) {
@@ -203,8 +233,8 @@ func TestGuestHandler_ListGuests_ReturnsGuestsForAdmin(t *testing.T) {
 	}
 
 	svc := &mockGuestService{
-		listGuestsFunc: func(ctx context.Context, adminID, partyID string) ([]*models.Guest, error) {
-			assert.Equal(t, "user-123", adminID)
+		listGuestsFunc: func(ctx context.Context, partyID string) ([]*models.Guest, error) {
+			assert.Equal(t, "user-123", authz.FromContext(ctx).UserID)
 			assert.Equal(t, "party-1", partyID)
 			return guests, nil
 		},
@@ -241,8 +271,8 @@ func TestGuestHandler_ListGuests_ReturnsGuestsForApprovedGuest(t *testing.T) {
 	}
 
 	svc := &mockGuestService{
-		listGuestsAsGuestFunc: func(ctx context.Context, guestID, partyID string) ([]*models.Guest, error) {
-			assert.Equal(t, "guest-1", guestID)
+		listGuestsFunc: func(ctx context.Context, partyID string) ([]*models.Guest, error) {
+			assert.Equal(t, "guest-1", authz.FromContext(ctx).GuestID)
 			assert.Equal(t, "party-1", partyID)
 			return guests, nil
 		},
@@ -250,7 +280,8 @@ func TestGuestHandler_ListGuests_ReturnsGuestsForApprovedGuest(t *testing.T) {
 
 	handler := handlers.NewGuestHandler(svc)
 
-	req := httptest.NewRequest(http.MethodGet, "/api/parties/party-1/guests?guestId=guest-1", nil)
+	req := httptest.NewRequest(http.MethodGet, "/api/parties/party-1/guests", nil)
+	req = requestWithGuest(req, "guest-1", "party-1")
 	rec := httptest.NewRecorder()
 
 	handler.ServeHTTP(rec, req)
@@ -265,6 +296,22 @@ func TestGuestHandler_ListGuests_ReturnsGuestsForApprovedGuest(t *testing.T) {
 	assert.Equal(t, "guest-1", response[0].ID)
 }
 
+func TestGuestHandler_ListGuests_IgnoresGuestIdQueryParameter(t *testing.T) {
+	handler := handlers.NewGuestHandler(&mockGuestService{
+		listGuestsFunc: func(ctx context.Context, partyID string) ([]*models.Guest, error) {
+			t.Fatal("service must not be called without a guest session")
+			return nil, nil
+		},
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/parties/party-1/guests?guestId=guest-1", nil)
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
 func TestGuestHandler_ListGuests_ReturnsUnauthorizedWithoutAuth(t *testing.T) {
 	handler := handlers.NewGuestHandler(&mockGuestService{})
 
@@ -278,7 +325,7 @@ func TestGuestHandler_ListGuests_ReturnsUnauthorizedWithoutAuth(t *testing.T) {
 
 func TestGuestHandler_ListGuests_ReturnsForbiddenForNonOwner(t *testing.T) {
 	svc := &mockGuestService{
-		listGuestsFunc: func(ctx context.Context, adminID, partyID string) ([]*models.Guest, error) {
+		listGuestsFunc: func(ctx context.Context, partyID string) ([]*models.Guest, error) {
 			return nil, services.ErrUnauthorized
 		},
 	}
@@ -308,8 +355,8 @@ func TestGuestHandler_ListJoinRequests_ReturnsPendingGuests(t *testing.T) {
 	}
 
 	svc := &mockGuestService{
-		listJoinRequestsFunc: func(ctx context.Context, adminID, partyID string) ([]*models.Guest, error) {
-			assert.Equal(t, "user-123", adminID)
+		listJoinRequestsFunc: func(ctx context.Context, partyID string) ([]*models.Guest, error) {
+			assert.Equal(t, "user-123", authz.FromContext(ctx).UserID)
 			assert.Equal(t, "party-1", partyID)
 			return guests, nil
 		},
@@ -347,7 +394,7 @@ func TestGuestHandler_ListJoinRequests_ReturnsUnauthorizedWithoutAuth(t *testing
 
 func TestGuestHandler_ListJoinRequests_ReturnsForbiddenForNonOwner(t *testing.T) {
 	svc := &mockGuestService{
-		listJoinRequestsFunc: func(ctx context.Context, adminID, partyID string) ([]*models.Guest, error) {
+		listJoinRequestsFunc: func(ctx context.Context, partyID string) ([]*models.Guest, error) {
 			return nil, services.ErrUnauthorized
 		},
 	}
@@ -367,8 +414,8 @@ func TestGuestHandler_ListJoinRequests_ReturnsForbiddenForNonOwner(t *testing.T)
 
 func TestGuestHandler_ApproveGuest_ReturnsOKOnSuccess(t *testing.T) {
 	svc := &mockGuestService{
-		approveGuestFunc: func(ctx context.Context, adminID, partyID, guestID string) error {
-			assert.Equal(t, "user-123", adminID)
+		approveGuestFunc: func(ctx context.Context, partyID, guestID string) error {
+			assert.Equal(t, "user-123", authz.FromContext(ctx).UserID)
 			assert.Equal(t, "party-1", partyID)
 			assert.Equal(t, "guest-1", guestID)
 			return nil
@@ -399,7 +446,7 @@ func TestGuestHandler_ApproveGuest_ReturnsUnauthorizedWithoutAuth(t *testing.T)
 
 func TestGuestHandler_ApproveGuest_ReturnsForbiddenForNonOwner(t *testing.T) {
 	svc := &mockGuestService{
-		approveGuestFunc: func(ctx context.Context, adminID, partyID, guestID string) error {
+		approveGuestFunc: func(ctx context.Context, partyID, guestID string) error {
 			return services.ErrUnauthorized
 		},
 	}
@@ -417,7 +464,7 @@ func TestGuestHandler_ApproveGuest_ReturnsForbiddenForNonOwner(t *testing.T) {
 
 func TestGuestHandler_ApproveGuest_ReturnsNotFoundWhenNotExists(t *testing.T) {
 	svc := &mockGuestService{
-		approveGuestFunc: func(ctx context.Context, adminID, partyID, guestID string) error {
+		approveGuestFunc: func(ctx context.Context, partyID, guestID string) error {
 			return services.ErrNotFound
 		},
 	}
@@ -437,8 +484,8 @@ func TestGuestHandler_ApproveGuest_ReturnsNotFoundWhenNotExists(t *testing.T) {
 
 func TestGuestHandler_RejectGuest_ReturnsOKOnSuccess(t *testing.T) {
 	svc := &mockGuestService{
-		rejectGuestFunc: func(ctx context.Context, adminID, partyID, guestID string) error {
-			assert.Equal(t, "user-123", adminID)
+		rejectGuestFunc: func(ctx context.Context, partyID, guestID string) error {
+			assert.Equal(t, "user-123", authz.FromContext(ctx).UserID)
 			assert.Equal(t, "party-1", partyID)
 			assert.Equal(t, "guest-1", guestID)
 			return nil
@@ -471,8 +518,8 @@ func TestGuestHandler_RejectGuest_ReturnsUnauthorizedWithoutAuth(t *testing.T) {
 
 func TestGuestHandler_RemoveGuest_ReturnsNoContentOnSuccess(t *testing.T) {
 	svc := &mockGuestService{
-		removeGuestFunc: func(ctx context.Context, adminID, partyID, guestID string) error {
-			assert.Equal(t, "user-123", adminID)
+		removeGuestFunc: func(ctx context.Context, partyID, guestID string) error {
+			assert.Equal(t, "user-123", authz.FromContext(ctx).UserID)
 			assert.Equal(t, "party-1", partyID)
 			assert.Equal(t, "guest-1", guestID)
 			return nil
@@ -503,7 +550,7 @@ func TestGuestHandler_RemoveGuest_ReturnsUnauthorizedWithoutAuth(t *testing.T) {
 
 func TestGuestHandler_RemoveGuest_ReturnsNotFoundWhenNotExists(t *testing.T) {
 	svc := &mockGuestService{
-		removeGuestFunc: func(ctx context.Context, adminID, partyID, guestID string) error {
+		removeGuestFunc: func(ctx context.Context, partyID, guestID string) error {
 			return services.ErrNotFound
 		},
 	}
@@ -540,7 +587,8 @@ func TestGuestHandler_GetGuestStatus_ReturnsGuestStatus(t *testing.T) {
 
 	handler := handlers.NewGuestHandler(svc)
 
-	req := httptest.NewRequest(http.MethodGet, "/api/parties/ABC234/guest-status?guestId=guest-1", nil)
+	req := httptest.NewRequest(http.MethodGet, "/api/parties/ABC234/guest-status", nil)
+	req = requestWithGuest(req, "guest-1", "party-1")
 	rec := httptest.NewRecorder()
 
 	handler.ServeHTTP(rec, req)
@@ -556,15 +604,15 @@ func TestGuestHandler_GetGuestStatus_ReturnsGuestStatus(t *testing.T) {
 	assert.Equal(t, models.GuestStatusApproved, response.Status)
 }
 
-func TestGuestHandler_GetGuestStatus_ReturnsBadRequestWithoutGuestId(t *testing.T) {
+func TestGuestHandler_GetGuestStatus_ReturnsUnauthorizedWithoutGuestSession(t *testing.T) {
 	handler := handlers.NewGuestHandler(&mockGuestService{})
 
-	req := httptest.NewRequest(http.MethodGet, "/api/parties/ABC234/guest-status", nil)
+	req := httptest.NewRequest(http.MethodGet, "/api/parties/ABC234/guest-status?guestId=guest-1", nil)
 	rec := httptest.NewRecorder()
 
 	handler.ServeHTTP(rec, req)
 
-	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
 }
 
 func TestGuestHandler_GetGuestStatus_ReturnsNotFoundWhenNotExists(t *testing.T) {
@@ -576,10 +624,64 @@ func TestGuestHandler_GetGuestStatus_ReturnsNotFoundWhenNotExists(t *testing.T)
 
 	handler := handlers.NewGuestHandler(svc)
 
-	req := httptest.NewRequest(http.MethodGet, "/api/parties/ABC234/guest-status?guestId=guest-1", nil)
+	req := httptest.NewRequest(http.MethodGet, "/api/parties/ABC234/guest-status", nil)
+	req = requestWithGuest(req, "guest-1", "party-1")
 	rec := httptest.NewRecorder()
 
 	handler.ServeHTTP(rec, req)
 
 	assert.Equal(t, http.StatusNotFound, rec.Code)
 }
+
+// --- Revoke Guest Session Tests ---
+
+func TestGuestHandler_RevokeGuestSession_ReturnsNoContentOnSuccess(t *testing.T) {
+	svc := &mockGuestService{
+		revokeGuestSessionFunc: func(ctx context.Context, partyID, guestID string) error {
+			assert.Equal(t, "user-123", authz.FromContext(ctx).UserID)
+			assert.Equal(t, "party-1", partyID)
+			assert.Equal(t, "guest-1", guestID)
+			return nil
+		},
+	}
+
+	handler := handlers.NewGuestHandler(svc)
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/parties/party-1/guests/guest-1/session", nil)
+	req = requestWithUserID(req, "user-123")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+}
+
+func TestGuestHandler_RevokeGuestSession_ReturnsUnauthorizedWithoutAuth(t *testing.T) {
+	handler := handlers.NewGuestHandler(&mockGuestService{})
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/parties/party-1/guests/guest-1/session", nil)
+	req = requestWithGuest(req, "guest-1", "party-1")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestGuestHandler_RevokeGuestSession_ReturnsForbiddenForNonOwner(t *testing.T) {
+	svc := &mockGuestService{
+		revokeGuestSessionFunc: func(ctx context.Context, partyID, guestID string) error {
+			return services.ErrUnauthorized
+		},
+	}
+
+	handler := handlers.NewGuestHandler(svc)
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/parties/party-1/guests/guest-1/session", nil)
+	req = requestWithUserID(req, "other-user")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
@@ -0,0 +1,199 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/sipgate/eurovision-vote-party/server/middleware"
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/services"
+)
+
+// MemberServiceHandler defines the operations needed by the member handler.
+type MemberServiceHandler interface {
+	ListMembers(ctx context.Context, partyID string) ([]models.PartyMember, error)
+	InviteMember(ctx context.Context, partyID string, req services.InviteMemberRequest) (*models.PartyMember, error)
+	UpdateMemberRole(ctx context.Context, partyID, userID string, role models.PartyRole) (*models.PartyMember, error)
+	RemoveMember(ctx context.Context, partyID, userID string) error
+	AcceptInvitation(ctx context.Context, userID, partyID string) (*models.PartyMember, error)
+	ListInvitations(ctx context.Context, userID string) ([]*models.Party, error)
+}
+
+// MemberHandler handles HTTP requests for party co-hosts and moderators.
+type MemberHandler struct {
+	service MemberServiceHandler
+}
+
+// NewMemberHandler creates a new MemberHandler.
+func NewMemberHandler(service MemberServiceHandler) *MemberHandler {
+	return &MemberHandler{service: service}
+}
+
+// inviteMemberRequest is the body of an invitation.
+type inviteMemberRequest struct {
+	User string           `json:"user"`
+	Role models.PartyRole `json:"role"`
+}
+
+// updateMemberRequest is the body of a role change.
+type updateMemberRequest struct {
+	Role models.PartyRole `json:"role"`
+}
+
+// ServeHTTP routes requests to the appropriate handler method. All routes
+// require an authenticated user.
+//
+//	GET    /api/parties/invitations                 list pending invitations
+//	GET    /api/parties/{partyID}/members           list the owner and members
+//	POST   /api/parties/{partyID}/members           invite a co-host or moderator
+//	POST   /api/parties/{partyID}/members/accept    accept an invitation
+//	PUT    /api/parties/{partyID}/members/{userID}  change a member's role
+//	DELETE /api/parties/{partyID}/members/{userID}  remove a member or decline
+func (h *MemberHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized)
+		return
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, "/api/parties/")
+	segments := strings.Split(path, "/")
+
+	switch {
+	case len(segments) == 1 && segments[0] == "invitations":
+		if r.Method == http.MethodGet {
+			h.handleListInvitations(w, r, userID)
+			return
+		}
+	case len(segments) == 2 && segments[1] == "members":
+		switch r.Method {
+		case http.MethodGet:
+			h.handleListMembers(w, r, segments[0])
+			return
+		case http.MethodPost:
+			h.handleInvite(w, r, segments[0])
+			return
+		}
+	case len(segments) == 3 && segments[1] == "members" && segments[2] == "accept":
+		if r.Method == http.MethodPost {
+			h.handleAccept(w, r, userID, segments[0])
+			return
+		}
+	case len(segments) == 3 && segments[1] == "members" && segments[2] != "":
+		switch r.Method {
+		case http.MethodPut:
+			h.handleUpdateRole(w, r, segments[0], segments[2])
+			return
+		case http.MethodDelete:
+			h.handleRemove(w, r, segments[0], segments[2])
+			return
+		}
+	}
+
+	writeError(w, http.StatusMethodNotAllowed)
+}
+
+// handleListInvitations handles GET /api/parties/invitations.
+func (h *MemberHandler) handleListInvitations(w http.ResponseWriter, r *http.Request, userID string) {
+	parties, err := h.service.ListInvitations(r.Context(), userID)
+	if err != nil {
+		writeMemberError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, parties)
+}
+
+// handleListMembers handles GET /api/parties/{partyID}/members.
+func (h *MemberHandler) handleListMembers(w http.ResponseWriter, r *http.Request, partyID string) {
+	members, err := h.service.ListMembers(r.Context(), partyID)
+	if err != nil {
+		writeMemberError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, members)
+}
+
+// handleInvite handles POST /api/parties/{partyID}/members.
+func (h *MemberHandler) handleInvite(w http.ResponseWriter, r *http.Request, partyID string) {
+	var req inviteMemberRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest)
+		return
+	}
+	if strings.TrimSpace(req.User) == "" {
+		writeJSONError(w, http.StatusBadRequest, "user is required")
+		return
+	}
+
+	member, err := h.service.InviteMember(r.Context(), partyID, services.InviteMemberRequest{
+		User: req.User,
+		Role: req.Role,
+	})
+	if err != nil {
+		writeMemberError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, member)
+}
+
+// handleAccept handles POST /api/parties/{partyID}/members/accept.
+func (h *MemberHandler) handleAccept(w http.ResponseWriter, r *http.Request, userID, partyID string) {
+	member, err := h.service.AcceptInvitation(r.Context(), userID, partyID)
+	if err != nil {
+		writeMemberError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, member)
+}
+
+// handleUpdateRole handles PUT /api/parties/{partyID}/members/{userID}.
+func (h *MemberHandler) handleUpdateRole(w http.ResponseWriter, r *http.Request, partyID, memberID string) {
+	var req updateMemberRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest)
+		return
+	}
+
+	member, err := h.service.UpdateMemberRole(r.Context(), partyID, memberID, req.Role)
+	if err != nil {
+		writeMemberError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, member)
+}
+
+// handleRemove handles DELETE /api/parties/{partyID}/members/{userID}.
+func (h *MemberHandler) handleRemove(w http.ResponseWriter, r *http.Request, partyID, memberID string) {
+	if err := h.service.RemoveMember(r.Context(), partyID, memberID); err != nil {
+		writeMemberError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// writeMemberError maps member service errors to HTTP responses.
+func writeMemberError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, services.ErrNotFound):
+		writeError(w, http.StatusNotFound)
+	case errors.Is(err, services.ErrUnauthorized):
+		writeError(w, http.StatusForbidden)
+	case errors.Is(err, services.ErrInvalidRole):
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, services.ErrUserNotFound), errors.Is(err, services.ErrMemberNotFound):
+		writeJSONError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, services.ErrAmbiguousUser), errors.Is(err, services.ErrAlreadyMember):
+		writeJSONError(w, http.StatusConflict, err.Error())
+	default:
+		writeError(w, http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,295 @@
+package handlers_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sipgate/eurovision-vote-party/server/authz"
+	"github.com/sipgate/eurovision-vote-party/server/handlers"
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/services"
+)
+
+type mockMemberService struct {
+	listMembersFunc      func(ctx context.Context, partyID string) ([]models.PartyMember, error)
+	inviteMemberFunc     func(ctx context.Context, partyID string, req services.InviteMemberRequest) (*models.PartyMember, error)
+	updateMemberRoleFunc func(ctx context.Context, partyID, userID string, role models.PartyRole) (*models.PartyMember, error)
+	removeMemberFunc     func(ctx context.Context, partyID, userID string) error
+	acceptFunc           func(ctx context.Context, userID, partyID string) (*models.PartyMember, error)
+	listInvitationsFunc  func(ctx context.Context, userID string) ([]*models.Party, error)
+}
+
+func (m *mockMemberService) ListMembers(ctx context.Context, partyID string) ([]models.PartyMember, error) {
+	if m.listMembersFunc != nil {
+		return m.listMembersFunc(ctx, partyID)
+	}
+	return nil, nil
+}
+
+func (m *mockMemberService) InviteMember(ctx context.Context, partyID string, req services.InviteMemberRequest) (*models.PartyMember, error) {
+	if m.inviteMemberFunc != nil {
+		return m.inviteMemberFunc(ctx, partyID, req)
+	}
+	return nil, nil
+}
+
+func (m *mockMemberService) UpdateMemberRole(ctx context.Context, partyID, userID string, role models.PartyRole) (*models.PartyMember, error) {
+	if m.updateMemberRoleFunc != nil {
+		return m.updateMemberRoleFunc(ctx, partyID, userID, role)
+	}
+	return nil, nil
+}
+
+func (m *mockMemberService) RemoveMember(ctx context.Context, partyID, userID string) error {
+	if m.removeMemberFunc != nil {
+		return m.removeMemberFunc(ctx, partyID, userID)
+	}
+	return nil
+}
+
+func (m *mockMemberService) AcceptInvitation(ctx context.Context, userID, partyID string) (*models.PartyMember, error) {
+	if m.acceptFunc != nil {
+		return m.acceptFunc(ctx, userID, partyID)
+	}
+	return nil, nil
+}
+
+func (m *mockMemberService) ListInvitations(ctx context.Context, userID string) ([]*models.Party, error) {
+	if m.listInvitationsFunc != nil {
+		return m.listInvitationsFunc(ctx, userID)
+	}
+	return nil, nil
+}
+
+func TestMemberHandler_RequiresAuth(t *testing.T) {
+	handler := handlers.NewMemberHandler(&mockMemberService{})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/parties/party-1/members", nil)
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestMemberHandler_ListMembers(t *testing.T) {
+	svc := &mockMemberService{
+		listMembersFunc: func(ctx context.Context, partyID string) ([]models.PartyMember, error) {
+			assert.Equal(t, "user-2", authz.FromContext(ctx).UserID)
+			assert.Equal(t, "party-1", partyID)
+			return []models.PartyMember{
+				{UserID: "admin-1", Role: models.PartyRoleOwner, Status: models.MemberStatusActive},
+				{UserID: "user-2", Role: models.PartyRoleCoHost, Status: models.MemberStatusActive},
+			}, nil
+		},
+	}
+	handler := handlers.NewMemberHandler(svc)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/parties/party-1/members", nil)
+	req = requestWithUserID(req, "user-2")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var members []models.PartyMember
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&members))
+	require.Len(t, members, 2)
+	assert.Equal(t, models.PartyRoleOwner, members[0].Role)
+}
+
+func TestMemberHandler_InviteMember(t *testing.T) {
+	svc := &mockMemberService{
+		inviteMemberFunc: func(ctx context.Context, partyID string, req services.InviteMemberRequest) (*models.PartyMember, error) {
+			assert.Equal(t, "admin-1", authz.FromContext(ctx).UserID)
+			assert.Equal(t, "party-1", partyID)
+			assert.Equal(t, services.InviteMemberRequest{User: "carol@example.com", Role: models.PartyRoleModerator}, req)
+			return &models.PartyMember{UserID: "user-2", Role: req.Role, Status: models.MemberStatusInvited}, nil
+		},
+	}
+	handler := handlers.NewMemberHandler(svc)
+
+	body := `{"user":"carol@example.com","role":"moderator"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/parties/party-1/members", bytes.NewBufferString(body))
+	req = requestWithUserID(req, "admin-1")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+	var member models.PartyMember
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&member))
+	assert.Equal(t, "user-2", member.UserID)
+	assert.Equal(t, models.MemberStatusInvited, member.Status)
+}
+
+func TestMemberHandler_InviteMember_RequiresUser(t *testing.T) {
+	handler := handlers.NewMemberHandler(&mockMemberService{})
+
+	req := httptest.NewRequest(http.MethodPost, "/api/parties/party-1/members", bytes.NewBufferString(`{"role":"cohost"}`))
+	req = requestWithUserID(req, "admin-1")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestMemberHandler_InviteMember_MapsErrors(t *testing.T) {
+	tests := []struct {
+		err  error
+		want int
+	}{
+		{services.ErrNotFound, http.StatusNotFound},
+		{services.ErrUnauthorized, http.StatusForbidden},
+		{services.ErrInvalidRole, http.StatusBadRequest},
+		{services.ErrUserNotFound, http.StatusNotFound},
+		{services.ErrAmbiguousUser, http.StatusConflict},
+		{services.ErrAlreadyMember, http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.err.Error(), func(t *testing.T) {
+			svc := &mockMemberService{
+				inviteMemberFunc: func(ctx context.Context, partyID string, req services.InviteMemberRequest) (*models.PartyMember, error) {
+					return nil, tt.err
+				},
+			}
+			handler := handlers.NewMemberHandler(svc)
+
+			body := `{"user":"carol","role":"cohost"}`
+			req := httptest.NewRequest(http.MethodPost, "/api/parties/party-1/members", bytes.NewBufferString(body))
+			req = requestWithUserID(req, "admin-1")
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.want, rec.Code)
+		})
+	}
+}
+
+func TestMemberHandler_UpdateMemberRole(t *testing.T) {
+	svc := &mockMemberService{
+		updateMemberRoleFunc: func(ctx context.Context, partyID, userID string, role models.PartyRole) (*models.PartyMember, error) {
+			assert.Equal(t, "admin-1", authz.FromContext(ctx).UserID)
+			assert.Equal(t, "party-1", partyID)
+			assert.Equal(t, "user-2", userID)
+			assert.Equal(t, models.PartyRoleCoHost, role)
+			return &models.PartyMember{UserID: userID, Role: role, Status: models.MemberStatusActive}, nil
+		},
+	}
+	handler := handlers.NewMemberHandler(svc)
+
+	req := httptest.NewRequest(http.MethodPut, "/api/parties/party-1/members/user-2", bytes.NewBufferString(`{"role":"cohost"}`))
+	req = requestWithUserID(req, "admin-1")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestMemberHandler_RemoveMember(t *testing.T) {
+	svc := &mockMemberService{
+		removeMemberFunc: func(ctx context.Context, partyID, userID string) error {
+			assert.Equal(t, "user-2", authz.FromContext(ctx).UserID)
+			assert.Equal(t, "party-1", partyID)
+			assert.Equal(t, "user-2", userID)
+			return nil
+		},
+	}
+	handler := handlers.NewMemberHandler(svc)
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/parties/party-1/members/user-2", nil)
+	req = requestWithUserID(req, "user-2")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+}
+
+func TestMemberHandler_RemoveMember_ReturnsNotFoundForNonMember(t *testing.T) {
+	svc := &mockMemberService{
+		removeMemberFunc: func(ctx context.Context, partyID, userID string) error {
+			return services.ErrMemberNotFound
+		},
+	}
+	handler := handlers.NewMemberHandler(svc)
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/parties/party-1/members/user-9", nil)
+	req = requestWithUserID(req, "admin-1")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestMemberHandler_AcceptInvitation(t *testing.T) {
+	svc := &mockMemberService{
+		acceptFunc: func(ctx context.Context, userID, partyID string) (*models.PartyMember, error) {
+			assert.Equal(t, "user-2", userID)
+			assert.Equal(t, "party-1", partyID)
+			return &models.PartyMember{UserID: userID, Role: models.PartyRoleCoHost, Status: models.MemberStatusActive}, nil
+		},
+		updateMemberRoleFunc: func(ctx context.Context, partyID, userID string, role models.PartyRole) (*models.PartyMember, error) {
+			t.Fatal("accept must not be routed as a role change")
+			return nil, nil
+		},
+	}
+	handler := handlers.NewMemberHandler(svc)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/parties/party-1/members/accept", nil)
+	req = requestWithUserID(req, "user-2")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var member models.PartyMember
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&member))
+	assert.Equal(t, models.MemberStatusActive, member.Status)
+}
+
+func TestMemberHandler_ListInvitations(t *testing.T) {
+	svc := &mockMemberService{
+		listInvitationsFunc: func(ctx context.Context, userID string) ([]*models.Party, error) {
+			assert.Equal(t, "user-2", userID)
+			return []*models.Party{{ID: "party-1", Name: "Party"}}, nil
+		},
+	}
+	handler := handlers.NewMemberHandler(svc)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/parties/invitations", nil)
+	req = requestWithUserID(req, "user-2")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var parties []models.Party
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&parties))
+	require.Len(t, parties, 1)
+	assert.Equal(t, "party-1", parties[0].ID)
+}
+
+func TestMemberHandler_RejectsUnsupportedMethod(t *testing.T) {
+	handler := handlers.NewMemberHandler(&mockMemberService{})
+
+	req := httptest.NewRequest(http.MethodPatch, "/api/parties/party-1/members", nil)
+	req = requestWithUserID(req, "admin-1")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
@@ -15,10 +15,10 @@ import (
 // PartyService defines the operations needed by the handler.
 type PartyService interface {
 	CreateParty(ctx context.Context, adminID string, req services.CreatePartyRequest) (*models.Party, error)
-	GetPartyByID(ctx context.Context, adminID, partyID string) (*models.Party, error)
+	GetPartyByID(ctx context.Context, partyID string) (*models.Party, error)
 	GetPartyByCode(ctx context.Context, code string) (*models.Party, error)
 	ListPartiesByAdmin(ctx context.Context, adminID string) ([]*models.Party, error)
-	DeleteParty(ctx context.Context, adminID, partyID string) error
+	DeleteParty(ctx context.Context, partyID string) error
 }
 
 // PartyHandler handles HTTP requests for party management.
@@ -33,17 +33,23 @@ func NewPartyHandler(service PartyService) *PartyHandler {
 
 // createPartyRequest represents the request body for creating a party.
 type createPartyRequest struct {
-	Name      string           `json:"name"`
-	EventType models.EventType `json:"eventType"`
+	Name          string               `json:"name"`
+	EventType     models.EventType     `json:"eventType"`
+	Contest       string               `json:"contest"`
+	ScoringSystem models.ScoringSystem `json:"scoringSystem"`
+	// AnonymousResults hides who awarded an act their top points in the results.
+	AnonymousResults bool `json:"anonymousResults"`
 }
 
 // publicPartyResponse represents the public-facing party data.
 type publicPartyResponse struct {
-	ID        string             `json:"id"`
-	Name      string             `json:"name"`
-	Code      string             `json:"code"`
-	EventType models.EventType   `json:"eventType"`
-	Status    models.PartyStatus `json:"status"`
+	ID            string               `json:"id"`
+	Name          string               `json:"name"`
+	Code          string               `json:"code"`
+	EventType     models.EventType     `json:"eventType"`
+	Contest       string               `json:"contest"`
+	Status        models.PartyStatus   `json:"status"`
+	ScoringSystem models.ScoringSystem `json:"scoringSystem"`
 }
 
 // ServeHTTP routes requests to the appropriate handler method.
@@ -112,12 +118,25 @@ func (h *PartyHandler) handleCreate(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if req.ScoringSystem != "" && !req.ScoringSystem.IsValid() {
+		writeError(w, http.StatusBadRequest)
+		return
+	}
+
 	party, err := h.service.CreateParty(r.Context(), userID, services.CreatePartyRequest{
-		Name:      req.Name,
-		EventType: req.EventType,
+		Name:             req.Name,
+		EventType:        req.EventType,
+		Contest:          req.Contest,
+		ScoringSystem:    req.ScoringSystem,
+		AnonymousResults: req.AnonymousResults,
 	})
 	if err != nil {
-		writeError(w, http.StatusInternalServerError)
+		switch {
+		case errors.Is(err, services.ErrUnknownContest), errors.Is(err, services.ErrInvalidEventType):
+			writeError(w, http.StatusBadRequest)
+		default:
+			writeError(w, http.StatusInternalServerError)
+		}
 		return
 	}
 
@@ -155,11 +174,13 @@ func (h *PartyHandler) handleGetByCode(w http.ResponseWriter, r *http.Request, c
 
 	// Return only public information
 	response := publicPartyResponse{
-		ID:        party.ID,
-		Name:      party.Name,
-		Code:      party.Code,
-		EventType: party.EventType,
-		Status:    party.Status,
+		ID:            party.ID,
+		Name:          party.Name,
+		Code:          party.Code,
+		EventType:     party.EventType,
+		Contest:       party.Edition(),
+		Status:        party.Status,
+		ScoringSystem: party.Scoring(),
 	}
 
 	writeJSON(w, http.StatusOK, response)
@@ -167,13 +188,12 @@ func (h *PartyHandler) handleGetByCode(w http.ResponseWriter, r *http.Request, c
 
 // handleGetByID handles GET /api/parties/:id (authenticated endpoint).
 func (h *PartyHandler) handleGetByID(w http.ResponseWriter, r *http.Request, id string) {
-	userID, ok := middleware.UserIDFromContext(r.Context())
-	if !ok {
+	if _, ok := middleware.UserIDFromContext(r.Context()); !ok {
 		writeError(w, http.StatusUnauthorized)
 		return
 	}
 
-	party, err := h.service.GetPartyByID(r.Context(), userID, id)
+	party, err := h.service.GetPartyByID(r.Context(), id)
 	if err != nil {
 		if errors.Is(err, services.ErrUnauthorized) {
 			writeError(w, http.StatusForbidden)
@@ -192,13 +212,12 @@ func (h *PartyHandler) handleGetByID(w http.ResponseWriter, r *http.Request, id
 
 // handleDelete handles DELETE /api/parties/:id.
 func (h *PartyHandler) handleDelete(w http.ResponseWriter, r *http.Request, id string) {
-	userID, ok := middleware.UserIDFromContext(r.Context())
-	if !ok {
+	if _, ok := middleware.UserIDFromContext(r.Context()); !ok {
 		writeError(w, http.StatusUnauthorized)
 		return
 	}
 
-	err := h.service.DeleteParty(r.Context(), userID, id)
+	err := h.service.DeleteParty(r.Context(), id)
 	if err != nil {
 		if errors.Is(err, services.ErrUnauthorized) {
 			writeError(w, http.StatusForbidden)
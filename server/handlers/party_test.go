@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/sipgate/eurovision-vote-party/server/authz"
 	"github.com/sipgate/eurovision-vote-party/server/handlers"
 	"github.com/sipgate/eurovision-vote-party/server/middleware"
 	"github.com/sipgate/eurovision-vote-party/server/models"
@@ -20,10 +21,10 @@ import (
 
 type mockPartyService struct {
 	createPartyFunc        func(ctx context.Context, adminID string, req services.CreatePartyRequest) (*models.Party, error)
-	getPartyByIDFunc       func(ctx context.Context, adminID, partyID string) (*models.Party, error)
+	getPartyByIDFunc       func(ctx context.Context, partyID string) (*models.Party, error)
 	getPartyByCodeFunc     func(ctx context.Context, code string) (*models.Party, error)
 	listPartiesByAdminFunc func(ctx context.Context, adminID string) ([]*models.Party, error)
-	deletePartyFunc        func(ctx context.Context, adminID, partyID string) error
+	deletePartyFunc        func(ctx context.Context, partyID string) error
 }
 
 func (m *mockPartyService) CreateParty(ctx context.Context, adminID string, req services.CreatePartyRequest) (*models.Party, error) {
@@ -33,9 +34,9 @@ func (m *mockPartyService) CreateParty(ctx context.Context, adminID string, req
 	return nil, nil
 }
 
-func (m *mockPartyService) GetPartyByID(ctx context.Context, adminID, partyID string) (*models.Party, error) {
+func (m *mockPartyService) GetPartyByID(ctx context.Context, partyID string) (*models.Party, error) {
 	if m.getPartyByIDFunc != nil {
-		return m.getPartyByIDFunc(ctx, adminID, partyID)
+		return m.getPartyByIDFunc(ctx, partyID)
 	}
 	return nil, services.ErrNotFound
 }
@@ -54,9 +55,9 @@ func (m *mockPartyService) ListPartiesByAdmin(ctx context.Context, adminID strin
 	return []*models.Party{}, nil
 }
 
-func (m *mockPartyService) DeleteParty(ctx context.Context, adminID, partyID string) error {
+func (m *mockPartyService) DeleteParty(ctx context.Context, partyID string) error {
 	if m.deletePartyFunc != nil {
-		return m.deletePartyFunc(ctx, adminID, partyID)
+		return m.deletePartyFunc(ctx, partyID)
 	}
 	return nil
 }
@@ -158,6 +159,59 @@ func TestPartyHandler_CreateParty_ReturnsBadRequestWithWhitespaceName(t *testing
 	assert.Equal(t, http.StatusBadRequest, rec.Code)
 }
 
+func TestPartyHandler_CreateParty_PassesScoringSystem(t *testing.T) {
+	svc := &mockPartyService{
+		createPartyFunc: func(ctx context.Context, adminID string, req services.CreatePartyRequest) (*models.Party, error) {
+			assert.Equal(t, models.ScoringRating, req.ScoringSystem)
+			return &models.Party{ID: "party-1", ScoringSystem: req.ScoringSystem}, nil
+		},
+	}
+
+	handler := handlers.NewPartyHandler(svc)
+
+	body := `{"name": "Test Party", "eventType": "grandfinal", "scoringSystem": "rating"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/parties", bytes.NewBufferString(body))
+	req = requestWithUserID(req, "user-123")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusCreated, rec.Code)
+}
+
+func TestPartyHandler_CreateParty_PassesAnonymousResults(t *testing.T) {
+	svc := &mockPartyService{
+		createPartyFunc: func(ctx context.Context, adminID string, req services.CreatePartyRequest) (*models.Party, error) {
+			assert.True(t, req.AnonymousResults)
+			return &models.Party{ID: "party-1", AnonymousResults: req.AnonymousResults}, nil
+		},
+	}
+
+	handler := handlers.NewPartyHandler(svc)
+
+	body := `{"name": "Test Party", "eventType": "grandfinal", "anonymousResults": true}`
+	req := httptest.NewRequest(http.MethodPost, "/api/parties", bytes.NewBufferString(body))
+	req = requestWithUserID(req, "user-123")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusCreated, rec.Code)
+}
+
+func TestPartyHandler_CreateParty_ReturnsBadRequestWithUnknownScoringSystem(t *testing.T) {
+	handler := handlers.NewPartyHandler(&mockPartyService{})
+
+	body := `{"name": "Test Party", "eventType": "grandfinal", "scoringSystem": "approval"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/parties", bytes.NewBufferString(body))
+	req = requestWithUserID(req, "user-123")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
 // --- List Parties Tests ---
 
 func TestPartyHandler_ListParties_ReturnsUnauthorizedWithoutAuth(t *testing.T) {
@@ -329,8 +383,8 @@ func TestPartyHandler_GetByID_ReturnsPartyForOwner(t *testing.T) {
 	}
 
 	svc := &mockPartyService{
-		getPartyByIDFunc: func(ctx context.Context, adminID, partyID string) (*models.Party, error) {
-			assert.Equal(t, "user-123", adminID)
+		getPartyByIDFunc: func(ctx context.Context, partyID string) (*models.Party, error) {
+			assert.Equal(t, "user-123", authz.FromContext(ctx).UserID)
 			assert.Equal(t, "550e8400-e29b-41d4-a716-446655440000", partyID)
 			return party, nil
 		},
@@ -356,7 +410,7 @@ func TestPartyHandler_GetByID_ReturnsPartyForOwner(t *testing.T) {
 
 func TestPartyHandler_GetByID_ReturnsForbiddenWhenNotOwner(t *testing.T) {
 	svc := &mockPartyService{
-		getPartyByIDFunc: func(ctx context.Context, adminID, partyID string) (*models.Party, error) {
+		getPartyByIDFunc: func(ctx context.Context, partyID string) (*models.Party, error) {
 			return nil, services.ErrUnauthorized
 		},
 	}
@@ -374,7 +428,7 @@ func TestPartyHandler_GetByID_ReturnsForbiddenWhenNotOwner(t *testing.T) {
 
 func TestPartyHandler_GetByID_ReturnsNotFoundWhenNotExists(t *testing.T) {
 	svc := &mockPartyService{
-		getPartyByIDFunc: func(ctx context.Context, adminID, partyID string) (*models.Party, error) {
+		getPartyByIDFunc: func(ctx context.Context, partyID string) (*models.Party, error) {
 			return nil, services.ErrNotFound
 		},
 	}
@@ -406,8 +460,8 @@ func TestPartyHandler_Delete_ReturnsUnauthorizedWithoutAuth(t *testing.T) {
 func TestPartyHandler_Delete_ReturnsNoContentOnSuccess(t *testing.T) {
 	deleteCalled := false
 	svc := &mockPartyService{
-		deletePartyFunc: func(ctx context.Context, adminID, partyID string) error {
-			assert.Equal(t, "user-123", adminID)
+		deletePartyFunc: func(ctx context.Context, partyID string) error {
+			assert.Equal(t, "user-123", authz.FromContext(ctx).UserID)
 			assert.Equal(t, "550e8400-e29b-41d4-a716-446655440000", partyID)
 			deleteCalled = true
 			return nil
@@ -428,7 +482,7 @@ func TestPartyHandler_Delete_ReturnsNoContentOnSuccess(t *testing.T) {
 
 func TestPartyHandler_Delete_ReturnsForbiddenWhenNotOwner(t *testing.T) {
 	svc := &mockPartyService{
-		deletePartyFunc: func(ctx context.Context, adminID, partyID string) error {
+		deletePartyFunc: func(ctx context.Context, partyID string) error {
 			return services.ErrUnauthorized
 		},
 	}
@@ -446,7 +500,7 @@ func TestPartyHandler_Delete_ReturnsForbiddenWhenNotOwner(t *testing.T) {
 
 func TestPartyHandler_Delete_ReturnsNotFoundWhenNotExists(t *testing.T) {
 	svc := &mockPartyService{
-		deletePartyFunc: func(ctx context.Context, adminID, partyID string) error {
+		deletePartyFunc: func(ctx context.Context, partyID string) error {
 			return services.ErrNotFound
 		},
 	}
@@ -529,7 +583,7 @@ func TestPartyHandler_DistinguishesBetweenCodeAndUUID(t *testing.T) {
 			}
 			return nil, services.ErrNotFound
 		},
-		getPartyByIDFunc: func(ctx context.Context, adminID, partyID string) (*models.Party, error) {
+		getPartyByIDFunc: func(ctx context.Context, partyID string) (*models.Party, error) {
 			if partyID == "550e8400-e29b-41d4-a716-446655440000" {
 				return idParty, nil
 			}
@@ -569,7 +623,7 @@ func TestPartyHandler_DistinguishesBetweenCodeAndUUID(t *testing.T) {
 
 func TestPartyHandler_CodeWithLowercaseIsNotConsideredCode(t *testing.T) {
 	svc := &mockPartyService{
-		getPartyByIDFunc: func(ctx context.Context, adminID, partyID string) (*models.Party, error) {
+		getPartyByIDFunc: func(ctx context.Context, partyID string) (*models.Party, error) {
 			// Lowercase 6-char string should be treated as ID, not code
 			assert.Equal(t, "abc123", partyID)
 			return nil, services.ErrNotFound
@@ -590,7 +644,7 @@ func TestPartyHandler_CodeWithLowercaseIsNotConsideredCode(t *testing.T) {
 
 func TestPartyHandler_CodeWithInvalidCharsIsNotConsideredCode(t *testing.T) {
 	svc := &mockPartyService{
-		getPartyByIDFunc: func(ctx context.Context, adminID, partyID string) (*models.Party, error) {
+		getPartyByIDFunc: func(ctx context.Context, partyID string) (*models.Party, error) {
 			// Code with invalid chars (0, 1, I, O, L) should be treated as ID
 			assert.Equal(t, "ABC10L", partyID)
 			return nil, services.ErrNotFound
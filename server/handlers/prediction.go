@@ -0,0 +1,171 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/sipgate/eurovision-vote-party/server/middleware"
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/services"
+)
+
+// PredictionServiceHandler defines the operations needed by the prediction handler.
+type PredictionServiceHandler interface {
+	SubmitPrediction(ctx context.Context, partyID string, req services.SubmitPredictionRequest) (*models.Prediction, error)
+	GetPrediction(ctx context.Context, partyID, guestID string) (*models.Prediction, error)
+	SetOutcome(ctx context.Context, partyID string, picks []string) (*models.PredictionOutcome, error)
+	GetLeaderboard(ctx context.Context, partyID string) (*services.PredictionLeaderboard, error)
+}
+
+// PredictionHandler handles HTTP requests for the prediction game.
+type PredictionHandler struct {
+	service PredictionServiceHandler
+}
+
+// NewPredictionHandler creates a new PredictionHandler.
+func NewPredictionHandler(service PredictionServiceHandler) *PredictionHandler {
+	return &PredictionHandler{service: service}
+}
+
+// predictionRequest represents the request body for submitting a prediction or
+// recording the outcome. Guests may omit GuestID; it defaults to the guest
+// identified by the session token.
+type predictionRequest struct {
+	GuestID string   `json:"guestId"`
+	Picks   []string `json:"picks"`
+}
+
+// ServeHTTP routes requests to the appropriate handler method.
+//
+//	PUT /api/parties/{partyID}/predictions              submit or change a prediction
+//	PUT /api/parties/{partyID}/predictions/outcome      record the official outcome
+//	GET /api/parties/{partyID}/predictions/leaderboard  rank the predictions
+//	GET /api/parties/{partyID}/predictions/{guestID}    get a guest's prediction
+func (h *PredictionHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimPrefix(r.URL.Path, "/api/parties/")
+	segments := strings.Split(path, "/")
+
+	if len(segments) < 2 || segments[1] != "predictions" {
+		writeError(w, http.StatusMethodNotAllowed)
+		return
+	}
+
+	partyID := segments[0]
+
+	switch len(segments) {
+	case 2:
+		if r.Method == http.MethodPut {
+			h.handleSubmit(w, r, partyID)
+			return
+		}
+	case 3:
+		switch {
+		case segments[2] == "outcome" && r.Method == http.MethodPut:
+			h.handleSetOutcome(w, r, partyID)
+			return
+		case segments[2] == "leaderboard" && r.Method == http.MethodGet:
+			h.handleLeaderboard(w, r, partyID)
+			return
+		case segments[2] != "" && r.Method == http.MethodGet:
+			h.handleGet(w, r, partyID, segments[2])
+			return
+		}
+	}
+
+	writeError(w, http.StatusMethodNotAllowed)
+}
+
+// handleSubmit handles PUT /api/parties/:partyID/predictions.
+// Hosts submitting on behalf of a guest must name the guest.
+func (h *PredictionHandler) handleSubmit(w http.ResponseWriter, r *http.Request, partyID string) {
+	var req predictionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest)
+		return
+	}
+	if !requireCaller(w, r) {
+		return
+	}
+
+	prediction, err := h.service.SubmitPrediction(r.Context(), partyID, services.SubmitPredictionRequest{
+		GuestID: req.GuestID,
+		Picks:   req.Picks,
+	})
+	if err != nil {
+		writePredictionError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, prediction)
+}
+
+// handleGet handles GET /api/parties/:partyID/predictions/:guestID.
+func (h *PredictionHandler) handleGet(w http.ResponseWriter, r *http.Request, partyID, guestID string) {
+	if !requireCaller(w, r) {
+		return
+	}
+
+	prediction, err := h.service.GetPrediction(r.Context(), partyID, guestID)
+	if err != nil {
+		writePredictionError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, prediction)
+}
+
+// handleSetOutcome handles PUT /api/parties/:partyID/predictions/outcome.
+func (h *PredictionHandler) handleSetOutcome(w http.ResponseWriter, r *http.Request, partyID string) {
+	if _, ok := middleware.UserIDFromContext(r.Context()); !ok {
+		writeError(w, http.StatusUnauthorized)
+		return
+	}
+
+	var req predictionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest)
+		return
+	}
+
+	outcome, err := h.service.SetOutcome(r.Context(), partyID, req.Picks)
+	if err != nil {
+		writePredictionError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, outcome)
+}
+
+// handleLeaderboard handles GET /api/parties/:partyID/predictions/leaderboard.
+func (h *PredictionHandler) handleLeaderboard(w http.ResponseWriter, r *http.Request, partyID string) {
+	if !requireCaller(w, r) {
+		return
+	}
+
+	leaderboard, err := h.service.GetLeaderboard(r.Context(), partyID)
+	if err != nil {
+		writePredictionError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, leaderboard)
+}
+
+// writePredictionError maps prediction service errors to HTTP responses.
+func writePredictionError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, services.ErrNotFound):
+		writeError(w, http.StatusNotFound)
+	case errors.Is(err, services.ErrUnauthorized), errors.Is(err, services.ErrGuestNotApproved):
+		writeError(w, http.StatusForbidden)
+	case errors.Is(err, services.ErrInvalidPrediction), errors.Is(err, services.ErrGuestIDRequired):
+		writeError(w, http.StatusBadRequest)
+	case errors.Is(err, services.ErrPredictionsLocked), errors.Is(err, services.ErrPredictionsOpen), errors.Is(err, services.ErrNoOutcome):
+		writeError(w, http.StatusConflict)
+	default:
+		writeError(w, http.StatusInternalServerError)
+	}
+}
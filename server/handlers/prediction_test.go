@@ -0,0 +1,244 @@
+package handlers_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sipgate/eurovision-vote-party/server/authz"
+	"github.com/sipgate/eurovision-vote-party/server/handlers"
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/services"
+)
+
+type mockPredictionService struct {
+	submitPredictionFunc func(ctx context.Context, partyID string, req services.SubmitPredictionRequest) (*models.Prediction, error)
+	getPredictionFunc    func(ctx context.Context, partyID, guestID string) (*models.Prediction, error)
+	setOutcomeFunc       func(ctx context.Context, partyID string, picks []string) (*models.PredictionOutcome, error)
+	getLeaderboardFunc   func(ctx context.Context, partyID string) (*services.PredictionLeaderboard, error)
+}
+
+func (m *mockPredictionService) SubmitPrediction(ctx context.Context, partyID string, req services.SubmitPredictionRequest) (*models.Prediction, error) {
+	if m.submitPredictionFunc != nil {
+		return m.submitPredictionFunc(ctx, partyID, req)
+	}
+	return nil, nil
+}
+
+func (m *mockPredictionService) GetPrediction(ctx context.Context, partyID, guestID string) (*models.Prediction, error) {
+	if m.getPredictionFunc != nil {
+		return m.getPredictionFunc(ctx, partyID, guestID)
+	}
+	return nil, nil
+}
+
+func (m *mockPredictionService) SetOutcome(ctx context.Context, partyID string, picks []string) (*models.PredictionOutcome, error) {
+	if m.setOutcomeFunc != nil {
+		return m.setOutcomeFunc(ctx, partyID, picks)
+	}
+	return nil, nil
+}
+
+func (m *mockPredictionService) GetLeaderboard(ctx context.Context, partyID string) (*services.PredictionLeaderboard, error) {
+	if m.getLeaderboardFunc != nil {
+		return m.getLeaderboardFunc(ctx, partyID)
+	}
+	return nil, nil
+}
+
+func TestPredictionHandler_Submit_PassesGuestSession(t *testing.T) {
+	svc := &mockPredictionService{
+		submitPredictionFunc: func(ctx context.Context, partyID string, req services.SubmitPredictionRequest) (*models.Prediction, error) {
+			assert.Equal(t, authz.Guest("guest-1", "party-1"), authz.FromContext(ctx))
+			assert.Equal(t, "party-1", partyID)
+			assert.Equal(t, "", req.GuestID)
+			return &models.Prediction{ID: "party-1_guest-1", GuestID: "guest-1", Picks: req.Picks}, nil
+		},
+	}
+
+	handler := handlers.NewPredictionHandler(svc)
+
+	body, _ := json.Marshal(map[string]interface{}{"picks": []string{"act-1", "act-2", "act-3"}})
+	req := httptest.NewRequest(http.MethodPut, "/api/parties/party-1/predictions", bytes.NewReader(body))
+	req = requestWithGuest(req, "guest-1", "party-1")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response models.Prediction
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, []string{"act-1", "act-2", "act-3"}, response.Picks)
+}
+
+func TestPredictionHandler_Submit_ReturnsConflictWhenLocked(t *testing.T) {
+	svc := &mockPredictionService{
+		submitPredictionFunc: func(ctx context.Context, partyID string, req services.SubmitPredictionRequest) (*models.Prediction, error) {
+			return nil, services.ErrPredictionsLocked
+		},
+	}
+
+	handler := handlers.NewPredictionHandler(svc)
+
+	body, _ := json.Marshal(map[string]interface{}{"picks": []string{"act-1", "act-2", "act-3"}})
+	req := httptest.NewRequest(http.MethodPut, "/api/parties/party-1/predictions", bytes.NewReader(body))
+	req = requestWithGuest(req, "guest-1", "party-1")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusConflict, rec.Code)
+}
+
+func TestPredictionHandler_Submit_RequiresGuestIDForAdmin(t *testing.T) {
+	handler := handlers.NewPredictionHandler(&mockPredictionService{
+		submitPredictionFunc: func(ctx context.Context, partyID string, req services.SubmitPredictionRequest) (*models.Prediction, error) {
+			return nil, services.ErrGuestIDRequired
+		},
+	})
+
+	body, _ := json.Marshal(map[string]interface{}{"picks": []string{"act-1", "act-2", "act-3"}})
+	req := httptest.NewRequest(http.MethodPut, "/api/parties/party-1/predictions", bytes.NewReader(body))
+	req = requestWithUserID(req, "admin-1")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestPredictionHandler_Get_ForbidsOtherGuests(t *testing.T) {
+	handler := handlers.NewPredictionHandler(&mockPredictionService{
+		getPredictionFunc: func(ctx context.Context, partyID, guestID string) (*models.Prediction, error) {
+			assert.Equal(t, "guest-2", guestID)
+			return nil, services.ErrUnauthorized
+		},
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/parties/party-1/predictions/guest-2", nil)
+	req = requestWithGuest(req, "guest-1", "party-1")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestPredictionHandler_SetOutcome(t *testing.T) {
+	t.Run("records outcome for admin", func(t *testing.T) {
+		svc := &mockPredictionService{
+			setOutcomeFunc: func(ctx context.Context, partyID string, picks []string) (*models.PredictionOutcome, error) {
+				assert.Equal(t, "admin-1", authz.FromContext(ctx).UserID)
+				assert.Equal(t, []string{"act-2", "act-1", "act-3"}, picks)
+				return &models.PredictionOutcome{PartyID: partyID, Picks: picks}, nil
+			},
+		}
+
+		handler := handlers.NewPredictionHandler(svc)
+
+		body, _ := json.Marshal(map[string]interface{}{"picks": []string{"act-2", "act-1", "act-3"}})
+		req := httptest.NewRequest(http.MethodPut, "/api/parties/party-1/predictions/outcome", bytes.NewReader(body))
+		req = requestWithUserID(req, "admin-1")
+		rec := httptest.NewRecorder()
+
+		handler.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("requires authentication", func(t *testing.T) {
+		handler := handlers.NewPredictionHandler(&mockPredictionService{})
+
+		req := httptest.NewRequest(http.MethodPut, "/api/parties/party-1/predictions/outcome", bytes.NewReader([]byte(`{}`)))
+		req = requestWithGuest(req, "guest-1", "party-1")
+		rec := httptest.NewRecorder()
+
+		handler.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("returns conflict while predictions are open", func(t *testing.T) {
+		svc := &mockPredictionService{
+			setOutcomeFunc: func(ctx context.Context, partyID string, picks []string) (*models.PredictionOutcome, error) {
+				return nil, services.ErrPredictionsOpen
+			},
+		}
+
+		handler := handlers.NewPredictionHandler(svc)
+
+		req := httptest.NewRequest(http.MethodPut, "/api/parties/party-1/predictions/outcome", bytes.NewReader([]byte(`{"picks":["act-1"]}`)))
+		req = requestWithUserID(req, "admin-1")
+		rec := httptest.NewRecorder()
+
+		handler.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	})
+}
+
+func TestPredictionHandler_Leaderboard(t *testing.T) {
+	t.Run("returns standings to guests", func(t *testing.T) {
+		svc := &mockPredictionService{
+			getLeaderboardFunc: func(ctx context.Context, partyID string) (*services.PredictionLeaderboard, error) {
+				assert.Equal(t, "", authz.FromContext(ctx).UserID)
+				return &services.PredictionLeaderboard{
+					PartyID:   partyID,
+					Outcome:   []string{"act-1", "act-2", "act-3"},
+					Standings: []services.PredictionStanding{{GuestID: "guest-1", Username: "alice", Points: 11, Correct: 3, Rank: 1}},
+				}, nil
+			},
+		}
+
+		handler := handlers.NewPredictionHandler(svc)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/parties/party-1/predictions/leaderboard", nil)
+		req = requestWithGuest(req, "guest-1", "party-1")
+		rec := httptest.NewRecorder()
+
+		handler.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response services.PredictionLeaderboard
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		require.Len(t, response.Standings, 1)
+		assert.Equal(t, 11, response.Standings[0].Points)
+	})
+
+	t.Run("requires a caller", func(t *testing.T) {
+		handler := handlers.NewPredictionHandler(&mockPredictionService{})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/parties/party-1/predictions/leaderboard", nil)
+		rec := httptest.NewRecorder()
+
+		handler.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("returns conflict before the outcome is recorded", func(t *testing.T) {
+		svc := &mockPredictionService{
+			getLeaderboardFunc: func(ctx context.Context, partyID string) (*services.PredictionLeaderboard, error) {
+				return nil, services.ErrNoOutcome
+			},
+		}
+
+		handler := handlers.NewPredictionHandler(svc)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/parties/party-1/predictions/leaderboard", nil)
+		req = requestWithUserID(req, "admin-1")
+		rec := httptest.NewRecorder()
+
+		handler.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	})
+}
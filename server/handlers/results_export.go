@@ -0,0 +1,402 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"mime"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/services"
+)
+
+// resultsFormat describes one representation the results endpoint can render.
+type resultsFormat struct {
+	name      string
+	mediaType string
+	render    func(results *services.PartyResults) ([]byte, error)
+}
+
+// resultsFormats lists the supported representations, in the order preferred
+// when an Accept header ranks several of them equally.
+var resultsFormats = []resultsFormat{
+	{name: "json", mediaType: "application/json", render: renderResultsJSON},
+	{name: "csv", mediaType: "text/csv", render: renderResultsCSV},
+	{name: "png", mediaType: "image/png", render: renderResultsPNG},
+	{name: "svg", mediaType: "image/svg+xml", render: renderResultsSVG},
+}
+
+// negotiateResultsFormat picks the representation of the results from the
+// format query parameter or, without one, from the Accept header. It reports
+// whether the results should be sent as a file download, which is the case
+// when the format is requested explicitly. On failure it returns the HTTP
+// status to respond with.
+func negotiateResultsFormat(r *http.Request) (*resultsFormat, bool, int) {
+	if name := r.URL.Query().Get("format"); name != "" {
+		for i := range resultsFormats {
+			if resultsFormats[i].name == name {
+				return &resultsFormats[i], true, http.StatusOK
+			}
+		}
+		return nil, false, http.StatusBadRequest
+	}
+
+	accept := r.Header.Get("Accept")
+	if strings.TrimSpace(accept) == "" {
+		return &resultsFormats[0], false, http.StatusOK
+	}
+
+	var best *resultsFormat
+	bestQuality := 0.0
+	for i := range resultsFormats {
+		if q := acceptQuality(accept, resultsFormats[i].mediaType); q > bestQuality {
+			best, bestQuality = &resultsFormats[i], q
+		}
+	}
+	if best == nil {
+		return nil, false, http.StatusNotAcceptable
+	}
+	return best, false, http.StatusOK
+}
+
+// acceptQuality returns the quality an Accept header assigns to a media type,
+// taken from the most specific media range that matches it.
+func acceptQuality(accept, mediaType string) float64 {
+	mainType, _, _ := strings.Cut(mediaType, "/")
+	quality, specificity := 0.0, -1
+	for _, part := range strings.Split(accept, ",") {
+		mediaRange, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+
+		var s int
+		switch mediaRange {
+		case mediaType:
+			s = 2
+		case mainType + "/*":
+			s = 1
+		case "*/*":
+			s = 0
+		default:
+			continue
+		}
+		if s <= specificity {
+			continue
+		}
+
+		specificity, quality = s, 1
+		if value, ok := params["q"]; ok {
+			q, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				q = 0
+			}
+			quality = q
+		}
+	}
+	return quality
+}
+
+// writeResults renders the results in the given format. Downloads are sent as
+// an attachment named after the party.
+func writeResults(w http.ResponseWriter, results *services.PartyResults, format *resultsFormat, download bool) {
+	if format.name == "json" && !download {
+		writeJSON(w, http.StatusOK, results)
+		return
+	}
+
+	body, err := format.render(results)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError)
+		return
+	}
+
+	contentType := format.mediaType
+	if strings.HasPrefix(contentType, "text/") {
+		contentType += "; charset=utf-8"
+	}
+	w.Header().Set("Content-Type", contentType)
+	if download {
+		filename := fmt.Sprintf("%s-results.%s", results.PartyID, format.name)
+		w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": filename}))
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
+}
+
+// renderResultsJSON renders the results as an indented JSON document.
+func renderResultsJSON(results *services.PartyResults) ([]byte, error) {
+	body, err := json.MarshalIndent(results, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(body, '\n'), nil
+}
+
+// renderResultsCSV renders one row per act. Every point value awarded in the
+// party gets its own column counting the ballots that gave it to the act.
+func renderResultsCSV(results *services.PartyResults) ([]byte, error) {
+	pointValues := awardedPointValues(results.Results)
+
+	header := []string{"rank", "actId", "country", "artist", "song", "totalPoints", "averageScore", "voters"}
+	for _, points := range pointValues {
+		header = append(header, fmt.Sprintf("%dPoints", points))
+	}
+	header = append(header, "topMarks", "tieBreak")
+
+	var buf bytes.Buffer
+	cw := csv.NewWriter(&buf)
+	cw.Write(header)
+	for _, res := range results.Results {
+		averageScore := ""
+		if res.AverageScore != 0 {
+			averageScore = strconv.FormatFloat(res.AverageScore, 'f', 2, 64)
+		}
+		tieBreak := ""
+		if res.TieBreak != nil {
+			tieBreak = string(res.TieBreak.Rule)
+		}
+
+		row := []string{
+			strconv.Itoa(res.Rank),
+			csvText(res.ActID),
+			csvText(res.Country),
+			csvText(res.Artist),
+			csvText(res.Song),
+			strconv.Itoa(res.TotalPoints),
+			averageScore,
+			strconv.Itoa(res.Voters),
+		}
+		for _, points := range pointValues {
+			row = append(row, strconv.Itoa(res.PointCounts[points]))
+		}
+		row = append(row, csvText(strings.Join(res.TopMarks, "; ")), tieBreak)
+		cw.Write(row)
+	}
+	cw.Flush()
+	return buf.Bytes(), cw.Error()
+}
+
+// awardedPointValues returns every point value awarded to any act, highest first.
+func awardedPointValues(results []models.VoteResult) []int {
+	seen := make(map[int]bool)
+	var values []int
+	for _, res := range results {
+		for points := range res.PointCounts {
+			if !seen[points] {
+				seen[points] = true
+				values = append(values, points)
+			}
+		}
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(values)))
+	return values
+}
+
+// csvText guards free text such as act names and usernames against being
+// evaluated as a formula by spreadsheet applications.
+func csvText(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
+
+// Scoreboard image layout in pixels. The PNG and SVG renderings share it so
+// both look alike.
+const (
+	scoreboardWidth      = 640
+	scoreboardPadding    = 16
+	scoreboardHeader     = 56
+	scoreboardRowHeight  = 28
+	scoreboardRankX      = scoreboardPadding
+	scoreboardCountryX   = 56
+	scoreboardBarX       = 260
+	scoreboardBarMaxX    = scoreboardWidth - 80
+	scoreboardBarHeight  = 16
+	scoreboardLabelChars = (scoreboardBarX - scoreboardCountryX) / 7
+	// The party name is set in the 7px bitmap font in PNG and in 20px bold
+	// sans-serif in SVG, whose glyphs are at most about 12px wide on average.
+	scoreboardTitleChars    = (scoreboardWidth - 2*scoreboardPadding) / 7
+	scoreboardSVGTitleChars = (scoreboardWidth - 2*scoreboardPadding) / 12
+)
+
+var (
+	scoreboardBackground = color.RGBA{R: 0x10, G: 0x16, B: 0x3a, A: 0xff}
+	scoreboardText       = color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+	scoreboardMuted      = color.RGBA{R: 0xa0, G: 0xa8, B: 0xd0, A: 0xff}
+	scoreboardBar        = color.RGBA{R: 0xe9, G: 0x1e, B: 0x63, A: 0xff}
+)
+
+// scoreboardRow is one act as drawn on the scoreboard image.
+type scoreboardRow struct {
+	rank     string
+	country  string
+	points   string
+	barWidth int
+}
+
+// scoreboardRows lays out the ranked acts, scaling the bars to the leading score.
+func scoreboardRows(results []models.VoteResult) []scoreboardRow {
+	score := func(res models.VoteResult) float64 {
+		if res.AverageScore != 0 {
+			return res.AverageScore
+		}
+		return float64(res.TotalPoints)
+	}
+
+	maxScore := 0.0
+	for _, res := range results {
+		maxScore = max(maxScore, score(res))
+	}
+
+	rows := make([]scoreboardRow, 0, len(results))
+	for _, res := range results {
+		row := scoreboardRow{
+			rank:    strconv.Itoa(res.Rank),
+			country: truncateLabel(res.Country, scoreboardLabelChars),
+			points:  strconv.Itoa(res.TotalPoints),
+		}
+		if res.AverageScore != 0 {
+			row.points = strconv.FormatFloat(res.AverageScore, 'f', 2, 64)
+		}
+		if maxScore > 0 {
+			row.barWidth = int(score(res) / maxScore * float64(scoreboardBarMaxX-scoreboardBarX))
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// scoreboardSubtitle summarizes the turnout below the party name.
+func scoreboardSubtitle(results *services.PartyResults) string {
+	if results.TotalVoters == 1 {
+		return "1 voter"
+	}
+	return fmt.Sprintf("%d voters", results.TotalVoters)
+}
+
+func scoreboardHeight(rows int) int {
+	return scoreboardHeader + rows*scoreboardRowHeight + scoreboardPadding
+}
+
+// truncateLabel shortens s to at most n characters, marking the cut with dots.
+func truncateLabel(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n-3]) + "..."
+}
+
+// renderResultsSVG renders the results as a scoreboard image in SVG.
+func renderResultsSVG(results *services.PartyResults) ([]byte, error) {
+	rows := scoreboardRows(results.Results)
+	height := scoreboardHeight(len(rows))
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="sans-serif">`+"\n",
+		scoreboardWidth, height, scoreboardWidth, height)
+	fmt.Fprintf(&buf, `<rect width="100%%" height="100%%" fill="%s"/>`+"\n", svgColor(scoreboardBackground))
+	fmt.Fprintf(&buf, `<text x="%d" y="28" font-size="20" font-weight="bold" fill="%s">%s</text>`+"\n",
+		scoreboardPadding, svgColor(scoreboardText), svgEscape(truncateLabel(results.PartyName, scoreboardSVGTitleChars)))
+	fmt.Fprintf(&buf, `<text x="%d" y="46" font-size="12" fill="%s">%s</text>`+"\n",
+		scoreboardPadding, svgColor(scoreboardMuted), svgEscape(scoreboardSubtitle(results)))
+
+	for i, row := range rows {
+		top := scoreboardHeader + i*scoreboardRowHeight
+		baseline := top + scoreboardRowHeight/2 + 5
+		fmt.Fprintf(&buf, `<text x="%d" y="%d" font-size="14" fill="%s">%s</text>`+"\n",
+			scoreboardRankX, baseline, svgColor(scoreboardMuted), row.rank)
+		fmt.Fprintf(&buf, `<text x="%d" y="%d" font-size="14" fill="%s">%s</text>`+"\n",
+			scoreboardCountryX, baseline, svgColor(scoreboardText), svgEscape(row.country))
+		fmt.Fprintf(&buf, `<rect x="%d" y="%d" width="%d" height="%d" rx="3" fill="%s"/>`+"\n",
+			scoreboardBarX, top+(scoreboardRowHeight-scoreboardBarHeight)/2, row.barWidth, scoreboardBarHeight, svgColor(scoreboardBar))
+		fmt.Fprintf(&buf, `<text x="%d" y="%d" font-size="14" font-weight="bold" text-anchor="end" fill="%s">%s</text>`+"\n",
+			scoreboardWidth-scoreboardPadding, baseline, svgColor(scoreboardText), row.points)
+	}
+
+	buf.WriteString("</svg>\n")
+	return buf.Bytes(), nil
+}
+
+func svgColor(c color.RGBA) string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
+
+func svgEscape(s string) string {
+	var buf strings.Builder
+	xml.EscapeText(&buf, []byte(s))
+	return buf.String()
+}
+
+// renderResultsPNG renders the results as a scoreboard image in PNG, using
+// the built-in bitmap font so that no font files are needed.
+func renderResultsPNG(results *services.PartyResults) ([]byte, error) {
+	rows := scoreboardRows(results.Results)
+	img := image.NewRGBA(image.Rect(0, 0, scoreboardWidth, scoreboardHeight(len(rows))))
+	draw.Draw(img, img.Bounds(), image.NewUniform(scoreboardBackground), image.Point{}, draw.Src)
+
+	face := basicfont.Face7x13
+	text := func(x, y int, c color.Color, s string) {
+		d := &font.Drawer{Dst: img, Src: image.NewUniform(c), Face: face, Dot: fixed.P(x, y)}
+		d.DrawString(asciiLabel(s))
+	}
+	textEnd := func(x, y int, c color.Color, s string) {
+		s = asciiLabel(s)
+		width := font.MeasureString(face, s).Round()
+		text(x-width, y, c, s)
+	}
+
+	text(scoreboardPadding, 28, scoreboardText, truncateLabel(results.PartyName, scoreboardTitleChars))
+	text(scoreboardPadding, 46, scoreboardMuted, scoreboardSubtitle(results))
+
+	for i, row := range rows {
+		top := scoreboardHeader + i*scoreboardRowHeight
+		baseline := top + scoreboardRowHeight/2 + 5
+		text(scoreboardRankX, baseline, scoreboardMuted, row.rank)
+		text(scoreboardCountryX, baseline, scoreboardText, row.country)
+		barTop := top + (scoreboardRowHeight-scoreboardBarHeight)/2
+		bar := image.Rect(scoreboardBarX, barTop, scoreboardBarX+row.barWidth, barTop+scoreboardBarHeight)
+		draw.Draw(img, bar, image.NewUniform(scoreboardBar), image.Point{}, draw.Src)
+		textEnd(scoreboardWidth-scoreboardPadding, baseline, scoreboardText, row.points)
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// asciiLabel folds text into the ASCII range covered by the bitmap font:
+// accents are dropped and any other character is replaced by a question mark.
+func asciiLabel(s string) string {
+	var buf strings.Builder
+	for _, r := range norm.NFD.String(s) {
+		switch {
+		case unicode.Is(unicode.Mn, r):
+		case r < 0x20 || r > 0x7e:
+			buf.WriteByte('?')
+		default:
+			buf.WriteRune(r)
+		}
+	}
+	return buf.String()
+}
@@ -0,0 +1,214 @@
+package handlers_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sipgate/eurovision-vote-party/server/handlers"
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/services"
+)
+
+func exportResults() *services.PartyResults {
+	return &services.PartyResults{
+		PartyID:     "party-1",
+		PartyName:   "Grand Final Party",
+		TotalVoters: 2,
+		Results: []models.VoteResult{
+			{
+				ActID: "act-1", Country: "Türkiye", Artist: "Artist 1", Song: "Song 1",
+				TotalPoints: 24, Rank: 1, Voters: 2,
+				PointCounts: map[int]int{12: 2},
+				TopMarks:    []string{"alice", "bob"},
+			},
+			{
+				ActID: "act-2", Country: "Sweden", Artist: "=HYPERLINK()", Song: "Song 2",
+				TotalPoints: 20, Rank: 2, Voters: 2,
+				PointCounts: map[int]int{10: 2},
+				TieBreak:    &models.TieBreak{Rule: models.TieBreakVoters, ActID: "act-3"},
+			},
+			{
+				ActID: "act-3", Country: "Norway", Artist: "Artist 3", Song: "Song 3",
+				TotalPoints: 20, Rank: 3, Voters: 1,
+				PointCounts: map[int]int{12: 1, 8: 1},
+			},
+		},
+	}
+}
+
+func exportRequest(t *testing.T, target, accept string) *httptest.ResponseRecorder {
+	t.Helper()
+	return exportRequestFor(t, exportResults(), target, accept)
+}
+
+func exportRequestFor(t *testing.T, results *services.PartyResults, target, accept string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	svc := &mockVoteService{
+		getResultsFunc: func(ctx context.Context, partyID string) (*services.PartyResults, error) {
+			return results, nil
+		},
+	}
+	handler := handlers.NewVoteHandler(svc)
+
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	req = requestWithUserID(req, "admin-1")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestVoteHandler_GetResults_ExportsCSV(t *testing.T) {
+	rec := exportRequest(t, "/api/parties/party-1/results?format=csv", "")
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/csv; charset=utf-8", rec.Header().Get("Content-Type"))
+	assert.Equal(t, `attachment; filename=party-1-results.csv`, rec.Header().Get("Content-Disposition"))
+
+	records, err := csv.NewReader(rec.Body).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 4)
+	assert.Equal(t, []string{
+		"rank", "actId", "country", "artist", "song", "totalPoints", "averageScore", "voters",
+		"12Points", "10Points", "8Points", "topMarks", "tieBreak",
+	}, records[0])
+	assert.Equal(t, []string{"1", "act-1", "Türkiye", "Artist 1", "Song 1", "24", "", "2", "2", "0", "0", "alice; bob", ""}, records[1])
+	assert.Equal(t, "'=HYPERLINK()", records[2][3], "formulas should be neutralized")
+	assert.Equal(t, "voters", records[2][12])
+}
+
+func TestVoteHandler_GetResults_ExportsJSONFile(t *testing.T) {
+	rec := exportRequest(t, "/api/parties/party-1/results?format=json", "")
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	assert.Equal(t, `attachment; filename=party-1-results.json`, rec.Header().Get("Content-Disposition"))
+
+	var response services.PartyResults
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, *exportResults(), response)
+	assert.Contains(t, rec.Body.String(), "\n  \"partyId\": \"party-1\"")
+}
+
+func TestVoteHandler_GetResults_ExportsPNG(t *testing.T) {
+	rec := exportRequest(t, "/api/parties/party-1/results?format=png", "")
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "image/png", rec.Header().Get("Content-Type"))
+
+	img, err := png.Decode(bytes.NewReader(rec.Body.Bytes()))
+	require.NoError(t, err)
+	assert.Equal(t, 640, img.Bounds().Dx())
+	assert.Greater(t, img.Bounds().Dy(), 3*28)
+}
+
+func TestVoteHandler_GetResults_ExportsSVG(t *testing.T) {
+	rec := exportRequest(t, "/api/parties/party-1/results?format=svg", "")
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "image/svg+xml", rec.Header().Get("Content-Type"))
+
+	decoder := xml.NewDecoder(bytes.NewReader(rec.Body.Bytes()))
+	for {
+		_, err := decoder.Token()
+		if err != nil {
+			require.ErrorContains(t, err, "EOF", "SVG should be well-formed XML")
+			break
+		}
+	}
+	body := rec.Body.String()
+	assert.Contains(t, body, "Grand Final Party")
+	assert.Contains(t, body, "Türkiye")
+	assert.Contains(t, body, ">24<")
+}
+
+func TestVoteHandler_GetResults_ExportsSVGWithTruncatedPartyName(t *testing.T) {
+	results := exportResults()
+	results.PartyName = strings.Repeat("Eurovision ", 20)
+	rec := exportRequestFor(t, results, "/api/parties/party-1/results?format=svg", "")
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	body := rec.Body.String()
+	assert.NotContains(t, body, results.PartyName)
+	assert.Contains(t, body, ">"+results.PartyName[:47]+"...</text>")
+}
+
+func TestVoteHandler_GetResults_NegotiatesFormatFromAcceptHeader(t *testing.T) {
+	tests := []struct {
+		accept      string
+		contentType string
+	}{
+		{accept: "text/csv", contentType: "text/csv; charset=utf-8"},
+		{accept: "image/svg+xml, application/json;q=0.5", contentType: "image/svg+xml"},
+		{accept: "image/*", contentType: "image/png"},
+		{accept: "image/png;q=0.2, image/*;q=0.8", contentType: "image/svg+xml"},
+		{accept: "*/*", contentType: "application/json"},
+		{accept: "text/html, */*;q=0.1", contentType: "application/json"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.accept, func(t *testing.T) {
+			rec := exportRequest(t, "/api/parties/party-1/results", tt.accept)
+
+			require.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, tt.contentType, rec.Header().Get("Content-Type"))
+			assert.Empty(t, rec.Header().Get("Content-Disposition"), "negotiated results should be shown inline")
+		})
+	}
+}
+
+func TestVoteHandler_GetResults_ReturnsNotAcceptableForUnsupportedAcceptHeader(t *testing.T) {
+	rec := exportRequest(t, "/api/parties/party-1/results", "text/html, application/json;q=0")
+
+	assert.Equal(t, http.StatusNotAcceptable, rec.Code)
+}
+
+func TestVoteHandler_GetResults_ReturnsBadRequestForUnknownFormat(t *testing.T) {
+	svc := &mockVoteService{
+		getResultsFunc: func(ctx context.Context, partyID string) (*services.PartyResults, error) {
+			t.Fatal("results should not be loaded for an unknown format")
+			return nil, nil
+		},
+	}
+	handler := handlers.NewVoteHandler(svc)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/parties/party-1/results?format=xlsx", nil)
+	req = requestWithUserID(req, "admin-1")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestVoteHandler_GetResults_ExportKeepsErrorMapping(t *testing.T) {
+	svc := &mockVoteService{
+		getResultsFunc: func(ctx context.Context, partyID string) (*services.PartyResults, error) {
+			return nil, services.ErrVotingNotEnded
+		},
+	}
+	handler := handlers.NewVoteHandler(svc)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/parties/party-1/results?format=png", nil)
+	req = requestWithUserID(req, "admin-1")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
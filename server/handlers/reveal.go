@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/sipgate/eurovision-vote-party/server/middleware"
+	"github.com/sipgate/eurovision-vote-party/server/services"
+)
+
+// RevealServiceHandler defines the operations needed by the reveal handler.
+type RevealServiceHandler interface {
+	GetReveal(ctx context.Context, partyID string) (*services.RevealState, error)
+	AdvanceReveal(ctx context.Context, partyID string) (*services.RevealState, error)
+	ResetReveal(ctx context.Context, partyID string) (*services.RevealState, error)
+}
+
+// RevealHandler handles HTTP requests for the step-by-step results reveal.
+type RevealHandler struct {
+	service RevealServiceHandler
+}
+
+// NewRevealHandler creates a new RevealHandler.
+func NewRevealHandler(service RevealServiceHandler) *RevealHandler {
+	return &RevealHandler{service: service}
+}
+
+// ServeHTTP routes requests to the appropriate handler method.
+func (h *RevealHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimPrefix(r.URL.Path, "/api/parties/")
+	segments := strings.Split(path, "/")
+
+	if len(segments) < 2 || segments[1] != "reveal" {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	partyID := segments[0]
+
+	switch len(segments) {
+	case 2: // /api/parties/{partyID}/reveal
+		if r.Method == http.MethodGet {
+			h.handleGetReveal(w, r, partyID)
+			return
+		}
+	case 3:
+		switch segments[2] {
+		case "next":
+			if r.Method == http.MethodPost {
+				h.handleAdvanceReveal(w, r, partyID)
+				return
+			}
+		case "reset":
+			if r.Method == http.MethodPost {
+				h.handleResetReveal(w, r, partyID)
+				return
+			}
+		}
+	}
+
+	http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+}
+
+// handleGetReveal handles GET /api/parties/:partyID/reveal.
+func (h *RevealHandler) handleGetReveal(w http.ResponseWriter, r *http.Request, partyID string) {
+	state, err := h.service.GetReveal(r.Context(), partyID)
+	if err != nil {
+		mapRevealError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, state)
+}
+
+// handleAdvanceReveal handles POST /api/parties/:partyID/reveal/next.
+func (h *RevealHandler) handleAdvanceReveal(w http.ResponseWriter, r *http.Request, partyID string) {
+	if _, ok := middleware.UserIDFromContext(r.Context()); !ok {
+		writeError(w, http.StatusUnauthorized)
+		return
+	}
+
+	state, err := h.service.AdvanceReveal(r.Context(), partyID)
+	if err != nil {
+		mapRevealError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, state)
+}
+
+// handleResetReveal handles POST /api/parties/:partyID/reveal/reset.
+func (h *RevealHandler) handleResetReveal(w http.ResponseWriter, r *http.Request, partyID string) {
+	if _, ok := middleware.UserIDFromContext(r.Context()); !ok {
+		writeError(w, http.StatusUnauthorized)
+		return
+	}
+
+	state, err := h.service.ResetReveal(r.Context(), partyID)
+	if err != nil {
+		mapRevealError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, state)
+}
+
+// mapRevealError maps service errors to HTTP status codes.
+func mapRevealError(w http.ResponseWriter, err error) {
+	if errors.Is(err, services.ErrRevealComplete) {
+		writeError(w, http.StatusConflict)
+		return
+	}
+	mapVoteError(w, err)
+}
@@ -0,0 +1,163 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sipgate/eurovision-vote-party/server/authz"
+	"github.com/sipgate/eurovision-vote-party/server/handlers"
+	"github.com/sipgate/eurovision-vote-party/server/services"
+)
+
+type mockRevealService struct {
+	getRevealFunc     func(ctx context.Context, partyID string) (*services.RevealState, error)
+	advanceRevealFunc func(ctx context.Context, partyID string) (*services.RevealState, error)
+	resetRevealFunc   func(ctx context.Context, partyID string) (*services.RevealState, error)
+}
+
+func (m *mockRevealService) GetReveal(ctx context.Context, partyID string) (*services.RevealState, error) {
+	if m.getRevealFunc != nil {
+		return m.getRevealFunc(ctx, partyID)
+	}
+	return nil, nil
+}
+
+func (m *mockRevealService) AdvanceReveal(ctx context.Context, partyID string) (*services.RevealState, error) {
+	if m.advanceRevealFunc != nil {
+		return m.advanceRevealFunc(ctx, partyID)
+	}
+	return nil, nil
+}
+
+func (m *mockRevealService) ResetReveal(ctx context.Context, partyID string) (*services.RevealState, error) {
+	if m.resetRevealFunc != nil {
+		return m.resetRevealFunc(ctx, partyID)
+	}
+	return nil, nil
+}
+
+func TestRevealHandler_GetReveal_ReturnsStateWithoutAuth(t *testing.T) {
+	svc := &mockRevealService{
+		getRevealFunc: func(ctx context.Context, partyID string) (*services.RevealState, error) {
+			assert.Equal(t, "", authz.FromContext(ctx).UserID)
+			assert.Equal(t, "party-1", partyID)
+			return &services.RevealState{PartyID: partyID, Step: 2, TotalSteps: 6}, nil
+		},
+	}
+
+	handler := handlers.NewRevealHandler(svc)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/parties/party-1/reveal", nil)
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response services.RevealState
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, 2, response.Step)
+	assert.Equal(t, 6, response.TotalSteps)
+}
+
+func TestRevealHandler_GetReveal_ReturnsForbiddenWhenVotingNotEnded(t *testing.T) {
+	svc := &mockRevealService{
+		getRevealFunc: func(ctx context.Context, partyID string) (*services.RevealState, error) {
+			return nil, services.ErrVotingNotEnded
+		},
+	}
+
+	handler := handlers.NewRevealHandler(svc)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/parties/party-1/reveal", nil)
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestRevealHandler_AdvanceReveal_ReturnsOKForAdmin(t *testing.T) {
+	svc := &mockRevealService{
+		advanceRevealFunc: func(ctx context.Context, partyID string) (*services.RevealState, error) {
+			assert.Equal(t, "admin-1", authz.FromContext(ctx).UserID)
+			assert.Equal(t, "party-1", partyID)
+			return &services.RevealState{PartyID: partyID, Step: 1}, nil
+		},
+	}
+
+	handler := handlers.NewRevealHandler(svc)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/parties/party-1/reveal/next", nil)
+	req = requestWithUserID(req, "admin-1")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestRevealHandler_AdvanceReveal_ReturnsUnauthorizedWithoutAuth(t *testing.T) {
+	handler := handlers.NewRevealHandler(&mockRevealService{})
+
+	req := httptest.NewRequest(http.MethodPost, "/api/parties/party-1/reveal/next", nil)
+	req = requestWithGuest(req, "guest-1", "party-1")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestRevealHandler_AdvanceReveal_ReturnsConflictWhenComplete(t *testing.T) {
+	svc := &mockRevealService{
+		advanceRevealFunc: func(ctx context.Context, partyID string) (*services.RevealState, error) {
+			return nil, services.ErrRevealComplete
+		},
+	}
+
+	handler := handlers.NewRevealHandler(svc)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/parties/party-1/reveal/next", nil)
+	req = requestWithUserID(req, "admin-1")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusConflict, rec.Code)
+}
+
+func TestRevealHandler_ResetReveal_ReturnsForbiddenForNonOwner(t *testing.T) {
+	svc := &mockRevealService{
+		resetRevealFunc: func(ctx context.Context, partyID string) (*services.RevealState, error) {
+			return nil, services.ErrUnauthorized
+		},
+	}
+
+	handler := handlers.NewRevealHandler(svc)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/parties/party-1/reveal/reset", nil)
+	req = requestWithUserID(req, "other-user")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestRevealHandler_ReturnsMethodNotAllowedForWrongMethod(t *testing.T) {
+	handler := handlers.NewRevealHandler(&mockRevealService{})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/parties/party-1/reveal/next", nil)
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
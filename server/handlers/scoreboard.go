@@ -0,0 +1,138 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/sipgate/eurovision-vote-party/server/middleware"
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/services"
+)
+
+// ScoreboardServiceHandler defines the operations needed by the scoreboard handler.
+type ScoreboardServiceHandler interface {
+	ImportScoreboard(ctx context.Context, userID string, scoreboard models.Scoreboard) (*models.Scoreboard, error)
+	GetScoreboard(ctx context.Context, contest, eventType string) (*models.Scoreboard, error)
+	CompareResults(ctx context.Context, partyID string) (*services.ResultsComparison, error)
+}
+
+// ScoreboardHandler handles HTTP requests for official scoreboards and the
+// comparison of party results with them.
+type ScoreboardHandler struct {
+	service ScoreboardServiceHandler
+}
+
+// NewScoreboardHandler creates a new ScoreboardHandler.
+func NewScoreboardHandler(service ScoreboardServiceHandler) *ScoreboardHandler {
+	return &ScoreboardHandler{service: service}
+}
+
+// importScoreboardRequest represents the request body for importing a scoreboard.
+type importScoreboardRequest struct {
+	Entries []models.ScoreboardEntry `json:"entries"`
+}
+
+// ServeHTTP routes requests to the appropriate handler method.
+//
+//	GET /api/contests/{contest}/scoreboard/{eventType}  get the official scoreboard of a show (public)
+//	PUT /api/contests/{contest}/scoreboard/{eventType}  import the official scoreboard of a show
+//	GET /api/parties/{partyID}/results/comparison       compare a party's results with the scoreboard
+func (h *ScoreboardHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if path, ok := strings.CutPrefix(r.URL.Path, "/api/parties/"); ok {
+		segments := strings.Split(path, "/")
+		if len(segments) == 3 && segments[1] == "results" && segments[2] == "comparison" && r.Method == http.MethodGet {
+			h.handleCompare(w, r, segments[0])
+			return
+		}
+		writeError(w, http.StatusMethodNotAllowed)
+		return
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, "/api/contests/")
+	segments := strings.Split(path, "/")
+	if len(segments) != 3 || segments[0] == "" || segments[1] != "scoreboard" || segments[2] == "" {
+		writeError(w, http.StatusNotFound)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		h.handleGet(w, r, segments[0], segments[2])
+		return
+	case http.MethodPut:
+		h.handleImport(w, r, segments[0], segments[2])
+		return
+	}
+
+	writeError(w, http.StatusMethodNotAllowed)
+}
+
+// handleGet handles GET /api/contests/{contest}/scoreboard/{eventType}.
+func (h *ScoreboardHandler) handleGet(w http.ResponseWriter, r *http.Request, contest, eventType string) {
+	scoreboard, err := h.service.GetScoreboard(r.Context(), contest, eventType)
+	if err != nil {
+		writeScoreboardError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, scoreboard)
+}
+
+// handleImport handles PUT /api/contests/{contest}/scoreboard/{eventType}.
+func (h *ScoreboardHandler) handleImport(w http.ResponseWriter, r *http.Request, contest, eventType string) {
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized)
+		return
+	}
+
+	var req importScoreboardRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest)
+		return
+	}
+
+	scoreboard, err := h.service.ImportScoreboard(r.Context(), userID, models.Scoreboard{
+		Contest:   contest,
+		EventType: models.EventType(eventType),
+		Entries:   req.Entries,
+	})
+	if err != nil {
+		writeScoreboardError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, scoreboard)
+}
+
+// handleCompare handles GET /api/parties/{partyID}/results/comparison.
+func (h *ScoreboardHandler) handleCompare(w http.ResponseWriter, r *http.Request, partyID string) {
+	comparison, err := h.service.CompareResults(r.Context(), partyID)
+	if err != nil {
+		writeScoreboardError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, comparison)
+}
+
+// writeScoreboardError maps scoreboard service errors to HTTP responses.
+func writeScoreboardError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, services.ErrInvalidScoreboard):
+		writeError(w, http.StatusBadRequest)
+	case errors.Is(err, services.ErrUnauthorized),
+		errors.Is(err, services.ErrVotingNotEnded),
+		errors.Is(err, services.ErrRevealInProgress):
+		writeError(w, http.StatusForbidden)
+	case errors.Is(err, services.ErrNotFound),
+		errors.Is(err, services.ErrUnknownContest),
+		errors.Is(err, services.ErrNoScoreboard):
+		writeError(w, http.StatusNotFound)
+	default:
+		writeError(w, http.StatusInternalServerError)
+	}
+}
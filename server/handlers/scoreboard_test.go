@@ -0,0 +1,167 @@
+package handlers_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sipgate/eurovision-vote-party/server/authz"
+	"github.com/sipgate/eurovision-vote-party/server/handlers"
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/services"
+)
+
+type mockScoreboardService struct {
+	importScoreboardFunc func(ctx context.Context, userID string, scoreboard models.Scoreboard) (*models.Scoreboard, error)
+	getScoreboardFunc    func(ctx context.Context, contest, eventType string) (*models.Scoreboard, error)
+	compareResultsFunc   func(ctx context.Context, partyID string) (*services.ResultsComparison, error)
+}
+
+func (m *mockScoreboardService) ImportScoreboard(ctx context.Context, userID string, scoreboard models.Scoreboard) (*models.Scoreboard, error) {
+	if m.importScoreboardFunc != nil {
+		return m.importScoreboardFunc(ctx, userID, scoreboard)
+	}
+	return nil, nil
+}
+
+func (m *mockScoreboardService) GetScoreboard(ctx context.Context, contest, eventType string) (*models.Scoreboard, error) {
+	if m.getScoreboardFunc != nil {
+		return m.getScoreboardFunc(ctx, contest, eventType)
+	}
+	return nil, nil
+}
+
+func (m *mockScoreboardService) CompareResults(ctx context.Context, partyID string) (*services.ResultsComparison, error) {
+	if m.compareResultsFunc != nil {
+		return m.compareResultsFunc(ctx, partyID)
+	}
+	return nil, nil
+}
+
+func TestScoreboardHandler_Import_PassesContestAndShowFromPath(t *testing.T) {
+	svc := &mockScoreboardService{
+		importScoreboardFunc: func(ctx context.Context, userID string, scoreboard models.Scoreboard) (*models.Scoreboard, error) {
+			assert.Equal(t, "editor-1", userID)
+			assert.Equal(t, "esc-2026", scoreboard.Contest)
+			assert.Equal(t, models.EventGrandFinal, scoreboard.EventType)
+			assert.Equal(t, []models.ScoreboardEntry{{ActID: "act-1", JuryPoints: 100, TelevotePoints: 50}}, scoreboard.Entries)
+			return &scoreboard, nil
+		},
+	}
+	handler := handlers.NewScoreboardHandler(svc)
+
+	body := `{"entries":[{"actId":"act-1","juryPoints":100,"televotePoints":50}]}`
+	req := httptest.NewRequest(http.MethodPut, "/api/contests/esc-2026/scoreboard/grandfinal", bytes.NewBufferString(body))
+	req = requestWithUserID(req, "editor-1")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestScoreboardHandler_Import_RequiresAuth(t *testing.T) {
+	handler := handlers.NewScoreboardHandler(&mockScoreboardService{})
+
+	req := httptest.NewRequest(http.MethodPut, "/api/contests/esc-2026/scoreboard/grandfinal", bytes.NewBufferString(`{"entries":[]}`))
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestScoreboardHandler_Import_MapsErrors(t *testing.T) {
+	for err, status := range map[error]int{
+		services.ErrInvalidScoreboard: http.StatusBadRequest,
+		services.ErrUnauthorized:      http.StatusForbidden,
+		services.ErrUnknownContest:    http.StatusNotFound,
+	} {
+		svc := &mockScoreboardService{
+			importScoreboardFunc: func(ctx context.Context, userID string, scoreboard models.Scoreboard) (*models.Scoreboard, error) {
+				return nil, err
+			},
+		}
+		handler := handlers.NewScoreboardHandler(svc)
+
+		req := httptest.NewRequest(http.MethodPut, "/api/contests/esc-2026/scoreboard/grandfinal", bytes.NewBufferString(`{"entries":[]}`))
+		req = requestWithUserID(req, "editor-1")
+		rec := httptest.NewRecorder()
+
+		handler.ServeHTTP(rec, req)
+
+		assert.Equal(t, status, rec.Code, err.Error())
+	}
+}
+
+func TestScoreboardHandler_Get_ReturnsNotFoundWithoutScoreboard(t *testing.T) {
+	svc := &mockScoreboardService{
+		getScoreboardFunc: func(ctx context.Context, contest, eventType string) (*models.Scoreboard, error) {
+			assert.Equal(t, "esc-2026", contest)
+			assert.Equal(t, "semifinal1", eventType)
+			return nil, services.ErrNoScoreboard
+		},
+	}
+	handler := handlers.NewScoreboardHandler(svc)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/contests/esc-2026/scoreboard/semifinal1", nil)
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestScoreboardHandler_Compare_ReturnsComparison(t *testing.T) {
+	rho := 0.5
+	svc := &mockScoreboardService{
+		compareResultsFunc: func(ctx context.Context, partyID string) (*services.ResultsComparison, error) {
+			assert.Equal(t, "admin-1", authz.FromContext(ctx).UserID)
+			assert.Equal(t, "party-1", partyID)
+			return &services.ResultsComparison{PartyID: partyID, Spearman: &rho}, nil
+		},
+	}
+	handler := handlers.NewScoreboardHandler(svc)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/parties/party-1/results/comparison", nil)
+	req = requestWithUserID(req, "admin-1")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var body services.ResultsComparison
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+	assert.Equal(t, "party-1", body.PartyID)
+	require.NotNil(t, body.Spearman)
+	assert.Equal(t, rho, *body.Spearman)
+}
+
+func TestScoreboardHandler_Compare_MapsErrors(t *testing.T) {
+	for err, status := range map[error]int{
+		services.ErrNotFound:         http.StatusNotFound,
+		services.ErrNoScoreboard:     http.StatusNotFound,
+		services.ErrVotingNotEnded:   http.StatusForbidden,
+		services.ErrRevealInProgress: http.StatusForbidden,
+	} {
+		svc := &mockScoreboardService{
+			compareResultsFunc: func(ctx context.Context, partyID string) (*services.ResultsComparison, error) {
+				return nil, err
+			},
+		}
+		handler := handlers.NewScoreboardHandler(svc)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/parties/party-1/results/comparison", nil)
+		rec := httptest.NewRecorder()
+
+		handler.ServeHTTP(rec, req)
+
+		assert.Equal(t, status, rec.Code, err.Error())
+	}
+}
@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/sipgate/eurovision-vote-party/server/services"
+)
+
+// StatsServiceHandler defines the operations needed by the stats handler.
+type StatsServiceHandler interface {
+	GetStats(ctx context.Context, partyID string) (*services.PartyStats, error)
+}
+
+// StatsHandler handles HTTP requests for party statistics.
+type StatsHandler struct {
+	service StatsServiceHandler
+}
+
+// NewStatsHandler creates a new StatsHandler.
+func NewStatsHandler(service StatsServiceHandler) *StatsHandler {
+	return &StatsHandler{service: service}
+}
+
+// ServeHTTP handles GET /api/parties/{partyID}/stats.
+func (h *StatsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimPrefix(r.URL.Path, "/api/parties/")
+	segments := strings.Split(path, "/")
+
+	if len(segments) != 2 || segments[1] != "stats" || r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed)
+		return
+	}
+
+	stats, err := h.service.GetStats(r.Context(), segments[0])
+	if err != nil {
+		mapVoteError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, stats)
+}
@@ -0,0 +1,90 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sipgate/eurovision-vote-party/server/authz"
+	"github.com/sipgate/eurovision-vote-party/server/handlers"
+	"github.com/sipgate/eurovision-vote-party/server/services"
+)
+
+type mockStatsService struct {
+	getStatsFunc func(ctx context.Context, partyID string) (*services.PartyStats, error)
+}
+
+func (m *mockStatsService) GetStats(ctx context.Context, partyID string) (*services.PartyStats, error) {
+	if m.getStatsFunc != nil {
+		return m.getStatsFunc(ctx, partyID)
+	}
+	return nil, nil
+}
+
+func TestStatsHandler_ReturnsStats(t *testing.T) {
+	match := 0.8
+	svc := &mockStatsService{
+		getStatsFunc: func(ctx context.Context, partyID string) (*services.PartyStats, error) {
+			assert.Equal(t, "admin-1", authz.FromContext(ctx).UserID)
+			assert.Equal(t, "party-1", partyID)
+			return &services.PartyStats{
+				PartyID:    partyID,
+				Guests:     []services.GuestTasteMatch{{GuestID: "guest-1", ConsensusMatch: &match}},
+				Similarity: [][]*float64{{&match}},
+			}, nil
+		},
+	}
+	handler := handlers.NewStatsHandler(svc)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/parties/party-1/stats", nil)
+	req = requestWithUserID(req, "admin-1")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var body services.PartyStats
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+	require.Len(t, body.Guests, 1)
+	assert.Equal(t, match, *body.Guests[0].ConsensusMatch)
+	assert.Nil(t, body.Guests[0].OfficialMatch)
+}
+
+func TestStatsHandler_MapsErrors(t *testing.T) {
+	for err, status := range map[error]int{
+		services.ErrNotFound:         http.StatusNotFound,
+		services.ErrUnauthorized:     http.StatusForbidden,
+		services.ErrVotingNotEnded:   http.StatusForbidden,
+		services.ErrRevealInProgress: http.StatusForbidden,
+	} {
+		svc := &mockStatsService{
+			getStatsFunc: func(ctx context.Context, partyID string) (*services.PartyStats, error) {
+				return nil, err
+			},
+		}
+		handler := handlers.NewStatsHandler(svc)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/parties/party-1/stats", nil)
+		rec := httptest.NewRecorder()
+
+		handler.ServeHTTP(rec, req)
+
+		assert.Equal(t, status, rec.Code, err.Error())
+	}
+}
+
+func TestStatsHandler_RejectsOtherMethods(t *testing.T) {
+	handler := handlers.NewStatsHandler(&mockStatsService{})
+
+	req := httptest.NewRequest(http.MethodPost, "/api/parties/party-1/stats", nil)
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
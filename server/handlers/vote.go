@@ -4,9 +4,11 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"io"
 	"net/http"
 	"strings"
 
+	"github.com/sipgate/eurovision-vote-party/server/authz"
 	"github.com/sipgate/eurovision-vote-party/server/middleware"
 	"github.com/sipgate/eurovision-vote-party/server/models"
 	"github.com/sipgate/eurovision-vote-party/server/services"
@@ -14,11 +16,14 @@ import (
 
 // VoteServiceHandler defines the operations needed by the vote handler.
 type VoteServiceHandler interface {
-	SubmitVote(ctx context.Context, adminID, partyID string, req services.SubmitVoteRequest) (*models.Vote, error)
-	GetVotes(ctx context.Context, adminID, partyID, guestID string) (*models.Vote, error)
-	UpdateVote(ctx context.Context, adminID, partyID string, req services.SubmitVoteRequest) (*models.Vote, error)
-	EndVoting(ctx context.Context, adminID, partyID string) (*models.Party, error)
-	GetResults(ctx context.Context, adminID, partyID string) (*services.PartyResults, error)
+	SubmitVote(ctx context.Context, partyID string, req services.SubmitVoteRequest) (*models.Vote, error)
+	GetVotes(ctx context.Context, partyID, guestID string) (*models.Vote, error)
+	UpdateVote(ctx context.Context, partyID string, req services.SubmitVoteRequest) (*models.Vote, error)
+	SaveDraft(ctx context.Context, partyID string, req services.SubmitVoteRequest) (*models.Vote, error)
+	FinalizeVote(ctx context.Context, partyID, guestID string) (*models.Vote, error)
+	StartVoting(ctx context.Context, partyID string) (*models.Party, error)
+	EndVoting(ctx context.Context, partyID string) (*models.Party, error)
+	GetResults(ctx context.Context, partyID string) (*services.PartyResults, error)
 }
 
 // VoteHandler handles HTTP requests for vote management.
@@ -32,9 +37,11 @@ func NewVoteHandler(service VoteServiceHandler) *VoteHandler {
 }
 
 // submitVoteRequest represents the request body for submitting or updating a vote.
+// Guests may omit GuestID; it defaults to the guest identified by the session token.
 type submitVoteRequest struct {
 	GuestID string         `json:"guestId"`
 	Votes   map[int]string `json:"votes"`
+	Ratings map[string]int `json:"ratings"`
 }
 
 // ServeHTTP routes requests to the appropriate handler method.
@@ -61,12 +68,24 @@ func (h *VoteHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				h.handleUpdateVote(w, r, partyID)
 				return
 			}
-		case 3: // /api/parties/{partyID}/votes/{guestID}
-			if r.Method == http.MethodGet {
+		case 3:
+			switch {
+			case segments[2] == "draft" && r.Method == http.MethodPut: // /api/parties/{partyID}/votes/draft
+				h.handleSaveDraft(w, r, partyID)
+				return
+			case segments[2] == "finalize" && r.Method == http.MethodPost: // /api/parties/{partyID}/votes/finalize
+				h.handleFinalizeVote(w, r, partyID)
+				return
+			case r.Method == http.MethodGet: // /api/parties/{partyID}/votes/{guestID}
 				h.handleGetVotes(w, r, partyID, segments[2])
 				return
 			}
 		}
+	case "start-voting":
+		if r.Method == http.MethodPost {
+			h.handleStartVoting(w, r, partyID)
+			return
+		}
 	case "end-voting":
 		if r.Method == http.MethodPost {
 			h.handleEndVoting(w, r, partyID)
@@ -84,23 +103,12 @@ func (h *VoteHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 // handleSubmitVote handles POST /api/parties/:partyID/votes.
 func (h *VoteHandler) handleSubmitVote(w http.ResponseWriter, r *http.Request, partyID string) {
-	adminID, _ := middleware.UserIDFromContext(r.Context())
-
-	var req submitVoteRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		writeError(w, http.StatusBadRequest)
+	req, ok := decodeBallot(w, r)
+	if !ok {
 		return
 	}
 
-	if strings.TrimSpace(req.GuestID) == "" {
-		writeError(w, http.StatusBadRequest)
-		return
-	}
-
-	vote, err := h.service.SubmitVote(r.Context(), adminID, partyID, services.SubmitVoteRequest{
-		GuestID: req.GuestID,
-		Votes:   req.Votes,
-	})
+	vote, err := h.service.SubmitVote(r.Context(), partyID, req)
 	if err != nil {
 		mapVoteError(w, err)
 		return
@@ -111,9 +119,11 @@ func (h *VoteHandler) handleSubmitVote(w http.ResponseWriter, r *http.Request, p
 
 // handleGetVotes handles GET /api/parties/:partyID/votes/:guestID.
 func (h *VoteHandler) handleGetVotes(w http.ResponseWriter, r *http.Request, partyID, guestID string) {
-	adminID, _ := middleware.UserIDFromContext(r.Context())
+	if !requireCaller(w, r) {
+		return
+	}
 
-	vote, err := h.service.GetVotes(r.Context(), adminID, partyID, guestID)
+	vote, err := h.service.GetVotes(r.Context(), partyID, guestID)
 	if err != nil {
 		mapVoteError(w, err)
 		return
@@ -124,23 +134,49 @@ func (h *VoteHandler) handleGetVotes(w http.ResponseWriter, r *http.Request, par
 
 // handleUpdateVote handles PUT /api/parties/:partyID/votes.
 func (h *VoteHandler) handleUpdateVote(w http.ResponseWriter, r *http.Request, partyID string) {
-	adminID, _ := middleware.UserIDFromContext(r.Context())
+	req, ok := decodeBallot(w, r)
+	if !ok {
+		return
+	}
 
-	var req submitVoteRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		writeError(w, http.StatusBadRequest)
+	vote, err := h.service.UpdateVote(r.Context(), partyID, req)
+	if err != nil {
+		mapVoteError(w, err)
 		return
 	}
 
-	if strings.TrimSpace(req.GuestID) == "" {
+	writeJSON(w, http.StatusOK, vote)
+}
+
+// handleSaveDraft handles PUT /api/parties/:partyID/votes/draft.
+func (h *VoteHandler) handleSaveDraft(w http.ResponseWriter, r *http.Request, partyID string) {
+	req, ok := decodeBallot(w, r)
+	if !ok {
+		return
+	}
+
+	vote, err := h.service.SaveDraft(r.Context(), partyID, req)
+	if err != nil {
+		mapVoteError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, vote)
+}
+
+// handleFinalizeVote handles POST /api/parties/:partyID/votes/finalize.
+// The ballot is taken from the stored draft, so only hosts need to send a body naming the guest.
+func (h *VoteHandler) handleFinalizeVote(w http.ResponseWriter, r *http.Request, partyID string) {
+	var req submitVoteRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
 		writeError(w, http.StatusBadRequest)
 		return
 	}
+	if !requireCaller(w, r) {
+		return
+	}
 
-	vote, err := h.service.UpdateVote(r.Context(), adminID, partyID, services.SubmitVoteRequest{
-		GuestID: req.GuestID,
-		Votes:   req.Votes,
-	})
+	vote, err := h.service.FinalizeVote(r.Context(), partyID, req.GuestID)
 	if err != nil {
 		mapVoteError(w, err)
 		return
@@ -149,15 +185,34 @@ func (h *VoteHandler) handleUpdateVote(w http.ResponseWriter, r *http.Request, p
 	writeJSON(w, http.StatusOK, vote)
 }
 
+// handleStartVoting handles POST /api/parties/:partyID/start-voting.
+func (h *VoteHandler) handleStartVoting(w http.ResponseWriter, r *http.Request, partyID string) {
+	if _, ok := middleware.UserIDFromContext(r.Context()); !ok {
+		writeError(w, http.StatusUnauthorized)
+		return
+	}
+
+	party, err := h.service.StartVoting(r.Context(), partyID)
+	if err != nil {
+		mapVoteError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"id":              party.ID,
+		"status":          party.Status,
+		"votingStartedAt": party.VotingStartedAt,
+	})
+}
+
 // handleEndVoting handles POST /api/parties/:partyID/end-voting.
 func (h *VoteHandler) handleEndVoting(w http.ResponseWriter, r *http.Request, partyID string) {
-	adminID, _ := middleware.UserIDFromContext(r.Context())
-	if adminID == "" {
+	if _, ok := middleware.UserIDFromContext(r.Context()); !ok {
 		writeError(w, http.StatusUnauthorized)
 		return
 	}
 
-	party, err := h.service.EndVoting(r.Context(), adminID, partyID)
+	party, err := h.service.EndVoting(r.Context(), partyID)
 	if err != nil {
 		mapVoteError(w, err)
 		return
@@ -170,16 +225,52 @@ func (h *VoteHandler) handleEndVoting(w http.ResponseWriter, r *http.Request, pa
 }
 
 // handleGetResults handles GET /api/parties/:partyID/results.
+// The format query parameter (json, csv, png or svg) or the Accept header selects
+// the representation; JSON is the default.
 func (h *VoteHandler) handleGetResults(w http.ResponseWriter, r *http.Request, partyID string) {
-	adminID, _ := middleware.UserIDFromContext(r.Context())
+	format, download, status := negotiateResultsFormat(r)
+	if status != http.StatusOK {
+		writeError(w, status)
+		return
+	}
 
-	results, err := h.service.GetResults(r.Context(), adminID, partyID)
+	results, err := h.service.GetResults(r.Context(), partyID)
 	if err != nil {
 		mapVoteError(w, err)
 		return
 	}
 
-	writeJSON(w, http.StatusOK, results)
+	writeResults(w, results, format, download)
+}
+
+// decodeBallot reads a ballot from the request body. Whether the caller may
+// cast it, and for which guest, is up to the service. On failure it writes the
+// error response and returns false.
+func decodeBallot(w http.ResponseWriter, r *http.Request) (services.SubmitVoteRequest, bool) {
+	var req submitVoteRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest)
+		return services.SubmitVoteRequest{}, false
+	}
+	if !requireCaller(w, r) {
+		return services.SubmitVoteRequest{}, false
+	}
+
+	return services.SubmitVoteRequest{
+		GuestID: req.GuestID,
+		Votes:   req.Votes,
+		Ratings: req.Ratings,
+	}, true
+}
+
+// requireCaller responds with 401 Unauthorized and returns false if the
+// request carries neither a user token nor a guest session.
+func requireCaller(w http.ResponseWriter, r *http.Request) bool {
+	if authz.FromContext(r.Context()).IsAnonymous() {
+		writeError(w, http.StatusUnauthorized)
+		return false
+	}
+	return true
 }
 
 // mapVoteError maps service errors to HTTP status codes.
@@ -195,10 +286,12 @@ func mapVoteError(w http.ResponseWriter, err error) {
 		writeError(w, http.StatusForbidden)
 	case errors.Is(err, services.ErrVoteAlreadyExists):
 		writeError(w, http.StatusConflict)
-	case errors.Is(err, services.ErrInvalidVotes):
+	case errors.Is(err, services.ErrInvalidVotes), errors.Is(err, services.ErrGuestIDRequired):
 		writeError(w, http.StatusBadRequest)
 	case errors.Is(err, services.ErrVotingNotEnded):
 		writeError(w, http.StatusForbidden)
+	case errors.Is(err, services.ErrRevealInProgress):
+		writeError(w, http.StatusForbidden)
 	default:
 		writeError(w, http.StatusInternalServerError)
 	}
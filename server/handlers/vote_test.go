@@ -12,50 +12,75 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/sipgate/eurovision-vote-party/server/authz"
 	"github.com/sipgate/eurovision-vote-party/server/handlers"
 	"github.com/sipgate/eurovision-vote-party/server/models"
 	"github.com/sipgate/eurovision-vote-party/server/services"
 )
 
 type mockVoteService struct {
-	submitVoteFunc func(ctx context.Context, adminID, partyID string, req services.SubmitVoteRequest) (*models.Vote, error)
-	getVotesFunc   func(ctx context.Context, adminID, partyID, guestID string) (*models.Vote, error)
-	updateVoteFunc func(ctx context.Context, adminID, partyID string, req services.SubmitVoteRequest) (*models.Vote, error)
-	endVotingFunc  func(ctx context.Context, adminID, partyID string) (*models.Party, error)
-	getResultsFunc func(ctx context.Context, adminID, partyID string) (*services.PartyResults, error)
+	submitVoteFunc   func(ctx context.Context, partyID string, req services.SubmitVoteRequest) (*models.Vote, error)
+	getVotesFunc     func(ctx context.Context, partyID, guestID string) (*models.Vote, error)
+	updateVoteFunc   func(ctx context.Context, partyID string, req services.SubmitVoteRequest) (*models.Vote, error)
+	saveDraftFunc    func(ctx context.Context, partyID string, req services.SubmitVoteRequest) (*models.Vote, error)
+	finalizeVoteFunc func(ctx context.Context, partyID, guestID string) (*models.Vote, error)
+	startVotingFunc  func(ctx context.Context, partyID string) (*models.Party, error)
+	endVotingFunc    func(ctx context.Context, partyID string) (*models.Party, error)
+	getResultsFunc   func(ctx context.Context, partyID string) (*services.PartyResults, error)
 }
 
-func (m *mockVoteService) SubmitVote(ctx context.Context, adminID, partyID string, req services.SubmitVoteRequest) (*models.Vote, error) {
+func (m *mockVoteService) SubmitVote(ctx context.Context, partyID string, req services.SubmitVoteRequest) (*models.Vote, error) {
 	if m.submitVoteFunc != nil {
-		return m.submitVoteFunc(ctx, adminID, partyID, req)
+		return m.submitVoteFunc(ctx, partyID, req)
 	}
 	return nil, nil
 }
 
-func (m *mockVoteService) GetVotes(ctx context.Context, adminID, partyID, guestID string) (*models.Vote, error) {
+func (m *mockVoteService) GetVotes(ctx context.Context, partyID, guestID string) (*models.Vote, error) {
 	if m.getVotesFunc != nil {
-		return m.getVotesFunc(ctx, adminID, partyID, guestID)
+		return m.getVotesFunc(ctx, partyID, guestID)
 	}
 	return nil, nil
 }
 
-func (m *mockVoteService) UpdateVote(ctx context.Context, adminID, partyID string, req services.SubmitVoteRequest) (*models.Vote, error) {
+func (m *mockVoteService) UpdateVote(ctx context.Context, partyID string, req services.SubmitVoteRequest) (*models.Vote, error) {
 	if m.updateVoteFunc != nil {
-		return m.updateVoteFunc(ctx, adminID, partyID, req)
+		return m.updateVoteFunc(ctx, partyID, req)
 	}
 	return nil, nil
 }
 
-func (m *mockVoteService) EndVoting(ctx context.Context, adminID, partyID string) (*models.Party, error) {
+func (m *mockVoteService) SaveDraft(ctx context.Context, partyID string, req services.SubmitVoteRequest) (*models.Vote, error) {
+	if m.saveDraftFunc != nil {
+		return m.saveDraftFunc(ctx, partyID, req)
+	}
+	return nil, nil
+}
+
+func (m *mockVoteService) FinalizeVote(ctx context.Context, partyID, guestID string) (*models.Vote, error) {
+	if m.finalizeVoteFunc != nil {
+		return m.finalizeVoteFunc(ctx, partyID, guestID)
+	}
+	return nil, nil
+}
+
+func (m *mockVoteService) StartVoting(ctx context.Context, partyID string) (*models.Party, error) {
+	if m.startVotingFunc != nil {
+		return m.startVotingFunc(ctx, partyID)
+	}
+	return nil, nil
+}
+
+func (m *mockVoteService) EndVoting(ctx context.Context, partyID string) (*models.Party, error) {
 	if m.endVotingFunc != nil {
-		return m.endVotingFunc(ctx, adminID, partyID)
+		return m.endVotingFunc(ctx, partyID)
 	}
 	return nil, nil
 }
 
-func (m *mockVoteService) GetResults(ctx context.Context, adminID, partyID string) (*services.PartyResults, error) {
+func (m *mockVoteService) GetResults(ctx context.Context, partyID string) (*services.PartyResults, error) {
 	if m.getResultsFunc != nil {
-		return m.getResultsFunc(ctx, adminID, partyID)
+		return m.getResultsFunc(ctx, partyID)
 	}
 	return nil, nil
 }
@@ -87,8 +112,8 @@ func TestVoteHandler_SubmitVote_ReturnsCreatedWithValidRequest(t *testing.T) {
 	}
 
 	svc := &mockVoteService{
-		submitVoteFunc: func(ctx context.Context, adminID, partyID string, req services.SubmitVoteRequest) (*models.Vote, error) {
-			assert.Equal(t, "user-123", adminID)
+		submitVoteFunc: func(ctx context.Context, partyID string, req services.SubmitVoteRequest) (*models.Vote, error) {
+			assert.Equal(t, "user-123", authz.FromContext(ctx).UserID)
 			assert.Equal(t, "party-1", partyID)
 			assert.Equal(t, "guest-1", req.GuestID)
 			assert.Equal(t, validVotes(), req.Votes)
@@ -129,8 +154,8 @@ func TestVoteHandler_SubmitVote_ReturnsCreatedWithoutAdminAuth(t *testing.T) {
 	}
 
 	svc := &mockVoteService{
-		submitVoteFunc: func(ctx context.Context, adminID, partyID string, req services.SubmitVoteRequest) (*models.Vote, error) {
-			assert.Equal(t, "", adminID)
+		submitVoteFunc: func(ctx context.Context, partyID string, req services.SubmitVoteRequest) (*models.Vote, error) {
+			assert.Equal(t, "", authz.FromContext(ctx).UserID)
 			assert.Equal(t, "party-1", partyID)
 			assert.Equal(t, "guest-1", req.GuestID)
 			return createdVote, nil
@@ -144,6 +169,7 @@ func TestVoteHandler_SubmitVote_ReturnsCreatedWithoutAdminAuth(t *testing.T) {
 		"votes":   validVotes(),
 	})
 	req := httptest.NewRequest(http.MethodPost, "/api/parties/party-1/votes", bytes.NewBuffer(body))
+	req = requestWithGuest(req, "guest-1", "party-1")
 	rec := httptest.NewRecorder()
 
 	handler.ServeHTTP(rec, req)
@@ -168,13 +194,18 @@ func TestVoteHandler_SubmitVote_ReturnsBadRequestWithInvalidJSON(t *testing.T) {
 }
 
 func TestVoteHandler_SubmitVote_ReturnsBadRequestWithEmptyGuestID(t *testing.T) {
-	handler := handlers.NewVoteHandler(&mockVoteService{})
+	handler := handlers.NewVoteHandler(&mockVoteService{
+		submitVoteFunc: func(ctx context.Context, partyID string, req services.SubmitVoteRequest) (*models.Vote, error) {
+			return nil, services.ErrGuestIDRequired
+		},
+	})
 
 	body, _ := json.Marshal(map[string]interface{}{
 		"guestId": "",
 		"votes":   validVotes(),
 	})
 	req := httptest.NewRequest(http.MethodPost, "/api/parties/party-1/votes", bytes.NewBuffer(body))
+	req = requestWithUserID(req, "user-123")
 	rec := httptest.NewRecorder()
 
 	handler.ServeHTTP(rec, req)
@@ -182,9 +213,86 @@ func TestVoteHandler_SubmitVote_ReturnsBadRequestWithEmptyGuestID(t *testing.T)
 	assert.Equal(t, http.StatusBadRequest, rec.Code)
 }
 
+func TestVoteHandler_SubmitVote_PassesGuestSessionWithoutGuestID(t *testing.T) {
+	svc := &mockVoteService{
+		submitVoteFunc: func(ctx context.Context, partyID string, req services.SubmitVoteRequest) (*models.Vote, error) {
+			assert.Equal(t, authz.Guest("guest-1", "party-1"), authz.FromContext(ctx))
+			assert.Equal(t, "", req.GuestID)
+			return &models.Vote{ID: "vote-1", GuestID: "guest-1", PartyID: partyID}, nil
+		},
+	}
+
+	handler := handlers.NewVoteHandler(svc)
+
+	body, _ := json.Marshal(map[string]interface{}{
+		"votes": validVotes(),
+	})
+	req := httptest.NewRequest(http.MethodPost, "/api/parties/party-1/votes", bytes.NewBuffer(body))
+	req = requestWithGuest(req, "guest-1", "party-1")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusCreated, rec.Code)
+}
+
+func TestVoteHandler_SubmitVote_ReturnsUnauthorizedWithoutGuestSession(t *testing.T) {
+	handler := handlers.NewVoteHandler(&mockVoteService{})
+
+	body, _ := json.Marshal(map[string]interface{}{
+		"guestId": "guest-1",
+		"votes":   validVotes(),
+	})
+	req := httptest.NewRequest(http.MethodPost, "/api/parties/party-1/votes", bytes.NewBuffer(body))
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestVoteHandler_SubmitVote_ReturnsForbiddenForAnotherGuestsBallot(t *testing.T) {
+	handler := handlers.NewVoteHandler(&mockVoteService{
+		submitVoteFunc: func(ctx context.Context, partyID string, req services.SubmitVoteRequest) (*models.Vote, error) {
+			return nil, services.ErrUnauthorized
+		},
+	})
+
+	body, _ := json.Marshal(map[string]interface{}{
+		"guestId": "guest-2",
+		"votes":   validVotes(),
+	})
+	req := httptest.NewRequest(http.MethodPost, "/api/parties/party-1/votes", bytes.NewBuffer(body))
+	req = requestWithGuest(req, "guest-1", "party-1")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestVoteHandler_SubmitVote_ReturnsForbiddenForGuestOfAnotherParty(t *testing.T) {
+	handler := handlers.NewVoteHandler(&mockVoteService{
+		submitVoteFunc: func(ctx context.Context, partyID string, req services.SubmitVoteRequest) (*models.Vote, error) {
+			return nil, services.ErrUnauthorized
+		},
+	})
+
+	body, _ := json.Marshal(map[string]interface{}{
+		"votes": validVotes(),
+	})
+	req := httptest.NewRequest(http.MethodPost, "/api/parties/party-1/votes", bytes.NewBuffer(body))
+	req = requestWithGuest(req, "guest-1", "party-2")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
 func TestVoteHandler_SubmitVote_ReturnsNotFoundWhenPartyNotFound(t *testing.T) {
 	svc := &mockVoteService{
-		submitVoteFunc: func(ctx context.Context, adminID, partyID string, req services.SubmitVoteRequest) (*models.Vote, error) {
+		submitVoteFunc: func(ctx context.Context, partyID string, req services.SubmitVoteRequest) (*models.Vote, error) {
 			return nil, services.ErrNotFound
 		},
 	}
@@ -196,6 +304,7 @@ func TestVoteHandler_SubmitVote_ReturnsNotFoundWhenPartyNotFound(t *testing.T) {
 		"votes":   validVotes(),
 	})
 	req := httptest.NewRequest(http.MethodPost, "/api/parties/party-1/votes", bytes.NewBuffer(body))
+	req = requestWithGuest(req, "guest-1", "party-1")
 	rec := httptest.NewRecorder()
 
 	handler.ServeHTTP(rec, req)
@@ -205,7 +314,7 @@ func TestVoteHandler_SubmitVote_ReturnsNotFoundWhenPartyNotFound(t *testing.T) {
 
 func TestVoteHandler_SubmitVote_ReturnsForbiddenWhenPartyClosed(t *testing.T) {
 	svc := &mockVoteService{
-		submitVoteFunc: func(ctx context.Context, adminID, partyID string, req services.SubmitVoteRequest) (*models.Vote, error) {
+		submitVoteFunc: func(ctx context.Context, partyID string, req services.SubmitVoteRequest) (*models.Vote, error) {
 			return nil, services.ErrPartyClosed
 		},
 	}
@@ -217,6 +326,7 @@ func TestVoteHandler_SubmitVote_ReturnsForbiddenWhenPartyClosed(t *testing.T) {
 		"votes":   validVotes(),
 	})
 	req := httptest.NewRequest(http.MethodPost, "/api/parties/party-1/votes", bytes.NewBuffer(body))
+	req = requestWithGuest(req, "guest-1", "party-1")
 	rec := httptest.NewRecorder()
 
 	handler.ServeHTTP(rec, req)
@@ -226,7 +336,7 @@ func TestVoteHandler_SubmitVote_ReturnsForbiddenWhenPartyClosed(t *testing.T) {
 
 func TestVoteHandler_SubmitVote_ReturnsForbiddenWhenUnauthorized(t *testing.T) {
 	svc := &mockVoteService{
-		submitVoteFunc: func(ctx context.Context, adminID, partyID string, req services.SubmitVoteRequest) (*models.Vote, error) {
+		submitVoteFunc: func(ctx context.Context, partyID string, req services.SubmitVoteRequest) (*models.Vote, error) {
 			return nil, services.ErrUnauthorized
 		},
 	}
@@ -248,7 +358,7 @@ func TestVoteHandler_SubmitVote_ReturnsForbiddenWhenUnauthorized(t *testing.T) {
 
 func TestVoteHandler_SubmitVote_ReturnsForbiddenWhenGuestNotApproved(t *testing.T) {
 	svc := &mockVoteService{
-		submitVoteFunc: func(ctx context.Context, adminID, partyID string, req services.SubmitVoteRequest) (*models.Vote, error) {
+		submitVoteFunc: func(ctx context.Context, partyID string, req services.SubmitVoteRequest) (*models.Vote, error) {
 			return nil, services.ErrGuestNotApproved
 		},
 	}
@@ -260,6 +370,7 @@ func TestVoteHandler_SubmitVote_ReturnsForbiddenWhenGuestNotApproved(t *testing.
 		"votes":   validVotes(),
 	})
 	req := httptest.NewRequest(http.MethodPost, "/api/parties/party-1/votes", bytes.NewBuffer(body))
+	req = requestWithGuest(req, "guest-1", "party-1")
 	rec := httptest.NewRecorder()
 
 	handler.ServeHTTP(rec, req)
@@ -269,7 +380,7 @@ func TestVoteHandler_SubmitVote_ReturnsForbiddenWhenGuestNotApproved(t *testing.
 
 func TestVoteHandler_SubmitVote_ReturnsConflictWhenVoteAlreadyExists(t *testing.T) {
 	svc := &mockVoteService{
-		submitVoteFunc: func(ctx context.Context, adminID, partyID string, req services.SubmitVoteRequest) (*models.Vote, error) {
+		submitVoteFunc: func(ctx context.Context, partyID string, req services.SubmitVoteRequest) (*models.Vote, error) {
 			return nil, services.ErrVoteAlreadyExists
 		},
 	}
@@ -281,6 +392,7 @@ func TestVoteHandler_SubmitVote_ReturnsConflictWhenVoteAlreadyExists(t *testing.
 		"votes":   validVotes(),
 	})
 	req := httptest.NewRequest(http.MethodPost, "/api/parties/party-1/votes", bytes.NewBuffer(body))
+	req = requestWithGuest(req, "guest-1", "party-1")
 	rec := httptest.NewRecorder()
 
 	handler.ServeHTTP(rec, req)
@@ -290,7 +402,7 @@ func TestVoteHandler_SubmitVote_ReturnsConflictWhenVoteAlreadyExists(t *testing.
 
 func TestVoteHandler_SubmitVote_ReturnsBadRequestWhenInvalidVotes(t *testing.T) {
 	svc := &mockVoteService{
-		submitVoteFunc: func(ctx context.Context, adminID, partyID string, req services.SubmitVoteRequest) (*models.Vote, error) {
+		submitVoteFunc: func(ctx context.Context, partyID string, req services.SubmitVoteRequest) (*models.Vote, error) {
 			return nil, services.ErrInvalidVotes
 		},
 	}
@@ -302,6 +414,7 @@ func TestVoteHandler_SubmitVote_ReturnsBadRequestWhenInvalidVotes(t *testing.T)
 		"votes":   validVotes(),
 	})
 	req := httptest.NewRequest(http.MethodPost, "/api/parties/party-1/votes", bytes.NewBuffer(body))
+	req = requestWithGuest(req, "guest-1", "party-1")
 	rec := httptest.NewRecorder()
 
 	handler.ServeHTTP(rec, req)
@@ -321,8 +434,8 @@ func TestVoteHandler_GetVotes_ReturnsVoteDataWithAdminAuth(t *testing.T) {
 	}
 
 	svc := &mockVoteService{
-		getVotesFunc: func(ctx context.Context, adminID, partyID, guestID string) (*models.Vote, error) {
-			assert.Equal(t, "user-123", adminID)
+		getVotesFunc: func(ctx context.Context, partyID, guestID string) (*models.Vote, error) {
+			assert.Equal(t, "user-123", authz.FromContext(ctx).UserID)
 			assert.Equal(t, "party-1", partyID)
 			assert.Equal(t, "guest-1", guestID)
 			return vote, nil
@@ -358,8 +471,8 @@ func TestVoteHandler_GetVotes_ReturnsVoteDataWithoutAdminAuth(t *testing.T) {
 	}
 
 	svc := &mockVoteService{
-		getVotesFunc: func(ctx context.Context, adminID, partyID, guestID string) (*models.Vote, error) {
-			assert.Equal(t, "", adminID)
+		getVotesFunc: func(ctx context.Context, partyID, guestID string) (*models.Vote, error) {
+			assert.Equal(t, "", authz.FromContext(ctx).UserID)
 			assert.Equal(t, "party-1", partyID)
 			assert.Equal(t, "guest-1", guestID)
 			return vote, nil
@@ -369,6 +482,7 @@ func TestVoteHandler_GetVotes_ReturnsVoteDataWithoutAdminAuth(t *testing.T) {
 	handler := handlers.NewVoteHandler(svc)
 
 	req := httptest.NewRequest(http.MethodGet, "/api/parties/party-1/votes/guest-1", nil)
+	req = requestWithGuest(req, "guest-1", "party-1")
 	rec := httptest.NewRecorder()
 
 	handler.ServeHTTP(rec, req)
@@ -381,9 +495,37 @@ func TestVoteHandler_GetVotes_ReturnsVoteDataWithoutAdminAuth(t *testing.T) {
 	assert.Equal(t, "vote-1", response.ID)
 }
 
+func TestVoteHandler_GetVotes_ReturnsUnauthorizedWithoutGuestSession(t *testing.T) {
+	handler := handlers.NewVoteHandler(&mockVoteService{})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/parties/party-1/votes/guest-1", nil)
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestVoteHandler_GetVotes_ReturnsForbiddenForAnotherGuestsBallot(t *testing.T) {
+	handler := handlers.NewVoteHandler(&mockVoteService{
+		getVotesFunc: func(ctx context.Context, partyID, guestID string) (*models.Vote, error) {
+			assert.Equal(t, "guest-2", guestID)
+			return nil, services.ErrUnauthorized
+		},
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/parties/party-1/votes/guest-2", nil)
+	req = requestWithGuest(req, "guest-1", "party-1")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
 func TestVoteHandler_GetVotes_ReturnsNotFoundWhenNotFound(t *testing.T) {
 	svc := &mockVoteService{
-		getVotesFunc: func(ctx context.Context, adminID, partyID, guestID string) (*models.Vote, error) {
+		getVotesFunc: func(ctx context.Context, partyID, guestID string) (*models.Vote, error) {
 			return nil, services.ErrNotFound
 		},
 	}
@@ -391,6 +533,7 @@ func TestVoteHandler_GetVotes_ReturnsNotFoundWhenNotFound(t *testing.T) {
 	handler := handlers.NewVoteHandler(svc)
 
 	req := httptest.NewRequest(http.MethodGet, "/api/parties/party-1/votes/guest-1", nil)
+	req = requestWithGuest(req, "guest-1", "party-1")
 	rec := httptest.NewRecorder()
 
 	handler.ServeHTTP(rec, req)
@@ -400,7 +543,7 @@ func TestVoteHandler_GetVotes_ReturnsNotFoundWhenNotFound(t *testing.T) {
 
 func TestVoteHandler_GetVotes_ReturnsForbiddenWhenUnauthorized(t *testing.T) {
 	svc := &mockVoteService{
-		getVotesFunc: func(ctx context.Context, adminID, partyID, guestID string) (*models.Vote, error) {
+		getVotesFunc: func(ctx context.Context, partyID, guestID string) (*models.Vote, error) {
 			return nil, services.ErrUnauthorized
 		},
 	}
@@ -428,8 +571,8 @@ func TestVoteHandler_UpdateVote_ReturnsOKWithValidRequest(t *testing.T) {
 	}
 
 	svc := &mockVoteService{
-		updateVoteFunc: func(ctx context.Context, adminID, partyID string, req services.SubmitVoteRequest) (*models.Vote, error) {
-			assert.Equal(t, "user-123", adminID)
+		updateVoteFunc: func(ctx context.Context, partyID string, req services.SubmitVoteRequest) (*models.Vote, error) {
+			assert.Equal(t, "user-123", authz.FromContext(ctx).UserID)
 			assert.Equal(t, "party-1", partyID)
 			assert.Equal(t, "guest-1", req.GuestID)
 			assert.Equal(t, validVotes(), req.Votes)
@@ -461,7 +604,7 @@ func TestVoteHandler_UpdateVote_ReturnsOKWithValidRequest(t *testing.T) {
 
 func TestVoteHandler_UpdateVote_ReturnsNotFoundWhenVoteNotFound(t *testing.T) {
 	svc := &mockVoteService{
-		updateVoteFunc: func(ctx context.Context, adminID, partyID string, req services.SubmitVoteRequest) (*models.Vote, error) {
+		updateVoteFunc: func(ctx context.Context, partyID string, req services.SubmitVoteRequest) (*models.Vote, error) {
 			return nil, services.ErrNotFound
 		},
 	}
@@ -473,6 +616,7 @@ func TestVoteHandler_UpdateVote_ReturnsNotFoundWhenVoteNotFound(t *testing.T) {
 		"votes":   validVotes(),
 	})
 	req := httptest.NewRequest(http.MethodPut, "/api/parties/party-1/votes", bytes.NewBuffer(body))
+	req = requestWithGuest(req, "guest-1", "party-1")
 	rec := httptest.NewRecorder()
 
 	handler.ServeHTTP(rec, req)
@@ -482,7 +626,7 @@ func TestVoteHandler_UpdateVote_ReturnsNotFoundWhenVoteNotFound(t *testing.T) {
 
 func TestVoteHandler_UpdateVote_ReturnsBadRequestWhenInvalidVotes(t *testing.T) {
 	svc := &mockVoteService{
-		updateVoteFunc: func(ctx context.Context, adminID, partyID string, req services.SubmitVoteRequest) (*models.Vote, error) {
+		updateVoteFunc: func(ctx context.Context, partyID string, req services.SubmitVoteRequest) (*models.Vote, error) {
 			return nil, services.ErrInvalidVotes
 		},
 	}
@@ -494,6 +638,112 @@ func TestVoteHandler_UpdateVote_ReturnsBadRequestWhenInvalidVotes(t *testing.T)
 		"votes":   validVotes(),
 	})
 	req := httptest.NewRequest(http.MethodPut, "/api/parties/party-1/votes", bytes.NewBuffer(body))
+	req = requestWithGuest(req, "guest-1", "party-1")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+// --- Draft Tests ---
+
+func TestVoteHandler_SaveDraft_UsesGuestSession(t *testing.T) {
+	svc := &mockVoteService{
+		saveDraftFunc: func(ctx context.Context, partyID string, req services.SubmitVoteRequest) (*models.Vote, error) {
+			assert.Equal(t, authz.Guest("guest-1", "party-1"), authz.FromContext(ctx))
+			assert.Equal(t, "party-1", partyID)
+			assert.Equal(t, "", req.GuestID)
+			assert.Equal(t, map[int]string{12: "act-3"}, req.Votes)
+			return &models.Vote{ID: "vote-1", GuestID: "guest-1", PartyID: partyID, Votes: req.Votes, Draft: true}, nil
+		},
+	}
+
+	handler := handlers.NewVoteHandler(svc)
+
+	body := `{"votes": {"12": "act-3"}}`
+	req := httptest.NewRequest(http.MethodPut, "/api/parties/party-1/votes/draft", bytes.NewBufferString(body))
+	req = requestWithGuest(req, "guest-1", "party-1")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response models.Vote
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.True(t, response.Draft)
+}
+
+func TestVoteHandler_SaveDraft_ReturnsConflictForFinalBallot(t *testing.T) {
+	svc := &mockVoteService{
+		saveDraftFunc: func(ctx context.Context, partyID string, req services.SubmitVoteRequest) (*models.Vote, error) {
+			return nil, services.ErrVoteAlreadyExists
+		},
+	}
+
+	handler := handlers.NewVoteHandler(svc)
+
+	body := `{"votes": {"12": "act-3"}}`
+	req := httptest.NewRequest(http.MethodPut, "/api/parties/party-1/votes/draft", bytes.NewBufferString(body))
+	req = requestWithGuest(req, "guest-1", "party-1")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusConflict, rec.Code)
+}
+
+func TestVoteHandler_FinalizeVote_AcceptsEmptyBodyFromGuest(t *testing.T) {
+	svc := &mockVoteService{
+		finalizeVoteFunc: func(ctx context.Context, partyID, guestID string) (*models.Vote, error) {
+			assert.Equal(t, authz.Guest("guest-1", "party-1"), authz.FromContext(ctx))
+			assert.Equal(t, "", guestID)
+			return &models.Vote{ID: "vote-1", GuestID: "guest-1", PartyID: partyID, Votes: validVotes()}, nil
+		},
+	}
+
+	handler := handlers.NewVoteHandler(svc)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/parties/party-1/votes/finalize", nil)
+	req = requestWithGuest(req, "guest-1", "party-1")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestVoteHandler_FinalizeVote_RequiresGuestIDFromAdmin(t *testing.T) {
+	handler := handlers.NewVoteHandler(&mockVoteService{
+		finalizeVoteFunc: func(ctx context.Context, partyID, guestID string) (*models.Vote, error) {
+			assert.Equal(t, "", guestID)
+			return nil, services.ErrGuestIDRequired
+		},
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/api/parties/party-1/votes/finalize", nil)
+	req = requestWithUserID(req, "admin-1")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestVoteHandler_FinalizeVote_ReturnsBadRequestForIncompleteDraft(t *testing.T) {
+	svc := &mockVoteService{
+		finalizeVoteFunc: func(ctx context.Context, partyID, guestID string) (*models.Vote, error) {
+			assert.Equal(t, "admin-1", authz.FromContext(ctx).UserID)
+			assert.Equal(t, "guest-1", guestID)
+			return nil, services.ErrInvalidVotes
+		},
+	}
+
+	handler := handlers.NewVoteHandler(svc)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/parties/party-1/votes/finalize", bytes.NewBufferString(`{"guestId": "guest-1"}`))
+	req = requestWithUserID(req, "admin-1")
 	rec := httptest.NewRecorder()
 
 	handler.ServeHTTP(rec, req)
@@ -516,10 +766,51 @@ func TestVoteHandler_ReturnsMethodNotAllowedForUnsupportedMethod(t *testing.T) {
 
 // --- EndVoting Tests ---
 
+func TestVoteHandler_StartVoting_ReturnsOKOnSuccess(t *testing.T) {
+	startedAt := time.Date(2026, time.May, 16, 21, 0, 0, 0, time.UTC)
+	svc := &mockVoteService{
+		startVotingFunc: func(ctx context.Context, partyID string) (*models.Party, error) {
+			assert.Equal(t, "admin-1", authz.FromContext(ctx).UserID)
+			assert.Equal(t, "party-1", partyID)
+			return &models.Party{
+				ID:              "party-1",
+				Status:          models.PartyStatusActive,
+				VotingStartedAt: startedAt,
+			}, nil
+		},
+	}
+
+	handler := handlers.NewVoteHandler(svc)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/parties/party-1/start-voting", nil)
+	req = requestWithUserID(req, "admin-1")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response map[string]interface{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, "active", response["status"])
+	assert.Equal(t, "2026-05-16T21:00:00Z", response["votingStartedAt"])
+}
+
+func TestVoteHandler_StartVoting_ReturnsUnauthorizedWhenNoAuth(t *testing.T) {
+	handler := handlers.NewVoteHandler(&mockVoteService{})
+
+	req := httptest.NewRequest(http.MethodPost, "/api/parties/party-1/start-voting", nil)
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
 func TestVoteHandler_EndVoting_ReturnsOKOnSuccess(t *testing.T) {
 	svc := &mockVoteService{
-		endVotingFunc: func(ctx context.Context, adminID, partyID string) (*models.Party, error) {
-			assert.Equal(t, "admin-1", adminID)
+		endVotingFunc: func(ctx context.Context, partyID string) (*models.Party, error) {
+			assert.Equal(t, "admin-1", authz.FromContext(ctx).UserID)
 			assert.Equal(t, "party-1", partyID)
 			return &models.Party{
 				ID:     "party-1",
@@ -559,7 +850,7 @@ func TestVoteHandler_EndVoting_ReturnsUnauthorizedWhenNoAuth(t *testing.T) {
 
 func TestVoteHandler_EndVoting_ReturnsForbiddenWhenUnauthorized(t *testing.T) {
 	svc := &mockVoteService{
-		endVotingFunc: func(ctx context.Context, adminID, partyID string) (*models.Party, error) {
+		endVotingFunc: func(ctx context.Context, partyID string) (*models.Party, error) {
 			return nil, services.ErrUnauthorized
 		},
 	}
@@ -577,7 +868,7 @@ func TestVoteHandler_EndVoting_ReturnsForbiddenWhenUnauthorized(t *testing.T) {
 
 func TestVoteHandler_EndVoting_ReturnsForbiddenWhenPartyClosed(t *testing.T) {
 	svc := &mockVoteService{
-		endVotingFunc: func(ctx context.Context, adminID, partyID string) (*models.Party, error) {
+		endVotingFunc: func(ctx context.Context, partyID string) (*models.Party, error) {
 			return nil, services.ErrPartyClosed
 		},
 	}
@@ -595,7 +886,7 @@ func TestVoteHandler_EndVoting_ReturnsForbiddenWhenPartyClosed(t *testing.T) {
 
 func TestVoteHandler_EndVoting_ReturnsNotFoundWhenPartyNotFound(t *testing.T) {
 	svc := &mockVoteService{
-		endVotingFunc: func(ctx context.Context, adminID, partyID string) (*models.Party, error) {
+		endVotingFunc: func(ctx context.Context, partyID string) (*models.Party, error) {
 			return nil, services.ErrNotFound
 		},
 	}
@@ -626,7 +917,7 @@ func TestVoteHandler_EndVoting_ReturnsMethodNotAllowedForWrongMethod(t *testing.
 
 func TestVoteHandler_GetResults_ReturnsOKWithResults(t *testing.T) {
 	svc := &mockVoteService{
-		getResultsFunc: func(ctx context.Context, adminID, partyID string) (*services.PartyResults, error) {
+		getResultsFunc: func(ctx context.Context, partyID string) (*services.PartyResults, error) {
 			assert.Equal(t, "party-1", partyID)
 			return &services.PartyResults{
 				PartyID:     "party-1",
@@ -674,7 +965,7 @@ func TestVoteHandler_GetResults_ReturnsOKWithResults(t *testing.T) {
 
 func TestVoteHandler_GetResults_ReturnsForbiddenWhenVotingNotEnded(t *testing.T) {
 	svc := &mockVoteService{
-		getResultsFunc: func(ctx context.Context, adminID, partyID string) (*services.PartyResults, error) {
+		getResultsFunc: func(ctx context.Context, partyID string) (*services.PartyResults, error) {
 			return nil, services.ErrVotingNotEnded
 		},
 	}
@@ -692,7 +983,7 @@ func TestVoteHandler_GetResults_ReturnsForbiddenWhenVotingNotEnded(t *testing.T)
 
 func TestVoteHandler_GetResults_ReturnsNotFoundWhenPartyNotFound(t *testing.T) {
 	svc := &mockVoteService{
-		getResultsFunc: func(ctx context.Context, adminID, partyID string) (*services.PartyResults, error) {
+		getResultsFunc: func(ctx context.Context, partyID string) (*services.PartyResults, error) {
 			return nil, services.ErrNotFound
 		},
 	}
@@ -710,7 +1001,7 @@ func TestVoteHandler_GetResults_ReturnsNotFoundWhenPartyNotFound(t *testing.T) {
 
 func TestVoteHandler_GetResults_ReturnsForbiddenWhenUnauthorized(t *testing.T) {
 	svc := &mockVoteService{
-		getResultsFunc: func(ctx context.Context, adminID, partyID string) (*services.PartyResults, error) {
+		getResultsFunc: func(ctx context.Context, partyID string) (*services.PartyResults, error) {
 			return nil, services.ErrUnauthorized
 		},
 	}
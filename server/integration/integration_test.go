@@ -11,8 +11,10 @@ import (
 
 	"cloud.google.com/go/firestore"
 
+	"github.com/sipgate/eurovision-vote-party/server/authz"
 	"github.com/sipgate/eurovision-vote-party/server/models"
 	"github.com/sipgate/eurovision-vote-party/server/persistence"
+	"github.com/sipgate/eurovision-vote-party/server/persistence/memory"
 	"github.com/sipgate/eurovision-vote-party/server/services"
 )
 
@@ -21,65 +23,125 @@ var (
 	actsService     services.ActsService
 )
 
-func TestMain(m *testing.M) {
-	if os.Getenv("FIRESTORE_EMULATOR_HOST") == "" {
-		log.Fatal("FIRESTORE_EMULATOR_HOST must be set for integration tests")
-	}
+// scoreboardEditorID is the user allowed to import official scoreboards.
+const scoreboardEditorID = "scoreboard-editor"
 
-	ctx := context.Background()
+// TestMain connects to the Firestore emulator when FIRESTORE_EMULATOR_HOST is
+// set. Otherwise the scenarios run against the in-memory persistence backend.
+func TestMain(m *testing.M) {
 	var err error
-	firestoreClient, err = firestore.NewClient(ctx, "test-project")
-	if err != nil {
-		log.Fatalf("failed to create Firestore client: %v", err)
+	if os.Getenv("FIRESTORE_EMULATOR_HOST") != "" {
+		firestoreClient, err = firestore.NewClient(context.Background(), "test-project")
+		if err != nil {
+			log.Fatalf("failed to create Firestore client: %v", err)
+		}
+	} else {
+		log.Println("FIRESTORE_EMULATOR_HOST not set, using in-memory persistence")
 	}
-	defer firestoreClient.Close()
 
-	actsService, err = services.NewActsService("../data/acts.json")
+	// The catalogue is read-only in these scenarios, so it always lives in memory.
+	actsService, err = services.NewActsService(context.Background(), memory.NewActDAO(memory.NewStore()), "../data/contests", nil)
 	if err != nil {
 		log.Fatalf("failed to load acts data: %v", err)
 	}
 
-	os.Exit(m.Run())
+	code := m.Run()
+	if firestoreClient != nil {
+		firestoreClient.Close()
+	}
+	os.Exit(code)
 }
 
-// testEnv holds all services and DAOs for a single test.
-type testEnv struct {
-	partyService services.PartyService
-	guestService services.GuestService
-	voteService  services.VoteService
-	userService  services.UserService
-	actsService  services.ActsService
+// testDAOs holds the DAOs for a single test.
+type testDAOs struct {
+	party      persistence.PartyDAO
+	guest      persistence.GuestDAO
+	vote       persistence.VoteDAO
+	user       persistence.UserDAO
+	account    persistence.AccountDAO
+	prediction persistence.PredictionDAO
+	scoreboard persistence.ScoreboardDAO
 }
 
-// setupTest creates a fresh testEnv with real DAOs and services wired to the
-// Firestore emulator. It registers cleanup that deletes all documents from the
-// relevant collections after the test completes.
-func setupTest(t *testing.T) *testEnv {
+// newTestDAOs returns Firestore DAOs when the emulator is available, registering
+// cleanup that deletes all documents after the test completes. Without the
+// emulator it returns DAOs backed by a fresh in-memory store.
+func newTestDAOs(t *testing.T) testDAOs {
 	t.Helper()
 
-	partyDAO := persistence.NewFirestorePartyDAO(firestoreClient)
-	guestDAO := persistence.NewFirestoreGuestDAO(firestoreClient)
-	voteDAO := persistence.NewFirestoreVoteDAO(firestoreClient)
-	userDAO := persistence.NewFirestoreUserDAO(firestoreClient)
-
-	partyService := services.NewPartyService(partyDAO)
-	guestService := services.NewGuestService(guestDAO, partyDAO)
-	voteService := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService)
-	userService := services.NewUserService(userDAO)
+	if firestoreClient == nil {
+		store := memory.NewStore()
+		return testDAOs{
+			party:      memory.NewPartyDAO(store),
+			guest:      memory.NewGuestDAO(store),
+			vote:       memory.NewVoteDAO(store),
+			user:       memory.NewUserDAO(store),
+			account:    memory.NewAccountDAO(store),
+			prediction: memory.NewPredictionDAO(store),
+			scoreboard: memory.NewScoreboardDAO(store),
+		}
+	}
 
 	t.Cleanup(func() {
 		ctx := context.Background()
-		for _, col := range []string{"parties", "guests", "votes", "users"} {
+		for _, col := range []string{"parties", "guests", "votes", "users", "accounts", "predictions", "predictionOutcomes", "contests/" + models.DefaultContest + "/scoreboards"} {
 			cleanupCollection(t, ctx, col)
 		}
 	})
 
+	return testDAOs{
+		party:      persistence.NewFirestorePartyDAO(firestoreClient),
+		guest:      persistence.NewFirestoreGuestDAO(firestoreClient),
+		vote:       persistence.NewFirestoreVoteDAO(firestoreClient),
+		user:       persistence.NewFirestoreUserDAO(firestoreClient),
+		account:    persistence.NewFirestoreAccountDAO(firestoreClient),
+		prediction: persistence.NewFirestorePredictionDAO(firestoreClient),
+		scoreboard: persistence.NewFirestoreScoreboardDAO(firestoreClient),
+	}
+}
+
+// testEnv holds all services and DAOs for a single test.
+type testEnv struct {
+	partyService      services.PartyService
+	guestService      services.GuestService
+	voteService       services.VoteService
+	predictionService services.PredictionService
+	scoreboardService services.ScoreboardService
+	statsService      services.StatsService
+	archiveService    services.ArchiveService
+	userService       services.UserService
+	memberService     services.MemberService
+	actsService       services.ActsService
+}
+
+// setupTest creates a fresh testEnv with real DAOs and services wired to the
+// configured persistence backend.
+func setupTest(t *testing.T) *testEnv {
+	t.Helper()
+
+	daos := newTestDAOs(t)
+
+	partyService := services.NewPartyService(daos.party, actsService)
+	guestService := services.NewGuestService(daos.guest, daos.party, []byte("integration-guest-token-key"), nil)
+	voteService := services.NewVoteService(daos.vote, daos.party, daos.guest, actsService, nil)
+	predictionService := services.NewPredictionService(daos.prediction, daos.party, daos.guest, actsService, nil)
+	scoreboardService := services.NewScoreboardService(daos.scoreboard, daos.party, actsService, voteService, []string{scoreboardEditorID})
+	statsService := services.NewStatsService(daos.vote, daos.party, daos.guest, daos.scoreboard, voteService)
+	archiveService := services.NewArchiveService(daos.party, daos.guest, daos.vote, daos.prediction, actsService)
+	userService := services.NewUserService(daos.user)
+	memberService := services.NewMemberService(daos.party, userService)
+
 	return &testEnv{
-		partyService: partyService,
-		guestService: guestService,
-		voteService:  voteService,
-		userService:  userService,
-		actsService:  actsService,
+		partyService:      partyService,
+		guestService:      guestService,
+		voteService:       voteService,
+		predictionService: predictionService,
+		scoreboardService: scoreboardService,
+		statsService:      statsService,
+		archiveService:    archiveService,
+		userService:       userService,
+		memberService:     memberService,
+		actsService:       actsService,
 	}
 }
 
@@ -119,6 +181,16 @@ func buildRotatedVotes(acts []models.Act, offset int) map[int]string {
 	return votes
 }
 
+// asUser returns a context whose caller is the signed-in user.
+func asUser(ctx context.Context, userID string) context.Context {
+	return authz.WithPrincipal(ctx, authz.User(userID))
+}
+
+// asGuest returns a context whose caller holds a guest session for the party.
+func asGuest(ctx context.Context, guestID, partyID string) context.Context {
+	return authz.WithPrincipal(ctx, authz.Guest(guestID, partyID))
+}
+
 // mustCreateParty is a test helper that creates a party and fails the test on error.
 func mustCreateParty(t *testing.T, env *testEnv, adminID, name string) *models.Party {
 	t.Helper()
@@ -137,7 +209,7 @@ func mustCreateParty(t *testing.T, env *testEnv, adminID, name string) *models.P
 func mustJoinParty(t *testing.T, env *testEnv, code, username string) *models.Guest {
 	t.Helper()
 	ctx := context.Background()
-	guest, err := env.guestService.JoinParty(ctx, code, username)
+	guest, _, err := env.guestService.JoinParty(ctx, code, username)
 	if err != nil {
 		t.Fatalf("failed to join party: %v", err)
 	}
@@ -148,7 +220,7 @@ func mustJoinParty(t *testing.T, env *testEnv, code, username string) *models.Gu
 func mustApproveGuest(t *testing.T, env *testEnv, adminID, partyID, guestID string) {
 	t.Helper()
 	ctx := context.Background()
-	if err := env.guestService.ApproveGuest(ctx, adminID, partyID, guestID); err != nil {
+	if err := env.guestService.ApproveGuest(asUser(ctx, adminID), partyID, guestID); err != nil {
 		t.Fatalf("failed to approve guest: %v", err)
 	}
 }
@@ -156,7 +228,7 @@ func mustApproveGuest(t *testing.T, env *testEnv, adminID, partyID, guestID stri
 // mustGetGrandFinalActs returns at least 10 grand final acts, failing the test otherwise.
 func mustGetGrandFinalActs(t *testing.T, env *testEnv) []models.Act {
 	t.Helper()
-	acts, err := env.actsService.ListActs(string(models.EventGrandFinal))
+	acts, err := env.actsService.ListActs(context.Background(), "", string(models.EventGrandFinal))
 	if err != nil {
 		t.Fatalf("failed to list acts: %v", err)
 	}
@@ -166,13 +238,12 @@ func mustGetGrandFinalActs(t *testing.T, env *testEnv) []models.Act {
 	return acts
 }
 
-// mustSubmitVote submits a vote and fails the test on error.
-func mustSubmitVote(t *testing.T, env *testEnv, adminID, partyID, guestID string, votes map[int]string) *models.Vote {
+// mustSubmitVote submits a vote as the guest and fails the test on error.
+func mustSubmitVote(t *testing.T, env *testEnv, partyID, guestID string, votes map[int]string) *models.Vote {
 	t.Helper()
-	ctx := context.Background()
-	vote, err := env.voteService.SubmitVote(ctx, adminID, partyID, services.SubmitVoteRequest{
-		GuestID: guestID,
-		Votes:   votes,
+	ctx := asGuest(context.Background(), guestID, partyID)
+	vote, err := env.voteService.SubmitVote(ctx, partyID, services.SubmitVoteRequest{
+		Votes: votes,
 	})
 	if err != nil {
 		t.Fatalf("failed to submit vote: %v", err)
@@ -184,7 +255,7 @@ func mustSubmitVote(t *testing.T, env *testEnv, adminID, partyID, guestID string
 func mustEndVoting(t *testing.T, env *testEnv, adminID, partyID string) *models.Party {
 	t.Helper()
 	ctx := context.Background()
-	party, err := env.voteService.EndVoting(ctx, adminID, partyID)
+	party, err := env.voteService.EndVoting(asUser(ctx, adminID), partyID)
 	if err != nil {
 		t.Fatalf("failed to end voting: %v", err)
 	}
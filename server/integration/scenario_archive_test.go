@@ -0,0 +1,59 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sipgate/eurovision-vote-party/server/archive"
+)
+
+func TestPartyArchiveFlow(t *testing.T) {
+	env := setupTest(t)
+	ctx := context.Background()
+	adminID := "admin-archive"
+
+	party := mustCreateParty(t, env, adminID, "Archive Party")
+	alice := mustJoinParty(t, env, party.Code, "Alice")
+	mustApproveGuest(t, env, adminID, party.ID, alice.ID)
+	acts := mustGetGrandFinalActs(t, env)
+	mustSubmitVote(t, env, party.ID, alice.ID, validVotesForActs(acts))
+	mustEndVoting(t, env, adminID, party.ID)
+
+	results, err := env.voteService.GetResults(asUser(ctx, adminID), party.ID)
+	require.NoError(t, err)
+
+	// Step 1: Export the party into a ZIP archive
+	exported, err := env.archiveService.ExportParty(asUser(ctx, adminID), party.ID)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, archive.Write(&buf, exported, archive.FormatZIP))
+
+	// Step 2: Importing it next to the original creates a copy with new IDs and code
+	imported, err := archive.Read(buf.Bytes())
+	require.NoError(t, err)
+	copied, err := env.archiveService.ImportParty(ctx, adminID, imported)
+	require.NoError(t, err)
+	assert.NotEqual(t, party.ID, copied.ID)
+	assert.NotEqual(t, party.Code, copied.Code)
+
+	copiedResults, err := env.voteService.GetResults(asUser(ctx, adminID), copied.ID)
+	require.NoError(t, err)
+	assert.Equal(t, results.Results, copiedResults.Results)
+
+	// Step 3: After deleting the original, importing restores it under its own ID
+	require.NoError(t, env.partyService.DeleteParty(asUser(ctx, adminID), party.ID))
+	restored, err := env.archiveService.ImportParty(ctx, adminID, imported)
+	require.NoError(t, err)
+	assert.Equal(t, party.ID, restored.ID)
+	assert.Equal(t, party.Code, restored.Code)
+
+	restoredResults, err := env.voteService.GetResults(asUser(ctx, adminID), party.ID)
+	require.NoError(t, err)
+	assert.Equal(t, results, restoredResults)
+}
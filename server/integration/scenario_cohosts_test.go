@@ -0,0 +1,81 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/services"
+)
+
+func TestCoHostFlow(t *testing.T) {
+	env := setupTest(t)
+	ctx := context.Background()
+	adminID := "admin-cohosts"
+
+	_, err := env.userService.UpsertProfile(ctx, "cohost-user", "carol@example.com", "carol")
+	require.NoError(t, err)
+	_, err = env.userService.UpsertProfile(ctx, "moderator-user", "dave@example.com", "dave")
+	require.NoError(t, err)
+
+	party := mustCreateParty(t, env, adminID, "Co-Host Party")
+	alice := mustJoinParty(t, env, party.Code, "Alice")
+
+	// Step 1: The owner invites a co-host by email and a moderator by username
+	_, err = env.memberService.InviteMember(asUser(ctx, adminID), party.ID, services.InviteMemberRequest{
+		User: "carol@example.com", Role: models.PartyRoleCoHost,
+	})
+	require.NoError(t, err)
+	_, err = env.memberService.InviteMember(asUser(ctx, adminID), party.ID, services.InviteMemberRequest{
+		User: "dave", Role: models.PartyRoleModerator,
+	})
+	require.NoError(t, err)
+
+	// Step 2: Invitees have no access until they accept
+	_, err = env.partyService.GetPartyByID(asUser(ctx, "cohost-user"), party.ID)
+	assert.ErrorIs(t, err, services.ErrUnauthorized)
+
+	invitations, err := env.memberService.ListInvitations(ctx, "cohost-user")
+	require.NoError(t, err)
+	require.Len(t, invitations, 1)
+	assert.Equal(t, party.ID, invitations[0].ID)
+
+	_, err = env.memberService.AcceptInvitation(ctx, "cohost-user", party.ID)
+	require.NoError(t, err)
+	_, err = env.memberService.AcceptInvitation(ctx, "moderator-user", party.ID)
+	require.NoError(t, err)
+
+	// Step 3: The moderator manages guests but cannot run the show
+	mustApproveGuest(t, env, "moderator-user", party.ID, alice.ID)
+	_, err = env.voteService.EndVoting(asUser(ctx, "moderator-user"), party.ID)
+	assert.ErrorIs(t, err, services.ErrUnauthorized)
+
+	// Step 4: The co-host runs the show but cannot delete the party
+	acts := mustGetGrandFinalActs(t, env)
+	mustSubmitVote(t, env, party.ID, alice.ID, validVotesForActs(acts))
+	mustEndVoting(t, env, "cohost-user", party.ID)
+	_, err = env.voteService.GetResults(asUser(ctx, "cohost-user"), party.ID)
+	require.NoError(t, err)
+	assert.ErrorIs(t, env.partyService.DeleteParty(asUser(ctx, "cohost-user"), party.ID), services.ErrUnauthorized)
+
+	parties, err := env.partyService.ListPartiesByAdmin(ctx, "cohost-user")
+	require.NoError(t, err)
+	require.Len(t, parties, 1)
+	assert.Equal(t, party.ID, parties[0].ID)
+
+	// Step 5: Once removed, the co-host loses access
+	require.NoError(t, env.memberService.RemoveMember(asUser(ctx, adminID), party.ID, "cohost-user"))
+	_, err = env.voteService.GetResults(asUser(ctx, "cohost-user"), party.ID)
+	assert.ErrorIs(t, err, services.ErrUnauthorized)
+
+	members, err := env.memberService.ListMembers(asUser(ctx, adminID), party.ID)
+	require.NoError(t, err)
+	require.Len(t, members, 2)
+	assert.Equal(t, models.PartyRoleOwner, members[0].Role)
+	assert.Equal(t, "moderator-user", members[1].UserID)
+}
@@ -36,7 +36,7 @@ func TestCompletePartyFlow(t *testing.T) {
 	assert.Equal(t, party.ID, guest.PartyID)
 
 	// Step 4: Admin sees join requests
-	joinRequests, err := env.guestService.ListJoinRequests(ctx, adminID, party.ID)
+	joinRequests, err := env.guestService.ListJoinRequests(asUser(ctx, adminID), party.ID)
 	require.NoError(t, err)
 	require.Len(t, joinRequests, 1)
 	assert.Equal(t, guest.ID, joinRequests[0].ID)
@@ -51,7 +51,7 @@ func TestCompletePartyFlow(t *testing.T) {
 	assert.Equal(t, models.GuestStatusApproved, guestStatus.Status)
 
 	// Verify approved guest appears in guest list
-	guests, err := env.guestService.ListGuests(ctx, adminID, party.ID)
+	guests, err := env.guestService.ListGuests(asUser(ctx, adminID), party.ID)
 	require.NoError(t, err)
 	require.Len(t, guests, 1)
 	assert.Equal(t, guest.ID, guests[0].ID)
@@ -59,7 +59,7 @@ func TestCompletePartyFlow(t *testing.T) {
 	// Step 6: Guest submits a vote
 	acts := mustGetGrandFinalActs(t, env)
 	votes := validVotesForActs(acts)
-	vote := mustSubmitVote(t, env, "", party.ID, guest.ID, votes)
+	vote := mustSubmitVote(t, env, party.ID, guest.ID, votes)
 	assert.Equal(t, guest.ID, vote.GuestID)
 	assert.Equal(t, party.ID, vote.PartyID)
 	assert.Equal(t, votes, vote.Votes)
@@ -69,7 +69,7 @@ func TestCompletePartyFlow(t *testing.T) {
 	assert.Equal(t, models.PartyStatusClosed, closedParty.Status)
 
 	// Step 8: Verify results
-	results, err := env.voteService.GetResults(ctx, adminID, party.ID)
+	results, err := env.voteService.GetResults(asUser(ctx, adminID), party.ID)
 	require.NoError(t, err)
 	assert.Equal(t, party.ID, results.PartyID)
 	assert.Equal(t, 1, results.TotalVoters)
@@ -23,12 +23,12 @@ func TestEdgeCases(t *testing.T) {
 		guest := mustJoinParty(t, env, party.Code, "RejectedGuest")
 
 		// Reject the guest
-		err := env.guestService.RejectGuest(ctx, adminID, party.ID, guest.ID)
+		err := env.guestService.RejectGuest(asUser(ctx, adminID), party.ID, guest.ID)
 		require.NoError(t, err)
 
 		// Attempt to vote
 		votes := validVotesForActs(acts)
-		_, err = env.voteService.SubmitVote(ctx, "", party.ID, services.SubmitVoteRequest{
+		_, err = env.voteService.SubmitVote(asGuest(ctx, guest.ID, party.ID), party.ID, services.SubmitVoteRequest{
 			GuestID: guest.ID,
 			Votes:   votes,
 		})
@@ -46,7 +46,7 @@ func TestEdgeCases(t *testing.T) {
 
 		// Guest is still pending - attempt to vote
 		votes := validVotesForActs(acts)
-		_, err := env.voteService.SubmitVote(ctx, "", party.ID, services.SubmitVoteRequest{
+		_, err := env.voteService.SubmitVote(asGuest(ctx, guest.ID, party.ID), party.ID, services.SubmitVoteRequest{
 			GuestID: guest.ID,
 			Votes:   votes,
 		})
@@ -61,7 +61,7 @@ func TestEdgeCases(t *testing.T) {
 		mustJoinParty(t, env, party.Code, "SameName")
 
 		// Try to join with the same username
-		_, err := env.guestService.JoinParty(context.Background(), party.Code, "SameName")
+		_, _, err := env.guestService.JoinParty(context.Background(), party.Code, "SameName")
 		assert.ErrorIs(t, err, services.ErrDuplicateUsername)
 	})
 
@@ -79,7 +79,7 @@ func TestEdgeCases(t *testing.T) {
 		votes := validVotesForActs(acts)
 		votes[12] = "nonexistent-act-id"
 
-		_, err := env.voteService.SubmitVote(ctx, "", party.ID, services.SubmitVoteRequest{
+		_, err := env.voteService.SubmitVote(asGuest(ctx, guest.ID, party.ID), party.ID, services.SubmitVoteRequest{
 			GuestID: guest.ID,
 			Votes:   votes,
 		})
@@ -97,10 +97,10 @@ func TestEdgeCases(t *testing.T) {
 		mustApproveGuest(t, env, adminID, party.ID, guest.ID)
 
 		votes := validVotesForActs(acts)
-		mustSubmitVote(t, env, "", party.ID, guest.ID, votes)
+		mustSubmitVote(t, env, party.ID, guest.ID, votes)
 
 		// Try to submit again
-		_, err := env.voteService.SubmitVote(ctx, "", party.ID, services.SubmitVoteRequest{
+		_, err := env.voteService.SubmitVote(asGuest(ctx, guest.ID, party.ID), party.ID, services.SubmitVoteRequest{
 			GuestID: guest.ID,
 			Votes:   votes,
 		})
@@ -122,7 +122,7 @@ func TestEdgeCases(t *testing.T) {
 
 		// Try to vote
 		votes := validVotesForActs(acts)
-		_, err := env.voteService.SubmitVote(ctx, "", party.ID, services.SubmitVoteRequest{
+		_, err := env.voteService.SubmitVote(asGuest(ctx, guest.ID, party.ID), party.ID, services.SubmitVoteRequest{
 			GuestID: guest.ID,
 			Votes:   votes,
 		})
@@ -141,14 +141,14 @@ func TestEdgeCases(t *testing.T) {
 
 		// Submit vote while active
 		votes := validVotesForActs(acts)
-		mustSubmitVote(t, env, "", party.ID, guest.ID, votes)
+		mustSubmitVote(t, env, party.ID, guest.ID, votes)
 
 		// End voting
 		mustEndVoting(t, env, adminID, party.ID)
 
 		// Try to update vote
 		newVotes := buildRotatedVotes(acts, 1)
-		_, err := env.voteService.UpdateVote(ctx, "", party.ID, services.SubmitVoteRequest{
+		_, err := env.voteService.UpdateVote(asGuest(ctx, guest.ID, party.ID), party.ID, services.SubmitVoteRequest{
 			GuestID: guest.ID,
 			Votes:   newVotes,
 		})
@@ -162,7 +162,7 @@ func TestEdgeCases(t *testing.T) {
 
 		party := mustCreateParty(t, env, adminID, "Active Results Party")
 
-		_, err := env.voteService.GetResults(ctx, adminID, party.ID)
+		_, err := env.voteService.GetResults(asUser(ctx, adminID), party.ID)
 		assert.ErrorIs(t, err, services.ErrVotingNotEnded)
 	})
 
@@ -174,7 +174,7 @@ func TestEdgeCases(t *testing.T) {
 
 		party := mustCreateParty(t, env, adminID, "End Voting Party")
 
-		_, err := env.voteService.EndVoting(ctx, nonAdminID, party.ID)
+		_, err := env.voteService.EndVoting(asUser(ctx, nonAdminID), party.ID)
 		assert.ErrorIs(t, err, services.ErrUnauthorized)
 	})
 
@@ -187,7 +187,7 @@ func TestEdgeCases(t *testing.T) {
 		party := mustCreateParty(t, env, adminID, "Approve Party")
 		guest := mustJoinParty(t, env, party.Code, "WaitingGuest")
 
-		err := env.guestService.ApproveGuest(ctx, nonAdminID, party.ID, guest.ID)
+		err := env.guestService.ApproveGuest(asUser(ctx, nonAdminID), party.ID, guest.ID)
 		assert.ErrorIs(t, err, services.ErrUnauthorized)
 	})
 
@@ -200,7 +200,7 @@ func TestEdgeCases(t *testing.T) {
 		party := mustCreateParty(t, env, adminID, "List Requests Party")
 		mustJoinParty(t, env, party.Code, "RequestGuest")
 
-		_, err := env.guestService.ListJoinRequests(ctx, nonAdminID, party.ID)
+		_, err := env.guestService.ListJoinRequests(asUser(ctx, nonAdminID), party.ID)
 		assert.ErrorIs(t, err, services.ErrUnauthorized)
 	})
 
@@ -216,11 +216,11 @@ func TestEdgeCases(t *testing.T) {
 
 		// Submit initial vote
 		votes := validVotesForActs(acts)
-		mustSubmitVote(t, env, "", party.ID, guest.ID, votes)
+		mustSubmitVote(t, env, party.ID, guest.ID, votes)
 
 		// Update vote while still active
 		newVotes := buildRotatedVotes(acts, 1)
-		updatedVote, err := env.voteService.UpdateVote(ctx, "", party.ID, services.SubmitVoteRequest{
+		updatedVote, err := env.voteService.UpdateVote(asGuest(ctx, guest.ID, party.ID), party.ID, services.SubmitVoteRequest{
 			GuestID: guest.ID,
 			Votes:   newVotes,
 		})
@@ -237,7 +237,7 @@ func TestEdgeCases(t *testing.T) {
 		mustEndVoting(t, env, adminID, party.ID)
 
 		// Try to end again
-		_, err := env.voteService.EndVoting(ctx, adminID, party.ID)
+		_, err := env.voteService.EndVoting(asUser(ctx, adminID), party.ID)
 		assert.ErrorIs(t, err, services.ErrPartyClosed)
 	})
 }
@@ -0,0 +1,109 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sipgate/eurovision-vote-party/server/middleware"
+	"github.com/sipgate/eurovision-vote-party/server/models"
+)
+
+func TestHTTPEventStream(t *testing.T) {
+	verifier, err := middleware.NewDevTokenVerifier(true)
+	require.NoError(t, err)
+	middleware.SetTokenVerifier(verifier)
+	t.Cleanup(func() { middleware.SetTokenVerifier(nil) })
+	adminToken := middleware.DevToken("events-admin-1", "admin@test.com")
+
+	server := httptest.NewServer(buildMux(t))
+	defer server.Close()
+
+	// Admin creates a party.
+	req, err := http.NewRequest(http.MethodPost, server.URL+"/api/parties", strings.NewReader(`{"name":"Live Party","eventType":"grandfinal"}`))
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer "+adminToken)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	var party models.Party
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&party))
+	resp.Body.Close()
+
+	// Admin opens the event stream.
+	req, err = http.NewRequest(http.MethodGet, server.URL+"/api/parties/"+party.ID+"/events", nil)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer "+adminToken)
+	stream, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer stream.Body.Close()
+	require.Equal(t, http.StatusOK, stream.StatusCode)
+	assert.Equal(t, "text/event-stream", stream.Header.Get("Content-Type"))
+
+	// A guest joins; the admin is notified.
+	resp, err = http.Post(server.URL+"/api/parties/"+party.Code+"/join", "application/json", strings.NewReader(`{"username":"LiveGuest"}`))
+	require.NoError(t, err)
+	var guest struct {
+		models.Guest
+		Token string `json:"token"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&guest))
+	resp.Body.Close()
+
+	frame := readFrame(t, bufio.NewReader(stream.Body))
+	require.Len(t, frame, 3)
+	assert.Equal(t, "id: 1", frame[0])
+	assert.Equal(t, "event: guest.joined", frame[1])
+	assert.Contains(t, frame[2], guest.ID)
+
+	// The waiting guest opens the stream like EventSource does, with the
+	// session token in the query string, and learns about its approval.
+	req, err = http.NewRequest(http.MethodGet, server.URL+"/api/parties/"+party.ID+"/events?guestToken="+guest.Token, nil)
+	require.NoError(t, err)
+	req.Header.Set("Accept", "text/event-stream")
+	guestStream, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer guestStream.Body.Close()
+	require.Equal(t, http.StatusOK, guestStream.StatusCode)
+
+	req, err = http.NewRequest(http.MethodPut, server.URL+"/api/parties/"+party.ID+"/guests/"+guest.ID+"/approve", nil)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer "+adminToken)
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	frame = readFrame(t, bufio.NewReader(guestStream.Body))
+	require.Len(t, frame, 3)
+	assert.Equal(t, "event: guest.approved", frame[1])
+	assert.Contains(t, frame[2], guest.ID)
+
+	// Without credentials the stream is refused.
+	resp, err = http.Get(server.URL + "/api/parties/" + party.ID + "/events")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
+
+// readFrame reads the lines of the next Server-Sent Events frame.
+func readFrame(t *testing.T, reader *bufio.Reader) []string {
+	t.Helper()
+	var frame []string
+	for {
+		line, err := reader.ReadString('\n')
+		require.NoError(t, err)
+		line = strings.TrimSuffix(line, "\n")
+		if line == "" {
+			return frame
+		}
+		frame = append(frame, line)
+	}
+}
@@ -11,71 +11,93 @@ import (
 	"strings"
 	"testing"
 
-	firebaseauth "firebase.google.com/go/v4/auth"
-
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/sipgate/eurovision-vote-party/server/events"
 	"github.com/sipgate/eurovision-vote-party/server/handlers"
 	"github.com/sipgate/eurovision-vote-party/server/middleware"
 	"github.com/sipgate/eurovision-vote-party/server/models"
-	"github.com/sipgate/eurovision-vote-party/server/persistence"
 	"github.com/sipgate/eurovision-vote-party/server/services"
 )
 
-// stubTokenVerifier implements the tokenVerifier interface via structural typing.
-// It maps token strings to (uid, email) pairs for testing.
-type stubTokenVerifier struct {
-	tokens map[string]tokenInfo
-}
-
-type tokenInfo struct {
-	uid   string
-	email string
-}
-
-func (s *stubTokenVerifier) VerifyIDToken(_ context.Context, idToken string) (*firebaseauth.Token, error) {
-	info, ok := s.tokens[idToken]
-	if !ok {
-		return nil, http.ErrAbortHandler // any non-nil error signals invalid token
-	}
-	return &firebaseauth.Token{
-		UID: info.uid,
-		Claims: map[string]interface{}{
-			"email": info.email,
-		},
-	}, nil
-}
-
 // buildMux constructs the same http.ServeMux as main.go, using real services
-// backed by the Firestore emulator.
+// backed by the configured persistence backend.
 func buildMux(t *testing.T) *http.ServeMux {
 	t.Helper()
 
-	partyDAO := persistence.NewFirestorePartyDAO(firestoreClient)
-	guestDAO := persistence.NewFirestoreGuestDAO(firestoreClient)
-	voteDAO := persistence.NewFirestoreVoteDAO(firestoreClient)
-	userDAO := persistence.NewFirestoreUserDAO(firestoreClient)
+	daos := newTestDAOs(t)
+
+	bus := events.NewBus(events.DefaultHistory)
 
-	partyService := services.NewPartyService(partyDAO)
-	guestService := services.NewGuestService(guestDAO, partyDAO)
-	voteService := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService)
-	userService := services.NewUserService(userDAO)
+	partyService := services.NewPartyService(daos.party, actsService)
+	guestService := services.NewGuestService(daos.guest, daos.party, []byte("integration-guest-token-key"), bus)
+	middleware.SetGuestTokenVerifier(guestService)
+	t.Cleanup(func() { middleware.SetGuestTokenVerifier(nil) })
+	voteService := services.NewVoteService(daos.vote, daos.party, daos.guest, actsService, bus)
+	predictionService := services.NewPredictionService(daos.prediction, daos.party, daos.guest, actsService, bus)
+	scoreboardService := services.NewScoreboardService(daos.scoreboard, daos.party, actsService, voteService, []string{scoreboardEditorID})
+	statsService := services.NewStatsService(daos.vote, daos.party, daos.guest, daos.scoreboard, voteService)
+	archiveService := services.NewArchiveService(daos.party, daos.guest, daos.vote, daos.prediction, actsService)
+	revealService := services.NewRevealService(daos.vote, daos.party, daos.guest, actsService, bus)
+	eventService := services.NewEventService(daos.party, daos.guest, bus)
+	userService := services.NewUserService(daos.user)
+	memberService := services.NewMemberService(daos.party, userService)
 
 	partyHandler := handlers.NewPartyHandler(partyService)
 	guestHandler := handlers.NewGuestHandler(guestService)
 	voteHandler := handlers.NewVoteHandler(voteService)
+	predictionHandler := handlers.NewPredictionHandler(predictionService)
+	scoreboardHandler := handlers.NewScoreboardHandler(scoreboardService)
+	statsHandler := handlers.NewStatsHandler(statsService)
+	archiveHandler := handlers.NewArchiveHandler(archiveService)
+	eventsHandler := handlers.NewEventsHandler(eventService, handlers.DefaultHeartbeatInterval)
+	revealHandler := handlers.NewRevealHandler(revealService)
 	actsHandler := handlers.NewActsHandler(actsService)
 	userHandler := handlers.NewUserHandler(userService)
+	memberHandler := handlers.NewMemberHandler(memberService)
 
 	apiHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		path := strings.TrimPrefix(r.URL.Path, "/api/parties/")
 		segments := strings.SplitN(path, "/", 3)
+		if path == "import" {
+			archiveHandler.ServeHTTP(w, r)
+			return
+		}
+		if path == "invitations" {
+			memberHandler.ServeHTTP(w, r)
+			return
+		}
 		if len(segments) >= 2 {
 			switch segments[1] {
-			case "votes", "end-voting", "results":
+			case "results":
+				if len(segments) == 3 && segments[2] == "comparison" {
+					scoreboardHandler.ServeHTTP(w, r)
+					return
+				}
 				voteHandler.ServeHTTP(w, r)
 				return
+			case "votes", "start-voting", "end-voting":
+				voteHandler.ServeHTTP(w, r)
+				return
+			case "predictions":
+				predictionHandler.ServeHTTP(w, r)
+				return
+			case "stats":
+				statsHandler.ServeHTTP(w, r)
+				return
+			case "archive":
+				archiveHandler.ServeHTTP(w, r)
+				return
+			case "events":
+				eventsHandler.ServeHTTP(w, r)
+				return
+			case "reveal":
+				revealHandler.ServeHTTP(w, r)
+				return
+			case "members":
+				memberHandler.ServeHTTP(w, r)
+				return
 			}
 			guestHandler.ServeHTTP(w, r)
 			return
@@ -86,29 +108,22 @@ func buildMux(t *testing.T) *http.ServeMux {
 	mux := http.NewServeMux()
 	mux.Handle("/api/health", handlers.NewHealthHandler())
 	mux.Handle("/api/acts", actsHandler)
+	mux.Handle("/api/contests/", middleware.OptionalAuthMiddleware(scoreboardHandler))
 	mux.Handle("/api/parties", middleware.AuthMiddleware(partyHandler))
-	mux.Handle("/api/parties/", middleware.OptionalAuthMiddleware(apiHandler))
+	rateLimiter := middleware.NewRateLimiter(middleware.DefaultRateLimitConfig())
+	mux.Handle("/api/parties/", rateLimiter.Middleware(middleware.OptionalAuthMiddleware(middleware.GuestSessionMiddleware(apiHandler))))
 	mux.Handle("/api/users/profile", middleware.AuthMiddleware(userHandler))
 
 	return mux
 }
 
 func TestHTTPFullStack(t *testing.T) {
-	// Set up stub verifier
-	stub := &stubTokenVerifier{
-		tokens: map[string]tokenInfo{
-			"admin-token": {uid: "http-admin-1", email: "admin@test.com"},
-			"guest-token": {uid: "http-guest-1", email: "guest@test.com"},
-		},
-	}
-	middleware.SetTokenVerifier(stub)
-
-	t.Cleanup(func() {
-		ctx := context.Background()
-		for _, col := range []string{"parties", "guests", "votes", "users"} {
-			cleanupCollection(t, ctx, col)
-		}
-	})
+	verifier, err := middleware.NewDevTokenVerifier(true)
+	require.NoError(t, err)
+	middleware.SetTokenVerifier(verifier)
+	t.Cleanup(func() { middleware.SetTokenVerifier(nil) })
+	adminToken := middleware.DevToken("http-admin-1", "admin@test.com")
+	userToken := middleware.DevToken("http-guest-1", "guest@test.com")
 
 	mux := buildMux(t)
 	server := httptest.NewServer(mux)
@@ -167,13 +182,15 @@ func TestHTTPFullStack(t *testing.T) {
 
 	var partyCode string
 	var partyID string
+	var guestID string
+	var guestToken string
 
 	t.Run("create party with auth", func(t *testing.T) {
 		reqBody := `{"name":"HTTP Test Party","eventType":"grandfinal"}`
 		req, err := http.NewRequest(http.MethodPost, server.URL+"/api/parties", strings.NewReader(reqBody))
 		require.NoError(t, err)
 		req.Header.Set("Content-Type", "application/json")
-		req.Header.Set("Authorization", "Bearer admin-token")
+		req.Header.Set("Authorization", "Bearer "+adminToken)
 
 		resp, err := http.DefaultClient.Do(req)
 		require.NoError(t, err)
@@ -204,10 +221,16 @@ func TestHTTPFullStack(t *testing.T) {
 
 		assert.Equal(t, http.StatusCreated, resp.StatusCode)
 
-		var guest models.Guest
+		var guest struct {
+			models.Guest
+			Token string `json:"token"`
+		}
 		require.NoError(t, json.NewDecoder(resp.Body).Decode(&guest))
 		assert.Equal(t, "HTTPGuest", guest.Username)
 		assert.Equal(t, models.GuestStatusPending, guest.Status)
+		assert.NotEmpty(t, guest.Token)
+		guestID = guest.ID
+		guestToken = guest.Token
 	})
 
 	t.Run("get party by code without auth", func(t *testing.T) {
@@ -232,7 +255,7 @@ func TestHTTPFullStack(t *testing.T) {
 
 		req, err := http.NewRequest(http.MethodGet, server.URL+"/api/parties/"+partyID+"/join-requests", nil)
 		require.NoError(t, err)
-		req.Header.Set("Authorization", "Bearer admin-token")
+		req.Header.Set("Authorization", "Bearer "+adminToken)
 
 		resp, err := http.DefaultClient.Do(req)
 		require.NoError(t, err)
@@ -246,13 +269,72 @@ func TestHTTPFullStack(t *testing.T) {
 		assert.Equal(t, "HTTPGuest", guests[0].Username)
 	})
 
+	t.Run("guest acts only through its session token", func(t *testing.T) {
+		require.NotEmpty(t, guestToken, "guest must have joined first")
+
+		do := func(method, path, token string, body string) *http.Response {
+			req, err := http.NewRequest(method, server.URL+path, strings.NewReader(body))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+			if token != "" {
+				req.Header.Set(middleware.GuestTokenHeader, token)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			t.Cleanup(func() { resp.Body.Close() })
+			return resp
+		}
+
+		resp := do(http.MethodGet, "/api/parties/"+partyCode+"/guest-status?guestId="+guestID, "", "")
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode, "bare guest ID must not be accepted")
+
+		resp = do(http.MethodGet, "/api/parties/"+partyCode+"/guest-status", guestToken, "")
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		approve, err := http.NewRequest(http.MethodPut, server.URL+"/api/parties/"+partyID+"/guests/"+guestID+"/approve", nil)
+		require.NoError(t, err)
+		approve.Header.Set("Authorization", "Bearer "+adminToken)
+		approveResp, err := http.DefaultClient.Do(approve)
+		require.NoError(t, err)
+		approveResp.Body.Close()
+		require.Equal(t, http.StatusOK, approveResp.StatusCode)
+
+		acts, err := actsService.ListActs(context.Background(), "", string(models.EventGrandFinal))
+		require.NoError(t, err)
+		ballot, err := json.Marshal(map[string]interface{}{"guestId": guestID, "votes": validVotesForActs(acts)})
+		require.NoError(t, err)
+
+		resp = do(http.MethodPost, "/api/parties/"+partyID+"/votes", "", string(ballot))
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode, "vote without session must be rejected")
+
+		resp = do(http.MethodPost, "/api/parties/"+partyID+"/votes", guestToken+"x", string(ballot))
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode, "forged token must be rejected")
+
+		resp = do(http.MethodPost, "/api/parties/"+partyID+"/votes", guestToken, string(ballot))
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		resp = do(http.MethodGet, "/api/parties/"+partyID+"/votes/"+guestID, guestToken, "")
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		revoke, err := http.NewRequest(http.MethodDelete, server.URL+"/api/parties/"+partyID+"/guests/"+guestID+"/session", nil)
+		require.NoError(t, err)
+		revoke.Header.Set("Authorization", "Bearer "+adminToken)
+		revokeResp, err := http.DefaultClient.Do(revoke)
+		require.NoError(t, err)
+		revokeResp.Body.Close()
+		require.Equal(t, http.StatusNoContent, revokeResp.StatusCode)
+
+		resp = do(http.MethodGet, "/api/parties/"+partyID+"/votes/"+guestID, guestToken, "")
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode, "revoked token must be rejected")
+	})
+
 	t.Run("user profile upsert and get", func(t *testing.T) {
 		// PUT profile
 		reqBody := `{"username":"admin_user"}`
 		req, err := http.NewRequest(http.MethodPut, server.URL+"/api/users/profile", bytes.NewBufferString(reqBody))
 		require.NoError(t, err)
 		req.Header.Set("Content-Type", "application/json")
-		req.Header.Set("Authorization", "Bearer admin-token")
+		req.Header.Set("Authorization", "Bearer "+adminToken)
 
 		resp, err := http.DefaultClient.Do(req)
 		require.NoError(t, err)
@@ -268,7 +350,7 @@ func TestHTTPFullStack(t *testing.T) {
 		// GET profile
 		req2, err := http.NewRequest(http.MethodGet, server.URL+"/api/users/profile", nil)
 		require.NoError(t, err)
-		req2.Header.Set("Authorization", "Bearer admin-token")
+		req2.Header.Set("Authorization", "Bearer "+adminToken)
 
 		resp2, err := http.DefaultClient.Do(req2)
 		require.NoError(t, err)
@@ -280,4 +362,53 @@ func TestHTTPFullStack(t *testing.T) {
 		require.NoError(t, json.NewDecoder(resp2.Body).Decode(&user2))
 		assert.Equal(t, "admin_user", user2.Username)
 	})
+
+	t.Run("co-host invitation", func(t *testing.T) {
+		require.NotEmpty(t, partyID, "party must be created first")
+
+		do := func(method, path, token, body string) *http.Response {
+			req, err := http.NewRequest(method, server.URL+path, strings.NewReader(body))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "Bearer "+token)
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			t.Cleanup(func() { resp.Body.Close() })
+			return resp
+		}
+
+		resp := do(http.MethodPut, "/api/users/profile", userToken, `{"username":"cohost_user"}`)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		resp = do(http.MethodGet, "/api/parties/"+partyID+"/join-requests", userToken, "")
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+		resp = do(http.MethodPost, "/api/parties/"+partyID+"/members", adminToken, `{"user":"guest@test.com","role":"cohost"}`)
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		var member models.PartyMember
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&member))
+		assert.Equal(t, "http-guest-1", member.UserID)
+		assert.Equal(t, models.MemberStatusInvited, member.Status)
+
+		resp = do(http.MethodGet, "/api/parties/invitations", userToken, "")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		var invitations []models.Party
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&invitations))
+		require.Len(t, invitations, 1)
+		assert.Equal(t, partyID, invitations[0].ID)
+
+		resp = do(http.MethodPost, "/api/parties/"+partyID+"/members/accept", userToken, "")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		resp = do(http.MethodGet, "/api/parties/"+partyID+"/join-requests", userToken, "")
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		resp = do(http.MethodGet, "/api/parties/"+partyID+"/members", userToken, "")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		var members []models.PartyMember
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&members))
+		require.Len(t, members, 2)
+		assert.Equal(t, "admin_user", members[0].Username)
+		assert.Equal(t, models.PartyRoleCoHost, members[1].Role)
+	})
 }
@@ -0,0 +1,105 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sipgate/eurovision-vote-party/server/handlers"
+	"github.com/sipgate/eurovision-vote-party/server/middleware"
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/services"
+)
+
+// TestHTTPLocalAuth runs the server the way AUTH_MODE=local does: users
+// register and sign in with the server itself and use its tokens for the API.
+func TestHTTPLocalAuth(t *testing.T) {
+	accountService := services.NewAccountService(newTestDAOs(t).account, []byte("integration-auth-token-key"))
+	middleware.SetTokenVerifier(accountService)
+	t.Cleanup(func() { middleware.SetTokenVerifier(nil) })
+
+	mux := buildMux(t)
+	mux.Handle("/api/auth/", middleware.NewRateLimiter(middleware.DefaultRateLimitConfig()).Middleware(handlers.NewAuthHandler(accountService)))
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	post := func(t *testing.T, path, body, accessToken string) *http.Response {
+		t.Helper()
+		req, err := http.NewRequest(http.MethodPost, server.URL+path, strings.NewReader(body))
+		require.NoError(t, err)
+		if accessToken != "" {
+			req.Header.Set("Authorization", "Bearer "+accessToken)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		t.Cleanup(func() { resp.Body.Close() })
+		return resp
+	}
+	decodeTokens := func(t *testing.T, resp *http.Response) services.AuthTokens {
+		t.Helper()
+		var tokens services.AuthTokens
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&tokens))
+		return tokens
+	}
+
+	resp := post(t, "/api/auth/register", `{"email":"host@example.com","password":"correct horse"}`, "")
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	registered := decodeTokens(t, resp)
+
+	t.Run("the access token signs API requests", func(t *testing.T) {
+		resp := post(t, "/api/parties", `{"name":"Offline Party","eventType":"grandfinal"}`, registered.AccessToken)
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		var party models.Party
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&party))
+		assert.NotEmpty(t, party.AdminID)
+	})
+
+	t.Run("the profile picks up the account's email", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodPut, server.URL+"/api/users/profile", strings.NewReader(`{"username":"host"}`))
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer "+registered.AccessToken)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var user models.User
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&user))
+		assert.Equal(t, "host@example.com", user.Email)
+	})
+
+	t.Run("a refresh token is not an access token", func(t *testing.T) {
+		resp := post(t, "/api/parties", `{"name":"Offline Party","eventType":"grandfinal"}`, registered.RefreshToken)
+
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	t.Run("login, refresh and logout", func(t *testing.T) {
+		resp := post(t, "/api/auth/login", `{"email":"host@example.com","password":"wrong password"}`, "")
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+		resp = post(t, "/api/auth/login", `{"email":"host@example.com","password":"correct horse"}`, "")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		loggedIn := decodeTokens(t, resp)
+
+		resp = post(t, "/api/auth/refresh", `{"refreshToken":"`+loggedIn.RefreshToken+`"}`, "")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		refreshed := decodeTokens(t, resp)
+
+		resp = post(t, "/api/auth/logout", `{"refreshToken":"`+refreshed.RefreshToken+`"}`, "")
+		require.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+		resp = post(t, "/api/auth/refresh", `{"refreshToken":"`+refreshed.RefreshToken+`"}`, "")
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+		resp = post(t, "/api/auth/refresh", `{"refreshToken":"`+registered.RefreshToken+`"}`, "")
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode, "logout signs the account out everywhere")
+	})
+}
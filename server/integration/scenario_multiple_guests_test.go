@@ -66,15 +66,15 @@ func TestMultipleGuestsVoting(t *testing.T) {
 	votes3 := buildRotatedVotes(acts, 1)         // 12->acts[1], 10->acts[2], 8->acts[3], ...
 
 	// Submit votes
-	mustSubmitVote(t, env, "", party.ID, guest1.ID, votes1)
-	mustSubmitVote(t, env, "", party.ID, guest2.ID, votes2)
-	mustSubmitVote(t, env, "", party.ID, guest3.ID, votes3)
+	mustSubmitVote(t, env, party.ID, guest1.ID, votes1)
+	mustSubmitVote(t, env, party.ID, guest2.ID, votes2)
+	mustSubmitVote(t, env, party.ID, guest3.ID, votes3)
 
 	// End voting
 	mustEndVoting(t, env, adminID, party.ID)
 
 	// Get results
-	results, err := env.voteService.GetResults(ctx, adminID, party.ID)
+	results, err := env.voteService.GetResults(asUser(ctx, adminID), party.ID)
 	require.NoError(t, err)
 	assert.Equal(t, 3, results.TotalVoters)
 
@@ -113,19 +113,20 @@ func TestMultipleGuestsVoting(t *testing.T) {
 	// Rank 1 should be the act with the highest total points
 	assert.Equal(t, 1, results.Results[0].Rank)
 
-	// Verify ranking uses standard competition ranking (1, 2, 2, 4)
+	// Verify every act has its own rank and acts level on points explain the tie-break
 	for i := 1; i < len(results.Results); i++ {
-		if results.Results[i].TotalPoints == results.Results[i-1].TotalPoints {
-			assert.Equal(t, results.Results[i-1].Rank, results.Results[i].Rank,
-				"tied acts should have the same rank")
+		prev, cur := results.Results[i-1], results.Results[i]
+		assert.Equal(t, i+1, cur.Rank, "every act should have its own rank")
+		if cur.TotalPoints == prev.TotalPoints {
+			require.NotNil(t, prev.TieBreak, "tied acts should report the deciding rule")
+			assert.Equal(t, cur.ActID, prev.TieBreak.ActID)
 		} else {
-			assert.Greater(t, results.Results[i].Rank, results.Results[i-1].Rank,
-				"lower-scored act should have a higher rank number")
+			assert.Nil(t, prev.TieBreak, "acts separated by points need no tie-break")
 		}
 	}
 
 	// Verify all acts from the grand final appear in results (even those with 0 points)
-	allGrandFinalActs, _ := env.actsService.ListActs(string(models.EventGrandFinal))
+	allGrandFinalActs, _ := env.actsService.ListActs(ctx, "", string(models.EventGrandFinal))
 	assert.Equal(t, len(allGrandFinalActs), len(results.Results),
 		"results should include all grand final acts")
 }
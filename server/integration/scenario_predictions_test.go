@@ -0,0 +1,73 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/services"
+)
+
+func TestPredictionGameFlow(t *testing.T) {
+	env := setupTest(t)
+	ctx := context.Background()
+	adminID := "admin-predictions"
+
+	party := mustCreateParty(t, env, adminID, "Prediction Party")
+	alice := mustJoinParty(t, env, party.Code, "Alice")
+	bob := mustJoinParty(t, env, party.Code, "Bob")
+	mustApproveGuest(t, env, adminID, party.ID, alice.ID)
+	mustApproveGuest(t, env, adminID, party.ID, bob.ID)
+
+	acts := mustGetGrandFinalActs(t, env)
+	require.GreaterOrEqual(t, len(acts), 4)
+
+	// Step 1: Guests predict the podium before voting starts
+	_, err := env.predictionService.SubmitPrediction(asGuest(ctx, alice.ID, party.ID), party.ID, services.SubmitPredictionRequest{
+		GuestID: alice.ID,
+		Picks:   []string{acts[0].ID, acts[1].ID, acts[2].ID},
+	})
+	require.NoError(t, err)
+	_, err = env.predictionService.SubmitPrediction(asUser(ctx, adminID), party.ID, services.SubmitPredictionRequest{
+		GuestID: bob.ID,
+		Picks:   []string{acts[3].ID, acts[0].ID, acts[1].ID},
+	})
+	require.NoError(t, err)
+
+	// Step 2: The outcome cannot be recorded while predictions are open
+	_, err = env.predictionService.SetOutcome(asUser(ctx, adminID), party.ID, []string{acts[0].ID, acts[1].ID, acts[2].ID})
+	assert.ErrorIs(t, err, services.ErrPredictionsOpen)
+
+	// Step 3: Starting voting locks predictions
+	started, err := env.voteService.StartVoting(asUser(ctx, adminID), party.ID)
+	require.NoError(t, err)
+	assert.True(t, started.VotingStarted())
+
+	_, err = env.predictionService.SubmitPrediction(asGuest(ctx, alice.ID, party.ID), party.ID, services.SubmitPredictionRequest{
+		GuestID: alice.ID,
+		Picks:   []string{acts[3].ID, acts[1].ID, acts[2].ID},
+	})
+	assert.ErrorIs(t, err, services.ErrPredictionsLocked)
+
+	// Step 4: Ballots are still accepted and voting ends as usual
+	mustSubmitVote(t, env, party.ID, alice.ID, validVotesForActs(acts))
+	mustEndVoting(t, env, adminID, party.ID)
+
+	// Step 5: The admin records the official top three and the leaderboard ranks the guests
+	_, err = env.predictionService.SetOutcome(asUser(ctx, adminID), party.ID, []string{acts[0].ID, acts[2].ID, acts[1].ID})
+	require.NoError(t, err)
+
+	leaderboard, err := env.predictionService.GetLeaderboard(asGuest(ctx, alice.ID, party.ID), party.ID)
+	require.NoError(t, err)
+	require.Len(t, leaderboard.Standings, 2)
+	assert.Equal(t, alice.ID, leaderboard.Standings[0].GuestID)
+	assert.Equal(t, 3*models.PodiumPredictionPoints+models.WinnerPredictionPoints, leaderboard.Standings[0].Points)
+	assert.Equal(t, bob.ID, leaderboard.Standings[1].GuestID)
+	assert.Equal(t, 2*models.PodiumPredictionPoints, leaderboard.Standings[1].Points)
+	assert.Equal(t, 2, leaderboard.Standings[1].Rank)
+}
@@ -0,0 +1,62 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/services"
+)
+
+func TestResultsComparisonFlow(t *testing.T) {
+	env := setupTest(t)
+	ctx := context.Background()
+	adminID := "admin-comparison"
+
+	party := mustCreateParty(t, env, adminID, "Comparison Party")
+	alice := mustJoinParty(t, env, party.Code, "Alice")
+	mustApproveGuest(t, env, adminID, party.ID, alice.ID)
+
+	acts := mustGetGrandFinalActs(t, env)
+	require.GreaterOrEqual(t, len(acts), 3)
+
+	// Step 1: Only catalogue editors may import the official scoreboard
+	scoreboard := models.Scoreboard{
+		Contest:   models.DefaultContest,
+		EventType: models.EventGrandFinal,
+		Entries: []models.ScoreboardEntry{
+			{ActID: acts[0].ID, JuryPoints: 60, TelevotePoints: 40},
+			{ActID: acts[1].ID, JuryPoints: 120, TelevotePoints: 80},
+			{ActID: acts[2].ID, JuryPoints: 180, TelevotePoints: 120},
+		},
+	}
+	_, err := env.scoreboardService.ImportScoreboard(ctx, adminID, scoreboard)
+	assert.ErrorIs(t, err, services.ErrUnauthorized)
+	_, err = env.scoreboardService.ImportScoreboard(ctx, scoreboardEditorID, scoreboard)
+	require.NoError(t, err)
+
+	// Step 2: The comparison is only available once the results are
+	mustSubmitVote(t, env, party.ID, alice.ID, validVotesForActs(acts))
+	_, err = env.scoreboardService.CompareResults(asUser(ctx, adminID), party.ID)
+	assert.ErrorIs(t, err, services.ErrVotingNotEnded)
+	mustEndVoting(t, env, adminID, party.ID)
+
+	// Step 3: The party's favourites finished in reverse order officially
+	comparison, err := env.scoreboardService.CompareResults(ctx, party.ID)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, len(comparison.Acts), 3)
+	first := comparison.Acts[0]
+	assert.Equal(t, acts[0].ID, first.ActID)
+	assert.Equal(t, 1, first.PartyRank)
+	assert.Equal(t, 3, first.OfficialRank)
+	assert.Equal(t, 100, first.OfficialPoints)
+	require.NotNil(t, first.RankDelta)
+	assert.Equal(t, 2, *first.RankDelta)
+	require.NotNil(t, comparison.Spearman)
+	assert.InDelta(t, -1, *comparison.Spearman, 1e-9)
+}
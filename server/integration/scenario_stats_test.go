@@ -0,0 +1,54 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sipgate/eurovision-vote-party/server/services"
+)
+
+func TestTasteStatsFlow(t *testing.T) {
+	env := setupTest(t)
+	ctx := context.Background()
+	adminID := "admin-stats"
+
+	party := mustCreateParty(t, env, adminID, "Stats Party")
+	alice := mustJoinParty(t, env, party.Code, "Alice")
+	bob := mustJoinParty(t, env, party.Code, "Bob")
+	mustApproveGuest(t, env, adminID, party.ID, alice.ID)
+	mustApproveGuest(t, env, adminID, party.ID, bob.ID)
+
+	acts := mustGetGrandFinalActs(t, env)
+	require.GreaterOrEqual(t, len(acts), 11)
+
+	// Step 1: Alice and Bob agree on everything but their last point
+	aliceVotes := validVotesForActs(acts)
+	bobVotes := validVotesForActs(acts)
+	bobVotes[1] = acts[10].ID
+	mustSubmitVote(t, env, party.ID, alice.ID, aliceVotes)
+	mustSubmitVote(t, env, party.ID, bob.ID, bobVotes)
+
+	// Step 2: Statistics stay hidden until voting has ended
+	_, err := env.statsService.GetStats(ctx, party.ID)
+	assert.ErrorIs(t, err, services.ErrVotingNotEnded)
+	mustEndVoting(t, env, adminID, party.ID)
+
+	// Step 3: Both ballots match the consensus closely and each other almost perfectly
+	stats, err := env.statsService.GetStats(ctx, party.ID)
+	require.NoError(t, err)
+	require.Len(t, stats.Guests, 2)
+	for _, guest := range stats.Guests {
+		require.NotNil(t, guest.ConsensusMatch)
+		assert.Greater(t, *guest.ConsensusMatch, 0.8)
+		assert.Nil(t, guest.OfficialMatch)
+	}
+	require.NotNil(t, stats.Similarity[0][1])
+	assert.Greater(t, *stats.Similarity[0][1], 0.8)
+	assert.Less(t, *stats.Similarity[0][1], 1.0)
+	assert.Equal(t, stats.Similarity[0][1], stats.Similarity[1][0])
+}
@@ -2,56 +2,126 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
+	"errors"
 	"log"
 	"net/http"
 	"os"
 	"strings"
+	"sync"
+	"time"
 
 	"cloud.google.com/go/firestore"
 	firebase "firebase.google.com/go/v4"
 
+	"github.com/sipgate/eurovision-vote-party/server/events"
 	"github.com/sipgate/eurovision-vote-party/server/handlers"
 	"github.com/sipgate/eurovision-vote-party/server/middleware"
+	"github.com/sipgate/eurovision-vote-party/server/oidc"
 	"github.com/sipgate/eurovision-vote-party/server/persistence"
+	"github.com/sipgate/eurovision-vote-party/server/persistence/backend"
 	"github.com/sipgate/eurovision-vote-party/server/services"
 )
 
 func main() {
 	ctx := context.Background()
-	app := configureFirebaseAuth(ctx)
-	firestoreClient := configureFirestore(ctx, app)
-	defer firestoreClient.Close()
+	app := sync.OnceValue(func() *firebase.App { return newFirebaseApp(ctx) })
+	daos, closeDAOs := configurePersistence(ctx, app)
+	defer closeDAOs()
+	authHandler, closeAuth := configureAuth(ctx, app, daos.Account)
+	defer closeAuth()
 
-	partyDAO := persistence.NewFirestorePartyDAO(firestoreClient)
-	partyService := services.NewPartyService(partyDAO)
-	partyHandler := handlers.NewPartyHandler(partyService)
-
-	guestDAO := persistence.NewFirestoreGuestDAO(firestoreClient)
-	guestService := services.NewGuestService(guestDAO, partyDAO)
-	guestHandler := handlers.NewGuestHandler(guestService)
+	bus := events.NewBus(events.DefaultHistory)
 
-	actsService, err := services.NewActsService("data/acts.json")
+	actsService, err := services.NewActsService(ctx, daos.Act, actsPath(), actsEditors())
 	if err != nil {
 		log.Fatalf("failed to load acts data: %v", err)
 	}
+	if interval := actsWatchInterval(); interval > 0 {
+		go watchActs(ctx, actsService, interval)
+	}
 	actsHandler := handlers.NewActsHandler(actsService)
+	contestsHandler := handlers.NewContestsHandler(actsService)
 
-	voteDAO := persistence.NewFirestoreVoteDAO(firestoreClient)
-	voteService := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService)
+	partyDAO := daos.Party
+	partyService := services.NewPartyService(partyDAO, actsService)
+	partyHandler := handlers.NewPartyHandler(partyService)
+
+	guestDAO := daos.Guest
+	guestService := services.NewGuestService(guestDAO, partyDAO, guestTokenKey(), bus)
+	middleware.SetGuestTokenVerifier(guestService)
+	guestHandler := handlers.NewGuestHandler(guestService)
+
+	voteDAO := daos.Vote
+	voteService := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService, bus)
 	voteHandler := handlers.NewVoteHandler(voteService)
 
-	userDAO := persistence.NewFirestoreUserDAO(firestoreClient)
+	predictionService := services.NewPredictionService(daos.Prediction, partyDAO, guestDAO, actsService, bus)
+	predictionHandler := handlers.NewPredictionHandler(predictionService)
+
+	scoreboardService := services.NewScoreboardService(daos.Scoreboard, partyDAO, actsService, voteService, actsEditors())
+	scoreboardHandler := handlers.NewScoreboardHandler(scoreboardService)
+
+	statsService := services.NewStatsService(voteDAO, partyDAO, guestDAO, daos.Scoreboard, voteService)
+	statsHandler := handlers.NewStatsHandler(statsService)
+
+	archiveService := services.NewArchiveService(partyDAO, guestDAO, voteDAO, daos.Prediction, actsService)
+	archiveHandler := handlers.NewArchiveHandler(archiveService)
+
+	revealService := services.NewRevealService(voteDAO, partyDAO, guestDAO, actsService, bus)
+	revealHandler := handlers.NewRevealHandler(revealService)
+
+	eventService := services.NewEventService(partyDAO, guestDAO, bus)
+	eventsHandler := handlers.NewEventsHandler(eventService, handlers.DefaultHeartbeatInterval)
+
+	userDAO := daos.User
 	userService := services.NewUserService(userDAO)
 	userHandler := handlers.NewUserHandler(userService)
 
+	memberService := services.NewMemberService(partyDAO, userService)
+	memberHandler := handlers.NewMemberHandler(memberService)
+
 	apiHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		path := strings.TrimPrefix(r.URL.Path, "/api/parties/")
 		segments := strings.SplitN(path, "/", 3)
+		if path == "import" {
+			archiveHandler.ServeHTTP(w, r)
+			return
+		}
+		if path == "invitations" {
+			memberHandler.ServeHTTP(w, r)
+			return
+		}
 		if len(segments) >= 2 {
 			switch segments[1] {
-			case "votes", "end-voting", "results":
+			case "results":
+				if len(segments) == 3 && segments[2] == "comparison" {
+					scoreboardHandler.ServeHTTP(w, r)
+					return
+				}
+				voteHandler.ServeHTTP(w, r)
+				return
+			case "votes", "start-voting", "end-voting":
 				voteHandler.ServeHTTP(w, r)
 				return
+			case "predictions":
+				predictionHandler.ServeHTTP(w, r)
+				return
+			case "stats":
+				statsHandler.ServeHTTP(w, r)
+				return
+			case "archive":
+				archiveHandler.ServeHTTP(w, r)
+				return
+			case "events":
+				eventsHandler.ServeHTTP(w, r)
+				return
+			case "reveal":
+				revealHandler.ServeHTTP(w, r)
+				return
+			case "members":
+				memberHandler.ServeHTTP(w, r)
+				return
 			}
 			guestHandler.ServeHTTP(w, r)
 			return
@@ -59,12 +129,20 @@ func main() {
 		partyHandler.ServeHTTP(w, r)
 	})
 
+	rateLimiter := middleware.NewRateLimiter(rateLimitConfig())
+
 	mux := http.NewServeMux()
 	mux.Handle("/api/health", handlers.NewHealthHandler())
-	mux.Handle("/api/acts", actsHandler)
+	mux.Handle("/api/acts", middleware.OptionalAuthMiddleware(actsHandler))
+	mux.Handle("/api/acts/", middleware.OptionalAuthMiddleware(actsHandler))
+	mux.Handle("/api/contests", contestsHandler)
+	mux.Handle("/api/contests/", middleware.OptionalAuthMiddleware(scoreboardHandler))
 	mux.Handle("/api/parties", middleware.AuthMiddleware(partyHandler))
-	mux.Handle("/api/parties/", middleware.OptionalAuthMiddleware(apiHandler))
+	mux.Handle("/api/parties/", rateLimiter.Middleware(middleware.OptionalAuthMiddleware(middleware.GuestSessionMiddleware(apiHandler))))
 	mux.Handle("/api/users/profile", middleware.AuthMiddleware(userHandler))
+	if authHandler != nil {
+		mux.Handle("/api/auth/", rateLimiter.Middleware(authHandler))
+	}
 
 	server := &http.Server{
 		Addr:    ":8080",
@@ -77,7 +155,9 @@ func main() {
 	}
 }
 
-func configureFirebaseAuth(ctx context.Context) *firebase.App {
+// newFirebaseApp initialises the Firebase app. It is only needed for Firebase
+// auth and the Firestore backend, so it is created on first use.
+func newFirebaseApp(ctx context.Context) *firebase.App {
 	projectID := os.Getenv("FIREBASE_PROJECT_ID")
 	var cfg *firebase.Config
 	if projectID != "" {
@@ -90,20 +170,174 @@ func configureFirebaseAuth(ctx context.Context) *firebase.App {
 	if err != nil {
 		log.Fatalf("failed to initialise firebase app: %v", err)
 	}
+	return app
+}
+
+// configureAuth sets up how users sign in, selected by AUTH_MODE:
+//
+//   - "firebase" (the default) verifies Firebase ID tokens.
+//   - "local" keeps email and password accounts in the persistence backend
+//     and issues its own tokens, signed with AUTH_TOKEN_SECRET.
+//   - "oidc" verifies ID tokens of the OpenID Connect provider at
+//     OIDC_ISSUER issued for the client OIDC_AUDIENCE. OIDC_JWKS_URL
+//     overrides the key set announced by the provider.
+//   - "dev" accepts unsigned "dev:<uid>:<email>" tokens for local testing.
+//     It is refused unless ALLOW_DEV_AUTH is "true".
+//
+// It returns the handler of the sign-in endpoints, or nil if users sign in
+// elsewhere, and a function that releases any resources held for verifying tokens.
+func configureAuth(ctx context.Context, app func() *firebase.App, accounts persistence.AccountDAO) (http.Handler, func()) {
+	switch mode := os.Getenv("AUTH_MODE"); mode {
+	case "", "firebase":
+		authClient, err := app().Auth(ctx)
+		if err != nil {
+			log.Fatalf("failed to initialise firebase auth client: %v", err)
+		}
+		middleware.SetTokenVerifier(authClient)
+		return nil, func() {}
+	case "local":
+		accountService := services.NewAccountService(accounts, signingKey("AUTH_TOKEN_SECRET", "signed-in users will have to log in again after a restart"))
+		middleware.SetTokenVerifier(accountService)
+		log.Println("using local accounts for authentication")
+		return handlers.NewAuthHandler(accountService), func() {}
+	case "oidc":
+		verifier, err := oidc.NewVerifier(ctx, oidc.Config{
+			Issuer:   os.Getenv("OIDC_ISSUER"),
+			Audience: os.Getenv("OIDC_AUDIENCE"),
+			JWKSURL:  os.Getenv("OIDC_JWKS_URL"),
+		})
+		if err != nil {
+			log.Fatalf("failed to initialise oidc verifier: %v", err)
+		}
+		middleware.SetTokenVerifier(verifier)
+		log.Printf("using oidc provider %s for authentication", os.Getenv("OIDC_ISSUER"))
+		return nil, verifier.Close
+	case "dev":
+		verifier, err := middleware.NewDevTokenVerifier(os.Getenv("ALLOW_DEV_AUTH") == "true")
+		if err != nil {
+			log.Fatalf("AUTH_MODE=dev requires ALLOW_DEV_AUTH=true: %v", err)
+		}
+		middleware.SetTokenVerifier(verifier)
+		logDevAuthWarning()
+		return nil, func() {}
+	default:
+		log.Fatalf("unknown AUTH_MODE %q", mode)
+		return nil, func() {}
+	}
+}
+
+// logDevAuthWarning makes it hard to miss that the server accepts fake tokens.
+func logDevAuthWarning() {
+	banner := strings.Repeat("!", 72)
+	log.Println(banner)
+	log.Println("!! AUTH_MODE=dev: authentication is DISABLED")
+	log.Println(`!! Any caller can act as any user with "Authorization: Bearer dev:<uid>:<email>".`)
+	log.Println("!! Never run this configuration where others can reach the server.")
+	log.Println(banner)
+}
+
+// actsPath returns the acts catalogue location: ACTS_PATH if set, otherwise the
+// directory of per-edition files shipped with the server.
+func actsPath() string {
+	if path := os.Getenv("ACTS_PATH"); path != "" {
+		return path
+	}
+	return "data/contests"
+}
 
-	authClient, err := app.Auth(ctx)
+// actsEditors returns the IDs of the users allowed to edit the acts catalogue,
+// read from the comma-separated ACTS_EDITORS. Without it the catalogue is read-only.
+func actsEditors() []string {
+	var editors []string
+	for _, id := range strings.Split(os.Getenv("ACTS_EDITORS"), ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			editors = append(editors, id)
+		}
+	}
+	return editors
+}
+
+// actsWatchInterval returns how often the acts files are checked for changes,
+// read from ACTS_WATCH_INTERVAL (e.g. "30s"). Zero disables the watcher.
+func actsWatchInterval() time.Duration {
+	value := os.Getenv("ACTS_WATCH_INTERVAL")
+	if value == "" {
+		return 0
+	}
+	interval, err := time.ParseDuration(value)
 	if err != nil {
-		log.Fatalf("failed to initialise firebase auth client: %v", err)
+		log.Fatalf("invalid ACTS_WATCH_INTERVAL %q: %v", value, err)
 	}
+	return interval
+}
 
-	middleware.SetTokenVerifier(authClient)
-	return app
+// watchActs reloads the acts files whenever they change, polling every interval.
+func watchActs(ctx context.Context, actsService services.ActsService, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := actsService.Reload(ctx)
+			switch {
+			case errors.Is(err, services.ErrCatalogueEdited):
+				log.Printf("warning: kept acts edited through the API instead of reloading them: %v", err)
+			case err != nil:
+				log.Printf("failed to reload acts data: %v", err)
+			}
+		}
+	}
+}
+
+// guestTokenKey returns the key used to sign guest session tokens.
+// It is read from GUEST_TOKEN_SECRET; without it a random key is generated,
+// which invalidates all guest sessions whenever the server restarts.
+func guestTokenKey() []byte {
+	return signingKey("GUEST_TOKEN_SECRET", "guest sessions will not survive a restart")
+}
+
+// rateLimitConfig returns the limits for the public party and sign-in
+// endpoints. Behind a reverse proxy RATE_LIMIT_TRUST_PROXY must be "true", or
+// all clients would share the proxy's limits.
+func rateLimitConfig() middleware.RateLimitConfig {
+	cfg := middleware.DefaultRateLimitConfig()
+	cfg.TrustProxy = os.Getenv("RATE_LIMIT_TRUST_PROXY") == "true"
+	return cfg
 }
 
-func configureFirestore(ctx context.Context, app *firebase.App) *firestore.Client {
-	client, err := app.Firestore(ctx)
+// signingKey returns the secret in the environment variable env. Without it a
+// random key is generated and a warning naming the consequence is logged.
+func signingKey(env, consequence string) []byte {
+	if secret := os.Getenv(env); secret != "" {
+		return []byte(secret)
+	}
+
+	log.Printf("%s is not set; %s", env, consequence)
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		log.Fatalf("failed to generate %s: %v", strings.ToLower(env), err)
+	}
+	return key
+}
+
+// configurePersistence opens the persistence backend selected by
+// PERSISTENCE_BACKEND; see backend.ConfigFromEnv. The returned function
+// releases any resources held by the backend.
+func configurePersistence(ctx context.Context, app func() *firebase.App) (*backend.DAOs, func()) {
+	cfg := backend.ConfigFromEnv()
+	cfg.Firestore = func(ctx context.Context) (*firestore.Client, error) {
+		return app().Firestore(ctx)
+	}
+	if cfg.Backend == "memory" {
+		log.Println("using in-memory persistence; all data is lost on restart")
+	}
+
+	daos, closeDAOs, err := backend.Open(ctx, cfg)
 	if err != nil {
-		log.Fatalf("failed to initialise firestore client: %v", err)
+		log.Fatal(err)
 	}
-	return client
+	return daos, closeDAOs
 }
@@ -6,9 +6,13 @@ import (
 	"strings"
 
 	firebaseauth "firebase.google.com/go/v4/auth"
+
+	"github.com/sipgate/eurovision-vote-party/server/authz"
 )
 
 // tokenVerifier defines the subset of the Firebase Auth client used by the middleware.
+// The local account service and the OIDC verifier implement it as well and
+// report their users in the shape of a Firebase ID token.
 type tokenVerifier interface {
 	VerifyIDToken(ctx context.Context, idToken string) (*firebaseauth.Token, error)
 }
@@ -16,12 +20,8 @@ type tokenVerifier interface {
 // contextKey avoids collisions with other context values.
 type contextKey string
 
-const (
-	// userIDContextKey stores the Firebase user ID extracted from a verified token.
-	userIDContextKey contextKey = "middleware/firebaseUserID"
-	// userEmailContextKey stores the email extracted from a verified token.
-	userEmailContextKey contextKey = "middleware/firebaseUserEmail"
-)
+// userEmailContextKey stores the email extracted from a verified token.
+const userEmailContextKey contextKey = "middleware/firebaseUserEmail"
 
 var verifier tokenVerifier
 
@@ -31,8 +31,8 @@ func SetTokenVerifier(v tokenVerifier) {
 	verifier = v
 }
 
-// AuthMiddleware verifies Firebase ID tokens from the Authorization header.
-// For valid tokens, the Firebase user ID is attached to the request context.
+// AuthMiddleware verifies ID tokens from the Authorization header with the
+// configured verifier. For valid tokens, the user becomes the principal of the request.
 func AuthMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if next == nil {
@@ -69,18 +69,26 @@ func AuthMiddleware(next http.Handler) http.Handler {
 			return
 		}
 
-		ctx := context.WithValue(r.Context(), userIDContextKey, verifiedToken.UID)
-		if email, ok := verifiedToken.Claims["email"].(string); ok {
-			ctx = context.WithValue(ctx, userEmailContextKey, email)
-		}
-		next.ServeHTTP(w, r.WithContext(ctx))
+		next.ServeHTTP(w, r.WithContext(withVerifiedToken(r.Context(), verifiedToken)))
 	})
 }
 
-// UserIDFromContext extracts the Firebase user ID from the request context.
+// withVerifiedToken attaches the user of a verified token to the context.
+// A guest session resolved later keeps the user as part of the principal.
+func withVerifiedToken(ctx context.Context, token *firebaseauth.Token) context.Context {
+	p := authz.FromContext(ctx)
+	p.UserID = token.UID
+	ctx = authz.WithPrincipal(ctx, p)
+	if email, ok := token.Claims["email"].(string); ok {
+		ctx = context.WithValue(ctx, userEmailContextKey, email)
+	}
+	return ctx
+}
+
+// UserIDFromContext extracts the user ID of the request's principal.
 func UserIDFromContext(ctx context.Context) (string, bool) {
-	uid, ok := ctx.Value(userIDContextKey).(string)
-	return uid, ok
+	p := authz.FromContext(ctx)
+	return p.UserID, p.IsUser()
 }
 
 // UserEmailFromContext extracts the email from the request context.
@@ -91,7 +99,7 @@ func UserEmailFromContext(ctx context.Context) (string, bool) {
 
 // OptionalAuthMiddleware extracts the user ID from the Authorization header if present.
 // Unlike AuthMiddleware, it does not block requests without valid auth - it simply
-// passes them through without a user ID in the context.
+// passes them through with an anonymous principal.
 func OptionalAuthMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if next == nil {
@@ -128,10 +136,6 @@ func OptionalAuthMiddleware(next http.Handler) http.Handler {
 			return
 		}
 
-		ctx := context.WithValue(r.Context(), userIDContextKey, verifiedToken.UID)
-		if email, ok := verifiedToken.Claims["email"].(string); ok {
-			ctx = context.WithValue(ctx, userEmailContextKey, email)
-		}
-		next.ServeHTTP(w, r.WithContext(ctx))
+		next.ServeHTTP(w, r.WithContext(withVerifiedToken(r.Context(), verifiedToken)))
 	})
 }
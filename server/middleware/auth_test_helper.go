@@ -1,13 +1,24 @@
 package middleware
 
-import "context"
+import (
+	"context"
+
+	"github.com/sipgate/eurovision-vote-party/server/authz"
+)
 
 // WithUserID returns a context with the given user ID for testing purposes.
 func WithUserID(ctx context.Context, userID string) context.Context {
-	return context.WithValue(ctx, userIDContextKey, userID)
+	p := authz.FromContext(ctx)
+	p.UserID = userID
+	return authz.WithPrincipal(ctx, p)
 }
 
 // WithUserEmail returns a context with the given email for testing purposes.
 func WithUserEmail(ctx context.Context, email string) context.Context {
 	return context.WithValue(ctx, userEmailContextKey, email)
 }
+
+// WithGuest returns a context with the given guest identity for testing purposes.
+func WithGuest(ctx context.Context, guestID, partyID string) context.Context {
+	return withGuest(ctx, guestID, partyID)
+}
@@ -0,0 +1,89 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNewDevTokenVerifierRequiresOptIn(t *testing.T) {
+	if _, err := NewDevTokenVerifier(false); !errors.Is(err, ErrDevAuthDisabled) {
+		t.Fatalf("expected ErrDevAuthDisabled, got %v", err)
+	}
+
+	if _, err := NewDevTokenVerifier(true); err != nil {
+		t.Fatalf("expected dev verifier when allowed, got %v", err)
+	}
+}
+
+func TestDevTokenVerifierParsesTokens(t *testing.T) {
+	verifier, _ := NewDevTokenVerifier(true)
+
+	tests := map[string]struct {
+		token string
+		uid   string
+		email string
+	}{
+		"with email":          {token: "dev:user-1:user@example.com", uid: "user-1", email: "user@example.com"},
+		"without email":       {token: "dev:user-1", uid: "user-1"},
+		"with trailing colon": {token: "dev:user-1:", uid: "user-1"},
+		"built by DevToken":   {token: DevToken("user-2", "two@example.com"), uid: "user-2", email: "two@example.com"},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			token, err := verifier.VerifyIDToken(context.Background(), tc.token)
+			if err != nil {
+				t.Fatalf("expected token to verify, got %v", err)
+			}
+			if token.UID != tc.uid {
+				t.Fatalf("expected uid %q, got %q", tc.uid, token.UID)
+			}
+			email, _ := token.Claims["email"].(string)
+			if email != tc.email {
+				t.Fatalf("expected email %q, got %q", tc.email, email)
+			}
+		})
+	}
+}
+
+func TestDevTokenVerifierRejectsOtherTokens(t *testing.T) {
+	verifier, _ := NewDevTokenVerifier(true)
+
+	for _, token := range []string{"", "dev:", "dev::user@example.com", "user-1:user@example.com", "eyJhbGciOiJSUzI1NiJ9.e30.sig"} {
+		if _, err := verifier.VerifyIDToken(context.Background(), token); err == nil {
+			t.Fatalf("expected token %q to be rejected", token)
+		}
+	}
+}
+
+func TestAuthMiddlewareWithDevTokenVerifier(t *testing.T) {
+	t.Cleanup(func() {
+		SetTokenVerifier(nil)
+	})
+
+	verifier, _ := NewDevTokenVerifier(true)
+	SetTokenVerifier(verifier)
+
+	var userID, email string
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ = UserIDFromContext(r.Context())
+		email, _ = UserEmailFromContext(r.Context())
+		w.WriteHeader(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Authorization", "Bearer "+DevToken("user-1", "user@example.com"))
+	rec := httptest.NewRecorder()
+
+	AuthMiddleware(next).ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, rec.Code)
+	}
+	if userID != "user-1" || email != "user@example.com" {
+		t.Fatalf("expected user-1 with user@example.com, got %q with %q", userID, email)
+	}
+}
@@ -0,0 +1,87 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/sipgate/eurovision-vote-party/server/authz"
+)
+
+// GuestTokenHeader carries the guest session token issued when joining a party.
+const GuestTokenHeader = "X-Guest-Token"
+
+// GuestTokenParam carries the guest session token in the query string of
+// event stream requests, since browsers' EventSource cannot set headers.
+const GuestTokenParam = "guestToken"
+
+// guestTokenVerifier resolves guest session tokens into a guest identity.
+type guestTokenVerifier interface {
+	VerifyGuestToken(ctx context.Context, token string) (guestID, partyID string, err error)
+}
+
+var guestVerifier guestTokenVerifier
+
+// SetGuestTokenVerifier configures the package-level verifier used by GuestSessionMiddleware.
+// It must be called during application startup before GuestSessionMiddleware is used.
+func SetGuestTokenVerifier(v guestTokenVerifier) {
+	guestVerifier = v
+}
+
+// GuestSessionMiddleware resolves the guest session token from the X-Guest-Token header if present.
+// GET requests accepting text/event-stream may pass it in the guestToken query parameter instead.
+// Like OptionalAuthMiddleware, it never blocks requests - missing or invalid tokens simply
+// leave the request without a guest identity in the context.
+func GuestSessionMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if next == nil {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		if guestVerifier == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		token := strings.TrimSpace(r.Header.Get(GuestTokenHeader))
+		if token == "" && isEventStreamRequest(r) {
+			token = strings.TrimSpace(r.URL.Query().Get(GuestTokenParam))
+		}
+		if token == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		guestID, partyID, err := guestVerifier.VerifyGuestToken(r.Context(), token)
+		if err != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(withGuest(r.Context(), guestID, partyID)))
+	})
+}
+
+// isEventStreamRequest reports whether r asks for a Server-Sent Events stream,
+// as EventSource does.
+func isEventStreamRequest(r *http.Request) bool {
+	return r.Method == http.MethodGet && strings.Contains(r.Header.Get("Accept"), "text/event-stream")
+}
+
+// withGuest adds a guest session to the principal of the context.
+func withGuest(ctx context.Context, guestID, partyID string) context.Context {
+	p := authz.FromContext(ctx)
+	p.GuestID = guestID
+	p.GuestPartyID = partyID
+	return authz.WithPrincipal(ctx, p)
+}
+
+// GuestFromContext extracts the guest identity resolved by GuestSessionMiddleware.
+func GuestFromContext(ctx context.Context) (guestID, partyID string, ok bool) {
+	p := authz.FromContext(ctx)
+	if p.GuestID == "" {
+		return "", "", false
+	}
+	return p.GuestID, p.GuestPartyID, true
+}
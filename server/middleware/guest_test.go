@@ -0,0 +1,145 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type stubGuestTokenVerifier struct {
+	guestID       string
+	partyID       string
+	err           error
+	callCount     int
+	receivedToken string
+}
+
+func (s *stubGuestTokenVerifier) VerifyGuestToken(ctx context.Context, token string) (string, string, error) {
+	s.callCount++
+	s.receivedToken = token
+	return s.guestID, s.partyID, s.err
+}
+
+func TestGuestSessionMiddlewarePassesThroughWithoutHeader(t *testing.T) {
+	t.Cleanup(func() {
+		SetGuestTokenVerifier(nil)
+	})
+
+	stub := &stubGuestTokenVerifier{guestID: "guest-1", partyID: "party-1"}
+	SetGuestTokenVerifier(stub)
+
+	called := false
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		if _, _, ok := GuestFromContext(r.Context()); ok {
+			t.Fatalf("expected no guest identity in context")
+		}
+	})
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+
+	GuestSessionMiddleware(next).ServeHTTP(rec, req)
+
+	if !called {
+		t.Fatalf("expected next handler to be called")
+	}
+	if stub.callCount != 0 {
+		t.Fatalf("expected verifier not to be called, got %d calls", stub.callCount)
+	}
+}
+
+func TestGuestSessionMiddlewarePassesThroughWhenVerifierReturnsError(t *testing.T) {
+	t.Cleanup(func() {
+		SetGuestTokenVerifier(nil)
+	})
+
+	SetGuestTokenVerifier(&stubGuestTokenVerifier{err: errors.New("invalid token")})
+
+	called := false
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		if _, _, ok := GuestFromContext(r.Context()); ok {
+			t.Fatalf("expected no guest identity in context")
+		}
+	})
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(GuestTokenHeader, "forged")
+	rec := httptest.NewRecorder()
+
+	GuestSessionMiddleware(next).ServeHTTP(rec, req)
+
+	if !called {
+		t.Fatalf("expected next handler to be called")
+	}
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, rec.Code)
+	}
+}
+
+func TestGuestSessionMiddlewareAttachesGuestIdentity(t *testing.T) {
+	t.Cleanup(func() {
+		SetGuestTokenVerifier(nil)
+	})
+
+	stub := &stubGuestTokenVerifier{guestID: "guest-1", partyID: "party-1"}
+	SetGuestTokenVerifier(stub)
+
+	var gotGuestID, gotPartyID string
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var ok bool
+		gotGuestID, gotPartyID, ok = GuestFromContext(r.Context())
+		if !ok {
+			t.Fatalf("expected guest identity in context")
+		}
+	})
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(GuestTokenHeader, "valid-token")
+	rec := httptest.NewRecorder()
+
+	GuestSessionMiddleware(next).ServeHTTP(rec, req)
+
+	if stub.receivedToken != "valid-token" {
+		t.Fatalf("expected verifier to receive token %q, got %q", "valid-token", stub.receivedToken)
+	}
+	if gotGuestID != "guest-1" || gotPartyID != "party-1" {
+		t.Fatalf("expected guest-1/party-1, got %s/%s", gotGuestID, gotPartyID)
+	}
+}
+
+func TestGuestSessionMiddlewareReadsQueryTokenOnlyForEventStreams(t *testing.T) {
+	t.Cleanup(func() {
+		SetGuestTokenVerifier(nil)
+	})
+
+	stub := &stubGuestTokenVerifier{guestID: "guest-1", partyID: "party-1"}
+	SetGuestTokenVerifier(stub)
+
+	var gotGuest string
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotGuest, _, _ = GuestFromContext(r.Context())
+	})
+
+	stream := httptest.NewRequest(http.MethodGet, "/api/parties/party-1/events?guestToken=token-123", nil)
+	stream.Header.Set("Accept", "text/event-stream")
+	GuestSessionMiddleware(next).ServeHTTP(httptest.NewRecorder(), stream)
+
+	if gotGuest != "guest-1" {
+		t.Fatalf("expected guest-1 for an event stream, got %q", gotGuest)
+	}
+	if stub.receivedToken != "token-123" {
+		t.Fatalf("expected token-123 to be verified, got %q", stub.receivedToken)
+	}
+
+	gotGuest = ""
+	plain := httptest.NewRequest(http.MethodGet, "/api/parties/party-1/guests?guestToken=token-123", nil)
+	GuestSessionMiddleware(next).ServeHTTP(httptest.NewRecorder(), plain)
+
+	if gotGuest != "" {
+		t.Fatalf("expected no guest identity outside event streams, got %q", gotGuest)
+	}
+	if stub.callCount != 1 {
+		t.Fatalf("expected verifier to be called once, got %d calls", stub.callCount)
+	}
+}
@@ -0,0 +1,321 @@
+package middleware
+
+import (
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// RateLimitConfig configures a RateLimiter.
+type RateLimitConfig struct {
+	// IPRate and IPBurst bound the public requests of one client IP: IPBurst
+	// requests at once, refilled at IPRate per second. Guests at a party
+	// often share one IP, so the burst must cover all of them joining.
+	IPRate  float64
+	IPBurst int
+	// JoinRate and JoinBurst bound the join requests for one party from all
+	// clients together.
+	JoinRate  float64
+	JoinBurst int
+	// LockoutThreshold is how many failed guesses, unknown party codes or
+	// rejected credentials, a client may make before it is locked out for
+	// LockoutBase. Every further failed guess doubles the lockout, up to
+	// LockoutMax. One failed guess is forgotten every MissDecay; successful
+	// requests do not help.
+	LockoutThreshold int
+	LockoutBase      time.Duration
+	LockoutMax       time.Duration
+	MissDecay        time.Duration
+	// TrustProxy takes the client IP from the last X-Forwarded-For entry,
+	// as appended by a reverse proxy in front of the server. Leave it off
+	// when clients connect directly, since they could pick any IP otherwise.
+	TrustProxy bool
+}
+
+// DefaultRateLimitConfig returns limits suited to a party of a few dozen
+// guests sharing one network.
+func DefaultRateLimitConfig() RateLimitConfig {
+	return RateLimitConfig{
+		IPRate:           1,
+		IPBurst:          60,
+		JoinRate:         0.5,
+		JoinBurst:        30,
+		LockoutThreshold: 10,
+		LockoutBase:      time.Minute,
+		LockoutMax:       time.Hour,
+		MissDecay:        time.Minute,
+	}
+}
+
+// rateLimitSweepInterval is how often state of idle clients and parties is dropped.
+const rateLimitSweepInterval = time.Minute
+
+// clientLimit is the state kept per client IP.
+type clientLimit struct {
+	bucket *rate.Limiter
+	misses int
+	// decayedAt is when misses were last reduced by MissDecay.
+	decayedAt   time.Time
+	lockedUntil time.Time
+	lastSeen    time.Time
+}
+
+// partyLimit is the state kept per party code.
+type partyLimit struct {
+	bucket   *rate.Limiter
+	lastSeen time.Time
+}
+
+// RateLimiter protects the endpoints that accept a secret without
+// authentication against brute-forcing and spam: looking a party up and
+// joining it by its code, and signing in to a local account. Other requests
+// pass through untouched.
+type RateLimiter struct {
+	cfg RateLimitConfig
+	now func() time.Time
+
+	mu        sync.Mutex
+	clients   map[string]*clientLimit
+	parties   map[string]*partyLimit
+	lastSweep time.Time
+}
+
+// NewRateLimiter creates a RateLimiter with the given limits.
+func NewRateLimiter(cfg RateLimitConfig) *RateLimiter {
+	return &RateLimiter{
+		cfg:     cfg,
+		now:     time.Now,
+		clients: make(map[string]*clientLimit),
+		parties: make(map[string]*partyLimit),
+	}
+}
+
+// Middleware rejects guarded requests over the limits with 429 Too Many
+// Requests and a Retry-After header. Every request counts against the
+// client's bucket and joins also against the party's. Lookups and joins
+// answered with 404 and sign-ins answered with 401 count as failed guesses
+// towards the client's lockout.
+func (l *RateLimiter) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		guarded, ok := guardRequest(r)
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ip := l.clientIP(r)
+		if wait := l.admit(ip, guarded.partyCode); wait > 0 {
+			w.Header().Set("Retry-After", retryAfterSeconds(wait))
+			http.Error(w, http.StatusText(http.StatusTooManyRequests), http.StatusTooManyRequests)
+			return
+		}
+
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rec, r)
+		l.recordOutcome(ip, rec.status == guarded.missStatus)
+	})
+}
+
+// guardedRequest describes a request the limiter guards.
+type guardedRequest struct {
+	// partyCode is set for joins, which are limited per party as well.
+	partyCode string
+	// missStatus is the response status that marks a failed guess.
+	missStatus int
+}
+
+// guardRequest reports whether r is guarded: looking a party up by its code
+// (GET /api/parties/{code}), joining it (POST /api/parties/{code}/join) or
+// posting credentials or a refresh token to /api/auth/.
+func guardRequest(r *http.Request) (guardedRequest, bool) {
+	if r.Method == http.MethodPost && strings.HasPrefix(r.URL.Path, "/api/auth/") {
+		return guardedRequest{missStatus: http.StatusUnauthorized}, true
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, "/api/parties/")
+	if path == r.URL.Path {
+		return guardedRequest{}, false
+	}
+	segments := strings.Split(path, "/")
+
+	switch {
+	case r.Method == http.MethodGet && len(segments) == 1 && looksLikePartyCode(segments[0]):
+		return guardedRequest{missStatus: http.StatusNotFound}, true
+	case r.Method == http.MethodPost && len(segments) == 2 && segments[1] == "join" && segments[0] != "":
+		return guardedRequest{partyCode: strings.ToUpper(segments[0]), missStatus: http.StatusNotFound}, true
+	}
+	return guardedRequest{}, false
+}
+
+// looksLikePartyCode mirrors how the party handler tells codes from IDs.
+func looksLikePartyCode(s string) bool {
+	if len(s) != 6 {
+		return false
+	}
+	for _, c := range s {
+		if !((c >= 'A' && c <= 'Z') || (c >= '2' && c <= '9')) {
+			return false
+		}
+	}
+	return true
+}
+
+// admit takes a token from the client's bucket and, for joins, from the
+// party's. It returns how long the client has to wait if it may not proceed.
+func (l *RateLimiter) admit(ip, partyCode string) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	client := l.client(ip, now)
+	if now.Before(client.lockedUntil) {
+		return client.lockedUntil.Sub(now)
+	}
+
+	clientRes := client.bucket.ReserveN(now, 1)
+	if wait := clientRes.DelayFrom(now); wait > 0 {
+		clientRes.CancelAt(now)
+		return wait
+	}
+
+	if partyCode != "" {
+		party := l.party(partyCode, now)
+		partyRes := party.bucket.ReserveN(now, 1)
+		if wait := partyRes.DelayFrom(now); wait > 0 {
+			partyRes.CancelAt(now)
+			clientRes.CancelAt(now)
+			return wait
+		}
+	}
+	return 0
+}
+
+// recordOutcome updates the client's lockout with the outcome of a request.
+func (l *RateLimiter) recordOutcome(ip string, miss bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	client, ok := l.clients[ip]
+	if !ok {
+		return
+	}
+
+	now := l.now()
+	l.decayMisses(client, now)
+	if !miss {
+		return
+	}
+
+	client.misses++
+	if client.misses >= l.cfg.LockoutThreshold {
+		client.lockedUntil = now.Add(l.lockout(client.misses - l.cfg.LockoutThreshold))
+		// Sitting out the lockout does not count towards forgetting misses.
+		client.decayedAt = client.lockedUntil
+	}
+}
+
+// decayMisses forgets one of the client's unknown codes for every MissDecay
+// that has passed.
+func (l *RateLimiter) decayMisses(client *clientLimit, now time.Time) {
+	if client.misses == 0 || l.cfg.MissDecay <= 0 {
+		client.decayedAt = now
+		return
+	}
+	forgiven := int(now.Sub(client.decayedAt) / l.cfg.MissDecay)
+	if forgiven <= 0 {
+		return
+	}
+	client.misses = max(0, client.misses-forgiven)
+	client.decayedAt = client.decayedAt.Add(time.Duration(forgiven) * l.cfg.MissDecay)
+}
+
+// lockout returns LockoutBase doubled the given number of times, capped at LockoutMax.
+func (l *RateLimiter) lockout(doublings int) time.Duration {
+	d := l.cfg.LockoutBase
+	for i := 0; i < doublings && d < l.cfg.LockoutMax; i++ {
+		d *= 2
+	}
+	return min(d, l.cfg.LockoutMax)
+}
+
+func (l *RateLimiter) client(ip string, now time.Time) *clientLimit {
+	client, ok := l.clients[ip]
+	if !ok {
+		client = &clientLimit{bucket: rate.NewLimiter(rate.Limit(l.cfg.IPRate), l.cfg.IPBurst)}
+		l.clients[ip] = client
+	}
+	client.lastSeen = now
+	return client
+}
+
+func (l *RateLimiter) party(code string, now time.Time) *partyLimit {
+	party, ok := l.parties[code]
+	if !ok {
+		party = &partyLimit{bucket: rate.NewLimiter(rate.Limit(l.cfg.JoinRate), l.cfg.JoinBurst)}
+		l.parties[code] = party
+	}
+	party.lastSeen = now
+	return party
+}
+
+// sweep drops clients and parties that have been idle long enough for their
+// buckets to refill and their lockouts to be forgotten.
+func (l *RateLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < rateLimitSweepInterval {
+		return
+	}
+	l.lastSweep = now
+
+	idle := max(l.cfg.LockoutMax, rateLimitSweepInterval)
+	for ip, client := range l.clients {
+		if now.Sub(client.lastSeen) > idle && now.After(client.lockedUntil) {
+			delete(l.clients, ip)
+		}
+	}
+	for code, party := range l.parties {
+		if now.Sub(party.lastSeen) > idle {
+			delete(l.parties, code)
+		}
+	}
+}
+
+// clientIP returns the IP the request came from.
+func (l *RateLimiter) clientIP(r *http.Request) string {
+	if l.cfg.TrustProxy {
+		if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+			hops := strings.Split(forwarded, ",")
+			if ip := strings.TrimSpace(hops[len(hops)-1]); ip != "" {
+				return ip
+			}
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// retryAfterSeconds formats a wait for the Retry-After header, rounding up.
+func retryAfterSeconds(wait time.Duration) string {
+	return strconv.Itoa(max(1, int(math.Ceil(wait.Seconds()))))
+}
+
+// statusRecorder captures the status code written by the next handler.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
@@ -0,0 +1,225 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// newTestRateLimiter returns a RateLimiter with a clock the test controls.
+func newTestRateLimiter(cfg RateLimitConfig) (*RateLimiter, *time.Time) {
+	now := time.Date(2026, 5, 16, 21, 0, 0, 0, time.UTC)
+	limiter := NewRateLimiter(cfg)
+	limiter.now = func() time.Time { return now }
+	return limiter, &now
+}
+
+func serveRateLimited(handler http.Handler, method, path, remoteAddr string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, nil)
+	req.RemoteAddr = remoteAddr
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func statusHandler(status int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+	})
+}
+
+func TestRateLimiterLimitsLookupsPerIP(t *testing.T) {
+	cfg := DefaultRateLimitConfig()
+	cfg.IPBurst = 2
+	limiter, now := newTestRateLimiter(cfg)
+	handler := limiter.Middleware(statusHandler(http.StatusOK))
+
+	for i := 0; i < 2; i++ {
+		if rec := serveRateLimited(handler, http.MethodGet, "/api/parties/ABC234", "10.0.0.1:1234"); rec.Code != http.StatusOK {
+			t.Fatalf("request %d: expected status %d, got %d", i, http.StatusOK, rec.Code)
+		}
+	}
+
+	rec := serveRateLimited(handler, http.MethodGet, "/api/parties/ABC234", "10.0.0.1:1234")
+	if rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected status %d, got %d", http.StatusTooManyRequests, rec.Code)
+	}
+	if got := rec.Header().Get("Retry-After"); got != "1" {
+		t.Fatalf("expected Retry-After 1, got %q", got)
+	}
+
+	if rec := serveRateLimited(handler, http.MethodGet, "/api/parties/ABC234", "10.0.0.2:1234"); rec.Code != http.StatusOK {
+		t.Fatalf("expected other IP to pass, got %d", rec.Code)
+	}
+
+	*now = now.Add(time.Second)
+	if rec := serveRateLimited(handler, http.MethodGet, "/api/parties/ABC234", "10.0.0.1:1234"); rec.Code != http.StatusOK {
+		t.Fatalf("expected request after refill to pass, got %d", rec.Code)
+	}
+}
+
+func TestRateLimiterLimitsJoinsPerParty(t *testing.T) {
+	cfg := DefaultRateLimitConfig()
+	cfg.JoinBurst = 2
+	limiter, _ := newTestRateLimiter(cfg)
+	handler := limiter.Middleware(statusHandler(http.StatusCreated))
+
+	serveRateLimited(handler, http.MethodPost, "/api/parties/ABC234/join", "10.0.0.1:1234")
+	serveRateLimited(handler, http.MethodPost, "/api/parties/abc234/join", "10.0.0.2:1234")
+
+	rec := serveRateLimited(handler, http.MethodPost, "/api/parties/ABC234/join", "10.0.0.3:1234")
+	if rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected status %d, got %d", http.StatusTooManyRequests, rec.Code)
+	}
+	if got := rec.Header().Get("Retry-After"); got != "2" {
+		t.Fatalf("expected Retry-After 2, got %q", got)
+	}
+
+	if rec := serveRateLimited(handler, http.MethodPost, "/api/parties/XYZ789/join", "10.0.0.3:1234"); rec.Code != http.StatusCreated {
+		t.Fatalf("expected join of other party to pass, got %d", rec.Code)
+	}
+}
+
+func TestRateLimiterLocksOutRepeatedUnknownCodes(t *testing.T) {
+	cfg := DefaultRateLimitConfig()
+	cfg.LockoutThreshold = 3
+	limiter, now := newTestRateLimiter(cfg)
+	handler := limiter.Middleware(statusHandler(http.StatusNotFound))
+
+	for i := 0; i < 3; i++ {
+		if rec := serveRateLimited(handler, http.MethodGet, "/api/parties/ABC234", "10.0.0.1:1234"); rec.Code != http.StatusNotFound {
+			t.Fatalf("lookup %d: expected status %d, got %d", i, http.StatusNotFound, rec.Code)
+		}
+	}
+
+	rec := serveRateLimited(handler, http.MethodGet, "/api/parties/ABC234", "10.0.0.1:1234")
+	if rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected status %d, got %d", http.StatusTooManyRequests, rec.Code)
+	}
+	if got := rec.Header().Get("Retry-After"); got != "60" {
+		t.Fatalf("expected Retry-After 60, got %q", got)
+	}
+
+	*now = now.Add(time.Minute)
+	serveRateLimited(handler, http.MethodGet, "/api/parties/ABC234", "10.0.0.1:1234")
+
+	rec = serveRateLimited(handler, http.MethodGet, "/api/parties/ABC234", "10.0.0.1:1234")
+	if got := rec.Header().Get("Retry-After"); got != "120" {
+		t.Fatalf("expected lockout to double to 120s, got %q", got)
+	}
+}
+
+func TestRateLimiterKnownCodesDoNotResetMisses(t *testing.T) {
+	cfg := DefaultRateLimitConfig()
+	cfg.LockoutThreshold = 3
+	limiter, _ := newTestRateLimiter(cfg)
+
+	handler := limiter.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/parties/KNOWN2" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+
+	for i := 0; i < 3; i++ {
+		serveRateLimited(handler, http.MethodGet, "/api/parties/KNOWN2", "10.0.0.1:1234")
+		if rec := serveRateLimited(handler, http.MethodGet, "/api/parties/ABC234", "10.0.0.1:1234"); rec.Code != http.StatusNotFound {
+			t.Fatalf("guess %d: expected status %d, got %d", i, http.StatusNotFound, rec.Code)
+		}
+	}
+
+	if rec := serveRateLimited(handler, http.MethodGet, "/api/parties/KNOWN2", "10.0.0.1:1234"); rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected lockout despite known codes in between, got %d", rec.Code)
+	}
+}
+
+func TestRateLimiterForgetsMissesOverTime(t *testing.T) {
+	cfg := DefaultRateLimitConfig()
+	cfg.LockoutThreshold = 3
+	limiter, now := newTestRateLimiter(cfg)
+	handler := limiter.Middleware(statusHandler(http.StatusNotFound))
+
+	serveRateLimited(handler, http.MethodGet, "/api/parties/ABC234", "10.0.0.1:1234")
+	serveRateLimited(handler, http.MethodGet, "/api/parties/ABC234", "10.0.0.1:1234")
+
+	*now = now.Add(2 * cfg.MissDecay)
+	for i := 0; i < 3; i++ {
+		if rec := serveRateLimited(handler, http.MethodGet, "/api/parties/ABC234", "10.0.0.1:1234"); rec.Code != http.StatusNotFound {
+			t.Fatalf("guess %d: expected earlier misses to be forgotten, got %d", i, rec.Code)
+		}
+	}
+
+	if rec := serveRateLimited(handler, http.MethodGet, "/api/parties/ABC234", "10.0.0.1:1234"); rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected lockout after three recent misses, got %d", rec.Code)
+	}
+}
+
+func TestRateLimiterGuardsSignIn(t *testing.T) {
+	cfg := DefaultRateLimitConfig()
+	cfg.LockoutThreshold = 2
+	limiter, _ := newTestRateLimiter(cfg)
+	handler := limiter.Middleware(statusHandler(http.StatusUnauthorized))
+
+	for i := 0; i < 2; i++ {
+		if rec := serveRateLimited(handler, http.MethodPost, "/api/auth/login", "10.0.0.1:1234"); rec.Code != http.StatusUnauthorized {
+			t.Fatalf("attempt %d: expected status %d, got %d", i, http.StatusUnauthorized, rec.Code)
+		}
+	}
+
+	rec := serveRateLimited(handler, http.MethodPost, "/api/auth/refresh", "10.0.0.1:1234")
+	if rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected status %d, got %d", http.StatusTooManyRequests, rec.Code)
+	}
+	if got := rec.Header().Get("Retry-After"); got != "60" {
+		t.Fatalf("expected Retry-After 60, got %q", got)
+	}
+}
+
+func TestRateLimiterLockoutIsCapped(t *testing.T) {
+	cfg := DefaultRateLimitConfig()
+	limiter := NewRateLimiter(cfg)
+
+	if got := limiter.lockout(0); got != cfg.LockoutBase {
+		t.Fatalf("expected %v, got %v", cfg.LockoutBase, got)
+	}
+	if got := limiter.lockout(1000); got != cfg.LockoutMax {
+		t.Fatalf("expected %v, got %v", cfg.LockoutMax, got)
+	}
+}
+
+func TestRateLimiterIgnoresOtherRequests(t *testing.T) {
+	cfg := DefaultRateLimitConfig()
+	cfg.IPBurst = 1
+	limiter, _ := newTestRateLimiter(cfg)
+	handler := limiter.Middleware(statusHandler(http.StatusOK))
+
+	requests := []struct{ method, path string }{
+		{http.MethodGet, "/api/parties/party-123"},
+		{http.MethodGet, "/api/parties/ABC234/guests"},
+		{http.MethodPost, "/api/parties"},
+		{http.MethodDelete, "/api/parties/ABC234"},
+		{http.MethodGet, "/api/songs"},
+	}
+	for _, r := range requests {
+		for i := 0; i < 3; i++ {
+			if rec := serveRateLimited(handler, r.method, r.path, "10.0.0.1:1234"); rec.Code != http.StatusOK {
+				t.Fatalf("%s %s: expected status %d, got %d", r.method, r.path, http.StatusOK, rec.Code)
+			}
+		}
+	}
+}
+
+func TestRateLimiterClientIP(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.RemoteAddr = "10.0.0.1:1234"
+	req.Header.Set("X-Forwarded-For", "1.2.3.4, 203.0.113.7")
+
+	if got := NewRateLimiter(RateLimitConfig{}).clientIP(req); got != "10.0.0.1" {
+		t.Fatalf("expected remote address without proxy, got %q", got)
+	}
+	if got := NewRateLimiter(RateLimitConfig{TrustProxy: true}).clientIP(req); got != "203.0.113.7" {
+		t.Fatalf("expected last forwarded IP behind proxy, got %q", got)
+	}
+}
@@ -0,0 +1,56 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Account is a locally managed login used when the server authenticates
+// users itself instead of delegating to Firebase or an OIDC provider. Its ID
+// is the user ID the rest of the system sees.
+type Account struct {
+	ID    string `firestore:"id" json:"id"`
+	Email string `firestore:"email" json:"email"`
+	// PasswordHash is the bcrypt hash of the password. It is never exposed via the API.
+	PasswordHash string    `firestore:"passwordHash" json:"-"`
+	CreatedAt    time.Time `firestore:"createdAt" json:"createdAt"`
+	// SessionID is embedded in every refresh token issued for the account.
+	// Replacing it revokes them all. It is never exposed via the API.
+	SessionID string `firestore:"sessionId" json:"-"`
+}
+
+// NormalizeEmail returns the canonical form of an email address, under which
+// accounts are stored and looked up.
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// ValidateEmail checks that the address has a local part and a domain.
+func ValidateEmail(email string) error {
+	local, domain, ok := strings.Cut(email, "@")
+	if !ok || local == "" || domain == "" || strings.ContainsAny(email, " \t\r\n") {
+		return fmt.Errorf("email %q is invalid", email)
+	}
+	return nil
+}
+
+// Validate ensures account data adheres to expected constraints.
+func (a Account) Validate() error {
+	if strings.TrimSpace(a.ID) == "" {
+		return fmt.Errorf("id is required")
+	}
+	if err := ValidateEmail(a.Email); err != nil {
+		return err
+	}
+	if a.PasswordHash == "" {
+		return fmt.Errorf("password hash is required")
+	}
+	if a.SessionID == "" {
+		return fmt.Errorf("session id is required")
+	}
+	if a.CreatedAt.IsZero() {
+		return fmt.Errorf("created at timestamp is required")
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAccountValidate(t *testing.T) {
+	base := Account{
+		ID:           "account-1",
+		Email:        "mango@example.com",
+		PasswordHash: "$2a$10$hash",
+		SessionID:    "session-1",
+		CreatedAt:    time.Now(),
+	}
+
+	t.Run("valid account", func(t *testing.T) {
+		if err := base.Validate(); err != nil {
+			t.Fatalf("expected validation to succeed, got %v", err)
+		}
+	})
+
+	tests := map[string]func(a *Account){
+		"missing id":            func(a *Account) { a.ID = "" },
+		"missing email":         func(a *Account) { a.Email = "" },
+		"email without domain":  func(a *Account) { a.Email = "mango@" },
+		"email without at sign": func(a *Account) { a.Email = "mango.example.com" },
+		"missing password hash": func(a *Account) { a.PasswordHash = "" },
+		"missing session id":    func(a *Account) { a.SessionID = "" },
+		"missing created at":    func(a *Account) { a.CreatedAt = time.Time{} },
+	}
+
+	for name, mutate := range tests {
+		t.Run(name, func(t *testing.T) {
+			account := base
+			mutate(&account)
+			if err := account.Validate(); err == nil {
+				t.Fatalf("expected validation to fail for %s", name)
+			}
+		})
+	}
+}
+
+func TestNormalizeEmail(t *testing.T) {
+	if got := NormalizeEmail("  Mango@Example.COM "); got != "mango@example.com" {
+		t.Fatalf("expected normalized email, got %q", got)
+	}
+}
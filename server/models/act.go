@@ -5,14 +5,49 @@ import (
 	"strings"
 )
 
-// Act represents an entry performing at Eurovision.
+// Qualification records whether a semifinal act advanced to the grand final.
+type Qualification string
+
+const (
+	// QualificationPending means the act's semifinal has not been decided yet.
+	QualificationPending Qualification = ""
+	// QualificationQualified means the act advanced to the grand final.
+	QualificationQualified Qualification = "qualified"
+	// QualificationEliminated means the act did not advance to the grand final.
+	QualificationEliminated Qualification = "eliminated"
+)
+
+// IsValid reports whether the qualification status is recognised.
+func (q Qualification) IsValid() bool {
+	switch q {
+	case QualificationPending, QualificationQualified, QualificationEliminated:
+		return true
+	default:
+		return false
+	}
+}
+
+// Act represents an entry performing in a contest edition.
+//
+// Acts that qualify for the grand final directly, such as the Big Five and the
+// host, perform in EventGrandFinal. Semifinal acts join the grand final once
+// they are marked as qualified; FinalRunningOrder then gives their position in
+// the grand final, while RunningOrder keeps their semifinal position.
 type Act struct {
-	ID           string    `json:"id"`
-	Country      string    `json:"country"`
-	Artist       string    `json:"artist"`
-	Song         string    `json:"song"`
-	RunningOrder int       `json:"runningOrder"`
-	EventType    EventType `json:"eventType"`
+	ID                string        `firestore:"id" json:"id"`
+	Contest           string        `firestore:"contest" json:"contest"`
+	Country           string        `firestore:"country" json:"country"`
+	Artist            string        `firestore:"artist" json:"artist"`
+	Song              string        `firestore:"song" json:"song"`
+	RunningOrder      int           `firestore:"runningOrder" json:"runningOrder"`
+	EventType         EventType     `firestore:"eventType" json:"eventType"`
+	Qualification     Qualification `firestore:"qualification" json:"qualification,omitempty"`
+	FinalRunningOrder int           `firestore:"finalRunningOrder" json:"finalRunningOrder,omitempty"`
+}
+
+// Qualified reports whether the semifinal act advanced to the grand final.
+func (a Act) Qualified() bool {
+	return a.EventType != EventGrandFinal && a.Qualification == QualificationQualified
 }
 
 // Validate checks that the act contains essential information.
@@ -32,5 +67,17 @@ func (a Act) Validate() error {
 	if !a.EventType.IsValid() {
 		return fmt.Errorf("event type %q is invalid", string(a.EventType))
 	}
+	if !a.Qualification.IsValid() {
+		return fmt.Errorf("qualification %q is invalid", string(a.Qualification))
+	}
+	if a.EventType == EventGrandFinal && a.Qualification != QualificationPending {
+		return fmt.Errorf("grand final acts cannot qualify")
+	}
+	if a.FinalRunningOrder < 0 {
+		return fmt.Errorf("final running order must not be negative")
+	}
+	if a.FinalRunningOrder > 0 && !a.Qualified() {
+		return fmt.Errorf("final running order requires a qualified act")
+	}
 	return nil
 }
@@ -58,6 +58,34 @@ func TestActValidate(t *testing.T) {
 			RunningOrder: base.RunningOrder,
 			EventType:    EventType("invalid"),
 		},
+		"invalid qualification": {
+			ID:            base.ID,
+			Country:       base.Country,
+			Artist:        base.Artist,
+			Song:          base.Song,
+			RunningOrder:  base.RunningOrder,
+			EventType:     EventSemifinal1,
+			Qualification: Qualification("maybe"),
+		},
+		"qualified grand final act": {
+			ID:            base.ID,
+			Country:       base.Country,
+			Artist:        base.Artist,
+			Song:          base.Song,
+			RunningOrder:  base.RunningOrder,
+			EventType:     EventGrandFinal,
+			Qualification: QualificationQualified,
+		},
+		"final running order without qualifying": {
+			ID:                base.ID,
+			Country:           base.Country,
+			Artist:            base.Artist,
+			Song:              base.Song,
+			RunningOrder:      base.RunningOrder,
+			EventType:         EventSemifinal1,
+			Qualification:     QualificationEliminated,
+			FinalRunningOrder: 4,
+		},
 	}
 
 	for name, act := range tests {
@@ -68,3 +96,28 @@ func TestActValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestActQualified(t *testing.T) {
+	semifinalist := Act{
+		ID:                "act-1",
+		Country:           "Sweden",
+		Artist:            "Loreen",
+		Song:              "Tattoo",
+		RunningOrder:      5,
+		EventType:         EventSemifinal2,
+		Qualification:     QualificationQualified,
+		FinalRunningOrder: 3,
+	}
+	if err := semifinalist.Validate(); err != nil {
+		t.Fatalf("expected qualified semifinalist to be valid, got %v", err)
+	}
+	if !semifinalist.Qualified() {
+		t.Fatal("expected semifinalist to be qualified")
+	}
+	if (Act{EventType: EventSemifinal1}).Qualified() {
+		t.Fatal("expected pending semifinalist not to be qualified")
+	}
+	if (Act{EventType: EventGrandFinal}).Qualified() {
+		t.Fatal("expected grand final act not to count as qualified")
+	}
+}
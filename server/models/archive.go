@@ -0,0 +1,101 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// ArchiveVersion is the format version of the party archives written by this server.
+// Archives of a newer version are rejected; older versions stay readable.
+const ArchiveVersion = 1
+
+// PartyArchive bundles a party with its guests, ballots and predictions, and
+// the acts it was scored against, so that it can be kept outside the database
+// and recreated later.
+type PartyArchive struct {
+	Version     int                `json:"version"`
+	ExportedAt  time.Time          `json:"exportedAt"`
+	Party       Party              `json:"party"`
+	Guests      []Guest            `json:"guests"`
+	Votes       []Vote             `json:"votes"`
+	Predictions []Prediction       `json:"predictions"`
+	Outcome     *PredictionOutcome `json:"outcome,omitempty"`
+	Acts        []Act              `json:"acts"`
+}
+
+// Validate ensures the archive is of a supported version and internally
+// consistent: every record belongs to the archived party and every ballot and
+// prediction to one of its guests.
+func (a PartyArchive) Validate() error {
+	if a.Version < 1 || a.Version > ArchiveVersion {
+		return fmt.Errorf("archive version %d is not supported", a.Version)
+	}
+	if a.Party.ID == "" {
+		return fmt.Errorf("party id is required")
+	}
+	if err := a.Party.Validate(); err != nil {
+		return fmt.Errorf("party: %w", err)
+	}
+
+	guests := make(map[string]bool, len(a.Guests))
+	for _, g := range a.Guests {
+		if g.ID == "" {
+			return fmt.Errorf("guest id is required")
+		}
+		if guests[g.ID] {
+			return fmt.Errorf("duplicate guest %q", g.ID)
+		}
+		guests[g.ID] = true
+		if g.PartyID != a.Party.ID {
+			return fmt.Errorf("guest %q belongs to another party", g.ID)
+		}
+		if err := g.Validate(); err != nil {
+			return fmt.Errorf("guest %q: %w", g.ID, err)
+		}
+	}
+
+	voted := make(map[string]bool, len(a.Votes))
+	for _, v := range a.Votes {
+		if v.PartyID != a.Party.ID {
+			return fmt.Errorf("vote of guest %q belongs to another party", v.GuestID)
+		}
+		if !guests[v.GuestID] {
+			return fmt.Errorf("vote of unknown guest %q", v.GuestID)
+		}
+		if voted[v.GuestID] {
+			return fmt.Errorf("duplicate vote of guest %q", v.GuestID)
+		}
+		voted[v.GuestID] = true
+		if err := v.Validate(); err != nil {
+			return fmt.Errorf("vote of guest %q: %w", v.GuestID, err)
+		}
+	}
+
+	predicted := make(map[string]bool, len(a.Predictions))
+	for _, p := range a.Predictions {
+		if p.PartyID != a.Party.ID {
+			return fmt.Errorf("prediction of guest %q belongs to another party", p.GuestID)
+		}
+		if !guests[p.GuestID] {
+			return fmt.Errorf("prediction of unknown guest %q", p.GuestID)
+		}
+		if predicted[p.GuestID] {
+			return fmt.Errorf("duplicate prediction of guest %q", p.GuestID)
+		}
+		predicted[p.GuestID] = true
+		if err := p.Validate(); err != nil {
+			return fmt.Errorf("prediction of guest %q: %w", p.GuestID, err)
+		}
+	}
+
+	if a.Outcome != nil {
+		if a.Outcome.PartyID != a.Party.ID {
+			return fmt.Errorf("prediction outcome belongs to another party")
+		}
+		if err := a.Outcome.Validate(); err != nil {
+			return fmt.Errorf("prediction outcome: %w", err)
+		}
+	}
+
+	return nil
+}
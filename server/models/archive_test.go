@@ -0,0 +1,99 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validArchive() PartyArchive {
+	now := time.Now()
+	return PartyArchive{
+		Version:    ArchiveVersion,
+		ExportedAt: now,
+		Party: Party{
+			ID:        "party-1",
+			Name:      "Grand Final Party",
+			Code:      "ABC234",
+			EventType: EventGrandFinal,
+			AdminID:   "admin-1",
+			Status:    PartyStatusClosed,
+			CreatedAt: now,
+		},
+		Guests: []Guest{
+			{ID: "guest-1", PartyID: "party-1", Username: "alice", Status: GuestStatusApproved, CreatedAt: now},
+			{ID: "guest-2", PartyID: "party-1", Username: "bob", Status: GuestStatusPending, CreatedAt: now},
+		},
+		Votes: []Vote{
+			{ID: "party-1_guest-1", GuestID: "guest-1", PartyID: "party-1", Votes: map[int]string{12: "act-1"}, CreatedAt: now},
+		},
+		Predictions: []Prediction{
+			{ID: "party-1_guest-2", PartyID: "party-1", GuestID: "guest-2", Picks: []string{"act-1"}, UpdatedAt: now},
+		},
+		Outcome: &PredictionOutcome{PartyID: "party-1", Picks: []string{"act-1"}, RecordedAt: now},
+	}
+}
+
+func TestPartyArchiveValidate(t *testing.T) {
+	t.Run("valid archive", func(t *testing.T) {
+		require.NoError(t, validArchive().Validate())
+	})
+
+	t.Run("unsupported version", func(t *testing.T) {
+		a := validArchive()
+		a.Version = ArchiveVersion + 1
+		err := a.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not supported")
+	})
+
+	t.Run("invalid party", func(t *testing.T) {
+		a := validArchive()
+		a.Party.Name = ""
+		err := a.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "party: party name is required")
+	})
+
+	t.Run("duplicate guest", func(t *testing.T) {
+		a := validArchive()
+		a.Guests[1].ID = "guest-1"
+		err := a.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "duplicate guest")
+	})
+
+	t.Run("guest of another party", func(t *testing.T) {
+		a := validArchive()
+		a.Guests[0].PartyID = "party-2"
+		err := a.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "belongs to another party")
+	})
+
+	t.Run("vote of unknown guest", func(t *testing.T) {
+		a := validArchive()
+		a.Votes[0].GuestID = "guest-3"
+		err := a.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "vote of unknown guest")
+	})
+
+	t.Run("prediction of unknown guest", func(t *testing.T) {
+		a := validArchive()
+		a.Predictions[0].GuestID = "guest-3"
+		err := a.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "prediction of unknown guest")
+	})
+
+	t.Run("outcome of another party", func(t *testing.T) {
+		a := validArchive()
+		a.Outcome.PartyID = "party-2"
+		err := a.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "outcome belongs to another party")
+	})
+}
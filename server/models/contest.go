@@ -0,0 +1,55 @@
+package models
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// DefaultContest is the contest edition of parties created before parties were
+// bound to a contest, and of act files that do not name one.
+const DefaultContest = "esc-2025"
+
+var contestIDPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// Contest describes one edition of a song contest, such as the Eurovision Song
+// Contest 2025, Junior Eurovision or a national final like Melodifestivalen.
+type Contest struct {
+	// ID identifies the edition, e.g. "esc-2025" or "mello-2026".
+	ID     string      `json:"id"`
+	Name   string      `json:"name"`
+	Year   int         `json:"year"`
+	Events []EventType `json:"events"`
+}
+
+// Validate ensures the contest contains the required data.
+func (c Contest) Validate() error {
+	if !contestIDPattern.MatchString(c.ID) {
+		return fmt.Errorf("contest id %q must be lowercase letters and digits separated by dashes", c.ID)
+	}
+	if strings.TrimSpace(c.Name) == "" {
+		return fmt.Errorf("contest name is required")
+	}
+	if c.Year <= 0 {
+		return fmt.Errorf("contest year must be positive")
+	}
+	if len(c.Events) == 0 {
+		return fmt.Errorf("contest must have at least one event")
+	}
+	for _, event := range c.Events {
+		if !event.IsValid() {
+			return fmt.Errorf("event type %q is invalid", string(event))
+		}
+	}
+	return nil
+}
+
+// HasEvent reports whether the event is part of the contest.
+func (c Contest) HasEvent(event EventType) bool {
+	for _, e := range c.Events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,47 @@
+package models
+
+import "testing"
+
+func TestContestValidate(t *testing.T) {
+	base := Contest{
+		ID:     "esc-2025",
+		Name:   "Eurovision Song Contest 2025",
+		Year:   2025,
+		Events: []EventType{EventSemifinal1, EventSemifinal2, EventGrandFinal},
+	}
+
+	if err := base.Validate(); err != nil {
+		t.Fatalf("expected validation to succeed, got error: %v", err)
+	}
+
+	tests := map[string]func(c *Contest){
+		"uppercase id":  func(c *Contest) { c.ID = "ESC-2025" },
+		"blank id":      func(c *Contest) { c.ID = "" },
+		"trailing dash": func(c *Contest) { c.ID = "esc-" },
+		"missing name":  func(c *Contest) { c.Name = " " },
+		"missing year":  func(c *Contest) { c.Year = 0 },
+		"no events":     func(c *Contest) { c.Events = nil },
+		"invalid event": func(c *Contest) { c.Events = []EventType{"quarterfinal"} },
+	}
+
+	for name, mutate := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := base
+			c.Events = append([]EventType(nil), base.Events...)
+			mutate(&c)
+			if err := c.Validate(); err == nil {
+				t.Fatalf("expected validation error for %s", name)
+			}
+		})
+	}
+}
+
+func TestContestHasEvent(t *testing.T) {
+	c := Contest{Events: []EventType{EventGrandFinal}}
+	if !c.HasEvent(EventGrandFinal) {
+		t.Fatal("expected grand final to be part of the contest")
+	}
+	if c.HasEvent(EventSemifinal1) {
+		t.Fatal("expected semifinal 1 not to be part of the contest")
+	}
+}
@@ -35,6 +35,9 @@ type Guest struct {
 	Username  string      `firestore:"username" json:"username"`
 	Status    GuestStatus `firestore:"status" json:"status"`
 	CreatedAt time.Time   `firestore:"createdAt" json:"createdAt"`
+	// SessionID identifies the guest's current session token. Replacing it
+	// revokes previously issued tokens. It is never exposed via the API.
+	SessionID string `firestore:"sessionId" json:"-"`
 }
 
 // Validate ensures guest data adheres to expected constraints.
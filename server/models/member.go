@@ -0,0 +1,113 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// PartyRole describes what a user may do in a party they help run.
+type PartyRole string
+
+const (
+	// PartyRoleOwner is the role of the party's admin, who created it. Owners
+	// can do everything, including deleting the party and managing its members.
+	PartyRoleOwner PartyRole = "owner"
+	// PartyRoleCoHost runs the show with the owner: besides managing guests,
+	// co-hosts open and close voting, reveal the results and record the
+	// prediction outcome.
+	PartyRoleCoHost PartyRole = "cohost"
+	// PartyRoleModerator approves, rejects and removes guests.
+	PartyRoleModerator PartyRole = "moderator"
+)
+
+// IsValid reports whether the role is one of the supported values.
+func (r PartyRole) IsValid() bool {
+	switch r {
+	case PartyRoleOwner, PartyRoleCoHost, PartyRoleModerator:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsInvitable reports whether users can be invited to the party in this role.
+// Every party has exactly one owner, its admin.
+func (r PartyRole) IsInvitable() bool {
+	return r == PartyRoleCoHost || r == PartyRoleModerator
+}
+
+// MemberStatus tracks whether a party member accepted their invitation.
+type MemberStatus string
+
+const (
+	// MemberStatusInvited marks an invitation that has not been accepted yet;
+	// invited users have no access to the party.
+	MemberStatusInvited MemberStatus = "invited"
+	// MemberStatusActive marks a member who accepted their invitation.
+	MemberStatusActive MemberStatus = "active"
+)
+
+// IsValid reports whether the status is one of the supported values.
+func (s MemberStatus) IsValid() bool {
+	switch s {
+	case MemberStatusInvited, MemberStatusActive:
+		return true
+	default:
+		return false
+	}
+}
+
+// PartyMember is a user who helps the admin run a party.
+type PartyMember struct {
+	UserID string `firestore:"userId" json:"userId"`
+	// Username is the member's profile username when they were invited, for display.
+	Username  string       `firestore:"username" json:"username"`
+	Role      PartyRole    `firestore:"role" json:"role"`
+	Status    MemberStatus `firestore:"status" json:"status"`
+	InvitedBy string       `firestore:"invitedBy" json:"invitedBy"`
+	InvitedAt time.Time    `firestore:"invitedAt" json:"invitedAt"`
+}
+
+// Validate ensures the member contains the required data.
+func (m PartyMember) Validate() error {
+	if strings.TrimSpace(m.UserID) == "" {
+		return fmt.Errorf("user id is required")
+	}
+	if !m.Role.IsInvitable() {
+		return fmt.Errorf("role %q is invalid", string(m.Role))
+	}
+	if !m.Status.IsValid() {
+		return fmt.Errorf("member status %q is invalid", string(m.Status))
+	}
+	if m.InvitedAt.IsZero() {
+		return fmt.Errorf("invited at timestamp is required")
+	}
+	return nil
+}
+
+// Member returns the member entry of a user, or nil if the user is neither a
+// member nor invited. The owner has no member entry.
+func (p Party) Member(userID string) *PartyMember {
+	for i := range p.Members {
+		if p.Members[i].UserID == userID {
+			return &p.Members[i]
+		}
+	}
+	return nil
+}
+
+// RoleOf returns the role of a user in the party and false if the user is
+// neither its owner nor an active member.
+func (p Party) RoleOf(userID string) (PartyRole, bool) {
+	if userID == "" {
+		return "", false
+	}
+	if p.AdminID == userID {
+		return PartyRoleOwner, true
+	}
+	if m := p.Member(userID); m != nil && m.Status == MemberStatusActive {
+		return m.Role, true
+	}
+	return "", false
+}
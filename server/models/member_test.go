@@ -0,0 +1,97 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPartyMemberValidate(t *testing.T) {
+	base := PartyMember{UserID: "user-2", Role: PartyRoleCoHost, Status: MemberStatusInvited, InvitedBy: "admin-1", InvitedAt: time.Now()}
+
+	if err := base.Validate(); err != nil {
+		t.Fatalf("expected validation to succeed, got error: %v", err)
+	}
+
+	missingUser := base
+	missingUser.UserID = ""
+	owner := base
+	owner.Role = PartyRoleOwner
+	invalidStatus := base
+	invalidStatus.Status = MemberStatus("left")
+	zeroInvitedAt := base
+	zeroInvitedAt.InvitedAt = time.Time{}
+
+	tests := map[string]PartyMember{
+		"missing user id": missingUser,
+		"owner role":      owner,
+		"invalid status":  invalidStatus,
+		"zero invited at": zeroInvitedAt,
+	}
+
+	for name, member := range tests {
+		t.Run(name, func(t *testing.T) {
+			if err := member.Validate(); err == nil {
+				t.Fatalf("expected validation to fail for %s", name)
+			}
+		})
+	}
+}
+
+func TestPartyValidateMembers(t *testing.T) {
+	member := PartyMember{UserID: "user-2", Role: PartyRoleModerator, Status: MemberStatusActive, InvitedAt: time.Now()}
+	party := Party{
+		ID: "party-1", Name: "Party", Code: "ABC123", EventType: EventGrandFinal,
+		AdminID: "admin-1", Status: PartyStatusActive, CreatedAt: time.Now(),
+		Members: []PartyMember{member},
+	}
+	if err := party.Validate(); err != nil {
+		t.Fatalf("expected validation to succeed, got error: %v", err)
+	}
+
+	party.Members = []PartyMember{member, member}
+	if err := party.Validate(); err == nil {
+		t.Fatal("expected duplicate members to fail validation")
+	}
+
+	owner := member
+	owner.UserID = party.AdminID
+	party.Members = []PartyMember{owner}
+	if err := party.Validate(); err == nil {
+		t.Fatal("expected the admin as a member to fail validation")
+	}
+}
+
+func TestPartyRoleOf(t *testing.T) {
+	party := Party{
+		AdminID: "admin-1",
+		Members: []PartyMember{
+			{UserID: "user-2", Role: PartyRoleCoHost, Status: MemberStatusActive},
+			{UserID: "user-3", Role: PartyRoleModerator, Status: MemberStatusInvited},
+		},
+	}
+
+	tests := map[string]struct {
+		userID string
+		role   PartyRole
+		ok     bool
+	}{
+		"owner":          {userID: "admin-1", role: PartyRoleOwner, ok: true},
+		"active member":  {userID: "user-2", role: PartyRoleCoHost, ok: true},
+		"invited member": {userID: "user-3"},
+		"stranger":       {userID: "user-4"},
+		"no user":        {userID: ""},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			role, ok := party.RoleOf(tt.userID)
+			if role != tt.role || ok != tt.ok {
+				t.Fatalf("expected (%q, %v), got (%q, %v)", tt.role, tt.ok, role, ok)
+			}
+		})
+	}
+
+	if party.Member("user-3") == nil || party.Member("admin-1") != nil {
+		t.Fatal("expected only listed users to have a member entry")
+	}
+}
@@ -57,6 +57,19 @@ type Party struct {
 	AdminID   string      `firestore:"adminId" json:"adminId"`
 	Status    PartyStatus `firestore:"status" json:"status"`
 	CreatedAt time.Time   `firestore:"createdAt" json:"createdAt"`
+	// Contest is the ID of the contest edition the party watches; empty means DefaultContest.
+	Contest string `firestore:"contest" json:"contest"`
+	// ScoringSystem decides how ballots are validated and tallied; empty means DefaultScoringSystem.
+	ScoringSystem ScoringSystem `firestore:"scoringSystem" json:"scoringSystem"`
+	// RevealStep counts the result reveal steps announced so far; 0 means the reveal has not started.
+	RevealStep int `firestore:"revealStep" json:"revealStep"`
+	// VotingStartedAt records when the admin opened voting; the zero time means voting has not started.
+	VotingStartedAt time.Time `firestore:"votingStartedAt" json:"votingStartedAt,omitzero"`
+	// AnonymousResults hides which guests awarded an act their top points in the results.
+	AnonymousResults bool `firestore:"anonymousResults" json:"anonymousResults"`
+	// Members lists the users who help the admin run the party, including
+	// pending invitations. The admin is the party's owner and not listed.
+	Members []PartyMember `firestore:"members" json:"members,omitempty"`
 }
 
 // Validate ensures the party contains the required data.
@@ -79,5 +92,44 @@ func (p Party) Validate() error {
 	if p.CreatedAt.IsZero() {
 		return fmt.Errorf("created at timestamp is required")
 	}
+	if p.ScoringSystem != "" && !p.ScoringSystem.IsValid() {
+		return fmt.Errorf("scoring system %q is invalid", string(p.ScoringSystem))
+	}
+	if p.RevealStep < 0 {
+		return fmt.Errorf("reveal step must not be negative")
+	}
+	seen := make(map[string]bool, len(p.Members))
+	for _, m := range p.Members {
+		if err := m.Validate(); err != nil {
+			return fmt.Errorf("member %q: %w", m.UserID, err)
+		}
+		if m.UserID == p.AdminID || seen[m.UserID] {
+			return fmt.Errorf("duplicate member %q", m.UserID)
+		}
+		seen[m.UserID] = true
+	}
 	return nil
 }
+
+// Scoring returns the party's scoring system, defaulting to DefaultScoringSystem
+// for parties that have none stored.
+func (p Party) Scoring() ScoringSystem {
+	if p.ScoringSystem == "" {
+		return DefaultScoringSystem
+	}
+	return p.ScoringSystem
+}
+
+// Edition returns the ID of the contest edition the party is bound to,
+// defaulting to DefaultContest for parties that have none stored.
+func (p Party) Edition() string {
+	if p.Contest == "" {
+		return DefaultContest
+	}
+	return p.Contest
+}
+
+// VotingStarted reports whether the admin has opened voting for the party.
+func (p Party) VotingStarted() bool {
+	return !p.VotingStartedAt.IsZero()
+}
@@ -73,6 +73,16 @@ func TestPartyValidate(t *testing.T) {
 			AdminID:   base.AdminID,
 			Status:    base.Status,
 		},
+		"invalid scoring system": {
+			ID:            base.ID,
+			Name:          base.Name,
+			Code:          base.Code,
+			EventType:     base.EventType,
+			AdminID:       base.AdminID,
+			Status:        base.Status,
+			CreatedAt:     base.CreatedAt,
+			ScoringSystem: ScoringSystem("invalid"),
+		},
 	}
 
 	for name, party := range tests {
@@ -83,3 +93,30 @@ func TestPartyValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestPartyScoring(t *testing.T) {
+	if got := (Party{}).Scoring(); got != DefaultScoringSystem {
+		t.Fatalf("expected default scoring system, got %q", got)
+	}
+	if got := (Party{ScoringSystem: ScoringBorda}).Scoring(); got != ScoringBorda {
+		t.Fatalf("expected borda scoring system, got %q", got)
+	}
+}
+
+func TestPartyEdition(t *testing.T) {
+	if got := (Party{}).Edition(); got != DefaultContest {
+		t.Fatalf("expected default contest, got %q", got)
+	}
+	if got := (Party{Contest: "jesc-2025"}).Edition(); got != "jesc-2025" {
+		t.Fatalf("expected jesc-2025, got %q", got)
+	}
+}
+
+func TestPartyVotingStarted(t *testing.T) {
+	if (Party{}).VotingStarted() {
+		t.Fatal("expected voting not to have started")
+	}
+	if !(Party{VotingStartedAt: time.Now()}).VotingStarted() {
+		t.Fatal("expected voting to have started")
+	}
+}
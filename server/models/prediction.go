@@ -0,0 +1,130 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// QualifiersPerSemifinal is the number of acts that qualify from a semifinal.
+	QualifiersPerSemifinal = 10
+	// PodiumSize is the number of top placed grand final acts guests predict.
+	PodiumSize = 3
+)
+
+const (
+	// QualifierPredictionPoints are awarded for each correctly predicted qualifier.
+	QualifierPredictionPoints = 1
+	// PodiumPredictionPoints are awarded for each predicted act that finishes in the top three.
+	PodiumPredictionPoints = 2
+	// WinnerPredictionPoints are awarded on top for predicting the winner.
+	WinnerPredictionPoints = 5
+)
+
+// Prediction records a guest's guess at the outcome of the show a party watches.
+// For a semifinal, Picks lists the acts the guest expects to qualify; for the
+// grand final, it lists the expected top three with the winner first.
+type Prediction struct {
+	ID        string    `firestore:"id" json:"id"`
+	PartyID   string    `firestore:"partyId" json:"partyId"`
+	GuestID   string    `firestore:"guestId" json:"guestId"`
+	Picks     []string  `firestore:"picks" json:"picks"`
+	UpdatedAt time.Time `firestore:"updatedAt" json:"updatedAt"`
+}
+
+// PredictionIDFor returns the deterministic ID of a guest's prediction in a party.
+func PredictionIDFor(partyID, guestID string) string {
+	return partyID + "_" + guestID
+}
+
+// Validate ensures the prediction is well-formed. Whether the picks fit the
+// party's show is checked against its lineup; see PredictionPicks.
+func (p Prediction) Validate() error {
+	if strings.TrimSpace(p.PartyID) == "" {
+		return fmt.Errorf("party id is required")
+	}
+	if strings.TrimSpace(p.GuestID) == "" {
+		return fmt.Errorf("guest id is required")
+	}
+	if err := validatePicks(p.Picks); err != nil {
+		return err
+	}
+	if p.UpdatedAt.IsZero() {
+		return fmt.Errorf("updated at timestamp is required")
+	}
+	return nil
+}
+
+// Score returns the points the prediction earns against the official outcome of
+// the given show, together with the number of picks that came true.
+func (p Prediction) Score(eventType EventType, outcome PredictionOutcome) (points, correct int) {
+	official := make(map[string]bool, len(outcome.Picks))
+	for _, actID := range outcome.Picks {
+		official[actID] = true
+	}
+
+	perPick := QualifierPredictionPoints
+	if eventType == EventGrandFinal {
+		perPick = PodiumPredictionPoints
+	}
+	for _, actID := range p.Picks {
+		if official[actID] {
+			points += perPick
+			correct++
+		}
+	}
+
+	if eventType == EventGrandFinal && len(p.Picks) > 0 && len(outcome.Picks) > 0 && p.Picks[0] == outcome.Picks[0] {
+		points += WinnerPredictionPoints
+	}
+	return points, correct
+}
+
+// PredictionOutcome is the official outcome of the show a party watches, as
+// entered by the party's admin. Picks has the same shape as Prediction.Picks.
+type PredictionOutcome struct {
+	PartyID    string    `firestore:"partyId" json:"partyId"`
+	Picks      []string  `firestore:"picks" json:"picks"`
+	RecordedAt time.Time `firestore:"recordedAt" json:"recordedAt"`
+}
+
+// Validate ensures the outcome is well-formed.
+func (o PredictionOutcome) Validate() error {
+	if strings.TrimSpace(o.PartyID) == "" {
+		return fmt.Errorf("party id is required")
+	}
+	if err := validatePicks(o.Picks); err != nil {
+		return err
+	}
+	if o.RecordedAt.IsZero() {
+		return fmt.Errorf("recorded at timestamp is required")
+	}
+	return nil
+}
+
+// PredictionPicks returns how many picks a prediction for the given show
+// needs when the given number of acts compete in it.
+func PredictionPicks(eventType EventType, acts int) int {
+	if eventType == EventGrandFinal {
+		return min(PodiumSize, acts)
+	}
+	return min(QualifiersPerSemifinal, acts)
+}
+
+func validatePicks(picks []string) error {
+	if len(picks) == 0 {
+		return fmt.Errorf("at least one pick is required")
+	}
+	seen := make(map[string]bool, len(picks))
+	for _, actID := range picks {
+		if strings.TrimSpace(actID) == "" {
+			return fmt.Errorf("act id is required for every pick")
+		}
+		if seen[actID] {
+			return fmt.Errorf("duplicate pick %q", actID)
+		}
+		seen[actID] = true
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPredictionValidate(t *testing.T) {
+	valid := func() Prediction {
+		return Prediction{
+			ID:        PredictionIDFor("party-1", "guest-1"),
+			PartyID:   "party-1",
+			GuestID:   "guest-1",
+			Picks:     []string{"act-1", "act-2", "act-3"},
+			UpdatedAt: time.Now(),
+		}
+	}
+
+	t.Run("valid prediction", func(t *testing.T) {
+		require.NoError(t, valid().Validate())
+	})
+
+	t.Run("missing guest id", func(t *testing.T) {
+		p := valid()
+		p.GuestID = ""
+		err := p.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "guest id is required")
+	})
+
+	t.Run("no picks", func(t *testing.T) {
+		p := valid()
+		p.Picks = nil
+		err := p.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "at least one pick is required")
+	})
+
+	t.Run("duplicate pick", func(t *testing.T) {
+		p := valid()
+		p.Picks = []string{"act-1", "act-1", "act-2"}
+		err := p.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "duplicate pick")
+	})
+
+	t.Run("missing updated at", func(t *testing.T) {
+		p := valid()
+		p.UpdatedAt = time.Time{}
+		err := p.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "updated at timestamp is required")
+	})
+}
+
+func TestPredictionOutcomeValidate(t *testing.T) {
+	outcome := PredictionOutcome{PartyID: "party-1", Picks: []string{"act-1"}, RecordedAt: time.Now()}
+	require.NoError(t, outcome.Validate())
+
+	outcome.Picks = []string{"act-1", " "}
+	err := outcome.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "act id is required")
+}
+
+func TestPredictionScore(t *testing.T) {
+	t.Run("semifinal counts qualifiers", func(t *testing.T) {
+		p := Prediction{Picks: []string{"act-1", "act-2", "act-3"}}
+		outcome := PredictionOutcome{Picks: []string{"act-3", "act-1", "act-9"}}
+
+		points, correct := p.Score(EventSemifinal1, outcome)
+
+		assert.Equal(t, 2*QualifierPredictionPoints, points)
+		assert.Equal(t, 2, correct)
+	})
+
+	t.Run("grand final rewards podium and winner", func(t *testing.T) {
+		p := Prediction{Picks: []string{"act-1", "act-2", "act-7"}}
+		outcome := PredictionOutcome{Picks: []string{"act-1", "act-3", "act-2"}}
+
+		points, correct := p.Score(EventGrandFinal, outcome)
+
+		assert.Equal(t, 2*PodiumPredictionPoints+WinnerPredictionPoints, points)
+		assert.Equal(t, 2, correct)
+	})
+
+	t.Run("grand final without the winner", func(t *testing.T) {
+		p := Prediction{Picks: []string{"act-3", "act-1", "act-2"}}
+		outcome := PredictionOutcome{Picks: []string{"act-1", "act-3", "act-2"}}
+
+		points, correct := p.Score(EventGrandFinal, outcome)
+
+		assert.Equal(t, 3*PodiumPredictionPoints, points)
+		assert.Equal(t, 3, correct)
+	})
+}
+
+func TestPredictionPicks(t *testing.T) {
+	assert.Equal(t, QualifiersPerSemifinal, PredictionPicks(EventSemifinal1, 15))
+	assert.Equal(t, 8, PredictionPicks(EventSemifinal2, 8))
+	assert.Equal(t, PodiumSize, PredictionPicks(EventGrandFinal, 26))
+	assert.Equal(t, 2, PredictionPicks(EventGrandFinal, 2))
+}
@@ -0,0 +1,81 @@
+package models
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ScoreboardEntry holds the official points an act received in a show.
+type ScoreboardEntry struct {
+	ActID          string `firestore:"actId" json:"actId"`
+	JuryPoints     int    `firestore:"juryPoints" json:"juryPoints"`
+	TelevotePoints int    `firestore:"televotePoints" json:"televotePoints"`
+}
+
+// Total returns the act's combined jury and televote points.
+func (e ScoreboardEntry) Total() int {
+	return e.JuryPoints + e.TelevotePoints
+}
+
+// Scoreboard is the official result of one show of a contest edition.
+type Scoreboard struct {
+	Contest    string            `firestore:"contest" json:"contest"`
+	EventType  EventType         `firestore:"eventType" json:"eventType"`
+	Entries    []ScoreboardEntry `firestore:"entries" json:"entries"`
+	ImportedAt time.Time         `firestore:"importedAt" json:"importedAt"`
+}
+
+// Validate ensures the scoreboard contains the required data.
+func (s Scoreboard) Validate() error {
+	if strings.TrimSpace(s.Contest) == "" {
+		return fmt.Errorf("contest is required")
+	}
+	if !s.EventType.IsValid() {
+		return fmt.Errorf("event type %q is invalid", string(s.EventType))
+	}
+	if len(s.Entries) == 0 {
+		return fmt.Errorf("at least one entry is required")
+	}
+	seen := make(map[string]bool, len(s.Entries))
+	for _, entry := range s.Entries {
+		if strings.TrimSpace(entry.ActID) == "" {
+			return fmt.Errorf("act id is required for every entry")
+		}
+		if seen[entry.ActID] {
+			return fmt.Errorf("duplicate entry for act %q", entry.ActID)
+		}
+		seen[entry.ActID] = true
+		if entry.JuryPoints < 0 || entry.TelevotePoints < 0 {
+			return fmt.Errorf("points for act %q must not be negative", entry.ActID)
+		}
+	}
+	if s.ImportedAt.IsZero() {
+		return fmt.Errorf("imported at timestamp is required")
+	}
+	return nil
+}
+
+// Ranks returns the official rank of every act on the scoreboard. Acts are
+// ranked by total points; as in the contest rules, the televote breaks ties,
+// and acts level on both share a rank.
+func (s Scoreboard) Ranks() map[string]int {
+	entries := append([]ScoreboardEntry(nil), s.Entries...)
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].Total() != entries[j].Total() {
+			return entries[i].Total() > entries[j].Total()
+		}
+		return entries[i].TelevotePoints > entries[j].TelevotePoints
+	})
+
+	ranks := make(map[string]int, len(entries))
+	for i, entry := range entries {
+		if i > 0 && entry.Total() == entries[i-1].Total() && entry.TelevotePoints == entries[i-1].TelevotePoints {
+			ranks[entry.ActID] = ranks[entries[i-1].ActID]
+		} else {
+			ranks[entry.ActID] = i + 1
+		}
+	}
+	return ranks
+}
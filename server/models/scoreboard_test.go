@@ -0,0 +1,77 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validScoreboard() Scoreboard {
+	return Scoreboard{
+		Contest:   "esc-2025",
+		EventType: EventGrandFinal,
+		Entries: []ScoreboardEntry{
+			{ActID: "act-1", JuryPoints: 200, TelevotePoints: 100},
+			{ActID: "act-2", JuryPoints: 100, TelevotePoints: 300},
+		},
+		ImportedAt: time.Now(),
+	}
+}
+
+func TestScoreboardValidate(t *testing.T) {
+	t.Run("valid scoreboard", func(t *testing.T) {
+		require.NoError(t, validScoreboard().Validate())
+	})
+
+	t.Run("invalid event type", func(t *testing.T) {
+		s := validScoreboard()
+		s.EventType = "final"
+		err := s.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "event type")
+	})
+
+	t.Run("no entries", func(t *testing.T) {
+		s := validScoreboard()
+		s.Entries = nil
+		err := s.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "at least one entry is required")
+	})
+
+	t.Run("duplicate entry", func(t *testing.T) {
+		s := validScoreboard()
+		s.Entries[1].ActID = "act-1"
+		err := s.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "duplicate entry")
+	})
+
+	t.Run("negative points", func(t *testing.T) {
+		s := validScoreboard()
+		s.Entries[0].JuryPoints = -1
+		err := s.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "must not be negative")
+	})
+}
+
+func TestScoreboardRanks(t *testing.T) {
+	s := Scoreboard{Entries: []ScoreboardEntry{
+		{ActID: "act-1", JuryPoints: 100, TelevotePoints: 50},
+		{ActID: "act-2", JuryPoints: 50, TelevotePoints: 100},
+		{ActID: "act-3", JuryPoints: 200, TelevotePoints: 0},
+		{ActID: "act-4", JuryPoints: 100, TelevotePoints: 50},
+		{ActID: "act-5", JuryPoints: 10, TelevotePoints: 5},
+	}}
+
+	assert.Equal(t, map[string]int{
+		"act-3": 1,
+		"act-2": 2,
+		"act-1": 3,
+		"act-4": 3,
+		"act-5": 5,
+	}, s.Ranks())
+}
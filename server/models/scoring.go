@@ -0,0 +1,243 @@
+package models
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+)
+
+// ScoringSystem names the rules a party uses to validate ballots and tally results.
+type ScoringSystem string
+
+const (
+	// ScoringEurovision awards 12, 10 and 8 down to 1 points to ten distinct acts.
+	ScoringEurovision ScoringSystem = "eurovision"
+	// ScoringTop3 awards 3, 2 and 1 points to a guest's three favourite acts.
+	ScoringTop3 ScoringSystem = "top3"
+	// ScoringRating rates every act from 1 to 10; results show the average rating.
+	ScoringRating ScoringSystem = "rating"
+	// ScoringBorda ranks every act; with n acts the first place earns n points and the last 1.
+	ScoringBorda ScoringSystem = "borda"
+)
+
+// DefaultScoringSystem is used for parties that do not choose a scoring system.
+const DefaultScoringSystem = ScoringEurovision
+
+// Top3PointValues defines the point values of a top 3 ballot.
+var Top3PointValues = []int{3, 2, 1}
+
+const (
+	// MinRating is the lowest rating a guest can give an act.
+	MinRating = 1
+	// MaxRating is the highest rating a guest can give an act.
+	MaxRating = 10
+)
+
+// Scorer validates ballots and converts them into points for one scoring system.
+type Scorer interface {
+	// ValidateBallot checks that the vote is a complete ballot for the given acts.
+	// It complements Vote.Validate, which only checks the vote's structure.
+	ValidateBallot(v Vote, actIDs []string) error
+	// ValidateDraft checks that the vote could still be completed into a valid
+	// ballot for the given acts.
+	ValidateDraft(v Vote, actIDs []string) error
+	// Points returns the points a valid ballot awards to each act.
+	Points(v Vote) map[string]int
+	// Averaged reports whether results present the mean points per ballot
+	// instead of the total.
+	Averaged() bool
+	// TopPoints returns the highest points a ballot can award a single act
+	// when the given number of acts compete.
+	TopPoints(acts int) int
+}
+
+var scorers = map[ScoringSystem]Scorer{
+	ScoringEurovision: fixedPointsScorer{values: ValidPointValues},
+	ScoringTop3:       fixedPointsScorer{values: Top3PointValues},
+	ScoringRating:     ratingScorer{},
+	ScoringBorda:      bordaScorer{},
+}
+
+// ScoringSystems returns the supported scoring systems in a stable order.
+func ScoringSystems() []ScoringSystem {
+	systems := make([]ScoringSystem, 0, len(scorers))
+	for system := range scorers {
+		systems = append(systems, system)
+	}
+	sort.Slice(systems, func(i, j int) bool { return systems[i] < systems[j] })
+	return systems
+}
+
+// IsValid reports whether the scoring system is supported.
+func (s ScoringSystem) IsValid() bool {
+	_, ok := scorers[s]
+	return ok
+}
+
+// Scorer returns the rules of the scoring system, falling back to the default
+// for unknown values such as the empty system of parties created before
+// scoring systems existed.
+func (s ScoringSystem) Scorer() Scorer {
+	if scorer, ok := scorers[s]; ok {
+		return scorer
+	}
+	return scorers[DefaultScoringSystem]
+}
+
+// fixedPointsScorer awards each of a fixed set of point values to a different act.
+type fixedPointsScorer struct {
+	values []int
+}
+
+func (f fixedPointsScorer) ValidateBallot(v Vote, actIDs []string) error {
+	if len(v.Ratings) > 0 {
+		return fmt.Errorf("ratings are not allowed for this scoring system")
+	}
+	if len(v.Votes) != len(f.values) {
+		return fmt.Errorf("exactly %d votes required, got %d", len(f.values), len(v.Votes))
+	}
+	for _, points := range f.values {
+		if _, ok := v.Votes[points]; !ok {
+			return fmt.Errorf("missing vote for point value %d", points)
+		}
+	}
+	return checkActs(v.Votes, actIDs)
+}
+
+func (f fixedPointsScorer) ValidateDraft(v Vote, actIDs []string) error {
+	if len(v.Ratings) > 0 {
+		return fmt.Errorf("ratings are not allowed for this scoring system")
+	}
+	allowed := make(map[int]bool, len(f.values))
+	for _, points := range f.values {
+		allowed[points] = true
+	}
+	for points := range v.Votes {
+		if !allowed[points] {
+			return fmt.Errorf("invalid point value %d", points)
+		}
+	}
+	return checkActs(v.Votes, actIDs)
+}
+
+func (f fixedPointsScorer) Points(v Vote) map[string]int {
+	return pointsByAct(v.Votes)
+}
+
+func (f fixedPointsScorer) Averaged() bool { return false }
+
+func (f fixedPointsScorer) TopPoints(int) int { return slices.Max(f.values) }
+
+// bordaScorer expects a full ranking: the ballot maps points n down to 1 to the
+// n competing acts, so the points double as the inverted rank.
+type bordaScorer struct{}
+
+func (bordaScorer) ValidateBallot(v Vote, actIDs []string) error {
+	if len(v.Ratings) > 0 {
+		return fmt.Errorf("ratings are not allowed for this scoring system")
+	}
+	if len(v.Votes) != len(actIDs) {
+		return fmt.Errorf("all %d acts must be ranked, got %d", len(actIDs), len(v.Votes))
+	}
+	for points := 1; points <= len(actIDs); points++ {
+		if _, ok := v.Votes[points]; !ok {
+			return fmt.Errorf("missing vote for point value %d", points)
+		}
+	}
+	return checkActs(v.Votes, actIDs)
+}
+
+func (bordaScorer) ValidateDraft(v Vote, actIDs []string) error {
+	if len(v.Ratings) > 0 {
+		return fmt.Errorf("ratings are not allowed for this scoring system")
+	}
+	for points := range v.Votes {
+		if points < 1 || points > len(actIDs) {
+			return fmt.Errorf("invalid point value %d", points)
+		}
+	}
+	return checkActs(v.Votes, actIDs)
+}
+
+func (bordaScorer) Points(v Vote) map[string]int {
+	return pointsByAct(v.Votes)
+}
+
+func (bordaScorer) Averaged() bool { return false }
+
+func (bordaScorer) TopPoints(acts int) int { return acts }
+
+// ratingScorer expects every act to be rated between MinRating and MaxRating.
+type ratingScorer struct{}
+
+func (ratingScorer) ValidateBallot(v Vote, actIDs []string) error {
+	if len(v.Votes) > 0 {
+		return fmt.Errorf("point votes are not allowed when rating acts")
+	}
+	if len(v.Ratings) != len(actIDs) {
+		return fmt.Errorf("all %d acts must be rated, got %d", len(actIDs), len(v.Ratings))
+	}
+	for _, actID := range actIDs {
+		rating, ok := v.Ratings[actID]
+		if !ok {
+			return fmt.Errorf("missing rating for act %q", actID)
+		}
+		if rating < MinRating || rating > MaxRating {
+			return fmt.Errorf("rating for act %q must be between %d and %d", actID, MinRating, MaxRating)
+		}
+	}
+	return nil
+}
+
+func (ratingScorer) ValidateDraft(v Vote, actIDs []string) error {
+	if len(v.Votes) > 0 {
+		return fmt.Errorf("point votes are not allowed when rating acts")
+	}
+	known := make(map[string]bool, len(actIDs))
+	for _, actID := range actIDs {
+		known[actID] = true
+	}
+	for actID, rating := range v.Ratings {
+		if !known[actID] {
+			return fmt.Errorf("unknown act id %q", actID)
+		}
+		if rating < MinRating || rating > MaxRating {
+			return fmt.Errorf("rating for act %q must be between %d and %d", actID, MinRating, MaxRating)
+		}
+	}
+	return nil
+}
+
+func (ratingScorer) Points(v Vote) map[string]int {
+	points := make(map[string]int, len(v.Ratings))
+	for actID, rating := range v.Ratings {
+		points[actID] = rating
+	}
+	return points
+}
+
+func (ratingScorer) Averaged() bool { return true }
+
+func (ratingScorer) TopPoints(int) int { return MaxRating }
+
+// checkActs ensures every act on the ballot competes in the party.
+func checkActs(votes map[int]string, actIDs []string) error {
+	known := make(map[string]bool, len(actIDs))
+	for _, actID := range actIDs {
+		known[actID] = true
+	}
+	for points, actID := range votes {
+		if !known[actID] {
+			return fmt.Errorf("unknown act id %q for points %d", actID, points)
+		}
+	}
+	return nil
+}
+
+func pointsByAct(votes map[int]string) map[string]int {
+	points := make(map[string]int, len(votes))
+	for value, actID := range votes {
+		points[actID] += value
+	}
+	return points
+}
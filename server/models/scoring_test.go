@@ -0,0 +1,208 @@
+package models
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testActIDs returns act-1 through act-n.
+func testActIDs(n int) []string {
+	ids := make([]string, n)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("act-%d", i+1)
+	}
+	return ids
+}
+
+func TestScoringSystem(t *testing.T) {
+	t.Run("supported systems are valid", func(t *testing.T) {
+		assert.Equal(t, []ScoringSystem{ScoringBorda, ScoringEurovision, ScoringRating, ScoringTop3}, ScoringSystems())
+		for _, system := range ScoringSystems() {
+			assert.True(t, system.IsValid(), system)
+		}
+		assert.False(t, ScoringSystem("").IsValid())
+		assert.False(t, ScoringSystem("approval").IsValid())
+	})
+
+	t.Run("top points", func(t *testing.T) {
+		assert.Equal(t, 12, ScoringEurovision.Scorer().TopPoints(26))
+		assert.Equal(t, 3, ScoringTop3.Scorer().TopPoints(26))
+		assert.Equal(t, 26, ScoringBorda.Scorer().TopPoints(26))
+		assert.Equal(t, MaxRating, ScoringRating.Scorer().TopPoints(26))
+	})
+
+	t.Run("unknown system falls back to default scorer", func(t *testing.T) {
+		assert.Equal(t, ScoringEurovision.Scorer(), ScoringSystem("").Scorer())
+	})
+}
+
+func TestEurovisionScorer(t *testing.T) {
+	scorer := ScoringEurovision.Scorer()
+	acts := testActIDs(12)
+
+	t.Run("valid ballot", func(t *testing.T) {
+		require.NoError(t, scorer.ValidateBallot(validVote(), acts))
+	})
+
+	t.Run("too few votes", func(t *testing.T) {
+		v := validVote()
+		v.Votes = map[int]string{
+			12: "act-1",
+			10: "act-2",
+			8:  "act-3",
+		}
+		err := scorer.ValidateBallot(v, acts)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "exactly 10 votes required, got 3")
+	})
+
+	t.Run("too many votes", func(t *testing.T) {
+		v := validVote()
+		v.Votes[9] = "act-11"
+		err := scorer.ValidateBallot(v, acts)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "exactly 10 votes required, got 11")
+	})
+
+	t.Run("invalid point value", func(t *testing.T) {
+		v := validVote()
+		delete(v.Votes, 8)
+		v.Votes[9] = "act-3"
+		err := scorer.ValidateBallot(v, acts)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "missing vote for point value 8")
+	})
+
+	t.Run("unknown act", func(t *testing.T) {
+		v := validVote()
+		v.Votes[12] = "act-99"
+		err := scorer.ValidateBallot(v, acts)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `unknown act id "act-99"`)
+	})
+
+	t.Run("ratings not allowed", func(t *testing.T) {
+		v := validVote()
+		v.Ratings = map[string]int{"act-1": 5}
+		require.Error(t, scorer.ValidateBallot(v, acts))
+	})
+
+	t.Run("partial draft", func(t *testing.T) {
+		v := validVote()
+		v.Votes = map[int]string{12: "act-11", 1: "act-2"}
+		require.NoError(t, scorer.ValidateDraft(v, acts))
+	})
+
+	t.Run("draft with invalid point value", func(t *testing.T) {
+		v := validVote()
+		v.Votes = map[int]string{9: "act-1"}
+		err := scorer.ValidateDraft(v, acts)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid point value 9")
+	})
+
+	t.Run("points sum per act", func(t *testing.T) {
+		points := scorer.Points(validVote())
+		assert.Equal(t, 12, points["act-1"])
+		assert.Equal(t, 1, points["act-10"])
+		assert.False(t, scorer.Averaged())
+	})
+}
+
+func TestTop3Scorer(t *testing.T) {
+	scorer := ScoringTop3.Scorer()
+	acts := testActIDs(5)
+
+	t.Run("valid ballot", func(t *testing.T) {
+		v := validVote()
+		v.Votes = map[int]string{3: "act-2", 2: "act-4", 1: "act-1"}
+		require.NoError(t, scorer.ValidateBallot(v, acts))
+		assert.Equal(t, map[string]int{"act-2": 3, "act-4": 2, "act-1": 1}, scorer.Points(v))
+	})
+
+	t.Run("eurovision point values rejected", func(t *testing.T) {
+		v := validVote()
+		v.Votes = map[int]string{12: "act-2", 10: "act-4", 8: "act-1"}
+		err := scorer.ValidateBallot(v, acts)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "missing vote for point value 3")
+	})
+}
+
+func TestBordaScorer(t *testing.T) {
+	scorer := ScoringBorda.Scorer()
+	acts := testActIDs(3)
+
+	t.Run("valid ranking", func(t *testing.T) {
+		v := validVote()
+		v.Votes = map[int]string{3: "act-3", 2: "act-1", 1: "act-2"}
+		require.NoError(t, scorer.ValidateBallot(v, acts))
+		assert.Equal(t, map[string]int{"act-3": 3, "act-1": 2, "act-2": 1}, scorer.Points(v))
+	})
+
+	t.Run("every act must be ranked", func(t *testing.T) {
+		v := validVote()
+		v.Votes = map[int]string{3: "act-3", 2: "act-1"}
+		err := scorer.ValidateBallot(v, acts)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "all 3 acts must be ranked, got 2")
+	})
+
+	t.Run("points must run from 1 to the number of acts", func(t *testing.T) {
+		v := validVote()
+		v.Votes = map[int]string{4: "act-3", 2: "act-1", 1: "act-2"}
+		err := scorer.ValidateBallot(v, acts)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "missing vote for point value 3")
+	})
+}
+
+func TestRatingScorer(t *testing.T) {
+	scorer := ScoringRating.Scorer()
+	acts := testActIDs(3)
+
+	ratingVote := func(ratings map[string]int) Vote {
+		v := validVote()
+		v.Votes = nil
+		v.Ratings = ratings
+		return v
+	}
+
+	t.Run("valid ratings", func(t *testing.T) {
+		v := ratingVote(map[string]int{"act-1": 10, "act-2": 10, "act-3": 1})
+		require.NoError(t, scorer.ValidateBallot(v, acts))
+		assert.Equal(t, map[string]int{"act-1": 10, "act-2": 10, "act-3": 1}, scorer.Points(v))
+		assert.True(t, scorer.Averaged())
+	})
+
+	t.Run("every act must be rated", func(t *testing.T) {
+		v := ratingVote(map[string]int{"act-1": 10, "act-2": 10, "act-4": 1})
+		err := scorer.ValidateBallot(v, acts)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `missing rating for act "act-3"`)
+	})
+
+	t.Run("partial draft", func(t *testing.T) {
+		v := ratingVote(map[string]int{"act-2": 6})
+		require.NoError(t, scorer.ValidateDraft(v, acts))
+
+		v = ratingVote(map[string]int{"act-2": 0})
+		require.Error(t, scorer.ValidateDraft(v, acts))
+	})
+
+	t.Run("rating out of range", func(t *testing.T) {
+		v := ratingVote(map[string]int{"act-1": 11, "act-2": 10, "act-3": 1})
+		err := scorer.ValidateBallot(v, acts)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "must be between 1 and 10")
+	})
+
+	t.Run("point votes not allowed", func(t *testing.T) {
+		v := ratingVote(map[string]int{"act-1": 5, "act-2": 5, "act-3": 5})
+		v.Votes = map[int]string{12: "act-1"}
+		require.Error(t, scorer.ValidateBallot(v, acts))
+	})
+}
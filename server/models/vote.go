@@ -6,19 +6,30 @@ import (
 	"time"
 )
 
-// ValidPointValues defines the Eurovision scoring system point values.
+// ValidPointValues defines the point values of a Eurovision ballot.
 var ValidPointValues = []int{12, 10, 8, 7, 6, 5, 4, 3, 2, 1}
 
 // Vote records the points a guest awards to acts during a party.
 type Vote struct {
-	ID        string         `firestore:"id" json:"id"`
-	GuestID   string         `firestore:"guestId" json:"guestId"`
-	PartyID   string         `firestore:"partyId" json:"partyId"`
-	Votes     map[int]string `firestore:"votes" json:"votes"` // points -> actID
-	CreatedAt time.Time      `firestore:"createdAt" json:"createdAt"`
+	ID      string         `firestore:"id" json:"id"`
+	GuestID string         `firestore:"guestId" json:"guestId"`
+	PartyID string         `firestore:"partyId" json:"partyId"`
+	Votes   map[int]string `firestore:"votes" json:"votes"`                         // points -> actID
+	Ratings map[string]int `firestore:"ratings,omitempty" json:"ratings,omitempty"` // actID -> rating, for ScoringRating
+	// Draft marks a ballot that is still being filled in; drafts may be incomplete and
+	// do not count towards results until they are finalized.
+	Draft     bool      `firestore:"draft" json:"draft"`
+	CreatedAt time.Time `firestore:"createdAt" json:"createdAt"`
 }
 
-// Validate ensures that the vote capture is well-formed.
+// VoteIDFor returns the deterministic ID of a guest's vote in a party. Deriving
+// the ID from the guest and party lets storage reject a second ballot atomically.
+func VoteIDFor(partyID, guestID string) string {
+	return partyID + "_" + guestID
+}
+
+// Validate ensures that the vote capture is well-formed. Whether the ballot is
+// complete depends on the party's scoring system; see Scorer.ValidateBallot.
 func (v Vote) Validate() error {
 	if strings.TrimSpace(v.GuestID) == "" {
 		return fmt.Errorf("guest id is required")
@@ -26,18 +37,19 @@ func (v Vote) Validate() error {
 	if strings.TrimSpace(v.PartyID) == "" {
 		return fmt.Errorf("party id is required")
 	}
-	if len(v.Votes) != len(ValidPointValues) {
-		return fmt.Errorf("exactly %d votes required, got %d", len(ValidPointValues), len(v.Votes))
+	if !v.Draft && len(v.Votes) == 0 && len(v.Ratings) == 0 {
+		return fmt.Errorf("at least one vote is required")
 	}
-	for _, points := range ValidPointValues {
-		actID, ok := v.Votes[points]
-		if !ok {
-			return fmt.Errorf("missing vote for point value %d", points)
-		}
+	for points, actID := range v.Votes {
 		if strings.TrimSpace(actID) == "" {
 			return fmt.Errorf("act id is required for points %d", points)
 		}
 	}
+	for actID := range v.Ratings {
+		if strings.TrimSpace(actID) == "" {
+			return fmt.Errorf("act id is required for ratings")
+		}
+	}
 	seen := make(map[string]bool, len(v.Votes))
 	for points, actID := range v.Votes {
 		if seen[actID] {
@@ -58,7 +70,42 @@ type VoteResult struct {
 	Artist      string `json:"artist"`
 	Song        string `json:"song"`
 	TotalPoints int    `json:"totalPoints"`
-	Rank        int    `json:"rank"`
+	// AverageScore is the mean points per ballot, set for averaged scoring systems.
+	AverageScore float64 `json:"averageScore,omitempty"`
+	Rank         int     `json:"rank"`
+	// PointCounts maps each point value to the number of ballots that awarded it to the act.
+	PointCounts map[int]int `json:"pointCounts,omitempty"`
+	// Voters is the number of ballots that gave the act any points.
+	Voters int `json:"voters"`
+	// TopMarks lists the usernames of the guests who gave the act the highest
+	// points a ballot can award, unless the party keeps its results anonymous.
+	TopMarks []string `json:"topMarks,omitempty"`
+	// TieBreak explains why the act ranks ahead of the next act when both
+	// have the same points.
+	TieBreak *TieBreak `json:"tieBreak,omitempty"`
+}
+
+// TieBreakRule names a rule that separates acts with the same points.
+type TieBreakRule string
+
+const (
+	// TieBreakVoters ranks the act that received points from more voters higher.
+	TieBreakVoters TieBreakRule = "voters"
+	// TieBreakPointCount ranks the act that received a point value more often
+	// higher, comparing the highest point value first.
+	TieBreakPointCount TieBreakRule = "pointCount"
+	// TieBreakRunningOrder ranks the act that performed earlier higher.
+	TieBreakRunningOrder TieBreakRule = "runningOrder"
+)
+
+// TieBreak records which rule placed an act directly ahead of another act
+// with the same points.
+type TieBreak struct {
+	Rule TieBreakRule `json:"rule"`
+	// Points is the point value whose count decided, for TieBreakPointCount.
+	Points int `json:"points,omitempty"`
+	// ActID identifies the act ranked directly below.
+	ActID string `json:"actId"`
 }
 
 // Validate ensures the vote result contains the data required to present rankings.
@@ -61,33 +61,19 @@ func TestVoteValidate(t *testing.T) {
 		assert.Contains(t, err.Error(), "created at timestamp is required")
 	})
 
-	t.Run("too few votes", func(t *testing.T) {
+	t.Run("empty ballot", func(t *testing.T) {
 		v := validVote()
-		v.Votes = map[int]string{
-			12: "act-1",
-			10: "act-2",
-			8:  "act-3",
-		}
-		err := v.Validate()
-		require.Error(t, err)
-		assert.Contains(t, err.Error(), "exactly 10 votes required, got 3")
-	})
-
-	t.Run("too many votes", func(t *testing.T) {
-		v := validVote()
-		v.Votes[9] = "act-11"
+		v.Votes = nil
 		err := v.Validate()
 		require.Error(t, err)
-		assert.Contains(t, err.Error(), "exactly 10 votes required, got 11")
+		assert.Contains(t, err.Error(), "at least one vote is required")
 	})
 
-	t.Run("invalid point value", func(t *testing.T) {
+	t.Run("empty draft", func(t *testing.T) {
 		v := validVote()
-		delete(v.Votes, 8)
-		v.Votes[9] = "act-3"
-		err := v.Validate()
-		require.Error(t, err)
-		assert.Contains(t, err.Error(), "missing vote for point value 8")
+		v.Votes = nil
+		v.Draft = true
+		require.NoError(t, v.Validate())
 	})
 
 	t.Run("duplicate act ids", func(t *testing.T) {
@@ -0,0 +1,167 @@
+// Package oidc verifies ID tokens issued by an OpenID Connect provider, so
+// that users can sign in through a self-hosted identity provider such as
+// Keycloak, Authentik or Dex instead of Firebase.
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	firebaseauth "firebase.google.com/go/v4/auth"
+	"github.com/MicahParks/keyfunc"
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// ErrInvalidToken is returned for tokens that are malformed, expired, not
+// signed by the provider or not issued for the configured audience.
+var ErrInvalidToken = errors.New("invalid ID token")
+
+// signingMethods are the algorithms accepted for ID tokens. Symmetric
+// algorithms are excluded so that a published key can never sign a token.
+var signingMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512", "EdDSA"}
+
+// keyRefreshInterval is how often the provider's keys are fetched again to
+// pick up key rotations.
+const keyRefreshInterval = time.Hour
+
+// Config configures a Verifier.
+type Config struct {
+	// Issuer is the provider's issuer URL. Tokens must carry it as their iss claim.
+	Issuer string
+	// Audience is the client ID tokens must be issued for.
+	Audience string
+	// JWKSURL is where the provider publishes its signing keys. When empty it
+	// is read from the provider's discovery document below Issuer.
+	JWKSURL string
+	// Client fetches the discovery document and the keys. Defaults to http.DefaultClient.
+	Client *http.Client
+}
+
+// Verifier verifies ID tokens of one OpenID Connect provider. It implements
+// the token verifier contract of the auth middleware.
+type Verifier struct {
+	issuer   string
+	audience string
+	jwks     *keyfunc.JWKS
+}
+
+// NewVerifier fetches the provider's signing keys and returns a Verifier
+// that keeps them up to date until Close is called.
+func NewVerifier(ctx context.Context, cfg Config) (*Verifier, error) {
+	if cfg.Issuer == "" {
+		return nil, fmt.Errorf("oidc issuer is required")
+	}
+	if cfg.Audience == "" {
+		return nil, fmt.Errorf("oidc audience is required")
+	}
+	client := cfg.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	jwksURL := cfg.JWKSURL
+	if jwksURL == "" {
+		var err error
+		if jwksURL, err = discoverJWKSURL(ctx, client, cfg.Issuer); err != nil {
+			return nil, err
+		}
+	}
+
+	jwks, err := keyfunc.Get(jwksURL, keyfunc.Options{
+		Client:            client,
+		Ctx:               ctx,
+		RefreshInterval:   keyRefreshInterval,
+		RefreshRateLimit:  time.Minute,
+		RefreshUnknownKID: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch oidc signing keys from %s: %w", jwksURL, err)
+	}
+
+	return &Verifier{issuer: cfg.Issuer, audience: cfg.Audience, jwks: jwks}, nil
+}
+
+// Close stops refreshing the provider's keys.
+func (v *Verifier) Close() {
+	v.jwks.EndBackground()
+}
+
+// VerifyIDToken verifies an ID token and returns it in the shape of a
+// Firebase ID token. The sub claim becomes the user ID. The email claim is
+// dropped if the provider reports it as unverified, since party members are
+// invited by email address.
+// Returns ErrInvalidToken if the token is not acceptable.
+func (v *Verifier) VerifyIDToken(_ context.Context, idToken string) (*firebaseauth.Token, error) {
+	claims := jwt.MapClaims{}
+	if _, err := jwt.ParseWithClaims(idToken, claims, v.jwks.Keyfunc, jwt.WithValidMethods(signingMethods)); err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	subject, _ := claims["sub"].(string)
+	issuer, _ := claims["iss"].(string)
+	if subject == "" || issuer != v.issuer || !claims.VerifyAudience(v.audience, true) || !claims.VerifyExpiresAt(time.Now().Unix(), true) {
+		return nil, ErrInvalidToken
+	}
+
+	if verified, ok := claims["email_verified"].(bool); ok && !verified {
+		delete(claims, "email")
+	}
+
+	token := &firebaseauth.Token{
+		Issuer:  issuer,
+		Subject: subject,
+		UID:     subject,
+		Claims:  claims,
+	}
+	if aud, ok := claims["aud"].(string); ok {
+		token.Audience = aud
+	} else {
+		token.Audience = v.audience
+	}
+	if exp, ok := claims["exp"].(float64); ok {
+		token.Expires = int64(exp)
+	}
+	if iat, ok := claims["iat"].(float64); ok {
+		token.IssuedAt = int64(iat)
+	}
+	return token, nil
+}
+
+// discoverJWKSURL reads the location of the provider's signing keys from its
+// discovery document.
+func discoverJWKSURL(ctx context.Context, client *http.Client, issuer string) (string, error) {
+	url := strings.TrimSuffix(issuer, "/") + "/.well-known/openid-configuration"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch oidc discovery document: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to fetch oidc discovery document: %s returned %s", url, resp.Status)
+	}
+
+	var doc struct {
+		Issuer  string `json:"issuer"`
+		JWKSURI string `json:"jwks_uri"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		return "", fmt.Errorf("failed to decode oidc discovery document: %w", err)
+	}
+	if doc.Issuer != issuer {
+		return "", fmt.Errorf("oidc discovery document is for issuer %q, expected %q", doc.Issuer, issuer)
+	}
+	if doc.JWKSURI == "" {
+		return "", fmt.Errorf("oidc discovery document has no jwks_uri")
+	}
+	return doc.JWKSURI, nil
+}
@@ -0,0 +1,193 @@
+package oidc_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sipgate/eurovision-vote-party/server/oidc"
+)
+
+const testKeyID = "key-1"
+
+// testProvider serves a discovery document and a key set for one RSA key.
+type testProvider struct {
+	server *httptest.Server
+	key    *rsa.PrivateKey
+}
+
+func newTestProvider(t *testing.T) *testProvider {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	p := &testProvider{key: key}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		writeTestJSON(w, map[string]string{
+			"issuer":   p.server.URL,
+			"jwks_uri": p.server.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		writeTestJSON(w, map[string]any{"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": testKeyID,
+			"use": "sig",
+			"alg": "RS256",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+	})
+	p.server = httptest.NewServer(mux)
+	t.Cleanup(p.server.Close)
+	return p
+}
+
+func (p *testProvider) sign(t *testing.T, claims jwt.MapClaims) string {
+	t.Helper()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = testKeyID
+	signed, err := token.SignedString(p.key)
+	require.NoError(t, err)
+	return signed
+}
+
+func (p *testProvider) claims() jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss":   p.server.URL,
+		"aud":   "evp",
+		"sub":   "user-1",
+		"email": "alice@example.com",
+		"iat":   time.Now().Unix(),
+		"exp":   time.Now().Add(time.Minute).Unix(),
+	}
+}
+
+func writeTestJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func newVerifier(t *testing.T, cfg oidc.Config) *oidc.Verifier {
+	t.Helper()
+
+	v, err := oidc.NewVerifier(context.Background(), cfg)
+	require.NoError(t, err)
+	t.Cleanup(v.Close)
+	return v
+}
+
+func TestVerifier(t *testing.T) {
+	ctx := context.Background()
+	provider := newTestProvider(t)
+	verifier := newVerifier(t, oidc.Config{Issuer: provider.server.URL, Audience: "evp"})
+
+	t.Run("accepts a valid token", func(t *testing.T) {
+		token, err := verifier.VerifyIDToken(ctx, provider.sign(t, provider.claims()))
+
+		require.NoError(t, err)
+		assert.Equal(t, "user-1", token.UID)
+		assert.Equal(t, "alice@example.com", token.Claims["email"])
+	})
+
+	t.Run("accepts a token for several audiences", func(t *testing.T) {
+		claims := provider.claims()
+		claims["aud"] = []string{"other-client", "evp"}
+
+		_, err := verifier.VerifyIDToken(ctx, provider.sign(t, claims))
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("drops unverified email addresses", func(t *testing.T) {
+		claims := provider.claims()
+		claims["email_verified"] = false
+
+		token, err := verifier.VerifyIDToken(ctx, provider.sign(t, claims))
+
+		require.NoError(t, err)
+		assert.NotContains(t, token.Claims, "email")
+	})
+
+	refused := map[string]func(c jwt.MapClaims){
+		"expired":        func(c jwt.MapClaims) { c["exp"] = time.Now().Add(-time.Minute).Unix() },
+		"without expiry": func(c jwt.MapClaims) { delete(c, "exp") },
+		"other issuer":   func(c jwt.MapClaims) { c["iss"] = "https://issuer.example.com" },
+		"other audience": func(c jwt.MapClaims) { c["aud"] = "other-client" },
+		"without sub":    func(c jwt.MapClaims) { delete(c, "sub") },
+	}
+	for name, mutate := range refused {
+		t.Run("refuses tokens "+name, func(t *testing.T) {
+			claims := provider.claims()
+			mutate(claims)
+
+			_, err := verifier.VerifyIDToken(ctx, provider.sign(t, claims))
+
+			assert.ErrorIs(t, err, oidc.ErrInvalidToken)
+		})
+	}
+
+	t.Run("refuses tokens signed by another key", func(t *testing.T) {
+		other := newTestProvider(t)
+		claims := provider.claims()
+
+		_, err := verifier.VerifyIDToken(ctx, other.sign(t, claims))
+
+		assert.ErrorIs(t, err, oidc.ErrInvalidToken)
+	})
+
+	t.Run("refuses symmetrically signed tokens", func(t *testing.T) {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, provider.claims())
+		token.Header["kid"] = testKeyID
+		signed, err := token.SignedString([]byte("secret"))
+		require.NoError(t, err)
+
+		_, err = verifier.VerifyIDToken(ctx, signed)
+
+		assert.ErrorIs(t, err, oidc.ErrInvalidToken)
+	})
+}
+
+func TestNewVerifier(t *testing.T) {
+	ctx := context.Background()
+	provider := newTestProvider(t)
+
+	t.Run("uses an explicit key set URL", func(t *testing.T) {
+		verifier := newVerifier(t, oidc.Config{Issuer: "https://issuer.example.com", Audience: "evp", JWKSURL: provider.server.URL + "/keys"})
+		claims := provider.claims()
+		claims["iss"] = "https://issuer.example.com"
+
+		token, err := verifier.VerifyIDToken(ctx, provider.sign(t, claims))
+
+		require.NoError(t, err)
+		assert.Equal(t, "user-1", token.UID)
+	})
+
+	t.Run("requires issuer and audience", func(t *testing.T) {
+		_, err := oidc.NewVerifier(ctx, oidc.Config{Audience: "evp"})
+		assert.Error(t, err)
+
+		_, err = oidc.NewVerifier(ctx, oidc.Config{Issuer: provider.server.URL})
+		assert.Error(t, err)
+	})
+
+	t.Run("rejects a discovery document for another issuer", func(t *testing.T) {
+		_, err := oidc.NewVerifier(ctx, oidc.Config{Issuer: provider.server.URL + "/", Audience: "evp"})
+
+		assert.ErrorContains(t, err, "discovery document is for issuer")
+	})
+}
@@ -0,0 +1,131 @@
+package persistence
+
+import (
+	"context"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/sipgate/eurovision-vote-party/server/models"
+)
+
+// AccountDAO defines the persistence operations for local accounts.
+type AccountDAO interface {
+	Create(ctx context.Context, account *models.Account) error
+	GetByID(ctx context.Context, id string) (*models.Account, error)
+	GetByEmail(ctx context.Context, email string) (*models.Account, error)
+	UpdateSessionID(ctx context.Context, id, sessionID string) error
+	ReplaceSessionID(ctx context.Context, id, oldSessionID, newSessionID string) error
+}
+
+// FirestoreAccountDAO is the Firestore implementation of AccountDAO.
+type FirestoreAccountDAO struct {
+	client *firestore.Client
+}
+
+// NewFirestoreAccountDAO creates a new FirestoreAccountDAO.
+func NewFirestoreAccountDAO(client *firestore.Client) *FirestoreAccountDAO {
+	return &FirestoreAccountDAO{client: client}
+}
+
+const accountsCollection = "accounts"
+
+// Create stores a new account.
+// Returns ErrEmailExists if another account uses the email address.
+func (d *FirestoreAccountDAO) Create(ctx context.Context, account *models.Account) error {
+	accounts := d.client.Collection(accountsCollection)
+	return d.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		docs, err := tx.Documents(accounts.Where("email", "==", account.Email).Limit(1)).GetAll()
+		if err != nil {
+			return err
+		}
+		if len(docs) > 0 {
+			return ErrEmailExists
+		}
+		return tx.Create(accounts.Doc(account.ID), account)
+	})
+}
+
+// GetByID retrieves an account by its ID.
+// Returns ErrNotFound if the account does not exist.
+func (d *FirestoreAccountDAO) GetByID(ctx context.Context, id string) (*models.Account, error) {
+	doc, err := d.client.Collection(accountsCollection).Doc(id).Get(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	var account models.Account
+	if err := doc.DataTo(&account); err != nil {
+		return nil, err
+	}
+
+	return &account, nil
+}
+
+// GetByEmail retrieves the account with the given email address.
+// Returns ErrNotFound if no account uses it.
+func (d *FirestoreAccountDAO) GetByEmail(ctx context.Context, email string) (*models.Account, error) {
+	iter := d.client.Collection(accountsCollection).Where("email", "==", email).Limit(1).Documents(ctx)
+	defer iter.Stop()
+
+	docs, err := iter.GetAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(docs) == 0 {
+		return nil, ErrNotFound
+	}
+
+	var account models.Account
+	if err := docs[0].DataTo(&account); err != nil {
+		return nil, err
+	}
+
+	return &account, nil
+}
+
+// UpdateSessionID replaces the session identifier of an existing account.
+// Returns ErrNotFound if the account does not exist.
+func (d *FirestoreAccountDAO) UpdateSessionID(ctx context.Context, id, sessionID string) error {
+	_, err := d.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	_, err = d.client.Collection(accountsCollection).Doc(id).Update(ctx, []firestore.Update{
+		{Path: "sessionId", Value: sessionID},
+	})
+	return err
+}
+
+// ReplaceSessionID replaces the session identifier of an account if it is
+// still oldSessionID, in one transaction.
+// Returns ErrNotFound if the account does not exist or has another session.
+func (d *FirestoreAccountDAO) ReplaceSessionID(ctx context.Context, id, oldSessionID, newSessionID string) error {
+	ref := d.client.Collection(accountsCollection).Doc(id)
+	return d.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		doc, err := tx.Get(ref)
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return ErrNotFound
+			}
+			return err
+		}
+
+		var account models.Account
+		if err := doc.DataTo(&account); err != nil {
+			return err
+		}
+		if account.SessionID != oldSessionID {
+			return ErrNotFound
+		}
+
+		return tx.Update(ref, []firestore.Update{
+			{Path: "sessionId", Value: newSessionID},
+		})
+	})
+}
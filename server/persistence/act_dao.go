@@ -0,0 +1,161 @@
+package persistence
+
+import (
+	"context"
+	"sort"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/sipgate/eurovision-vote-party/server/models"
+)
+
+// ActDAO defines the persistence operations for the acts catalogue.
+// Acts are identified by their contest together with their ID.
+type ActDAO interface {
+	Create(ctx context.Context, act *models.Act) error
+	GetByID(ctx context.Context, contest, id string) (*models.Act, error)
+	ListByContest(ctx context.Context, contest string) ([]*models.Act, error)
+	Update(ctx context.Context, act *models.Act) error
+	Delete(ctx context.Context, contest, id string) error
+	ReplaceContest(ctx context.Context, contest string, acts []*models.Act) error
+}
+
+// FirestoreActDAO is the Firestore implementation of ActDAO.
+// Acts are stored in an acts subcollection of their contest document.
+type FirestoreActDAO struct {
+	client *firestore.Client
+}
+
+// NewFirestoreActDAO creates a new FirestoreActDAO.
+func NewFirestoreActDAO(client *firestore.Client) *FirestoreActDAO {
+	return &FirestoreActDAO{client: client}
+}
+
+const (
+	contestsCollection = "contests"
+	actsCollection     = "acts"
+)
+
+func (d *FirestoreActDAO) acts(contest string) *firestore.CollectionRef {
+	return d.client.Collection(contestsCollection).Doc(contest).Collection(actsCollection)
+}
+
+// Create stores a new act in Firestore.
+// Returns ErrActExists if the contest already has an act with the same ID.
+func (d *FirestoreActDAO) Create(ctx context.Context, act *models.Act) error {
+	_, err := d.acts(act.Contest).Doc(act.ID).Create(ctx, act)
+	if status.Code(err) == codes.AlreadyExists {
+		return ErrActExists
+	}
+	return err
+}
+
+// GetByID retrieves an act of a contest by its ID.
+// Returns ErrNotFound if the act does not exist.
+func (d *FirestoreActDAO) GetByID(ctx context.Context, contest, id string) (*models.Act, error) {
+	doc, err := d.acts(contest).Doc(id).Get(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	var act models.Act
+	if err := doc.DataTo(&act); err != nil {
+		return nil, err
+	}
+
+	return &act, nil
+}
+
+// ListByContest retrieves all acts of a contest ordered by ID.
+// Returns an empty slice if the contest has no acts.
+func (d *FirestoreActDAO) ListByContest(ctx context.Context, contest string) ([]*models.Act, error) {
+	docs, err := d.acts(contest).Documents(ctx).GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	acts := make([]*models.Act, 0, len(docs))
+	for _, doc := range docs {
+		var act models.Act
+		if err := doc.DataTo(&act); err != nil {
+			return nil, err
+		}
+		acts = append(acts, &act)
+	}
+
+	sort.Slice(acts, func(i, j int) bool { return acts[i].ID < acts[j].ID })
+	return acts, nil
+}
+
+// Update overwrites an existing act.
+// Returns ErrNotFound if the act does not exist.
+func (d *FirestoreActDAO) Update(ctx context.Context, act *models.Act) error {
+	_, err := d.GetByID(ctx, act.Contest, act.ID)
+	if err != nil {
+		return err
+	}
+
+	_, err = d.acts(act.Contest).Doc(act.ID).Set(ctx, act)
+	return err
+}
+
+// Delete removes an act.
+// Returns ErrNotFound if the act does not exist.
+func (d *FirestoreActDAO) Delete(ctx context.Context, contest, id string) error {
+	_, err := d.GetByID(ctx, contest, id)
+	if err != nil {
+		return err
+	}
+
+	_, err = d.acts(contest).Doc(id).Delete(ctx)
+	return err
+}
+
+// ReplaceContest replaces all acts of a contest with the given acts.
+// Contests with up to maxTransactionWrites changes are replaced in a single
+// transaction; larger contests are written in chunks.
+func (d *FirestoreActDAO) ReplaceContest(ctx context.Context, contest string, acts []*models.Act) error {
+	existing, err := d.acts(contest).Select().Documents(ctx).GetAll()
+	if err != nil {
+		return err
+	}
+
+	keep := make(map[string]bool, len(acts))
+	for _, act := range acts {
+		keep[act.ID] = true
+	}
+
+	var writes []func(tx *firestore.Transaction) error
+	for _, doc := range existing {
+		if !keep[doc.Ref.ID] {
+			ref := doc.Ref
+			writes = append(writes, func(tx *firestore.Transaction) error { return tx.Delete(ref) })
+		}
+	}
+	for _, act := range acts {
+		ref, act := d.acts(contest).Doc(act.ID), act
+		writes = append(writes, func(tx *firestore.Transaction) error { return tx.Set(ref, act) })
+	}
+
+	for start := 0; start < len(writes); start += maxTransactionWrites {
+		chunk := writes[start:min(start+maxTransactionWrites, len(writes))]
+		err := d.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+			for _, write := range chunk {
+				if err := write(tx); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
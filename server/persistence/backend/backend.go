@@ -0,0 +1,105 @@
+// Package backend opens the configured persistence backend and hands out its
+// DAOs, so that the server and the command line tools share one setup.
+package backend
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"cloud.google.com/go/firestore"
+
+	"github.com/sipgate/eurovision-vote-party/server/persistence"
+	"github.com/sipgate/eurovision-vote-party/server/persistence/memory"
+	persistencesql "github.com/sipgate/eurovision-vote-party/server/persistence/sql"
+)
+
+// DAOs bundles the DAOs of one persistence backend.
+type DAOs struct {
+	Party      persistence.PartyDAO
+	Guest      persistence.GuestDAO
+	Vote       persistence.VoteDAO
+	User       persistence.UserDAO
+	Account    persistence.AccountDAO
+	Act        persistence.ActDAO
+	Prediction persistence.PredictionDAO
+	Scoreboard persistence.ScoreboardDAO
+}
+
+// Config selects and configures a persistence backend.
+type Config struct {
+	// Backend is "firestore" (used when empty), "sqlite", "postgres" or "memory".
+	Backend string
+	// DatabaseURL is the connection string of the SQL backends. SQLite
+	// defaults to the file evp.db.
+	DatabaseURL string
+	// Firestore connects to Firestore; it is only called for the Firestore backend.
+	Firestore func(ctx context.Context) (*firestore.Client, error)
+}
+
+// ConfigFromEnv reads the backend from PERSISTENCE_BACKEND and the SQL
+// connection string from DATABASE_URL.
+func ConfigFromEnv() Config {
+	return Config{
+		Backend:     os.Getenv("PERSISTENCE_BACKEND"),
+		DatabaseURL: os.Getenv("DATABASE_URL"),
+	}
+}
+
+// Open connects to the configured backend. The returned function releases any
+// resources held by the backend.
+func Open(ctx context.Context, cfg Config) (*DAOs, func(), error) {
+	switch cfg.Backend {
+	case "", "firestore":
+		if cfg.Firestore == nil {
+			return nil, nil, fmt.Errorf("no firestore client configured")
+		}
+		client, err := cfg.Firestore(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to initialise firestore client: %w", err)
+		}
+		return &DAOs{
+			Party:      persistence.NewFirestorePartyDAO(client),
+			Guest:      persistence.NewFirestoreGuestDAO(client),
+			Vote:       persistence.NewFirestoreVoteDAO(client),
+			User:       persistence.NewFirestoreUserDAO(client),
+			Account:    persistence.NewFirestoreAccountDAO(client),
+			Act:        persistence.NewFirestoreActDAO(client),
+			Prediction: persistence.NewFirestorePredictionDAO(client),
+			Scoreboard: persistence.NewFirestoreScoreboardDAO(client),
+		}, func() { client.Close() }, nil
+	case "sqlite", "postgres":
+		dsn := cfg.DatabaseURL
+		if dsn == "" && cfg.Backend == "sqlite" {
+			dsn = "evp.db"
+		}
+		db, err := persistencesql.Open(ctx, persistencesql.Dialect(cfg.Backend), dsn)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to initialise %s database: %w", cfg.Backend, err)
+		}
+		return &DAOs{
+			Party:      persistencesql.NewPartyDAO(db),
+			Guest:      persistencesql.NewGuestDAO(db),
+			Vote:       persistencesql.NewVoteDAO(db),
+			User:       persistencesql.NewUserDAO(db),
+			Account:    persistencesql.NewAccountDAO(db),
+			Act:        persistencesql.NewActDAO(db),
+			Prediction: persistencesql.NewPredictionDAO(db),
+			Scoreboard: persistencesql.NewScoreboardDAO(db),
+		}, func() { db.Close() }, nil
+	case "memory":
+		store := memory.NewStore()
+		return &DAOs{
+			Party:      memory.NewPartyDAO(store),
+			Guest:      memory.NewGuestDAO(store),
+			Vote:       memory.NewVoteDAO(store),
+			User:       memory.NewUserDAO(store),
+			Account:    memory.NewAccountDAO(store),
+			Act:        memory.NewActDAO(store),
+			Prediction: memory.NewPredictionDAO(store),
+			Scoreboard: memory.NewScoreboardDAO(store),
+		}, func() {}, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown persistence backend %q", cfg.Backend)
+	}
+}
@@ -0,0 +1,60 @@
+package backend_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/persistence/backend"
+)
+
+func TestOpen(t *testing.T) {
+	ctx := context.Background()
+
+	configs := map[string]backend.Config{
+		"memory": {Backend: "memory"},
+		"sqlite": {Backend: "sqlite", DatabaseURL: filepath.Join(t.TempDir(), "evp.db")},
+	}
+
+	for name, cfg := range configs {
+		t.Run(name, func(t *testing.T) {
+			daos, closeDAOs, err := backend.Open(ctx, cfg)
+			require.NoError(t, err)
+			defer closeDAOs()
+
+			party := &models.Party{
+				ID: "party-1", Name: "Party", Code: "ABC234", EventType: models.EventGrandFinal,
+				AdminID: "admin-1", Status: models.PartyStatusActive, CreatedAt: time.Now(),
+			}
+			require.NoError(t, daos.Party.Create(ctx, party))
+			got, err := daos.Party.GetByID(ctx, party.ID)
+			require.NoError(t, err)
+			assert.Equal(t, party.Name, got.Name)
+		})
+	}
+}
+
+func TestOpen_Errors(t *testing.T) {
+	ctx := context.Background()
+
+	configs := map[string]backend.Config{
+		"unknown backend":            {Backend: "mongodb"},
+		"firestore without a client": {Backend: "firestore"},
+		"firestore failing to connect": {Backend: "firestore", Firestore: func(context.Context) (*firestore.Client, error) {
+			return nil, assert.AnError
+		}},
+	}
+
+	for name, cfg := range configs {
+		t.Run(name, func(t *testing.T) {
+			_, _, err := backend.Open(ctx, cfg)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package persistence_test
+
+import (
+	"testing"
+
+	"github.com/sipgate/eurovision-vote-party/server/persistence"
+	"github.com/sipgate/eurovision-vote-party/server/persistence/persistencetest"
+)
+
+func TestFirestorePartyDAO_Conformance(t *testing.T) {
+	persistencetest.RunPartyDAO(t, func(t *testing.T) persistence.PartyDAO {
+		client := setupFirestoreClient(t)
+		cleanupCollection(t, client, "parties")
+		t.Cleanup(func() { cleanupCollection(t, client, "parties") })
+		return persistence.NewFirestorePartyDAO(client)
+	})
+}
+
+func TestFirestoreGuestDAO_Conformance(t *testing.T) {
+	persistencetest.RunGuestDAO(t, func(t *testing.T) persistence.GuestDAO {
+		client := setupFirestoreClient(t)
+		cleanupCollection(t, client, "guests")
+		t.Cleanup(func() { cleanupCollection(t, client, "guests") })
+		return persistence.NewFirestoreGuestDAO(client)
+	})
+}
+
+func TestFirestoreVoteDAO_Conformance(t *testing.T) {
+	persistencetest.RunVoteDAO(t, func(t *testing.T) persistence.VoteDAO {
+		client := setupFirestoreClient(t)
+		cleanupCollection(t, client, "votes")
+		t.Cleanup(func() { cleanupCollection(t, client, "votes") })
+		return persistence.NewFirestoreVoteDAO(client)
+	})
+}
+
+func TestFirestoreUserDAO_Conformance(t *testing.T) {
+	persistencetest.RunUserDAO(t, func(t *testing.T) persistence.UserDAO {
+		client := setupFirestoreClient(t)
+		cleanupCollection(t, client, "users")
+		t.Cleanup(func() { cleanupCollection(t, client, "users") })
+		return persistence.NewFirestoreUserDAO(client)
+	})
+}
+
+func TestFirestoreAccountDAO_Conformance(t *testing.T) {
+	persistencetest.RunAccountDAO(t, func(t *testing.T) persistence.AccountDAO {
+		client := setupFirestoreClient(t)
+		cleanupCollection(t, client, "accounts")
+		t.Cleanup(func() { cleanupCollection(t, client, "accounts") })
+		return persistence.NewFirestoreAccountDAO(client)
+	})
+}
+
+func TestFirestoreActDAO_Conformance(t *testing.T) {
+	persistencetest.RunActDAO(t, func(t *testing.T) persistence.ActDAO {
+		client := setupFirestoreClient(t)
+		collections := []string{"contests/esc-2026/acts", "contests/jesc-2026/acts"}
+		for _, c := range collections {
+			cleanupCollection(t, client, c)
+		}
+		t.Cleanup(func() {
+			for _, c := range collections {
+				cleanupCollection(t, client, c)
+			}
+		})
+		return persistence.NewFirestoreActDAO(client)
+	})
+}
+
+func TestFirestorePredictionDAO_Conformance(t *testing.T) {
+	persistencetest.RunPredictionDAO(t, func(t *testing.T) persistence.PredictionDAO {
+		client := setupFirestoreClient(t)
+		collections := []string{"predictions", "predictionOutcomes"}
+		for _, c := range collections {
+			cleanupCollection(t, client, c)
+		}
+		t.Cleanup(func() {
+			for _, c := range collections {
+				cleanupCollection(t, client, c)
+			}
+		})
+		return persistence.NewFirestorePredictionDAO(client)
+	})
+}
+
+func TestFirestoreScoreboardDAO_Conformance(t *testing.T) {
+	persistencetest.RunScoreboardDAO(t, func(t *testing.T) persistence.ScoreboardDAO {
+		client := setupFirestoreClient(t)
+		collections := []string{"contests/esc-2026/scoreboards", "contests/jesc-2026/scoreboards"}
+		for _, c := range collections {
+			cleanupCollection(t, client, c)
+		}
+		t.Cleanup(func() {
+			for _, c := range collections {
+				cleanupCollection(t, client, c)
+			}
+		})
+		return persistence.NewFirestoreScoreboardDAO(client)
+	})
+}
+
+// newFirestoreDAOs returns DAOs on the emulator with the collections they
+// use emptied before and after the test.
+func newFirestoreDAOs(t *testing.T) persistencetest.DAOs {
+	client := setupFirestoreClient(t)
+	collections := []string{"parties", "guests", "votes", "users", "predictions", "predictionOutcomes"}
+	for _, c := range collections {
+		cleanupCollection(t, client, c)
+	}
+	t.Cleanup(func() {
+		for _, c := range collections {
+			cleanupCollection(t, client, c)
+		}
+	})
+	return persistencetest.DAOs{
+		Party:      persistence.NewFirestorePartyDAO(client),
+		Guest:      persistence.NewFirestoreGuestDAO(client),
+		Vote:       persistence.NewFirestoreVoteDAO(client),
+		User:       persistence.NewFirestoreUserDAO(client),
+		Prediction: persistence.NewFirestorePredictionDAO(client),
+	}
+}
+
+func TestFirestorePartyDAO_DeleteCascadeConformance(t *testing.T) {
+	persistencetest.RunDeleteCascade(t, newFirestoreDAOs)
+}
+
+func TestFirestoreGuestDAO_CreatePendingConformance(t *testing.T) {
+	persistencetest.RunCreatePending(t, newFirestoreDAOs)
+}
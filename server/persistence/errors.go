@@ -3,6 +3,11 @@ package persistence
 import "errors"
 
 var (
-	ErrNotFound   = errors.New("entity not found")
-	ErrCodeExists = errors.New("party code already exists")
+	ErrNotFound       = errors.New("entity not found")
+	ErrCodeExists     = errors.New("party code already exists")
+	ErrUsernameExists = errors.New("guest username already exists")
+	ErrVoteExists     = errors.New("vote already exists")
+	ErrActExists      = errors.New("act already exists")
+	ErrEmailExists    = errors.New("account email already exists")
+	ErrPendingLimit   = errors.New("party has too many pending guests")
 )
@@ -13,10 +13,12 @@ import (
 // GuestDAO defines the persistence operations for guests.
 type GuestDAO interface {
 	Create(ctx context.Context, guest *models.Guest) error
+	CreatePending(ctx context.Context, guest *models.Guest, maxPending int) error
 	GetByID(ctx context.Context, id string) (*models.Guest, error)
 	ListByPartyID(ctx context.Context, partyID string) ([]*models.Guest, error)
 	ListByPartyIDAndStatus(ctx context.Context, partyID string, status models.GuestStatus) ([]*models.Guest, error)
 	UpdateStatus(ctx context.Context, id string, status models.GuestStatus) error
+	UpdateSessionID(ctx context.Context, id, sessionID string) error
 	Delete(ctx context.Context, id string) error
 	ExistsByPartyAndUsername(ctx context.Context, partyID, username string) (bool, error)
 }
@@ -39,6 +41,37 @@ func (d *FirestoreGuestDAO) Create(ctx context.Context, guest *models.Guest) err
 	return err
 }
 
+// CreatePending stores a new guest in Firestore unless its party already has
+// maxPending pending guests, in which case it returns ErrPendingLimit. The
+// count and the write run in one transaction, which also reads the party so
+// that it conflicts with DeleteCascade.
+// Returns ErrNotFound if the party does not exist or is being deleted.
+func (d *FirestoreGuestDAO) CreatePending(ctx context.Context, guest *models.Guest, maxPending int) error {
+	guests := d.client.Collection(guestsCollection)
+	return d.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		party, err := tx.Get(d.client.Collection(partiesCollection).Doc(guest.PartyID))
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return ErrNotFound
+			}
+			return err
+		}
+		if deleting, _ := party.Data()[partyDeletingField].(bool); deleting {
+			return ErrNotFound
+		}
+
+		pending := guests.Where("partyId", "==", guest.PartyID).Where("status", "==", string(models.GuestStatusPending)).Limit(maxPending)
+		docs, err := tx.Documents(pending).GetAll()
+		if err != nil {
+			return err
+		}
+		if len(docs) >= maxPending {
+			return ErrPendingLimit
+		}
+		return tx.Create(guests.Doc(guest.ID), guest)
+	})
+}
+
 // GetByID retrieves a guest by its ID.
 // Returns ErrNotFound if the guest does not exist.
 func (d *FirestoreGuestDAO) GetByID(ctx context.Context, id string) (*models.Guest, error) {
@@ -118,6 +151,20 @@ func (d *FirestoreGuestDAO) UpdateStatus(ctx context.Context, id string, guestSt
 	return err
 }
 
+// UpdateSessionID replaces the session identifier of an existing guest.
+// Returns ErrNotFound if the guest does not exist.
+func (d *FirestoreGuestDAO) UpdateSessionID(ctx context.Context, id, sessionID string) error {
+	_, err := d.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	_, err = d.client.Collection(guestsCollection).Doc(id).Update(ctx, []firestore.Update{
+		{Path: "sessionId", Value: sessionID},
+	})
+	return err
+}
+
 // Delete removes a guest from Firestore.
 // Returns ErrNotFound if the guest does not exist.
 func (d *FirestoreGuestDAO) Delete(ctx context.Context, id string) error {
@@ -0,0 +1,89 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/persistence"
+)
+
+// AccountDAO is the in-memory implementation of persistence.AccountDAO.
+type AccountDAO struct {
+	store *Store
+}
+
+// NewAccountDAO creates a new AccountDAO backed by the given store.
+func NewAccountDAO(store *Store) *AccountDAO {
+	return &AccountDAO{store: store}
+}
+
+// Create stores a new account.
+// Returns persistence.ErrEmailExists if another account uses the email address.
+func (d *AccountDAO) Create(_ context.Context, account *models.Account) error {
+	d.store.mu.Lock()
+	defer d.store.mu.Unlock()
+
+	for _, a := range d.store.accounts {
+		if a.Email == account.Email {
+			return persistence.ErrEmailExists
+		}
+	}
+	d.store.accounts[account.ID] = copyAccount(account)
+	return nil
+}
+
+// GetByID retrieves an account by its ID.
+// Returns persistence.ErrNotFound if the account does not exist.
+func (d *AccountDAO) GetByID(_ context.Context, id string) (*models.Account, error) {
+	d.store.mu.RLock()
+	defer d.store.mu.RUnlock()
+
+	account, ok := d.store.accounts[id]
+	if !ok {
+		return nil, persistence.ErrNotFound
+	}
+	return copyAccount(account), nil
+}
+
+// GetByEmail retrieves the account with the given email address.
+// Returns persistence.ErrNotFound if no account uses it.
+func (d *AccountDAO) GetByEmail(_ context.Context, email string) (*models.Account, error) {
+	d.store.mu.RLock()
+	defer d.store.mu.RUnlock()
+
+	for _, a := range d.store.accounts {
+		if a.Email == email {
+			return copyAccount(a), nil
+		}
+	}
+	return nil, persistence.ErrNotFound
+}
+
+// UpdateSessionID replaces the session identifier of an existing account.
+// Returns persistence.ErrNotFound if the account does not exist.
+func (d *AccountDAO) UpdateSessionID(_ context.Context, id, sessionID string) error {
+	d.store.mu.Lock()
+	defer d.store.mu.Unlock()
+
+	account, ok := d.store.accounts[id]
+	if !ok {
+		return persistence.ErrNotFound
+	}
+	account.SessionID = sessionID
+	return nil
+}
+
+// ReplaceSessionID replaces the session identifier of an account if it is
+// still oldSessionID.
+// Returns persistence.ErrNotFound if the account does not exist or has another session.
+func (d *AccountDAO) ReplaceSessionID(_ context.Context, id, oldSessionID, newSessionID string) error {
+	d.store.mu.Lock()
+	defer d.store.mu.Unlock()
+
+	account, ok := d.store.accounts[id]
+	if !ok || account.SessionID != oldSessionID {
+		return persistence.ErrNotFound
+	}
+	account.SessionID = newSessionID
+	return nil
+}
@@ -0,0 +1,106 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/persistence"
+)
+
+// ActDAO is the in-memory implementation of persistence.ActDAO.
+type ActDAO struct {
+	store *Store
+}
+
+// NewActDAO creates a new ActDAO backed by the given store.
+func NewActDAO(store *Store) *ActDAO {
+	return &ActDAO{store: store}
+}
+
+// Create stores a new act.
+// Returns persistence.ErrActExists if the contest already has an act with the same ID.
+func (d *ActDAO) Create(_ context.Context, act *models.Act) error {
+	d.store.mu.Lock()
+	defer d.store.mu.Unlock()
+
+	key := actKey{contest: act.Contest, id: act.ID}
+	if _, ok := d.store.acts[key]; ok {
+		return persistence.ErrActExists
+	}
+	d.store.acts[key] = copyAct(act)
+	return nil
+}
+
+// GetByID retrieves an act of a contest by its ID.
+// Returns persistence.ErrNotFound if the act does not exist.
+func (d *ActDAO) GetByID(_ context.Context, contest, id string) (*models.Act, error) {
+	d.store.mu.RLock()
+	defer d.store.mu.RUnlock()
+
+	act, ok := d.store.acts[actKey{contest: contest, id: id}]
+	if !ok {
+		return nil, persistence.ErrNotFound
+	}
+	return copyAct(act), nil
+}
+
+// ListByContest retrieves all acts of a contest ordered by ID.
+// Returns an empty slice if the contest has no acts.
+func (d *ActDAO) ListByContest(_ context.Context, contest string) ([]*models.Act, error) {
+	d.store.mu.RLock()
+	defer d.store.mu.RUnlock()
+
+	acts := make([]*models.Act, 0)
+	for key, act := range d.store.acts {
+		if key.contest == contest {
+			acts = append(acts, copyAct(act))
+		}
+	}
+	sort.Slice(acts, func(i, j int) bool { return acts[i].ID < acts[j].ID })
+	return acts, nil
+}
+
+// Update overwrites an existing act.
+// Returns persistence.ErrNotFound if the act does not exist.
+func (d *ActDAO) Update(_ context.Context, act *models.Act) error {
+	d.store.mu.Lock()
+	defer d.store.mu.Unlock()
+
+	key := actKey{contest: act.Contest, id: act.ID}
+	if _, ok := d.store.acts[key]; !ok {
+		return persistence.ErrNotFound
+	}
+	d.store.acts[key] = copyAct(act)
+	return nil
+}
+
+// Delete removes an act.
+// Returns persistence.ErrNotFound if the act does not exist.
+func (d *ActDAO) Delete(_ context.Context, contest, id string) error {
+	d.store.mu.Lock()
+	defer d.store.mu.Unlock()
+
+	key := actKey{contest: contest, id: id}
+	if _, ok := d.store.acts[key]; !ok {
+		return persistence.ErrNotFound
+	}
+	delete(d.store.acts, key)
+	return nil
+}
+
+// ReplaceContest atomically replaces all acts of a contest with the given acts.
+func (d *ActDAO) ReplaceContest(_ context.Context, contest string, acts []*models.Act) error {
+	d.store.mu.Lock()
+	defer d.store.mu.Unlock()
+
+	for key := range d.store.acts {
+		if key.contest == contest {
+			delete(d.store.acts, key)
+		}
+	}
+	for _, act := range acts {
+		d.store.acts[actKey{contest: contest, id: act.ID}] = copyAct(act)
+	}
+	return nil
+}
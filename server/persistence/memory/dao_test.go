@@ -0,0 +1,110 @@
+package memory_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sipgate/eurovision-vote-party/server/persistence"
+	"github.com/sipgate/eurovision-vote-party/server/persistence/memory"
+	"github.com/sipgate/eurovision-vote-party/server/persistence/persistencetest"
+)
+
+func TestPartyDAO(t *testing.T) {
+	persistencetest.RunPartyDAO(t, func(t *testing.T) persistence.PartyDAO {
+		return memory.NewPartyDAO(memory.NewStore())
+	})
+}
+
+func TestGuestDAO(t *testing.T) {
+	persistencetest.RunGuestDAO(t, func(t *testing.T) persistence.GuestDAO {
+		return memory.NewGuestDAO(memory.NewStore())
+	})
+}
+
+func TestVoteDAO(t *testing.T) {
+	persistencetest.RunVoteDAO(t, func(t *testing.T) persistence.VoteDAO {
+		return memory.NewVoteDAO(memory.NewStore())
+	})
+}
+
+func TestUserDAO(t *testing.T) {
+	persistencetest.RunUserDAO(t, func(t *testing.T) persistence.UserDAO {
+		return memory.NewUserDAO(memory.NewStore())
+	})
+}
+
+func TestAccountDAO(t *testing.T) {
+	persistencetest.RunAccountDAO(t, func(t *testing.T) persistence.AccountDAO {
+		return memory.NewAccountDAO(memory.NewStore())
+	})
+}
+
+func TestActDAO(t *testing.T) {
+	persistencetest.RunActDAO(t, func(t *testing.T) persistence.ActDAO {
+		return memory.NewActDAO(memory.NewStore())
+	})
+}
+
+func TestPredictionDAO(t *testing.T) {
+	persistencetest.RunPredictionDAO(t, func(t *testing.T) persistence.PredictionDAO {
+		return memory.NewPredictionDAO(memory.NewStore())
+	})
+}
+
+func TestScoreboardDAO(t *testing.T) {
+	persistencetest.RunScoreboardDAO(t, func(t *testing.T) persistence.ScoreboardDAO {
+		return memory.NewScoreboardDAO(memory.NewStore())
+	})
+}
+
+func TestPartyDAO_CreateIsAtomicForConcurrentCodes(t *testing.T) {
+	dao := memory.NewPartyDAO(memory.NewStore())
+	ctx := context.Background()
+
+	const attempts = 50
+	var wg sync.WaitGroup
+	errs := make(chan error, attempts)
+	for i := 0; i < attempts; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs <- dao.Create(ctx, persistencetest.NewParty(fmt.Sprintf("party-%d", i), "RACE01", "admin-1"))
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+
+	created := 0
+	for err := range errs {
+		if err == nil {
+			created++
+			continue
+		}
+		assert.ErrorIs(t, err, persistence.ErrCodeExists)
+	}
+	assert.Equal(t, 1, created)
+}
+
+// newDAOs returns DAOs sharing a fresh store.
+func newDAOs(t *testing.T) persistencetest.DAOs {
+	store := memory.NewStore()
+	return persistencetest.DAOs{
+		Party:      memory.NewPartyDAO(store),
+		Guest:      memory.NewGuestDAO(store),
+		Vote:       memory.NewVoteDAO(store),
+		User:       memory.NewUserDAO(store),
+		Prediction: memory.NewPredictionDAO(store),
+	}
+}
+
+func TestPartyDAO_DeleteCascade(t *testing.T) {
+	persistencetest.RunDeleteCascade(t, newDAOs)
+}
+
+func TestGuestDAO_CreatePending(t *testing.T) {
+	persistencetest.RunCreatePending(t, newDAOs)
+}
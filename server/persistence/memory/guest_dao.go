@@ -0,0 +1,155 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/persistence"
+)
+
+// GuestDAO is the in-memory implementation of persistence.GuestDAO.
+type GuestDAO struct {
+	store *Store
+}
+
+// NewGuestDAO creates a new GuestDAO backed by the given store.
+func NewGuestDAO(store *Store) *GuestDAO {
+	return &GuestDAO{store: store}
+}
+
+// Create stores a new guest.
+func (d *GuestDAO) Create(_ context.Context, guest *models.Guest) error {
+	d.store.mu.Lock()
+	defer d.store.mu.Unlock()
+
+	d.store.guests[guest.ID] = copyGuest(guest)
+	return nil
+}
+
+// CreatePending stores a new guest unless its party already has maxPending
+// pending guests. Returns persistence.ErrPendingLimit in that case and
+// persistence.ErrNotFound if the party does not exist.
+func (d *GuestDAO) CreatePending(_ context.Context, guest *models.Guest, maxPending int) error {
+	d.store.mu.Lock()
+	defer d.store.mu.Unlock()
+
+	if _, ok := d.store.parties[guest.PartyID]; !ok {
+		return persistence.ErrNotFound
+	}
+
+	pending := 0
+	for _, g := range d.store.guests {
+		if g.PartyID == guest.PartyID && g.Status == models.GuestStatusPending {
+			pending++
+		}
+	}
+	if pending >= maxPending {
+		return persistence.ErrPendingLimit
+	}
+
+	d.store.guests[guest.ID] = copyGuest(guest)
+	return nil
+}
+
+// GetByID retrieves a guest by its ID.
+// Returns persistence.ErrNotFound if the guest does not exist.
+func (d *GuestDAO) GetByID(_ context.Context, id string) (*models.Guest, error) {
+	d.store.mu.RLock()
+	defer d.store.mu.RUnlock()
+
+	guest, ok := d.store.guests[id]
+	if !ok {
+		return nil, persistence.ErrNotFound
+	}
+	return copyGuest(guest), nil
+}
+
+// ListByPartyID retrieves all guests for a given party, ordered by ID.
+// Returns an empty slice if no guests are found.
+func (d *GuestDAO) ListByPartyID(_ context.Context, partyID string) ([]*models.Guest, error) {
+	d.store.mu.RLock()
+	defer d.store.mu.RUnlock()
+
+	return d.filter(func(g *models.Guest) bool {
+		return g.PartyID == partyID
+	}), nil
+}
+
+// ListByPartyIDAndStatus retrieves all guests for a given party filtered by status, ordered by ID.
+// Returns an empty slice if no guests are found.
+func (d *GuestDAO) ListByPartyIDAndStatus(_ context.Context, partyID string, status models.GuestStatus) ([]*models.Guest, error) {
+	d.store.mu.RLock()
+	defer d.store.mu.RUnlock()
+
+	return d.filter(func(g *models.Guest) bool {
+		return g.PartyID == partyID && g.Status == status
+	}), nil
+}
+
+// UpdateStatus updates the status field of an existing guest.
+// Returns persistence.ErrNotFound if the guest does not exist.
+func (d *GuestDAO) UpdateStatus(_ context.Context, id string, status models.GuestStatus) error {
+	d.store.mu.Lock()
+	defer d.store.mu.Unlock()
+
+	guest, ok := d.store.guests[id]
+	if !ok {
+		return persistence.ErrNotFound
+	}
+	guest.Status = status
+	return nil
+}
+
+// UpdateSessionID replaces the session identifier of an existing guest.
+// Returns persistence.ErrNotFound if the guest does not exist.
+func (d *GuestDAO) UpdateSessionID(_ context.Context, id, sessionID string) error {
+	d.store.mu.Lock()
+	defer d.store.mu.Unlock()
+
+	guest, ok := d.store.guests[id]
+	if !ok {
+		return persistence.ErrNotFound
+	}
+	guest.SessionID = sessionID
+	return nil
+}
+
+// Delete removes a guest.
+// Returns persistence.ErrNotFound if the guest does not exist.
+func (d *GuestDAO) Delete(_ context.Context, id string) error {
+	d.store.mu.Lock()
+	defer d.store.mu.Unlock()
+
+	if _, ok := d.store.guests[id]; !ok {
+		return persistence.ErrNotFound
+	}
+	delete(d.store.guests, id)
+	return nil
+}
+
+// ExistsByPartyAndUsername checks whether a guest with the given partyID and username exists.
+func (d *GuestDAO) ExistsByPartyAndUsername(_ context.Context, partyID, username string) (bool, error) {
+	d.store.mu.RLock()
+	defer d.store.mu.RUnlock()
+
+	for _, g := range d.store.guests {
+		if g.PartyID == partyID && g.Username == username {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// filter returns copies of all guests matching keep, ordered by ID.
+// The caller must hold the store lock.
+func (d *GuestDAO) filter(keep func(*models.Guest) bool) []*models.Guest {
+	guests := make([]*models.Guest, 0)
+	for _, g := range d.store.guests {
+		if keep(g) {
+			guests = append(guests, copyGuest(g))
+		}
+	}
+	sort.Slice(guests, func(i, j int) bool { return guests[i].ID < guests[j].ID })
+	return guests
+}
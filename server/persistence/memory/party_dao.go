@@ -0,0 +1,214 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/persistence"
+)
+
+// PartyDAO is the in-memory implementation of persistence.PartyDAO.
+type PartyDAO struct {
+	store *Store
+}
+
+// NewPartyDAO creates a new PartyDAO backed by the given store.
+func NewPartyDAO(store *Store) *PartyDAO {
+	return &PartyDAO{store: store}
+}
+
+// Create stores a new party.
+// Returns persistence.ErrCodeExists if a party with the same code already exists.
+func (d *PartyDAO) Create(_ context.Context, party *models.Party) error {
+	d.store.mu.Lock()
+	defer d.store.mu.Unlock()
+
+	for _, p := range d.store.parties {
+		if p.Code == party.Code {
+			return persistence.ErrCodeExists
+		}
+	}
+
+	d.store.parties[party.ID] = copyParty(party)
+	return nil
+}
+
+// GetByID retrieves a party by its ID.
+// Returns persistence.ErrNotFound if the party does not exist.
+func (d *PartyDAO) GetByID(_ context.Context, id string) (*models.Party, error) {
+	d.store.mu.RLock()
+	defer d.store.mu.RUnlock()
+
+	party, ok := d.store.parties[id]
+	if !ok {
+		return nil, persistence.ErrNotFound
+	}
+	return copyParty(party), nil
+}
+
+// GetByCode retrieves a party by its unique code.
+// Returns persistence.ErrNotFound if no party with the given code exists.
+func (d *PartyDAO) GetByCode(_ context.Context, code string) (*models.Party, error) {
+	d.store.mu.RLock()
+	defer d.store.mu.RUnlock()
+
+	for _, p := range d.store.parties {
+		if p.Code == code {
+			return copyParty(p), nil
+		}
+	}
+	return nil, persistence.ErrNotFound
+}
+
+// ListByAdminID retrieves all parties created by a given admin, ordered by ID.
+// Returns an empty slice if no parties are found.
+func (d *PartyDAO) ListByAdminID(_ context.Context, adminID string) ([]*models.Party, error) {
+	d.store.mu.RLock()
+	defer d.store.mu.RUnlock()
+
+	parties := make([]*models.Party, 0)
+	for _, p := range d.store.parties {
+		if p.AdminID == adminID {
+			parties = append(parties, copyParty(p))
+		}
+	}
+	sort.Slice(parties, func(i, j int) bool { return parties[i].ID < parties[j].ID })
+	return parties, nil
+}
+
+// ListByMemberID retrieves all parties that list the given user as a member,
+// including pending invitations, ordered by ID.
+// Returns an empty slice if no parties are found.
+func (d *PartyDAO) ListByMemberID(_ context.Context, userID string) ([]*models.Party, error) {
+	d.store.mu.RLock()
+	defer d.store.mu.RUnlock()
+
+	parties := make([]*models.Party, 0)
+	for _, p := range d.store.parties {
+		if p.Member(userID) != nil {
+			parties = append(parties, copyParty(p))
+		}
+	}
+	sort.Slice(parties, func(i, j int) bool { return parties[i].ID < parties[j].ID })
+	return parties, nil
+}
+
+// Delete removes a party.
+// Returns persistence.ErrNotFound if the party does not exist.
+func (d *PartyDAO) Delete(_ context.Context, id string) error {
+	d.store.mu.Lock()
+	defer d.store.mu.Unlock()
+
+	if _, ok := d.store.parties[id]; !ok {
+		return persistence.ErrNotFound
+	}
+	delete(d.store.parties, id)
+	return nil
+}
+
+// DeleteCascade atomically removes a party together with all of its guests, votes and predictions.
+// Returns persistence.ErrNotFound if the party does not exist.
+func (d *PartyDAO) DeleteCascade(_ context.Context, id string) error {
+	d.store.mu.Lock()
+	defer d.store.mu.Unlock()
+
+	if _, ok := d.store.parties[id]; !ok {
+		return persistence.ErrNotFound
+	}
+	for voteID, v := range d.store.votes {
+		if v.PartyID == id {
+			delete(d.store.votes, voteID)
+		}
+	}
+	for predictionID, p := range d.store.predictions {
+		if p.PartyID == id {
+			delete(d.store.predictions, predictionID)
+		}
+	}
+	delete(d.store.outcomes, id)
+	for guestID, g := range d.store.guests {
+		if g.PartyID == id {
+			delete(d.store.guests, guestID)
+		}
+	}
+	delete(d.store.parties, id)
+	return nil
+}
+
+// UpdateStatus updates the status of a party.
+// Returns persistence.ErrNotFound if the party does not exist.
+func (d *PartyDAO) UpdateStatus(_ context.Context, id string, status models.PartyStatus) error {
+	d.store.mu.Lock()
+	defer d.store.mu.Unlock()
+
+	party, ok := d.store.parties[id]
+	if !ok {
+		return persistence.ErrNotFound
+	}
+	party.Status = status
+	return nil
+}
+
+// UpdateRevealStep records how many result reveal steps of a party have been announced.
+// Returns persistence.ErrNotFound if the party does not exist.
+func (d *PartyDAO) UpdateRevealStep(_ context.Context, id string, step int) error {
+	d.store.mu.Lock()
+	defer d.store.mu.Unlock()
+
+	party, ok := d.store.parties[id]
+	if !ok {
+		return persistence.ErrNotFound
+	}
+	party.RevealStep = step
+	return nil
+}
+
+// UpdateVotingStartedAt records when voting in a party was opened.
+// Returns persistence.ErrNotFound if the party does not exist.
+func (d *PartyDAO) UpdateVotingStartedAt(_ context.Context, id string, startedAt time.Time) error {
+	d.store.mu.Lock()
+	defer d.store.mu.Unlock()
+
+	party, ok := d.store.parties[id]
+	if !ok {
+		return persistence.ErrNotFound
+	}
+	party.VotingStartedAt = startedAt
+	return nil
+}
+
+// UpdateMembers replaces the members of a party with those update derives
+// from the current ones, holding the store's lock throughout. Errors of
+// update are returned as they are.
+// Returns persistence.ErrNotFound if the party does not exist.
+func (d *PartyDAO) UpdateMembers(_ context.Context, id string, update func(members []models.PartyMember) ([]models.PartyMember, error)) error {
+	d.store.mu.Lock()
+	defer d.store.mu.Unlock()
+
+	party, ok := d.store.parties[id]
+	if !ok {
+		return persistence.ErrNotFound
+	}
+	members, err := update(slices.Clone(party.Members))
+	if err != nil {
+		return err
+	}
+	party.Members = slices.Clone(members)
+	return nil
+}
+
+// CodeExists checks whether a party with the given code exists.
+func (d *PartyDAO) CodeExists(_ context.Context, code string) (bool, error) {
+	d.store.mu.RLock()
+	defer d.store.mu.RUnlock()
+
+	for _, p := range d.store.parties {
+		if p.Code == code {
+			return true, nil
+		}
+	}
+	return false, nil
+}
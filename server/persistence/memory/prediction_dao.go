@@ -0,0 +1,79 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/persistence"
+)
+
+// PredictionDAO is the in-memory implementation of persistence.PredictionDAO.
+type PredictionDAO struct {
+	store *Store
+}
+
+// NewPredictionDAO creates a new PredictionDAO backed by the given store.
+func NewPredictionDAO(store *Store) *PredictionDAO {
+	return &PredictionDAO{store: store}
+}
+
+// Upsert stores a prediction, replacing any previous prediction with the same ID.
+func (d *PredictionDAO) Upsert(_ context.Context, prediction *models.Prediction) error {
+	d.store.mu.Lock()
+	defer d.store.mu.Unlock()
+
+	d.store.predictions[prediction.ID] = copyPrediction(prediction)
+	return nil
+}
+
+// GetByGuestAndParty retrieves a guest's prediction in a party.
+// Returns persistence.ErrNotFound if the guest has not made a prediction.
+func (d *PredictionDAO) GetByGuestAndParty(_ context.Context, guestID, partyID string) (*models.Prediction, error) {
+	d.store.mu.RLock()
+	defer d.store.mu.RUnlock()
+
+	for _, p := range d.store.predictions {
+		if p.GuestID == guestID && p.PartyID == partyID {
+			return copyPrediction(p), nil
+		}
+	}
+	return nil, persistence.ErrNotFound
+}
+
+// ListByPartyID retrieves all predictions made in a party, ordered by ID.
+func (d *PredictionDAO) ListByPartyID(_ context.Context, partyID string) ([]*models.Prediction, error) {
+	d.store.mu.RLock()
+	defer d.store.mu.RUnlock()
+
+	predictions := make([]*models.Prediction, 0)
+	for _, p := range d.store.predictions {
+		if p.PartyID == partyID {
+			predictions = append(predictions, copyPrediction(p))
+		}
+	}
+	sort.Slice(predictions, func(i, j int) bool { return predictions[i].ID < predictions[j].ID })
+	return predictions, nil
+}
+
+// SetOutcome stores the official outcome of a party's show, replacing any previous outcome.
+func (d *PredictionDAO) SetOutcome(_ context.Context, outcome *models.PredictionOutcome) error {
+	d.store.mu.Lock()
+	defer d.store.mu.Unlock()
+
+	d.store.outcomes[outcome.PartyID] = copyOutcome(outcome)
+	return nil
+}
+
+// GetOutcome retrieves the official outcome of a party's show.
+// Returns persistence.ErrNotFound if no outcome has been recorded.
+func (d *PredictionDAO) GetOutcome(_ context.Context, partyID string) (*models.PredictionOutcome, error) {
+	d.store.mu.RLock()
+	defer d.store.mu.RUnlock()
+
+	outcome, ok := d.store.outcomes[partyID]
+	if !ok {
+		return nil, persistence.ErrNotFound
+	}
+	return copyOutcome(outcome), nil
+}
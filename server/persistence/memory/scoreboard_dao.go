@@ -0,0 +1,44 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/persistence"
+)
+
+// ScoreboardDAO is the in-memory implementation of persistence.ScoreboardDAO.
+type ScoreboardDAO struct {
+	store *Store
+}
+
+// NewScoreboardDAO creates a new ScoreboardDAO backed by the given store.
+func NewScoreboardDAO(store *Store) *ScoreboardDAO {
+	return &ScoreboardDAO{store: store}
+}
+
+// Replace stores a scoreboard, replacing any previous scoreboard of the same show.
+func (d *ScoreboardDAO) Replace(_ context.Context, scoreboard *models.Scoreboard) error {
+	d.store.mu.Lock()
+	defer d.store.mu.Unlock()
+
+	d.store.scoreboards[scoreboardKey{scoreboard.Contest, scoreboard.EventType}] = copyScoreboard(scoreboard)
+	return nil
+}
+
+// Get retrieves the scoreboard of a show with its entries ordered by act ID.
+// Returns persistence.ErrNotFound if no scoreboard has been stored for the show.
+func (d *ScoreboardDAO) Get(_ context.Context, contest string, eventType models.EventType) (*models.Scoreboard, error) {
+	d.store.mu.RLock()
+	defer d.store.mu.RUnlock()
+
+	scoreboard, ok := d.store.scoreboards[scoreboardKey{contest, eventType}]
+	if !ok {
+		return nil, persistence.ErrNotFound
+	}
+
+	c := copyScoreboard(scoreboard)
+	sort.Slice(c.Entries, func(i, j int) bool { return c.Entries[i].ActID < c.Entries[j].ActID })
+	return c, nil
+}
@@ -0,0 +1,113 @@
+// Package memory provides map-backed implementations of the persistence DAOs.
+// They mirror the semantics of the Firestore DAOs and are intended for tests
+// and for running the server locally without Firestore.
+package memory
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/sipgate/eurovision-vote-party/server/models"
+)
+
+// Store holds the in-memory state shared by the DAOs in this package.
+// A single lock guards all collections so that operations spanning
+// several entities observe a consistent view.
+type Store struct {
+	mu          sync.RWMutex
+	parties     map[string]*models.Party
+	guests      map[string]*models.Guest
+	votes       map[string]*models.Vote
+	users       map[string]*models.User
+	accounts    map[string]*models.Account
+	acts        map[actKey]*models.Act
+	predictions map[string]*models.Prediction
+	outcomes    map[string]*models.PredictionOutcome
+	scoreboards map[scoreboardKey]*models.Scoreboard
+}
+
+// actKey identifies an act within the catalogue.
+type actKey struct {
+	contest string
+	id      string
+}
+
+// scoreboardKey identifies the scoreboard of one show of a contest edition.
+type scoreboardKey struct {
+	contest   string
+	eventType models.EventType
+}
+
+// NewStore creates an empty Store.
+func NewStore() *Store {
+	return &Store{
+		parties:     make(map[string]*models.Party),
+		guests:      make(map[string]*models.Guest),
+		votes:       make(map[string]*models.Vote),
+		users:       make(map[string]*models.User),
+		accounts:    make(map[string]*models.Account),
+		acts:        make(map[actKey]*models.Act),
+		predictions: make(map[string]*models.Prediction),
+		outcomes:    make(map[string]*models.PredictionOutcome),
+		scoreboards: make(map[scoreboardKey]*models.Scoreboard),
+	}
+}
+
+func copyParty(p *models.Party) *models.Party {
+	c := *p
+	c.Members = slices.Clone(p.Members)
+	return &c
+}
+
+func copyGuest(g *models.Guest) *models.Guest {
+	c := *g
+	return &c
+}
+
+func copyVote(v *models.Vote) *models.Vote {
+	c := *v
+	c.Votes = make(map[int]string, len(v.Votes))
+	for points, actID := range v.Votes {
+		c.Votes[points] = actID
+	}
+	if v.Ratings != nil {
+		c.Ratings = make(map[string]int, len(v.Ratings))
+		for actID, rating := range v.Ratings {
+			c.Ratings[actID] = rating
+		}
+	}
+	return &c
+}
+
+func copyUser(u *models.User) *models.User {
+	c := *u
+	return &c
+}
+
+func copyAccount(a *models.Account) *models.Account {
+	c := *a
+	return &c
+}
+
+func copyAct(a *models.Act) *models.Act {
+	c := *a
+	return &c
+}
+
+func copyPrediction(p *models.Prediction) *models.Prediction {
+	c := *p
+	c.Picks = append([]string(nil), p.Picks...)
+	return &c
+}
+
+func copyOutcome(o *models.PredictionOutcome) *models.PredictionOutcome {
+	c := *o
+	c.Picks = append([]string(nil), o.Picks...)
+	return &c
+}
+
+func copyScoreboard(s *models.Scoreboard) *models.Scoreboard {
+	c := *s
+	c.Entries = append([]models.ScoreboardEntry(nil), s.Entries...)
+	return &c
+}
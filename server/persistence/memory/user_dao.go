@@ -0,0 +1,67 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/persistence"
+)
+
+// UserDAO is the in-memory implementation of persistence.UserDAO.
+type UserDAO struct {
+	store *Store
+}
+
+// NewUserDAO creates a new UserDAO backed by the given store.
+func NewUserDAO(store *Store) *UserDAO {
+	return &UserDAO{store: store}
+}
+
+// Upsert creates or updates a user.
+func (d *UserDAO) Upsert(_ context.Context, user *models.User) error {
+	d.store.mu.Lock()
+	defer d.store.mu.Unlock()
+
+	d.store.users[user.ID] = copyUser(user)
+	return nil
+}
+
+// GetByID retrieves a user by its ID.
+// Returns persistence.ErrNotFound if the user does not exist.
+func (d *UserDAO) GetByID(_ context.Context, id string) (*models.User, error) {
+	d.store.mu.RLock()
+	defer d.store.mu.RUnlock()
+
+	user, ok := d.store.users[id]
+	if !ok {
+		return nil, persistence.ErrNotFound
+	}
+	return copyUser(user), nil
+}
+
+// ListByEmail retrieves all users with the given email address, ordered by ID.
+// Returns an empty slice if no users are found.
+func (d *UserDAO) ListByEmail(_ context.Context, email string) ([]*models.User, error) {
+	return d.listWhere(func(u *models.User) bool { return u.Email == email }), nil
+}
+
+// ListByUsername retrieves all users with the given username, ordered by ID.
+// Returns an empty slice if no users are found.
+func (d *UserDAO) ListByUsername(_ context.Context, username string) ([]*models.User, error) {
+	return d.listWhere(func(u *models.User) bool { return u.Username == username }), nil
+}
+
+func (d *UserDAO) listWhere(match func(u *models.User) bool) []*models.User {
+	d.store.mu.RLock()
+	defer d.store.mu.RUnlock()
+
+	users := make([]*models.User, 0)
+	for _, u := range d.store.users {
+		if match(u) {
+			users = append(users, copyUser(u))
+		}
+	}
+	sort.Slice(users, func(i, j int) bool { return users[i].ID < users[j].ID })
+	return users
+}
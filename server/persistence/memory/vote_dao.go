@@ -0,0 +1,96 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/persistence"
+)
+
+// VoteDAO is the in-memory implementation of persistence.VoteDAO.
+type VoteDAO struct {
+	store *Store
+}
+
+// NewVoteDAO creates a new VoteDAO backed by the given store.
+func NewVoteDAO(store *Store) *VoteDAO {
+	return &VoteDAO{store: store}
+}
+
+// Create stores a new vote.
+// Returns persistence.ErrVoteExists if a vote with the same ID or for the same
+// guest and party already exists.
+func (d *VoteDAO) Create(_ context.Context, vote *models.Vote) error {
+	d.store.mu.Lock()
+	defer d.store.mu.Unlock()
+
+	if _, ok := d.store.votes[vote.ID]; ok {
+		return persistence.ErrVoteExists
+	}
+	for _, v := range d.store.votes {
+		if v.GuestID == vote.GuestID && v.PartyID == vote.PartyID {
+			return persistence.ErrVoteExists
+		}
+	}
+
+	d.store.votes[vote.ID] = copyVote(vote)
+	return nil
+}
+
+// GetByGuestAndParty retrieves a vote by guest ID and party ID.
+// Returns persistence.ErrNotFound if no vote exists for the given guest and party.
+func (d *VoteDAO) GetByGuestAndParty(_ context.Context, guestID, partyID string) (*models.Vote, error) {
+	d.store.mu.RLock()
+	defer d.store.mu.RUnlock()
+
+	for _, v := range d.store.votes {
+		if v.GuestID == guestID && v.PartyID == partyID {
+			return copyVote(v), nil
+		}
+	}
+	return nil, persistence.ErrNotFound
+}
+
+// Update overwrites an existing vote.
+func (d *VoteDAO) Update(_ context.Context, vote *models.Vote) error {
+	d.store.mu.Lock()
+	defer d.store.mu.Unlock()
+
+	d.store.votes[vote.ID] = copyVote(vote)
+	return nil
+}
+
+// UpdateDraft overwrites a vote as long as the stored vote is still a draft.
+// Returns persistence.ErrNotFound if the vote does not exist and
+// persistence.ErrVoteExists if it has been finalized.
+func (d *VoteDAO) UpdateDraft(_ context.Context, vote *models.Vote) error {
+	d.store.mu.Lock()
+	defer d.store.mu.Unlock()
+
+	stored, ok := d.store.votes[vote.ID]
+	if !ok {
+		return persistence.ErrNotFound
+	}
+	if !stored.Draft {
+		return persistence.ErrVoteExists
+	}
+
+	d.store.votes[vote.ID] = copyVote(vote)
+	return nil
+}
+
+// ListByPartyID retrieves all votes for a given party, ordered by ID.
+func (d *VoteDAO) ListByPartyID(_ context.Context, partyID string) ([]*models.Vote, error) {
+	d.store.mu.RLock()
+	defer d.store.mu.RUnlock()
+
+	votes := make([]*models.Vote, 0)
+	for _, v := range d.store.votes {
+		if v.PartyID == partyID {
+			votes = append(votes, copyVote(v))
+		}
+	}
+	sort.Slice(votes, func(i, j int) bool { return votes[i].ID < votes[j].ID })
+	return votes, nil
+}
@@ -2,6 +2,7 @@ package persistence
 
 import (
 	"context"
+	"time"
 
 	"cloud.google.com/go/firestore"
 	"google.golang.org/grpc/codes"
@@ -16,9 +17,14 @@ type PartyDAO interface {
 	GetByID(ctx context.Context, id string) (*models.Party, error)
 	GetByCode(ctx context.Context, code string) (*models.Party, error)
 	ListByAdminID(ctx context.Context, adminID string) ([]*models.Party, error)
+	ListByMemberID(ctx context.Context, userID string) ([]*models.Party, error)
 	Delete(ctx context.Context, id string) error
+	DeleteCascade(ctx context.Context, id string) error
 	CodeExists(ctx context.Context, code string) (bool, error)
 	UpdateStatus(ctx context.Context, id string, status models.PartyStatus) error
+	UpdateRevealStep(ctx context.Context, id string, step int) error
+	UpdateVotingStartedAt(ctx context.Context, id string, startedAt time.Time) error
+	UpdateMembers(ctx context.Context, id string, update func(members []models.PartyMember) ([]models.PartyMember, error)) error
 }
 
 // FirestorePartyDAO is the Firestore implementation of PartyDAO.
@@ -33,6 +39,22 @@ func NewFirestorePartyDAO(client *firestore.Client) *FirestorePartyDAO {
 
 const partiesCollection = "parties"
 
+// firestoreParty adds the IDs of a party's members to its document, so that
+// ListByMemberID can find the party with an array-contains query.
+type firestoreParty struct {
+	models.Party
+	MemberIDs []string `firestore:"memberIds"`
+}
+
+// memberIDs returns the user IDs of the given members.
+func memberIDs(members []models.PartyMember) []string {
+	ids := make([]string, len(members))
+	for i, m := range members {
+		ids[i] = m.UserID
+	}
+	return ids
+}
+
 // Create stores a new party in Firestore.
 // Returns ErrCodeExists if a party with the same code already exists.
 func (d *FirestorePartyDAO) Create(ctx context.Context, party *models.Party) error {
@@ -44,7 +66,10 @@ func (d *FirestorePartyDAO) Create(ctx context.Context, party *models.Party) err
 		return ErrCodeExists
 	}
 
-	_, err = d.client.Collection(partiesCollection).Doc(party.ID).Set(ctx, party)
+	_, err = d.client.Collection(partiesCollection).Doc(party.ID).Set(ctx, firestoreParty{
+		Party:     *party,
+		MemberIDs: memberIDs(party.Members),
+	})
 	return err
 }
 
@@ -109,6 +134,30 @@ func (d *FirestorePartyDAO) ListByAdminID(ctx context.Context, adminID string) (
 	return parties, nil
 }
 
+// ListByMemberID retrieves all parties that list the given user as a member,
+// including pending invitations.
+// Returns an empty slice if no parties are found.
+func (d *FirestorePartyDAO) ListByMemberID(ctx context.Context, userID string) ([]*models.Party, error) {
+	iter := d.client.Collection(partiesCollection).Where("memberIds", "array-contains", userID).Documents(ctx)
+	defer iter.Stop()
+
+	docs, err := iter.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	parties := make([]*models.Party, 0, len(docs))
+	for _, doc := range docs {
+		var party models.Party
+		if err := doc.DataTo(&party); err != nil {
+			return nil, err
+		}
+		parties = append(parties, &party)
+	}
+
+	return parties, nil
+}
+
 // Delete removes a party from Firestore.
 // Returns ErrNotFound if the party does not exist.
 func (d *FirestorePartyDAO) Delete(ctx context.Context, id string) error {
@@ -121,6 +170,78 @@ func (d *FirestorePartyDAO) Delete(ctx context.Context, id string) error {
 	return err
 }
 
+// maxTransactionWrites is the maximum number of writes Firestore accepts in a
+// single transaction.
+const maxTransactionWrites = 500
+
+// partyDeletingField marks a party whose deletion by DeleteCascade has begun
+// but not finished. Joins are refused while it is set.
+const partyDeletingField = "deleting"
+
+// DeleteCascade removes a party together with all of its guests, votes and predictions.
+// Parties with fewer than maxTransactionWrites documents are deleted in a single
+// transaction. Larger parties are marked as being deleted, which stops guests
+// from joining, and removed over several transactions with the party document
+// in the last one. If that fails midway the party is left marked and calling
+// DeleteCascade again completes the deletion.
+// Returns ErrNotFound if the party does not exist.
+func (d *FirestorePartyDAO) DeleteCascade(ctx context.Context, id string) error {
+	for {
+		done, err := d.deleteCascadeStep(ctx, id)
+		if err != nil || done {
+			return err
+		}
+	}
+}
+
+// deleteCascadeStep deletes as much of a party as fits into one transaction,
+// reading the documents to delete inside it. It reports whether the party is
+// gone.
+func (d *FirestorePartyDAO) deleteCascadeStep(ctx context.Context, id string) (bool, error) {
+	partyRef := d.client.Collection(partiesCollection).Doc(id)
+	var done bool
+	err := d.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		if _, err := tx.Get(partyRef); err != nil {
+			if status.Code(err) == codes.NotFound {
+				return ErrNotFound
+			}
+			return err
+		}
+
+		var refs []*firestore.DocumentRef
+		for _, collection := range []string{votesCollection, predictionsCollection, guestsCollection} {
+			query := d.client.Collection(collection).Where("partyId", "==", id).Select().Limit(maxTransactionWrites)
+			docs, err := tx.Documents(query).GetAll()
+			if err != nil {
+				return err
+			}
+			for _, doc := range docs {
+				refs = append(refs, doc.Ref)
+			}
+		}
+
+		// The outcome and the party document take the last two writes.
+		done = len(refs) <= maxTransactionWrites-2
+		if !done {
+			for _, ref := range refs[:maxTransactionWrites-1] {
+				if err := tx.Delete(ref); err != nil {
+					return err
+				}
+			}
+			return tx.Update(partyRef, []firestore.Update{{Path: partyDeletingField, Value: true}})
+		}
+
+		refs = append(refs, d.client.Collection(predictionOutcomesCollection).Doc(id), partyRef)
+		for _, ref := range refs {
+			if err := tx.Delete(ref); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return done, err
+}
+
 // UpdateStatus updates the status of a party.
 // Returns ErrNotFound if the party does not exist.
 func (d *FirestorePartyDAO) UpdateStatus(ctx context.Context, id string, status models.PartyStatus) error {
@@ -135,6 +256,66 @@ func (d *FirestorePartyDAO) UpdateStatus(ctx context.Context, id string, status
 	return err
 }
 
+// UpdateRevealStep records how many result reveal steps of a party have been announced.
+// Returns ErrNotFound if the party does not exist.
+func (d *FirestorePartyDAO) UpdateRevealStep(ctx context.Context, id string, step int) error {
+	_, err := d.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	_, err = d.client.Collection(partiesCollection).Doc(id).Set(ctx, map[string]interface{}{
+		"revealStep": step,
+	}, firestore.MergeAll)
+	return err
+}
+
+// UpdateVotingStartedAt records when voting in a party was opened.
+// Returns ErrNotFound if the party does not exist.
+func (d *FirestorePartyDAO) UpdateVotingStartedAt(ctx context.Context, id string, startedAt time.Time) error {
+	_, err := d.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	_, err = d.client.Collection(partiesCollection).Doc(id).Set(ctx, map[string]interface{}{
+		"votingStartedAt": startedAt,
+	}, firestore.MergeAll)
+	return err
+}
+
+// UpdateMembers replaces the members of a party with those update derives
+// from the current ones, in one transaction. update may be called again if
+// the party changes concurrently; its errors are returned as they are.
+// Returns ErrNotFound if the party does not exist.
+func (d *FirestorePartyDAO) UpdateMembers(ctx context.Context, id string, update func(members []models.PartyMember) ([]models.PartyMember, error)) error {
+	ref := d.client.Collection(partiesCollection).Doc(id)
+	return d.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		doc, err := tx.Get(ref)
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return ErrNotFound
+			}
+			return err
+		}
+
+		var party models.Party
+		if err := doc.DataTo(&party); err != nil {
+			return err
+		}
+
+		members, err := update(party.Members)
+		if err != nil {
+			return err
+		}
+
+		return tx.Set(ref, map[string]interface{}{
+			"members":   members,
+			"memberIds": memberIDs(members),
+		}, firestore.MergeAll)
+	})
+}
+
 // CodeExists checks whether a party with the given code exists.
 func (d *FirestorePartyDAO) CodeExists(ctx context.Context, code string) (bool, error) {
 	iter := d.client.Collection(partiesCollection).Where("code", "==", code).Limit(1).Documents(ctx)
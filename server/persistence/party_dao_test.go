@@ -236,3 +236,34 @@ func TestFirestorePartyDAO_CodeExists(t *testing.T) {
 		assert.False(t, exists)
 	})
 }
+
+func TestFirestorePartyDAO_DeleteCascadeResumesInterruptedDeletion(t *testing.T) {
+	client := setupFirestoreClient(t)
+	t.Cleanup(func() {
+		cleanupCollection(t, client, "parties")
+		cleanupCollection(t, client, "guests")
+	})
+
+	partyDAO := persistence.NewFirestorePartyDAO(client)
+	guestDAO := persistence.NewFirestoreGuestDAO(client)
+	ctx := context.Background()
+
+	require.NoError(t, partyDAO.Create(ctx, createTestParty("party-del-1", "DELCODE1", "admin-1")))
+	require.NoError(t, guestDAO.Create(ctx, &models.Guest{ID: "guest-del-1", PartyID: "party-del-1", Username: "alice", Status: models.GuestStatusApproved}))
+
+	// A deletion of a large party that failed after its first transaction
+	// leaves the party marked.
+	_, err := client.Collection("parties").Doc("party-del-1").Update(ctx, []firestore.Update{{Path: "deleting", Value: true}})
+	require.NoError(t, err)
+
+	err = guestDAO.CreatePending(ctx, &models.Guest{ID: "guest-del-2", PartyID: "party-del-1", Username: "bob", Status: models.GuestStatusPending}, 10)
+	assert.ErrorIs(t, err, persistence.ErrNotFound)
+
+	require.NoError(t, partyDAO.DeleteCascade(ctx, "party-del-1"))
+
+	_, err = partyDAO.GetByID(ctx, "party-del-1")
+	assert.ErrorIs(t, err, persistence.ErrNotFound)
+	guests, err := guestDAO.ListByPartyID(ctx, "party-del-1")
+	require.NoError(t, err)
+	assert.Empty(t, guests)
+}
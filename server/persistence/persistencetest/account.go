@@ -0,0 +1,103 @@
+package persistencetest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/persistence"
+)
+
+func newAccount(id, email string) *models.Account {
+	return &models.Account{
+		ID:           id,
+		Email:        email,
+		PasswordHash: "$2a$10$hash-of-" + id,
+		CreatedAt:    time.Date(2025, 5, 17, 18, 0, 0, 0, time.UTC),
+		SessionID:    "session-of-" + id,
+	}
+}
+
+// RunAccountDAO runs the AccountDAO conformance suite.
+func RunAccountDAO(t *testing.T, newDAO func(t *testing.T) persistence.AccountDAO) {
+	ctx := context.Background()
+
+	t.Run("Create stores account", func(t *testing.T) {
+		dao := newDAO(t)
+		account := newAccount("account-1", "alice@example.com")
+
+		require.NoError(t, dao.Create(ctx, account))
+
+		retrieved, err := dao.GetByID(ctx, "account-1")
+		require.NoError(t, err)
+		assert.Equal(t, account, retrieved)
+
+		retrieved, err = dao.GetByEmail(ctx, "alice@example.com")
+		require.NoError(t, err)
+		assert.Equal(t, account, retrieved)
+	})
+
+	t.Run("Create rejects duplicate email", func(t *testing.T) {
+		dao := newDAO(t)
+		require.NoError(t, dao.Create(ctx, newAccount("account-1", "alice@example.com")))
+
+		err := dao.Create(ctx, newAccount("account-2", "alice@example.com"))
+
+		assert.ErrorIs(t, err, persistence.ErrEmailExists)
+	})
+
+	t.Run("GetByID returns ErrNotFound for missing account", func(t *testing.T) {
+		dao := newDAO(t)
+
+		_, err := dao.GetByID(ctx, "nonexistent-id")
+
+		assert.ErrorIs(t, err, persistence.ErrNotFound)
+	})
+
+	t.Run("GetByEmail returns ErrNotFound for unknown email", func(t *testing.T) {
+		dao := newDAO(t)
+		require.NoError(t, dao.Create(ctx, newAccount("account-1", "alice@example.com")))
+
+		_, err := dao.GetByEmail(ctx, "bob@example.com")
+
+		assert.ErrorIs(t, err, persistence.ErrNotFound)
+	})
+
+	t.Run("UpdateSessionID replaces session", func(t *testing.T) {
+		dao := newDAO(t)
+		require.NoError(t, dao.Create(ctx, newAccount("account-1", "alice@example.com")))
+
+		require.NoError(t, dao.UpdateSessionID(ctx, "account-1", "session-2"))
+
+		retrieved, err := dao.GetByID(ctx, "account-1")
+		require.NoError(t, err)
+		assert.Equal(t, "session-2", retrieved.SessionID)
+	})
+
+	t.Run("ReplaceSessionID replaces only the expected session", func(t *testing.T) {
+		dao := newDAO(t)
+		account := newAccount("account-1", "alice@example.com")
+		require.NoError(t, dao.Create(ctx, account))
+
+		require.NoError(t, dao.ReplaceSessionID(ctx, "account-1", account.SessionID, "session-2"))
+		err := dao.ReplaceSessionID(ctx, "account-1", account.SessionID, "session-3")
+
+		assert.ErrorIs(t, err, persistence.ErrNotFound)
+		retrieved, err := dao.GetByID(ctx, "account-1")
+		require.NoError(t, err)
+		assert.Equal(t, "session-2", retrieved.SessionID)
+		assert.ErrorIs(t, dao.ReplaceSessionID(ctx, "nonexistent-id", "session-2", "session-3"), persistence.ErrNotFound)
+	})
+
+	t.Run("UpdateSessionID returns ErrNotFound for missing account", func(t *testing.T) {
+		dao := newDAO(t)
+
+		err := dao.UpdateSessionID(ctx, "nonexistent-id", "session-2")
+
+		assert.ErrorIs(t, err, persistence.ErrNotFound)
+	})
+}
@@ -0,0 +1,138 @@
+package persistencetest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/persistence"
+)
+
+// NewAct returns a valid grand final act of the given contest for use in tests.
+func NewAct(contest, id string, runningOrder int) *models.Act {
+	return &models.Act{
+		ID:           id,
+		Contest:      contest,
+		Country:      "Sweden",
+		Artist:       "Artist " + id,
+		Song:         "Song " + id,
+		RunningOrder: runningOrder,
+		EventType:    models.EventGrandFinal,
+	}
+}
+
+// RunActDAO runs the ActDAO conformance suite.
+func RunActDAO(t *testing.T, newDAO func(t *testing.T) persistence.ActDAO) {
+	ctx := context.Background()
+
+	t.Run("Create stores act", func(t *testing.T) {
+		dao := newDAO(t)
+		act := NewAct("esc-2026", "se-2026", 1)
+
+		require.NoError(t, dao.Create(ctx, act))
+
+		retrieved, err := dao.GetByID(ctx, "esc-2026", "se-2026")
+		require.NoError(t, err)
+		assert.Equal(t, act, retrieved)
+	})
+
+	t.Run("Create returns ErrActExists for duplicate ID within a contest", func(t *testing.T) {
+		dao := newDAO(t)
+		require.NoError(t, dao.Create(ctx, NewAct("esc-2026", "se-2026", 1)))
+
+		err := dao.Create(ctx, NewAct("esc-2026", "se-2026", 2))
+		assert.ErrorIs(t, err, persistence.ErrActExists)
+
+		err = dao.Create(ctx, NewAct("jesc-2026", "se-2026", 1))
+		assert.NoError(t, err)
+	})
+
+	t.Run("GetByID returns ErrNotFound for missing act", func(t *testing.T) {
+		dao := newDAO(t)
+		require.NoError(t, dao.Create(ctx, NewAct("esc-2026", "se-2026", 1)))
+
+		_, err := dao.GetByID(ctx, "jesc-2026", "se-2026")
+
+		assert.ErrorIs(t, err, persistence.ErrNotFound)
+	})
+
+	t.Run("ListByContest returns the contest's acts ordered by ID", func(t *testing.T) {
+		dao := newDAO(t)
+		require.NoError(t, dao.Create(ctx, NewAct("esc-2026", "se-2026", 2)))
+		require.NoError(t, dao.Create(ctx, NewAct("esc-2026", "at-2026", 1)))
+		require.NoError(t, dao.Create(ctx, NewAct("jesc-2026", "fr-2026", 1)))
+
+		acts, err := dao.ListByContest(ctx, "esc-2026")
+
+		require.NoError(t, err)
+		require.Len(t, acts, 2)
+		assert.Equal(t, "at-2026", acts[0].ID)
+		assert.Equal(t, "se-2026", acts[1].ID)
+	})
+
+	t.Run("ListByContest returns empty slice for unknown contest", func(t *testing.T) {
+		dao := newDAO(t)
+
+		acts, err := dao.ListByContest(ctx, "esc-1956")
+
+		require.NoError(t, err)
+		assert.NotNil(t, acts)
+		assert.Empty(t, acts)
+	})
+
+	t.Run("Update overwrites act", func(t *testing.T) {
+		dao := newDAO(t)
+		require.NoError(t, dao.Create(ctx, NewAct("esc-2026", "se-2026", 1)))
+
+		updated := NewAct("esc-2026", "se-2026", 7)
+		updated.Song = "New Song"
+		updated.EventType = models.EventSemifinal2
+		updated.Qualification = models.QualificationQualified
+		updated.FinalRunningOrder = 12
+		require.NoError(t, dao.Update(ctx, updated))
+
+		retrieved, err := dao.GetByID(ctx, "esc-2026", "se-2026")
+		require.NoError(t, err)
+		assert.Equal(t, updated, retrieved)
+	})
+
+	t.Run("Update returns ErrNotFound for missing act", func(t *testing.T) {
+		dao := newDAO(t)
+
+		err := dao.Update(ctx, NewAct("esc-2026", "se-2026", 1))
+
+		assert.ErrorIs(t, err, persistence.ErrNotFound)
+	})
+
+	t.Run("Delete removes act", func(t *testing.T) {
+		dao := newDAO(t)
+		require.NoError(t, dao.Create(ctx, NewAct("esc-2026", "se-2026", 1)))
+
+		require.NoError(t, dao.Delete(ctx, "esc-2026", "se-2026"))
+
+		_, err := dao.GetByID(ctx, "esc-2026", "se-2026")
+		assert.ErrorIs(t, err, persistence.ErrNotFound)
+		assert.ErrorIs(t, dao.Delete(ctx, "esc-2026", "se-2026"), persistence.ErrNotFound)
+	})
+
+	t.Run("ReplaceContest replaces only the contest's acts", func(t *testing.T) {
+		dao := newDAO(t)
+		require.NoError(t, dao.Create(ctx, NewAct("esc-2026", "se-2026", 1)))
+		require.NoError(t, dao.Create(ctx, NewAct("esc-2026", "no-2026", 2)))
+		require.NoError(t, dao.Create(ctx, NewAct("jesc-2026", "fr-2026", 1)))
+
+		replacement := []*models.Act{NewAct("esc-2026", "no-2026", 1), NewAct("esc-2026", "it-2026", 2)}
+		require.NoError(t, dao.ReplaceContest(ctx, "esc-2026", replacement))
+
+		acts, err := dao.ListByContest(ctx, "esc-2026")
+		require.NoError(t, err)
+		assert.Equal(t, []*models.Act{replacement[1], replacement[0]}, acts)
+
+		other, err := dao.ListByContest(ctx, "jesc-2026")
+		require.NoError(t, err)
+		assert.Len(t, other, 1)
+	})
+}
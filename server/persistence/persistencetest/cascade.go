@@ -0,0 +1,146 @@
+package persistencetest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/persistence"
+)
+
+// DAOs bundles DAOs that share the same underlying storage.
+type DAOs struct {
+	Party      persistence.PartyDAO
+	Guest      persistence.GuestDAO
+	Vote       persistence.VoteDAO
+	User       persistence.UserDAO
+	Prediction persistence.PredictionDAO
+}
+
+// RunDeleteCascade runs the conformance suite for PartyDAO.DeleteCascade.
+func RunDeleteCascade(t *testing.T, newDAOs func(t *testing.T) DAOs) {
+	ctx := context.Background()
+
+	t.Run("removes party, guests, votes and predictions", func(t *testing.T) {
+		daos := newDAOs(t)
+		seedParty(t, daos, "party-1", "CASC01", 3)
+		seedParty(t, daos, "party-2", "CASC02", 2)
+
+		require.NoError(t, daos.Party.DeleteCascade(ctx, "party-1"))
+
+		assertPartyEmpty(t, daos, "party-1")
+
+		_, err := daos.Party.GetByID(ctx, "party-2")
+		assert.NoError(t, err)
+		guests, err := daos.Guest.ListByPartyID(ctx, "party-2")
+		require.NoError(t, err)
+		assert.Len(t, guests, 2)
+		votes, err := daos.Vote.ListByPartyID(ctx, "party-2")
+		require.NoError(t, err)
+		assert.Len(t, votes, 2)
+		predictions, err := daos.Prediction.ListByPartyID(ctx, "party-2")
+		require.NoError(t, err)
+		assert.Len(t, predictions, 2)
+		_, err = daos.Prediction.GetOutcome(ctx, "party-2")
+		assert.NoError(t, err)
+	})
+
+	t.Run("leaves nothing behind for a party recreated with the same IDs", func(t *testing.T) {
+		daos := newDAOs(t)
+		seedParty(t, daos, "party-1", "CASC05", 2)
+
+		require.NoError(t, daos.Party.DeleteCascade(ctx, "party-1"))
+
+		// Points left over from the deleted votes would collide with or be
+		// read back alongside those of the new ones.
+		seedParty(t, daos, "party-1", "CASC05", 2)
+		votes, err := daos.Vote.ListByPartyID(ctx, "party-1")
+		require.NoError(t, err)
+		require.Len(t, votes, 2)
+		for _, vote := range votes {
+			assert.Equal(t, NewVote(vote.ID, vote.GuestID, vote.PartyID).Votes, vote.Votes)
+		}
+	})
+
+	t.Run("removes parties larger than a single write batch", func(t *testing.T) {
+		daos := newDAOs(t)
+		seedParty(t, daos, "party-1", "CASC03", 300)
+
+		require.NoError(t, daos.Party.DeleteCascade(ctx, "party-1"))
+
+		assertPartyEmpty(t, daos, "party-1")
+	})
+
+	t.Run("removes party without guests", func(t *testing.T) {
+		daos := newDAOs(t)
+		seedParty(t, daos, "party-1", "CASC04", 0)
+
+		require.NoError(t, daos.Party.DeleteCascade(ctx, "party-1"))
+
+		assertPartyEmpty(t, daos, "party-1")
+	})
+
+	t.Run("returns ErrNotFound for missing party", func(t *testing.T) {
+		daos := newDAOs(t)
+
+		err := daos.Party.DeleteCascade(ctx, "nonexistent-id")
+
+		assert.ErrorIs(t, err, persistence.ErrNotFound)
+	})
+}
+
+// seedParty creates a party with the given number of approved guests, each
+// of which has submitted a vote and a prediction, and records the party's
+// prediction outcome.
+func seedParty(t *testing.T, daos DAOs, partyID, code string, guests int) {
+	t.Helper()
+	ctx := context.Background()
+
+	party := NewParty(partyID, code, "admin-1")
+	party.Members = []models.PartyMember{NewMember("cohost-1", models.PartyRoleCoHost, models.MemberStatusActive)}
+	require.NoError(t, daos.Party.Create(ctx, party))
+	for i := 0; i < guests; i++ {
+		guestID := fmt.Sprintf("%s-guest-%d", partyID, i)
+		require.NoError(t, daos.Guest.Create(ctx, NewGuest(guestID, partyID, fmt.Sprintf("guest%d", i), models.GuestStatusApproved)))
+		require.NoError(t, daos.Vote.Create(ctx, NewVote(fmt.Sprintf("%s-vote-%d", partyID, i), guestID, partyID)))
+		require.NoError(t, daos.Prediction.Upsert(ctx, NewPrediction(guestID, partyID)))
+	}
+	require.NoError(t, daos.Prediction.SetOutcome(ctx, &models.PredictionOutcome{
+		PartyID:    partyID,
+		Picks:      []string{actID(1), actID(2), actID(3)},
+		RecordedAt: time.Now().UTC(),
+	}))
+}
+
+// assertPartyEmpty fails the test if anything is left behind for the party.
+func assertPartyEmpty(t *testing.T, daos DAOs, partyID string) {
+	t.Helper()
+	ctx := context.Background()
+
+	_, err := daos.Party.GetByID(ctx, partyID)
+	assert.ErrorIs(t, err, persistence.ErrNotFound)
+
+	guests, err := daos.Guest.ListByPartyID(ctx, partyID)
+	require.NoError(t, err)
+	assert.Empty(t, guests)
+
+	votes, err := daos.Vote.ListByPartyID(ctx, partyID)
+	require.NoError(t, err)
+	assert.Empty(t, votes)
+
+	predictions, err := daos.Prediction.ListByPartyID(ctx, partyID)
+	require.NoError(t, err)
+	assert.Empty(t, predictions)
+
+	_, err = daos.Prediction.GetOutcome(ctx, partyID)
+	assert.ErrorIs(t, err, persistence.ErrNotFound)
+
+	parties, err := daos.Party.ListByMemberID(ctx, "cohost-1")
+	require.NoError(t, err)
+	assert.NotContains(t, partyIDs(parties), partyID)
+}
@@ -0,0 +1,7 @@
+// Package persistencetest provides conformance suites for the persistence DAO
+// interfaces. Every DAO implementation should pass these suites so that the
+// services behave identically regardless of the configured backend.
+//
+// Each suite takes a factory that returns a DAO backed by empty storage; it is
+// called once per subtest.
+package persistencetest
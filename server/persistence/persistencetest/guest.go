@@ -0,0 +1,221 @@
+package persistencetest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/persistence"
+)
+
+// NewGuest returns a valid guest for use in tests.
+func NewGuest(id, partyID, username string, status models.GuestStatus) *models.Guest {
+	return &models.Guest{
+		ID:        id,
+		PartyID:   partyID,
+		Username:  username,
+		Status:    status,
+		CreatedAt: time.Now().UTC().Truncate(time.Millisecond),
+		SessionID: "session-" + id,
+	}
+}
+
+// RunGuestDAO runs the GuestDAO conformance suite.
+func RunGuestDAO(t *testing.T, newDAO func(t *testing.T) persistence.GuestDAO) {
+	ctx := context.Background()
+
+	t.Run("Create stores guest", func(t *testing.T) {
+		dao := newDAO(t)
+		guest := NewGuest("guest-1", "party-1", "alice", models.GuestStatusPending)
+
+		require.NoError(t, dao.Create(ctx, guest))
+
+		retrieved, err := dao.GetByID(ctx, guest.ID)
+		require.NoError(t, err)
+		assert.Equal(t, guest.ID, retrieved.ID)
+		assert.Equal(t, guest.PartyID, retrieved.PartyID)
+		assert.Equal(t, guest.Username, retrieved.Username)
+		assert.Equal(t, guest.Status, retrieved.Status)
+		assert.True(t, guest.CreatedAt.Equal(retrieved.CreatedAt))
+		assert.Equal(t, guest.SessionID, retrieved.SessionID)
+	})
+
+	t.Run("GetByID returns ErrNotFound for missing guest", func(t *testing.T) {
+		dao := newDAO(t)
+
+		_, err := dao.GetByID(ctx, "nonexistent-id")
+
+		assert.ErrorIs(t, err, persistence.ErrNotFound)
+	})
+
+	t.Run("ListByPartyID returns only the party's guests", func(t *testing.T) {
+		dao := newDAO(t)
+		require.NoError(t, dao.Create(ctx, NewGuest("guest-1", "party-1", "alice", models.GuestStatusPending)))
+		require.NoError(t, dao.Create(ctx, NewGuest("guest-2", "party-1", "bob", models.GuestStatusApproved)))
+		require.NoError(t, dao.Create(ctx, NewGuest("guest-3", "party-2", "carol", models.GuestStatusPending)))
+
+		guests, err := dao.ListByPartyID(ctx, "party-1")
+
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"guest-1", "guest-2"}, guestIDs(guests))
+	})
+
+	t.Run("ListByPartyID returns empty non-nil slice", func(t *testing.T) {
+		dao := newDAO(t)
+
+		guests, err := dao.ListByPartyID(ctx, "party-without-guests")
+
+		require.NoError(t, err)
+		assert.NotNil(t, guests)
+		assert.Empty(t, guests)
+	})
+
+	t.Run("ListByPartyIDAndStatus filters by status", func(t *testing.T) {
+		dao := newDAO(t)
+		require.NoError(t, dao.Create(ctx, NewGuest("guest-1", "party-1", "alice", models.GuestStatusPending)))
+		require.NoError(t, dao.Create(ctx, NewGuest("guest-2", "party-1", "bob", models.GuestStatusApproved)))
+		require.NoError(t, dao.Create(ctx, NewGuest("guest-3", "party-1", "carol", models.GuestStatusPending)))
+		require.NoError(t, dao.Create(ctx, NewGuest("guest-4", "party-2", "dave", models.GuestStatusPending)))
+
+		pending, err := dao.ListByPartyIDAndStatus(ctx, "party-1", models.GuestStatusPending)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"guest-1", "guest-3"}, guestIDs(pending))
+
+		rejected, err := dao.ListByPartyIDAndStatus(ctx, "party-1", models.GuestStatusRejected)
+		require.NoError(t, err)
+		assert.NotNil(t, rejected)
+		assert.Empty(t, rejected)
+	})
+
+	t.Run("UpdateStatus changes status", func(t *testing.T) {
+		dao := newDAO(t)
+		require.NoError(t, dao.Create(ctx, NewGuest("guest-1", "party-1", "alice", models.GuestStatusPending)))
+
+		require.NoError(t, dao.UpdateStatus(ctx, "guest-1", models.GuestStatusApproved))
+
+		retrieved, err := dao.GetByID(ctx, "guest-1")
+		require.NoError(t, err)
+		assert.Equal(t, models.GuestStatusApproved, retrieved.Status)
+		assert.Equal(t, "alice", retrieved.Username)
+	})
+
+	t.Run("UpdateStatus returns ErrNotFound for missing guest", func(t *testing.T) {
+		dao := newDAO(t)
+
+		err := dao.UpdateStatus(ctx, "nonexistent-id", models.GuestStatusApproved)
+
+		assert.ErrorIs(t, err, persistence.ErrNotFound)
+	})
+
+	t.Run("UpdateSessionID replaces session", func(t *testing.T) {
+		dao := newDAO(t)
+		require.NoError(t, dao.Create(ctx, NewGuest("guest-1", "party-1", "alice", models.GuestStatusApproved)))
+
+		require.NoError(t, dao.UpdateSessionID(ctx, "guest-1", "rotated"))
+
+		retrieved, err := dao.GetByID(ctx, "guest-1")
+		require.NoError(t, err)
+		assert.Equal(t, "rotated", retrieved.SessionID)
+		assert.Equal(t, models.GuestStatusApproved, retrieved.Status)
+	})
+
+	t.Run("UpdateSessionID returns ErrNotFound for missing guest", func(t *testing.T) {
+		dao := newDAO(t)
+
+		err := dao.UpdateSessionID(ctx, "nonexistent-id", "rotated")
+
+		assert.ErrorIs(t, err, persistence.ErrNotFound)
+	})
+
+	t.Run("Delete removes guest", func(t *testing.T) {
+		dao := newDAO(t)
+		require.NoError(t, dao.Create(ctx, NewGuest("guest-1", "party-1", "alice", models.GuestStatusPending)))
+
+		require.NoError(t, dao.Delete(ctx, "guest-1"))
+
+		_, err := dao.GetByID(ctx, "guest-1")
+		assert.ErrorIs(t, err, persistence.ErrNotFound)
+	})
+
+	t.Run("Delete returns ErrNotFound for missing guest", func(t *testing.T) {
+		dao := newDAO(t)
+
+		err := dao.Delete(ctx, "nonexistent-id")
+
+		assert.ErrorIs(t, err, persistence.ErrNotFound)
+	})
+
+	t.Run("ExistsByPartyAndUsername is scoped to the party", func(t *testing.T) {
+		dao := newDAO(t)
+		require.NoError(t, dao.Create(ctx, NewGuest("guest-1", "party-1", "alice", models.GuestStatusPending)))
+
+		exists, err := dao.ExistsByPartyAndUsername(ctx, "party-1", "alice")
+		require.NoError(t, err)
+		assert.True(t, exists)
+
+		exists, err = dao.ExistsByPartyAndUsername(ctx, "party-2", "alice")
+		require.NoError(t, err)
+		assert.False(t, exists)
+
+		exists, err = dao.ExistsByPartyAndUsername(ctx, "party-1", "bob")
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+}
+
+func guestIDs(guests []*models.Guest) []string {
+	ids := make([]string, len(guests))
+	for i, g := range guests {
+		ids[i] = g.ID
+	}
+	return ids
+}
+
+// RunCreatePending runs the conformance suite for GuestDAO.CreatePending,
+// which needs the guest's party to exist.
+func RunCreatePending(t *testing.T, newDAOs func(t *testing.T) DAOs) {
+	ctx := context.Background()
+
+	t.Run("stops at the pending limit of the party", func(t *testing.T) {
+		daos := newDAOs(t)
+		require.NoError(t, daos.Party.Create(ctx, NewParty("party-1", "JOIN01", "admin-1")))
+		require.NoError(t, daos.Party.Create(ctx, NewParty("party-2", "JOIN02", "admin-1")))
+		require.NoError(t, daos.Guest.Create(ctx, NewGuest("guest-1", "party-1", "alice", models.GuestStatusPending)))
+		require.NoError(t, daos.Guest.Create(ctx, NewGuest("guest-2", "party-1", "bob", models.GuestStatusApproved)))
+		require.NoError(t, daos.Guest.Create(ctx, NewGuest("guest-3", "party-2", "carol", models.GuestStatusPending)))
+
+		require.NoError(t, daos.Guest.CreatePending(ctx, NewGuest("guest-4", "party-1", "dave", models.GuestStatusPending), 2))
+		err := daos.Guest.CreatePending(ctx, NewGuest("guest-5", "party-1", "erin", models.GuestStatusPending), 2)
+
+		assert.ErrorIs(t, err, persistence.ErrPendingLimit)
+		_, err = daos.Guest.GetByID(ctx, "guest-5")
+		assert.ErrorIs(t, err, persistence.ErrNotFound)
+		_, err = daos.Guest.GetByID(ctx, "guest-4")
+		assert.NoError(t, err)
+	})
+
+	t.Run("returns ErrNotFound once the party is deleted", func(t *testing.T) {
+		daos := newDAOs(t)
+		seedParty(t, daos, "party-1", "JOIN03", 1)
+		require.NoError(t, daos.Party.DeleteCascade(ctx, "party-1"))
+
+		err := daos.Guest.CreatePending(ctx, NewGuest("guest-late", "party-1", "late", models.GuestStatusPending), 10)
+
+		assert.ErrorIs(t, err, persistence.ErrNotFound)
+		guests, err := daos.Guest.ListByPartyID(ctx, "party-1")
+		require.NoError(t, err)
+		assert.Empty(t, guests)
+	})
+
+	t.Run("returns ErrNotFound for missing party", func(t *testing.T) {
+		daos := newDAOs(t)
+
+		err := daos.Guest.CreatePending(ctx, NewGuest("guest-1", "nonexistent-id", "alice", models.GuestStatusPending), 10)
+
+		assert.ErrorIs(t, err, persistence.ErrNotFound)
+	})
+}
@@ -0,0 +1,411 @@
+package persistencetest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/persistence"
+)
+
+// NewParty returns a valid active grand final party for use in tests.
+func NewParty(id, code, adminID string) *models.Party {
+	return &models.Party{
+		ID:            id,
+		Name:          "Test Party",
+		Code:          code,
+		EventType:     models.EventGrandFinal,
+		Contest:       models.DefaultContest,
+		AdminID:       adminID,
+		Status:        models.PartyStatusActive,
+		CreatedAt:     time.Now().UTC().Truncate(time.Millisecond),
+		ScoringSystem: models.DefaultScoringSystem,
+	}
+}
+
+// NewMember returns a party member invited by "admin-1" for use in tests.
+func NewMember(userID string, role models.PartyRole, status models.MemberStatus) models.PartyMember {
+	return models.PartyMember{
+		UserID:    userID,
+		Username:  userID + "_name",
+		Role:      role,
+		Status:    status,
+		InvitedBy: "admin-1",
+		InvitedAt: time.Now().UTC().Truncate(time.Millisecond),
+	}
+}
+
+// RunPartyDAO runs the PartyDAO conformance suite.
+func RunPartyDAO(t *testing.T, newDAO func(t *testing.T) persistence.PartyDAO) {
+	ctx := context.Background()
+
+	t.Run("Create stores party", func(t *testing.T) {
+		dao := newDAO(t)
+		party := NewParty("party-1", "CODE01", "admin-1")
+
+		require.NoError(t, dao.Create(ctx, party))
+
+		retrieved, err := dao.GetByID(ctx, party.ID)
+		require.NoError(t, err)
+		assert.Equal(t, party.ID, retrieved.ID)
+		assert.Equal(t, party.Name, retrieved.Name)
+		assert.Equal(t, party.Code, retrieved.Code)
+		assert.Equal(t, party.EventType, retrieved.EventType)
+		assert.Equal(t, party.AdminID, retrieved.AdminID)
+		assert.Equal(t, party.Status, retrieved.Status)
+		assert.Equal(t, party.Contest, retrieved.Contest)
+		assert.Equal(t, party.ScoringSystem, retrieved.ScoringSystem)
+		assert.True(t, party.CreatedAt.Equal(retrieved.CreatedAt))
+	})
+
+	t.Run("Create stores anonymous results setting", func(t *testing.T) {
+		dao := newDAO(t)
+		party := NewParty("party-1", "CODE01", "admin-1")
+		party.AnonymousResults = true
+
+		require.NoError(t, dao.Create(ctx, party))
+
+		retrieved, err := dao.GetByID(ctx, party.ID)
+		require.NoError(t, err)
+		assert.True(t, retrieved.AnonymousResults)
+	})
+
+	t.Run("Create returns ErrCodeExists for duplicate code", func(t *testing.T) {
+		dao := newDAO(t)
+		require.NoError(t, dao.Create(ctx, NewParty("party-1", "DUPCOD", "admin-1")))
+
+		err := dao.Create(ctx, NewParty("party-2", "DUPCOD", "admin-2"))
+
+		assert.ErrorIs(t, err, persistence.ErrCodeExists)
+		_, err = dao.GetByID(ctx, "party-2")
+		assert.ErrorIs(t, err, persistence.ErrNotFound)
+	})
+
+	t.Run("GetByID returns ErrNotFound for missing party", func(t *testing.T) {
+		dao := newDAO(t)
+
+		_, err := dao.GetByID(ctx, "nonexistent-id")
+
+		assert.ErrorIs(t, err, persistence.ErrNotFound)
+	})
+
+	t.Run("GetByID returns an independent copy", func(t *testing.T) {
+		dao := newDAO(t)
+		require.NoError(t, dao.Create(ctx, NewParty("party-1", "COPY01", "admin-1")))
+
+		first, err := dao.GetByID(ctx, "party-1")
+		require.NoError(t, err)
+		first.Name = "Mutated"
+
+		second, err := dao.GetByID(ctx, "party-1")
+		require.NoError(t, err)
+		assert.Equal(t, "Test Party", second.Name)
+	})
+
+	t.Run("GetByCode returns party", func(t *testing.T) {
+		dao := newDAO(t)
+		party := NewParty("party-1", "BYCODE", "admin-1")
+		require.NoError(t, dao.Create(ctx, party))
+
+		retrieved, err := dao.GetByCode(ctx, party.Code)
+
+		require.NoError(t, err)
+		assert.Equal(t, party.ID, retrieved.ID)
+	})
+
+	t.Run("GetByCode returns ErrNotFound for unknown code", func(t *testing.T) {
+		dao := newDAO(t)
+
+		_, err := dao.GetByCode(ctx, "NOCODE")
+
+		assert.ErrorIs(t, err, persistence.ErrNotFound)
+	})
+
+	t.Run("ListByAdminID returns only the admin's parties", func(t *testing.T) {
+		dao := newDAO(t)
+		require.NoError(t, dao.Create(ctx, NewParty("party-1", "LIST01", "admin-1")))
+		require.NoError(t, dao.Create(ctx, NewParty("party-2", "LIST02", "admin-1")))
+		require.NoError(t, dao.Create(ctx, NewParty("party-3", "LIST03", "admin-2")))
+
+		parties, err := dao.ListByAdminID(ctx, "admin-1")
+
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"party-1", "party-2"}, partyIDs(parties))
+	})
+
+	t.Run("ListByAdminID returns empty non-nil slice", func(t *testing.T) {
+		dao := newDAO(t)
+
+		parties, err := dao.ListByAdminID(ctx, "admin-without-parties")
+
+		require.NoError(t, err)
+		assert.NotNil(t, parties)
+		assert.Empty(t, parties)
+	})
+
+	t.Run("Create stores members in order", func(t *testing.T) {
+		dao := newDAO(t)
+		party := NewParty("party-1", "MEMB01", "admin-1")
+		party.Members = []models.PartyMember{
+			NewMember("user-3", models.PartyRoleModerator, models.MemberStatusInvited),
+			NewMember("user-2", models.PartyRoleCoHost, models.MemberStatusActive),
+		}
+
+		require.NoError(t, dao.Create(ctx, party))
+
+		retrieved, err := dao.GetByID(ctx, party.ID)
+		require.NoError(t, err)
+		assertMembers(t, party.Members, retrieved.Members)
+
+		retrieved, err = dao.GetByCode(ctx, party.Code)
+		require.NoError(t, err)
+		assertMembers(t, party.Members, retrieved.Members)
+	})
+
+	t.Run("GetByID returns members as an independent copy", func(t *testing.T) {
+		dao := newDAO(t)
+		party := NewParty("party-1", "MEMB02", "admin-1")
+		party.Members = []models.PartyMember{NewMember("user-2", models.PartyRoleCoHost, models.MemberStatusActive)}
+		require.NoError(t, dao.Create(ctx, party))
+
+		first, err := dao.GetByID(ctx, "party-1")
+		require.NoError(t, err)
+		first.Members[0].Role = models.PartyRoleModerator
+
+		second, err := dao.GetByID(ctx, "party-1")
+		require.NoError(t, err)
+		assert.Equal(t, models.PartyRoleCoHost, second.Members[0].Role)
+	})
+
+	t.Run("UpdateMembers replaces members", func(t *testing.T) {
+		dao := newDAO(t)
+		party := NewParty("party-1", "MEMB03", "admin-1")
+		party.Members = []models.PartyMember{NewMember("user-2", models.PartyRoleCoHost, models.MemberStatusInvited)}
+		require.NoError(t, dao.Create(ctx, party))
+
+		members := []models.PartyMember{
+			NewMember("user-2", models.PartyRoleCoHost, models.MemberStatusActive),
+			NewMember("user-3", models.PartyRoleModerator, models.MemberStatusInvited),
+		}
+		require.NoError(t, dao.UpdateMembers(ctx, "party-1", replaceMembers(members)))
+
+		retrieved, err := dao.GetByID(ctx, "party-1")
+		require.NoError(t, err)
+		assertMembers(t, members, retrieved.Members)
+		assert.Equal(t, "MEMB03", retrieved.Code)
+
+		require.NoError(t, dao.UpdateMembers(ctx, "party-1", replaceMembers(nil)))
+
+		retrieved, err = dao.GetByID(ctx, "party-1")
+		require.NoError(t, err)
+		assert.Empty(t, retrieved.Members)
+	})
+
+	t.Run("UpdateMembers derives members from the current ones", func(t *testing.T) {
+		dao := newDAO(t)
+		party := NewParty("party-1", "MEMB04", "admin-1")
+		party.Members = []models.PartyMember{NewMember("user-2", models.PartyRoleCoHost, models.MemberStatusInvited)}
+		require.NoError(t, dao.Create(ctx, party))
+
+		invite := func(userID string) func([]models.PartyMember) ([]models.PartyMember, error) {
+			return func(members []models.PartyMember) ([]models.PartyMember, error) {
+				return append(members, NewMember(userID, models.PartyRoleModerator, models.MemberStatusInvited)), nil
+			}
+		}
+		require.NoError(t, dao.UpdateMembers(ctx, "party-1", invite("user-3")))
+		require.NoError(t, dao.UpdateMembers(ctx, "party-1", invite("user-4")))
+
+		errStop := errors.New("stop")
+		err := dao.UpdateMembers(ctx, "party-1", func([]models.PartyMember) ([]models.PartyMember, error) {
+			return nil, errStop
+		})
+		assert.ErrorIs(t, err, errStop)
+
+		retrieved, err := dao.GetByID(ctx, "party-1")
+		require.NoError(t, err)
+		require.Len(t, retrieved.Members, 3)
+		assert.Equal(t, []string{"user-2", "user-3", "user-4"},
+			[]string{retrieved.Members[0].UserID, retrieved.Members[1].UserID, retrieved.Members[2].UserID})
+	})
+
+	t.Run("UpdateMembers returns ErrNotFound for missing party", func(t *testing.T) {
+		dao := newDAO(t)
+
+		err := dao.UpdateMembers(ctx, "nonexistent-id", replaceMembers(nil))
+
+		assert.ErrorIs(t, err, persistence.ErrNotFound)
+	})
+
+	t.Run("ListByMemberID returns parties listing the user", func(t *testing.T) {
+		dao := newDAO(t)
+		first := NewParty("party-1", "MLST01", "admin-1")
+		first.Members = []models.PartyMember{NewMember("user-2", models.PartyRoleCoHost, models.MemberStatusActive)}
+		second := NewParty("party-2", "MLST02", "admin-2")
+		second.Members = []models.PartyMember{
+			NewMember("user-3", models.PartyRoleCoHost, models.MemberStatusActive),
+			NewMember("user-2", models.PartyRoleModerator, models.MemberStatusInvited),
+		}
+		require.NoError(t, dao.Create(ctx, first))
+		require.NoError(t, dao.Create(ctx, second))
+		require.NoError(t, dao.Create(ctx, NewParty("party-3", "MLST03", "user-2")))
+
+		parties, err := dao.ListByMemberID(ctx, "user-2")
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"party-1", "party-2"}, partyIDs(parties))
+		for _, p := range parties {
+			if p.ID == "party-2" {
+				assert.Len(t, p.Members, 2)
+			}
+		}
+
+		require.NoError(t, dao.UpdateMembers(ctx, "party-1", replaceMembers(nil)))
+
+		parties, err = dao.ListByMemberID(ctx, "user-2")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"party-2"}, partyIDs(parties))
+	})
+
+	t.Run("ListByMemberID returns empty non-nil slice", func(t *testing.T) {
+		dao := newDAO(t)
+
+		parties, err := dao.ListByMemberID(ctx, "user-without-parties")
+
+		require.NoError(t, err)
+		assert.NotNil(t, parties)
+		assert.Empty(t, parties)
+	})
+
+	t.Run("Delete removes party", func(t *testing.T) {
+		dao := newDAO(t)
+		require.NoError(t, dao.Create(ctx, NewParty("party-1", "DEL001", "admin-1")))
+
+		require.NoError(t, dao.Delete(ctx, "party-1"))
+
+		_, err := dao.GetByID(ctx, "party-1")
+		assert.ErrorIs(t, err, persistence.ErrNotFound)
+		exists, err := dao.CodeExists(ctx, "DEL001")
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+
+	t.Run("Delete returns ErrNotFound for missing party", func(t *testing.T) {
+		dao := newDAO(t)
+
+		err := dao.Delete(ctx, "nonexistent-id")
+
+		assert.ErrorIs(t, err, persistence.ErrNotFound)
+	})
+
+	t.Run("UpdateStatus changes status", func(t *testing.T) {
+		dao := newDAO(t)
+		require.NoError(t, dao.Create(ctx, NewParty("party-1", "STAT01", "admin-1")))
+
+		require.NoError(t, dao.UpdateStatus(ctx, "party-1", models.PartyStatusClosed))
+
+		retrieved, err := dao.GetByID(ctx, "party-1")
+		require.NoError(t, err)
+		assert.Equal(t, models.PartyStatusClosed, retrieved.Status)
+		assert.Equal(t, "STAT01", retrieved.Code)
+	})
+
+	t.Run("UpdateStatus returns ErrNotFound for missing party", func(t *testing.T) {
+		dao := newDAO(t)
+
+		err := dao.UpdateStatus(ctx, "nonexistent-id", models.PartyStatusClosed)
+
+		assert.ErrorIs(t, err, persistence.ErrNotFound)
+	})
+
+	t.Run("UpdateRevealStep records step", func(t *testing.T) {
+		dao := newDAO(t)
+		require.NoError(t, dao.Create(ctx, NewParty("party-1", "REVL01", "admin-1")))
+
+		require.NoError(t, dao.UpdateRevealStep(ctx, "party-1", 4))
+
+		retrieved, err := dao.GetByID(ctx, "party-1")
+		require.NoError(t, err)
+		assert.Equal(t, 4, retrieved.RevealStep)
+		assert.Equal(t, "REVL01", retrieved.Code)
+	})
+
+	t.Run("UpdateRevealStep returns ErrNotFound for missing party", func(t *testing.T) {
+		dao := newDAO(t)
+
+		err := dao.UpdateRevealStep(ctx, "nonexistent-id", 1)
+
+		assert.ErrorIs(t, err, persistence.ErrNotFound)
+	})
+
+	t.Run("UpdateVotingStartedAt records timestamp", func(t *testing.T) {
+		dao := newDAO(t)
+		require.NoError(t, dao.Create(ctx, NewParty("party-1", "START1", "admin-1")))
+
+		retrieved, err := dao.GetByID(ctx, "party-1")
+		require.NoError(t, err)
+		assert.False(t, retrieved.VotingStarted())
+
+		startedAt := time.Now().UTC().Truncate(time.Millisecond)
+		require.NoError(t, dao.UpdateVotingStartedAt(ctx, "party-1", startedAt))
+
+		retrieved, err = dao.GetByID(ctx, "party-1")
+		require.NoError(t, err)
+		assert.True(t, startedAt.Equal(retrieved.VotingStartedAt))
+		assert.Equal(t, "START1", retrieved.Code)
+	})
+
+	t.Run("UpdateVotingStartedAt returns ErrNotFound for missing party", func(t *testing.T) {
+		dao := newDAO(t)
+
+		err := dao.UpdateVotingStartedAt(ctx, "nonexistent-id", time.Now())
+
+		assert.ErrorIs(t, err, persistence.ErrNotFound)
+	})
+
+	t.Run("CodeExists reports presence", func(t *testing.T) {
+		dao := newDAO(t)
+		require.NoError(t, dao.Create(ctx, NewParty("party-1", "EXIST1", "admin-1")))
+
+		exists, err := dao.CodeExists(ctx, "EXIST1")
+		require.NoError(t, err)
+		assert.True(t, exists)
+
+		exists, err = dao.CodeExists(ctx, "NOPE01")
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+}
+
+// assertMembers compares members field by field, since stored timestamps may
+// lose their location.
+func assertMembers(t *testing.T, expected, actual []models.PartyMember) {
+	t.Helper()
+	require.Len(t, actual, len(expected))
+	for i := range expected {
+		assert.Equal(t, expected[i].UserID, actual[i].UserID)
+		assert.Equal(t, expected[i].Username, actual[i].Username)
+		assert.Equal(t, expected[i].Role, actual[i].Role)
+		assert.Equal(t, expected[i].Status, actual[i].Status)
+		assert.Equal(t, expected[i].InvitedBy, actual[i].InvitedBy)
+		assert.True(t, expected[i].InvitedAt.Equal(actual[i].InvitedAt))
+	}
+}
+
+func partyIDs(parties []*models.Party) []string {
+	ids := make([]string, len(parties))
+	for i, p := range parties {
+		ids[i] = p.ID
+	}
+	return ids
+}
+
+// replaceMembers returns an UpdateMembers update that sets the given members.
+func replaceMembers(members []models.PartyMember) func([]models.PartyMember) ([]models.PartyMember, error) {
+	return func([]models.PartyMember) ([]models.PartyMember, error) {
+		return members, nil
+	}
+}
@@ -0,0 +1,119 @@
+package persistencetest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/persistence"
+)
+
+// NewPrediction returns a grand final prediction picking act-1 to win ahead of act-2 and act-3.
+func NewPrediction(guestID, partyID string) *models.Prediction {
+	return &models.Prediction{
+		ID:        models.PredictionIDFor(partyID, guestID),
+		PartyID:   partyID,
+		GuestID:   guestID,
+		Picks:     []string{actID(1), actID(2), actID(3)},
+		UpdatedAt: time.Now().UTC().Truncate(time.Millisecond),
+	}
+}
+
+// RunPredictionDAO runs the PredictionDAO conformance suite.
+func RunPredictionDAO(t *testing.T, newDAO func(t *testing.T) persistence.PredictionDAO) {
+	ctx := context.Background()
+
+	t.Run("Upsert stores prediction", func(t *testing.T) {
+		dao := newDAO(t)
+		prediction := NewPrediction("guest-1", "party-1")
+
+		require.NoError(t, dao.Upsert(ctx, prediction))
+
+		retrieved, err := dao.GetByGuestAndParty(ctx, "guest-1", "party-1")
+		require.NoError(t, err)
+		assert.Equal(t, prediction.ID, retrieved.ID)
+		assert.Equal(t, prediction.PartyID, retrieved.PartyID)
+		assert.Equal(t, prediction.GuestID, retrieved.GuestID)
+		assert.Equal(t, prediction.Picks, retrieved.Picks)
+		assert.True(t, prediction.UpdatedAt.Equal(retrieved.UpdatedAt))
+	})
+
+	t.Run("Upsert replaces picks", func(t *testing.T) {
+		dao := newDAO(t)
+		prediction := NewPrediction("guest-1", "party-1")
+		require.NoError(t, dao.Upsert(ctx, prediction))
+
+		prediction.Picks = []string{actID(4), actID(1)}
+		require.NoError(t, dao.Upsert(ctx, prediction))
+
+		retrieved, err := dao.GetByGuestAndParty(ctx, "guest-1", "party-1")
+		require.NoError(t, err)
+		assert.Equal(t, []string{actID(4), actID(1)}, retrieved.Picks)
+	})
+
+	t.Run("GetByGuestAndParty returns ErrNotFound for missing prediction", func(t *testing.T) {
+		dao := newDAO(t)
+		require.NoError(t, dao.Upsert(ctx, NewPrediction("guest-1", "party-1")))
+
+		_, err := dao.GetByGuestAndParty(ctx, "guest-1", "party-2")
+
+		assert.ErrorIs(t, err, persistence.ErrNotFound)
+	})
+
+	t.Run("ListByPartyID returns the party's predictions ordered by ID", func(t *testing.T) {
+		dao := newDAO(t)
+		require.NoError(t, dao.Upsert(ctx, NewPrediction("guest-2", "party-1")))
+		require.NoError(t, dao.Upsert(ctx, NewPrediction("guest-1", "party-1")))
+		require.NoError(t, dao.Upsert(ctx, NewPrediction("guest-3", "party-2")))
+
+		predictions, err := dao.ListByPartyID(ctx, "party-1")
+		require.NoError(t, err)
+		require.Len(t, predictions, 2)
+		assert.Equal(t, models.PredictionIDFor("party-1", "guest-1"), predictions[0].ID)
+		assert.Equal(t, models.PredictionIDFor("party-1", "guest-2"), predictions[1].ID)
+		assert.Equal(t, []string{actID(1), actID(2), actID(3)}, predictions[1].Picks)
+	})
+
+	t.Run("ListByPartyID returns empty slice for party without predictions", func(t *testing.T) {
+		dao := newDAO(t)
+
+		predictions, err := dao.ListByPartyID(ctx, "party-1")
+		require.NoError(t, err)
+		assert.NotNil(t, predictions)
+		assert.Empty(t, predictions)
+	})
+
+	t.Run("SetOutcome stores and replaces outcome", func(t *testing.T) {
+		dao := newDAO(t)
+		outcome := &models.PredictionOutcome{
+			PartyID:    "party-1",
+			Picks:      []string{actID(2), actID(1), actID(3)},
+			RecordedAt: time.Now().UTC().Truncate(time.Millisecond),
+		}
+		require.NoError(t, dao.SetOutcome(ctx, outcome))
+
+		retrieved, err := dao.GetOutcome(ctx, "party-1")
+		require.NoError(t, err)
+		assert.Equal(t, outcome.Picks, retrieved.Picks)
+		assert.True(t, outcome.RecordedAt.Equal(retrieved.RecordedAt))
+
+		outcome.Picks = []string{actID(3), actID(2), actID(1)}
+		require.NoError(t, dao.SetOutcome(ctx, outcome))
+
+		retrieved, err = dao.GetOutcome(ctx, "party-1")
+		require.NoError(t, err)
+		assert.Equal(t, outcome.Picks, retrieved.Picks)
+	})
+
+	t.Run("GetOutcome returns ErrNotFound for missing outcome", func(t *testing.T) {
+		dao := newDAO(t)
+
+		_, err := dao.GetOutcome(ctx, "party-1")
+
+		assert.ErrorIs(t, err, persistence.ErrNotFound)
+	})
+}
@@ -0,0 +1,84 @@
+package persistencetest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/persistence"
+)
+
+// NewScoreboard returns a grand final scoreboard of the given contest in which
+// act-1 wins ahead of act-2 and act-3.
+func NewScoreboard(contest string) *models.Scoreboard {
+	return &models.Scoreboard{
+		Contest:   contest,
+		EventType: models.EventGrandFinal,
+		Entries: []models.ScoreboardEntry{
+			{ActID: actID(1), JuryPoints: 200, TelevotePoints: 150},
+			{ActID: actID(2), JuryPoints: 120, TelevotePoints: 180},
+			{ActID: actID(3), JuryPoints: 40, TelevotePoints: 10},
+		},
+		ImportedAt: time.Now().UTC().Truncate(time.Millisecond),
+	}
+}
+
+// RunScoreboardDAO runs the ScoreboardDAO conformance suite.
+func RunScoreboardDAO(t *testing.T, newDAO func(t *testing.T) persistence.ScoreboardDAO) {
+	ctx := context.Background()
+
+	t.Run("Replace stores scoreboard", func(t *testing.T) {
+		dao := newDAO(t)
+		scoreboard := NewScoreboard("esc-2026")
+
+		require.NoError(t, dao.Replace(ctx, scoreboard))
+
+		retrieved, err := dao.Get(ctx, "esc-2026", models.EventGrandFinal)
+		require.NoError(t, err)
+		assert.Equal(t, scoreboard.Contest, retrieved.Contest)
+		assert.Equal(t, scoreboard.EventType, retrieved.EventType)
+		assert.Equal(t, scoreboard.Entries, retrieved.Entries)
+		assert.True(t, scoreboard.ImportedAt.Equal(retrieved.ImportedAt))
+	})
+
+	t.Run("Replace overwrites the previous scoreboard of the show", func(t *testing.T) {
+		dao := newDAO(t)
+		require.NoError(t, dao.Replace(ctx, NewScoreboard("esc-2026")))
+
+		replacement := NewScoreboard("esc-2026")
+		replacement.Entries = []models.ScoreboardEntry{{ActID: actID(4), JuryPoints: 1, TelevotePoints: 2}}
+		require.NoError(t, dao.Replace(ctx, replacement))
+
+		retrieved, err := dao.Get(ctx, "esc-2026", models.EventGrandFinal)
+		require.NoError(t, err)
+		assert.Equal(t, replacement.Entries, retrieved.Entries)
+	})
+
+	t.Run("Get returns entries ordered by act ID", func(t *testing.T) {
+		dao := newDAO(t)
+		scoreboard := NewScoreboard("esc-2026")
+		scoreboard.Entries[0], scoreboard.Entries[2] = scoreboard.Entries[2], scoreboard.Entries[0]
+		require.NoError(t, dao.Replace(ctx, scoreboard))
+
+		retrieved, err := dao.Get(ctx, "esc-2026", models.EventGrandFinal)
+		require.NoError(t, err)
+		require.Len(t, retrieved.Entries, 3)
+		assert.Equal(t, []string{actID(1), actID(2), actID(3)},
+			[]string{retrieved.Entries[0].ActID, retrieved.Entries[1].ActID, retrieved.Entries[2].ActID})
+	})
+
+	t.Run("Get returns ErrNotFound for missing scoreboard", func(t *testing.T) {
+		dao := newDAO(t)
+		require.NoError(t, dao.Replace(ctx, NewScoreboard("esc-2026")))
+
+		_, err := dao.Get(ctx, "esc-2026", models.EventSemifinal1)
+		assert.ErrorIs(t, err, persistence.ErrNotFound)
+
+		_, err = dao.Get(ctx, "jesc-2026", models.EventGrandFinal)
+		assert.ErrorIs(t, err, persistence.ErrNotFound)
+	})
+}
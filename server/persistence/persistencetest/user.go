@@ -0,0 +1,83 @@
+package persistencetest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/persistence"
+)
+
+// RunUserDAO runs the UserDAO conformance suite.
+func RunUserDAO(t *testing.T, newDAO func(t *testing.T) persistence.UserDAO) {
+	ctx := context.Background()
+
+	t.Run("Upsert creates user", func(t *testing.T) {
+		dao := newDAO(t)
+		user := &models.User{ID: "user-1", Username: "alice", Email: "alice@example.com"}
+
+		require.NoError(t, dao.Upsert(ctx, user))
+
+		retrieved, err := dao.GetByID(ctx, "user-1")
+		require.NoError(t, err)
+		assert.Equal(t, user, retrieved)
+	})
+
+	t.Run("Upsert overwrites existing user", func(t *testing.T) {
+		dao := newDAO(t)
+		require.NoError(t, dao.Upsert(ctx, &models.User{ID: "user-1", Username: "original", Email: "a@example.com"}))
+
+		require.NoError(t, dao.Upsert(ctx, &models.User{ID: "user-1", Username: "updated", Email: "a@example.com"}))
+
+		retrieved, err := dao.GetByID(ctx, "user-1")
+		require.NoError(t, err)
+		assert.Equal(t, "updated", retrieved.Username)
+	})
+
+	t.Run("GetByID returns ErrNotFound for missing user", func(t *testing.T) {
+		dao := newDAO(t)
+
+		_, err := dao.GetByID(ctx, "nonexistent-id")
+
+		assert.ErrorIs(t, err, persistence.ErrNotFound)
+	})
+
+	t.Run("ListByEmail returns matching users", func(t *testing.T) {
+		dao := newDAO(t)
+		require.NoError(t, dao.Upsert(ctx, &models.User{ID: "user-1", Username: "alice", Email: "alice@example.com"}))
+		require.NoError(t, dao.Upsert(ctx, &models.User{ID: "user-2", Username: "bob", Email: "bob@example.com"}))
+
+		users, err := dao.ListByEmail(ctx, "bob@example.com")
+		require.NoError(t, err)
+		require.Len(t, users, 1)
+		assert.Equal(t, "user-2", users[0].ID)
+
+		users, err = dao.ListByEmail(ctx, "carol@example.com")
+		require.NoError(t, err)
+		assert.NotNil(t, users)
+		assert.Empty(t, users)
+	})
+
+	t.Run("ListByUsername returns every user with the username", func(t *testing.T) {
+		dao := newDAO(t)
+		require.NoError(t, dao.Upsert(ctx, &models.User{ID: "user-1", Username: "alice", Email: "alice@example.com"}))
+		require.NoError(t, dao.Upsert(ctx, &models.User{ID: "user-2", Username: "alice", Email: "alice@example.org"}))
+		require.NoError(t, dao.Upsert(ctx, &models.User{ID: "user-3", Username: "bob", Email: "bob@example.com"}))
+
+		users, err := dao.ListByUsername(ctx, "alice")
+		require.NoError(t, err)
+		ids := make([]string, len(users))
+		for i, u := range users {
+			ids[i] = u.ID
+		}
+		assert.ElementsMatch(t, []string{"user-1", "user-2"}, ids)
+
+		users, err = dao.ListByUsername(ctx, "carol")
+		require.NoError(t, err)
+		assert.NotNil(t, users)
+		assert.Empty(t, users)
+	})
+}
@@ -0,0 +1,249 @@
+package persistencetest
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/persistence"
+)
+
+// NewVote returns a complete ballot awarding act-1 through act-10 in
+// ValidPointValues order.
+func NewVote(id, guestID, partyID string) *models.Vote {
+	votes := make(map[int]string, len(models.ValidPointValues))
+	for i, points := range models.ValidPointValues {
+		votes[points] = actID(i + 1)
+	}
+	return &models.Vote{
+		ID:        id,
+		GuestID:   guestID,
+		PartyID:   partyID,
+		Votes:     votes,
+		CreatedAt: time.Now().UTC().Truncate(time.Millisecond),
+	}
+}
+
+// RunVoteDAO runs the VoteDAO conformance suite.
+func RunVoteDAO(t *testing.T, newDAO func(t *testing.T) persistence.VoteDAO) {
+	ctx := context.Background()
+
+	t.Run("Create stores vote", func(t *testing.T) {
+		dao := newDAO(t)
+		vote := NewVote("vote-1", "guest-1", "party-1")
+
+		require.NoError(t, dao.Create(ctx, vote))
+
+		retrieved, err := dao.GetByGuestAndParty(ctx, "guest-1", "party-1")
+		require.NoError(t, err)
+		assert.Equal(t, vote.ID, retrieved.ID)
+		assert.Equal(t, vote.GuestID, retrieved.GuestID)
+		assert.Equal(t, vote.PartyID, retrieved.PartyID)
+		assert.Equal(t, vote.Votes, retrieved.Votes)
+		assert.True(t, vote.CreatedAt.Equal(retrieved.CreatedAt))
+	})
+
+	t.Run("Create returns ErrVoteExists for existing vote ID", func(t *testing.T) {
+		dao := newDAO(t)
+		id := models.VoteIDFor("party-1", "guest-1")
+		require.NoError(t, dao.Create(ctx, NewVote(id, "guest-1", "party-1")))
+
+		second := NewVote(id, "guest-1", "party-1")
+		second.Votes[12], second.Votes[1] = second.Votes[1], second.Votes[12]
+		err := dao.Create(ctx, second)
+
+		assert.ErrorIs(t, err, persistence.ErrVoteExists)
+		retrieved, err := dao.GetByGuestAndParty(ctx, "guest-1", "party-1")
+		require.NoError(t, err)
+		assert.Equal(t, actID(1), retrieved.Votes[12])
+	})
+
+	t.Run("Create stores exactly one of many concurrent ballots", func(t *testing.T) {
+		dao := newDAO(t)
+		id := models.VoteIDFor("party-1", "guest-1")
+
+		const attempts = 20
+		var wg sync.WaitGroup
+		errs := make(chan error, attempts)
+		for i := 0; i < attempts; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				errs <- dao.Create(ctx, NewVote(id, "guest-1", "party-1"))
+			}()
+		}
+		wg.Wait()
+		close(errs)
+
+		created := 0
+		for err := range errs {
+			if err == nil {
+				created++
+				continue
+			}
+			assert.ErrorIs(t, err, persistence.ErrVoteExists)
+		}
+		assert.Equal(t, 1, created)
+
+		votes, err := dao.ListByPartyID(ctx, "party-1")
+		require.NoError(t, err)
+		assert.Len(t, votes, 1)
+	})
+
+	t.Run("GetByGuestAndParty returns ErrNotFound for missing vote", func(t *testing.T) {
+		dao := newDAO(t)
+		require.NoError(t, dao.Create(ctx, NewVote("vote-1", "guest-1", "party-1")))
+
+		_, err := dao.GetByGuestAndParty(ctx, "guest-1", "party-2")
+		assert.ErrorIs(t, err, persistence.ErrNotFound)
+
+		_, err = dao.GetByGuestAndParty(ctx, "guest-2", "party-1")
+		assert.ErrorIs(t, err, persistence.ErrNotFound)
+	})
+
+	t.Run("GetByGuestAndParty returns an independent copy", func(t *testing.T) {
+		dao := newDAO(t)
+		require.NoError(t, dao.Create(ctx, NewVote("vote-1", "guest-1", "party-1")))
+
+		first, err := dao.GetByGuestAndParty(ctx, "guest-1", "party-1")
+		require.NoError(t, err)
+		first.Votes[12] = "mutated"
+
+		second, err := dao.GetByGuestAndParty(ctx, "guest-1", "party-1")
+		require.NoError(t, err)
+		assert.Equal(t, actID(1), second.Votes[12])
+	})
+
+	t.Run("Update overwrites ballot", func(t *testing.T) {
+		dao := newDAO(t)
+		vote := NewVote("vote-1", "guest-1", "party-1")
+		require.NoError(t, dao.Create(ctx, vote))
+
+		vote.Votes[12], vote.Votes[1] = vote.Votes[1], vote.Votes[12]
+		require.NoError(t, dao.Update(ctx, vote))
+
+		retrieved, err := dao.GetByGuestAndParty(ctx, "guest-1", "party-1")
+		require.NoError(t, err)
+		assert.Equal(t, "vote-1", retrieved.ID)
+		assert.Equal(t, actID(10), retrieved.Votes[12])
+		assert.Equal(t, actID(1), retrieved.Votes[1])
+	})
+
+	t.Run("Update finalizes draft", func(t *testing.T) {
+		dao := newDAO(t)
+		vote := NewVote("vote-1", "guest-1", "party-1")
+		vote.Draft = true
+		delete(vote.Votes, 12)
+		require.NoError(t, dao.Create(ctx, vote))
+
+		retrieved, err := dao.GetByGuestAndParty(ctx, "guest-1", "party-1")
+		require.NoError(t, err)
+		assert.True(t, retrieved.Draft)
+		assert.Len(t, retrieved.Votes, len(models.ValidPointValues)-1)
+
+		vote.Draft = false
+		vote.Votes[12] = actID(11)
+		require.NoError(t, dao.Update(ctx, vote))
+
+		retrieved, err = dao.GetByGuestAndParty(ctx, "guest-1", "party-1")
+		require.NoError(t, err)
+		assert.False(t, retrieved.Draft)
+		assert.Equal(t, vote.Votes, retrieved.Votes)
+	})
+
+	t.Run("Create stores rating ballot", func(t *testing.T) {
+		dao := newDAO(t)
+		vote := NewVote("vote-1", "guest-1", "party-1")
+		vote.Votes = map[int]string{}
+		vote.Ratings = map[string]int{actID(1): 10, actID(2): 3}
+		require.NoError(t, dao.Create(ctx, vote))
+
+		retrieved, err := dao.GetByGuestAndParty(ctx, "guest-1", "party-1")
+		require.NoError(t, err)
+		assert.Empty(t, retrieved.Votes)
+		assert.Equal(t, vote.Ratings, retrieved.Ratings)
+	})
+
+	t.Run("UpdateDraft replaces only drafts", func(t *testing.T) {
+		dao := newDAO(t)
+		vote := NewVote("vote-1", "guest-1", "party-1")
+		vote.Draft = true
+		delete(vote.Votes, 12)
+		require.NoError(t, dao.Create(ctx, vote))
+
+		delete(vote.Votes, 10)
+		require.NoError(t, dao.UpdateDraft(ctx, vote))
+		retrieved, err := dao.GetByGuestAndParty(ctx, "guest-1", "party-1")
+		require.NoError(t, err)
+		assert.Equal(t, vote.Votes, retrieved.Votes)
+
+		vote.Draft = false
+		vote.Votes[12], vote.Votes[10] = actID(11), actID(12)
+		require.NoError(t, dao.Update(ctx, vote))
+
+		draft := NewVote("vote-1", "guest-1", "party-1")
+		draft.Draft = true
+		assert.ErrorIs(t, dao.UpdateDraft(ctx, draft), persistence.ErrVoteExists)
+		retrieved, err = dao.GetByGuestAndParty(ctx, "guest-1", "party-1")
+		require.NoError(t, err)
+		assert.False(t, retrieved.Draft)
+		assert.Equal(t, vote.Votes, retrieved.Votes)
+
+		missing := NewVote("vote-2", "guest-2", "party-1")
+		missing.Draft = true
+		assert.ErrorIs(t, dao.UpdateDraft(ctx, missing), persistence.ErrNotFound)
+	})
+
+	t.Run("Update replaces ratings", func(t *testing.T) {
+		dao := newDAO(t)
+		vote := NewVote("vote-1", "guest-1", "party-1")
+		vote.Votes = map[int]string{}
+		vote.Ratings = map[string]int{actID(1): 10, actID(2): 3}
+		require.NoError(t, dao.Create(ctx, vote))
+
+		vote.Ratings = map[string]int{actID(1): 4, actID(3): 7}
+		require.NoError(t, dao.Update(ctx, vote))
+
+		votes, err := dao.ListByPartyID(ctx, "party-1")
+		require.NoError(t, err)
+		require.Len(t, votes, 1)
+		assert.Equal(t, vote.Ratings, votes[0].Ratings)
+	})
+
+	t.Run("ListByPartyID returns only the party's votes", func(t *testing.T) {
+		dao := newDAO(t)
+		require.NoError(t, dao.Create(ctx, NewVote("vote-1", "guest-1", "party-1")))
+		require.NoError(t, dao.Create(ctx, NewVote("vote-2", "guest-2", "party-1")))
+		require.NoError(t, dao.Create(ctx, NewVote("vote-3", "guest-3", "party-2")))
+
+		votes, err := dao.ListByPartyID(ctx, "party-1")
+
+		require.NoError(t, err)
+		ids := make([]string, len(votes))
+		for i, v := range votes {
+			ids[i] = v.ID
+			assert.Len(t, v.Votes, len(models.ValidPointValues))
+		}
+		assert.ElementsMatch(t, []string{"vote-1", "vote-2"}, ids)
+	})
+
+	t.Run("ListByPartyID returns empty non-nil slice", func(t *testing.T) {
+		dao := newDAO(t)
+
+		votes, err := dao.ListByPartyID(ctx, "party-without-votes")
+
+		require.NoError(t, err)
+		assert.NotNil(t, votes)
+		assert.Empty(t, votes)
+	})
+}
+
+func actID(n int) string {
+	return fmt.Sprintf("act-%d", n)
+}
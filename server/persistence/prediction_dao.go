@@ -0,0 +1,107 @@
+package persistence
+
+import (
+	"context"
+	"sort"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/sipgate/eurovision-vote-party/server/models"
+)
+
+// PredictionDAO defines the persistence operations for the prediction game.
+type PredictionDAO interface {
+	Upsert(ctx context.Context, prediction *models.Prediction) error
+	GetByGuestAndParty(ctx context.Context, guestID, partyID string) (*models.Prediction, error)
+	ListByPartyID(ctx context.Context, partyID string) ([]*models.Prediction, error)
+	SetOutcome(ctx context.Context, outcome *models.PredictionOutcome) error
+	GetOutcome(ctx context.Context, partyID string) (*models.PredictionOutcome, error)
+}
+
+// FirestorePredictionDAO is the Firestore implementation of PredictionDAO.
+// Outcomes are stored in their own collection under the ID of their party.
+type FirestorePredictionDAO struct {
+	client *firestore.Client
+}
+
+// NewFirestorePredictionDAO creates a new FirestorePredictionDAO.
+func NewFirestorePredictionDAO(client *firestore.Client) *FirestorePredictionDAO {
+	return &FirestorePredictionDAO{client: client}
+}
+
+const (
+	predictionsCollection        = "predictions"
+	predictionOutcomesCollection = "predictionOutcomes"
+)
+
+// Upsert stores a prediction, replacing any previous prediction with the same ID.
+func (d *FirestorePredictionDAO) Upsert(ctx context.Context, prediction *models.Prediction) error {
+	_, err := d.client.Collection(predictionsCollection).Doc(prediction.ID).Set(ctx, prediction)
+	return err
+}
+
+// GetByGuestAndParty retrieves a guest's prediction in a party.
+// Returns ErrNotFound if the guest has not made a prediction.
+func (d *FirestorePredictionDAO) GetByGuestAndParty(ctx context.Context, guestID, partyID string) (*models.Prediction, error) {
+	iter := d.client.Collection(predictionsCollection).Where("guestId", "==", guestID).Where("partyId", "==", partyID).Limit(1).Documents(ctx)
+	defer iter.Stop()
+
+	doc, err := iter.Next()
+	if err != nil {
+		return nil, ErrNotFound
+	}
+
+	var prediction models.Prediction
+	if err := doc.DataTo(&prediction); err != nil {
+		return nil, err
+	}
+
+	return &prediction, nil
+}
+
+// ListByPartyID retrieves all predictions made in a party, ordered by ID.
+func (d *FirestorePredictionDAO) ListByPartyID(ctx context.Context, partyID string) ([]*models.Prediction, error) {
+	docs, err := d.client.Collection(predictionsCollection).Where("partyId", "==", partyID).Documents(ctx).GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	predictions := make([]*models.Prediction, 0, len(docs))
+	for _, doc := range docs {
+		var prediction models.Prediction
+		if err := doc.DataTo(&prediction); err != nil {
+			return nil, err
+		}
+		predictions = append(predictions, &prediction)
+	}
+
+	sort.Slice(predictions, func(i, j int) bool { return predictions[i].ID < predictions[j].ID })
+	return predictions, nil
+}
+
+// SetOutcome stores the official outcome of a party's show, replacing any previous outcome.
+func (d *FirestorePredictionDAO) SetOutcome(ctx context.Context, outcome *models.PredictionOutcome) error {
+	_, err := d.client.Collection(predictionOutcomesCollection).Doc(outcome.PartyID).Set(ctx, outcome)
+	return err
+}
+
+// GetOutcome retrieves the official outcome of a party's show.
+// Returns ErrNotFound if no outcome has been recorded.
+func (d *FirestorePredictionDAO) GetOutcome(ctx context.Context, partyID string) (*models.PredictionOutcome, error) {
+	doc, err := d.client.Collection(predictionOutcomesCollection).Doc(partyID).Get(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	var outcome models.PredictionOutcome
+	if err := doc.DataTo(&outcome); err != nil {
+		return nil, err
+	}
+
+	return &outcome, nil
+}
@@ -0,0 +1,63 @@
+package persistence
+
+import (
+	"context"
+	"sort"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/sipgate/eurovision-vote-party/server/models"
+)
+
+// ScoreboardDAO defines the persistence operations for official scoreboards.
+// A contest edition has at most one scoreboard per show.
+type ScoreboardDAO interface {
+	Replace(ctx context.Context, scoreboard *models.Scoreboard) error
+	Get(ctx context.Context, contest string, eventType models.EventType) (*models.Scoreboard, error)
+}
+
+// FirestoreScoreboardDAO is the Firestore implementation of ScoreboardDAO.
+// Scoreboards are stored in a scoreboards subcollection of their contest
+// document, keyed by event type.
+type FirestoreScoreboardDAO struct {
+	client *firestore.Client
+}
+
+// NewFirestoreScoreboardDAO creates a new FirestoreScoreboardDAO.
+func NewFirestoreScoreboardDAO(client *firestore.Client) *FirestoreScoreboardDAO {
+	return &FirestoreScoreboardDAO{client: client}
+}
+
+const scoreboardsCollection = "scoreboards"
+
+func (d *FirestoreScoreboardDAO) doc(contest string, eventType models.EventType) *firestore.DocumentRef {
+	return d.client.Collection(contestsCollection).Doc(contest).Collection(scoreboardsCollection).Doc(string(eventType))
+}
+
+// Replace stores a scoreboard, replacing any previous scoreboard of the same show.
+func (d *FirestoreScoreboardDAO) Replace(ctx context.Context, scoreboard *models.Scoreboard) error {
+	_, err := d.doc(scoreboard.Contest, scoreboard.EventType).Set(ctx, scoreboard)
+	return err
+}
+
+// Get retrieves the scoreboard of a show with its entries ordered by act ID.
+// Returns ErrNotFound if no scoreboard has been stored for the show.
+func (d *FirestoreScoreboardDAO) Get(ctx context.Context, contest string, eventType models.EventType) (*models.Scoreboard, error) {
+	doc, err := d.doc(contest, eventType).Get(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	var scoreboard models.Scoreboard
+	if err := doc.DataTo(&scoreboard); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(scoreboard.Entries, func(i, j int) bool { return scoreboard.Entries[i].ActID < scoreboard.Entries[j].ActID })
+	return &scoreboard, nil
+}
@@ -0,0 +1,86 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/persistence"
+)
+
+// AccountDAO is the SQL implementation of persistence.AccountDAO.
+type AccountDAO struct {
+	db *DB
+}
+
+// NewAccountDAO creates a new AccountDAO.
+func NewAccountDAO(db *DB) *AccountDAO {
+	return &AccountDAO{db: db}
+}
+
+const accountColumns = `id, email, password_hash, created_at, session_id`
+
+// Create stores a new account.
+// Returns persistence.ErrEmailExists if another account uses the email address.
+func (d *AccountDAO) Create(ctx context.Context, account *models.Account) error {
+	_, err := d.db.db.ExecContext(ctx, d.db.rebind(`INSERT INTO accounts (`+accountColumns+`) VALUES (?, ?, ?, ?, ?)`),
+		account.ID, account.Email, account.PasswordHash, toUnixMicro(account.CreatedAt), account.SessionID)
+	if err != nil && isUniqueViolation(err) {
+		if _, getErr := d.GetByEmail(ctx, account.Email); getErr == nil {
+			return persistence.ErrEmailExists
+		}
+	}
+	return err
+}
+
+// GetByID retrieves an account by its ID.
+// Returns persistence.ErrNotFound if the account does not exist.
+func (d *AccountDAO) GetByID(ctx context.Context, id string) (*models.Account, error) {
+	return d.get(ctx, `WHERE id = ?`, id)
+}
+
+// GetByEmail retrieves the account with the given email address.
+// Returns persistence.ErrNotFound if no account uses it.
+func (d *AccountDAO) GetByEmail(ctx context.Context, email string) (*models.Account, error) {
+	return d.get(ctx, `WHERE email = ?`, email)
+}
+
+// UpdateSessionID replaces the session identifier of an existing account.
+// Returns persistence.ErrNotFound if the account does not exist.
+func (d *AccountDAO) UpdateSessionID(ctx context.Context, id, sessionID string) error {
+	res, err := d.db.db.ExecContext(ctx, d.db.rebind(`UPDATE accounts SET session_id = ? WHERE id = ?`), sessionID, id)
+	if err != nil {
+		return err
+	}
+	return requireAffected(res)
+}
+
+// ReplaceSessionID replaces the session identifier of an account if it is
+// still oldSessionID.
+// Returns persistence.ErrNotFound if the account does not exist or has another session.
+func (d *AccountDAO) ReplaceSessionID(ctx context.Context, id, oldSessionID, newSessionID string) error {
+	res, err := d.db.db.ExecContext(ctx, d.db.rebind(`UPDATE accounts SET session_id = ? WHERE id = ? AND session_id = ?`),
+		newSessionID, id, oldSessionID)
+	if err != nil {
+		return err
+	}
+	return requireAffected(res)
+}
+
+func (d *AccountDAO) get(ctx context.Context, where string, arg string) (*models.Account, error) {
+	var (
+		account   models.Account
+		createdAt int64
+	)
+	err := d.db.db.QueryRowContext(ctx, d.db.rebind(`SELECT `+accountColumns+` FROM accounts `+where), arg).
+		Scan(&account.ID, &account.Email, &account.PasswordHash, &createdAt, &account.SessionID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, persistence.ErrNotFound
+		}
+		return nil, err
+	}
+	account.CreatedAt = fromUnixMicro(createdAt)
+	return &account, nil
+}
@@ -0,0 +1,129 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/persistence"
+)
+
+// ActDAO is the SQL implementation of persistence.ActDAO.
+type ActDAO struct {
+	db *DB
+}
+
+// NewActDAO creates a new ActDAO.
+func NewActDAO(db *DB) *ActDAO {
+	return &ActDAO{db: db}
+}
+
+const actColumns = `contest, id, country, artist, song, running_order, event_type, qualification, final_running_order`
+
+// Create stores a new act.
+// Returns persistence.ErrActExists if the contest already has an act with the same ID.
+func (d *ActDAO) Create(ctx context.Context, act *models.Act) error {
+	err := insertAct(ctx, d.db, d.db.db, act)
+	if err != nil && isUniqueViolation(err) {
+		return persistence.ErrActExists
+	}
+	return err
+}
+
+// GetByID retrieves an act of a contest by its ID.
+// Returns persistence.ErrNotFound if the act does not exist.
+func (d *ActDAO) GetByID(ctx context.Context, contest, id string) (*models.Act, error) {
+	row := d.db.db.QueryRowContext(ctx, d.db.rebind(`SELECT `+actColumns+` FROM acts WHERE contest = ? AND id = ?`), contest, id)
+	act, err := scanAct(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, persistence.ErrNotFound
+	}
+	return act, err
+}
+
+// ListByContest retrieves all acts of a contest ordered by ID.
+// Returns an empty slice if the contest has no acts.
+func (d *ActDAO) ListByContest(ctx context.Context, contest string) ([]*models.Act, error) {
+	rows, err := d.db.db.QueryContext(ctx, d.db.rebind(`SELECT `+actColumns+` FROM acts WHERE contest = ? ORDER BY id`), contest)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	acts := make([]*models.Act, 0)
+	for rows.Next() {
+		act, err := scanAct(rows)
+		if err != nil {
+			return nil, err
+		}
+		acts = append(acts, act)
+	}
+	return acts, rows.Err()
+}
+
+// Update overwrites an existing act.
+// Returns persistence.ErrNotFound if the act does not exist.
+func (d *ActDAO) Update(ctx context.Context, act *models.Act) error {
+	res, err := d.db.db.ExecContext(ctx, d.db.rebind(`UPDATE acts SET country = ?, artist = ?, song = ?, running_order = ?, event_type = ?,
+		qualification = ?, final_running_order = ? WHERE contest = ? AND id = ?`),
+		act.Country, act.Artist, act.Song, act.RunningOrder, string(act.EventType),
+		string(act.Qualification), act.FinalRunningOrder, act.Contest, act.ID)
+	if err != nil {
+		return err
+	}
+	return requireAffected(res)
+}
+
+// Delete removes an act.
+// Returns persistence.ErrNotFound if the act does not exist.
+func (d *ActDAO) Delete(ctx context.Context, contest, id string) error {
+	res, err := d.db.db.ExecContext(ctx, d.db.rebind(`DELETE FROM acts WHERE contest = ? AND id = ?`), contest, id)
+	if err != nil {
+		return err
+	}
+	return requireAffected(res)
+}
+
+// ReplaceContest atomically replaces all acts of a contest with the given acts.
+func (d *ActDAO) ReplaceContest(ctx context.Context, contest string, acts []*models.Act) error {
+	return d.db.withTx(ctx, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, d.db.rebind(`DELETE FROM acts WHERE contest = ?`), contest); err != nil {
+			return err
+		}
+		for _, act := range acts {
+			if err := insertAct(ctx, d.db, tx, act); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// execer is satisfied by both *sql.DB and *sql.Tx.
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// insertAct inserts an act using either the database handle or a transaction.
+func insertAct(ctx context.Context, db *DB, exec execer, act *models.Act) error {
+	_, err := exec.ExecContext(ctx, db.rebind(`INSERT INTO acts (`+actColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`),
+		act.Contest, act.ID, act.Country, act.Artist, act.Song, act.RunningOrder, string(act.EventType),
+		string(act.Qualification), act.FinalRunningOrder)
+	return err
+}
+
+func scanAct(row rowScanner) (*models.Act, error) {
+	var (
+		act           models.Act
+		eventType     string
+		qualification string
+	)
+	if err := row.Scan(&act.Contest, &act.ID, &act.Country, &act.Artist, &act.Song, &act.RunningOrder, &eventType,
+		&qualification, &act.FinalRunningOrder); err != nil {
+		return nil, err
+	}
+	act.EventType = models.EventType(eventType)
+	act.Qualification = models.Qualification(qualification)
+	return &act, nil
+}
@@ -0,0 +1,221 @@
+package sql_test
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/persistence"
+	"github.com/sipgate/eurovision-vote-party/server/persistence/persistencetest"
+	persistencesql "github.com/sipgate/eurovision-vote-party/server/persistence/sql"
+)
+
+// backends lists the databases the conformance suites run against. SQLite is
+// always available; PostgreSQL runs when POSTGRES_TEST_DSN is set.
+func backends() map[string]func(t *testing.T) *persistencesql.DB {
+	b := map[string]func(t *testing.T) *persistencesql.DB{
+		"SQLite": openSQLite,
+	}
+	if os.Getenv("POSTGRES_TEST_DSN") != "" {
+		b["Postgres"] = openPostgres
+	}
+	return b
+}
+
+func openSQLite(t *testing.T) *persistencesql.DB {
+	t.Helper()
+
+	db, err := persistencesql.Open(context.Background(), persistencesql.DialectSQLite, ":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func openPostgres(t *testing.T) *persistencesql.DB {
+	t.Helper()
+
+	ctx := context.Background()
+	db, err := persistencesql.Open(ctx, persistencesql.DialectPostgres, os.Getenv("POSTGRES_TEST_DSN"))
+	require.NoError(t, err)
+	require.NoError(t, persistencesql.Truncate(ctx, db))
+	t.Cleanup(func() {
+		persistencesql.Truncate(ctx, db)
+		db.Close()
+	})
+	return db
+}
+
+func TestPartyDAO(t *testing.T) {
+	for name, open := range backends() {
+		t.Run(name, func(t *testing.T) {
+			persistencetest.RunPartyDAO(t, func(t *testing.T) persistence.PartyDAO {
+				return persistencesql.NewPartyDAO(open(t))
+			})
+		})
+	}
+}
+
+func TestGuestDAO(t *testing.T) {
+	for name, open := range backends() {
+		t.Run(name, func(t *testing.T) {
+			persistencetest.RunGuestDAO(t, func(t *testing.T) persistence.GuestDAO {
+				return persistencesql.NewGuestDAO(open(t))
+			})
+		})
+	}
+}
+
+func TestVoteDAO(t *testing.T) {
+	for name, open := range backends() {
+		t.Run(name, func(t *testing.T) {
+			persistencetest.RunVoteDAO(t, func(t *testing.T) persistence.VoteDAO {
+				return persistencesql.NewVoteDAO(open(t))
+			})
+		})
+	}
+}
+
+func TestUserDAO(t *testing.T) {
+	for name, open := range backends() {
+		t.Run(name, func(t *testing.T) {
+			persistencetest.RunUserDAO(t, func(t *testing.T) persistence.UserDAO {
+				return persistencesql.NewUserDAO(open(t))
+			})
+		})
+	}
+}
+
+func TestAccountDAO(t *testing.T) {
+	for name, open := range backends() {
+		t.Run(name, func(t *testing.T) {
+			persistencetest.RunAccountDAO(t, func(t *testing.T) persistence.AccountDAO {
+				return persistencesql.NewAccountDAO(open(t))
+			})
+		})
+	}
+}
+
+func TestActDAO(t *testing.T) {
+	for name, open := range backends() {
+		t.Run(name, func(t *testing.T) {
+			persistencetest.RunActDAO(t, func(t *testing.T) persistence.ActDAO {
+				return persistencesql.NewActDAO(open(t))
+			})
+		})
+	}
+}
+
+func TestPredictionDAO(t *testing.T) {
+	for name, open := range backends() {
+		t.Run(name, func(t *testing.T) {
+			persistencetest.RunPredictionDAO(t, func(t *testing.T) persistence.PredictionDAO {
+				return persistencesql.NewPredictionDAO(open(t))
+			})
+		})
+	}
+}
+
+func TestScoreboardDAO(t *testing.T) {
+	for name, open := range backends() {
+		t.Run(name, func(t *testing.T) {
+			persistencetest.RunScoreboardDAO(t, func(t *testing.T) persistence.ScoreboardDAO {
+				return persistencesql.NewScoreboardDAO(open(t))
+			})
+		})
+	}
+}
+
+// newDAOs returns DAOs sharing one database of the given backend.
+func newDAOs(open func(t *testing.T) *persistencesql.DB) func(t *testing.T) persistencetest.DAOs {
+	return func(t *testing.T) persistencetest.DAOs {
+		db := open(t)
+		return persistencetest.DAOs{
+			Party:      persistencesql.NewPartyDAO(db),
+			Guest:      persistencesql.NewGuestDAO(db),
+			Vote:       persistencesql.NewVoteDAO(db),
+			User:       persistencesql.NewUserDAO(db),
+			Prediction: persistencesql.NewPredictionDAO(db),
+		}
+	}
+}
+
+func TestPartyDAO_DeleteCascade(t *testing.T) {
+	for name, open := range backends() {
+		t.Run(name, func(t *testing.T) {
+			persistencetest.RunDeleteCascade(t, newDAOs(open))
+		})
+	}
+}
+
+func TestGuestDAO_CreatePending(t *testing.T) {
+	for name, open := range backends() {
+		t.Run(name, func(t *testing.T) {
+			persistencetest.RunCreatePending(t, newDAOs(open))
+		})
+	}
+}
+
+func TestGuestDAO_Create_EnforcesUniqueUsernamePerParty(t *testing.T) {
+	for name, open := range backends() {
+		t.Run(name, func(t *testing.T) {
+			dao := persistencesql.NewGuestDAO(open(t))
+			ctx := context.Background()
+			require.NoError(t, dao.Create(ctx, persistencetest.NewGuest("guest-1", "party-1", "alice", models.GuestStatusPending)))
+
+			err := dao.Create(ctx, persistencetest.NewGuest("guest-2", "party-1", "alice", models.GuestStatusPending))
+			assert.ErrorIs(t, err, persistence.ErrUsernameExists)
+
+			err = dao.Create(ctx, persistencetest.NewGuest("guest-3", "party-2", "alice", models.GuestStatusPending))
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestOpen_MigrationsAreIdempotent(t *testing.T) {
+	ctx := context.Background()
+	path := t.TempDir() + "/evp.db"
+
+	db, err := persistencesql.Open(ctx, persistencesql.DialectSQLite, path)
+	require.NoError(t, err)
+	require.NoError(t, persistencesql.NewPartyDAO(db).Create(ctx, persistencetest.NewParty("party-1", "KEEP01", "admin-1")))
+	require.NoError(t, db.Close())
+
+	db, err = persistencesql.Open(ctx, persistencesql.DialectSQLite, path)
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	party, err := persistencesql.NewPartyDAO(db).GetByID(ctx, "party-1")
+	require.NoError(t, err)
+	assert.Equal(t, "KEEP01", party.Code)
+}
+
+func TestOpen_RejectsUnknownDialect(t *testing.T) {
+	_, err := persistencesql.Open(context.Background(), persistencesql.Dialect("oracle"), "")
+
+	assert.Error(t, err)
+}
+
+func TestOpen_KeepsSQLiteQueryParameters(t *testing.T) {
+	ctx := context.Background()
+
+	db, err := persistencesql.Open(ctx, persistencesql.DialectSQLite, "file:"+t.TempDir()+"/evp.db?mode=rwc")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	assert.NoError(t, persistencesql.NewPartyDAO(db).Create(ctx, persistencetest.NewParty("party-1", "KEEP02", "admin-1")))
+}
+
+func TestNew_RejectsSQLiteWithoutForeignKeys(t *testing.T) {
+	handle, err := sql.Open("sqlite", ":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { handle.Close() })
+
+	_, err = persistencesql.New(context.Background(), handle, persistencesql.DialectSQLite)
+
+	assert.ErrorContains(t, err, "foreign keys")
+}
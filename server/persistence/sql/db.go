@@ -0,0 +1,201 @@
+// Package sql provides database/sql implementations of the persistence DAOs
+// for SQLite and PostgreSQL, allowing the server to be self-hosted without
+// Google Cloud.
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	// Register the database drivers used by Open.
+	_ "github.com/jackc/pgx/v5/stdlib"
+	_ "modernc.org/sqlite"
+)
+
+// Dialect identifies the SQL database flavour.
+type Dialect string
+
+const (
+	// DialectSQLite selects SQLite via the pure Go modernc.org/sqlite driver.
+	DialectSQLite Dialect = "sqlite"
+	// DialectPostgres selects PostgreSQL via the pgx driver.
+	DialectPostgres Dialect = "postgres"
+)
+
+// driverName returns the database/sql driver name registered for the dialect.
+func (d Dialect) driverName() (string, error) {
+	switch d {
+	case DialectSQLite:
+		return "sqlite", nil
+	case DialectPostgres:
+		return "pgx", nil
+	default:
+		return "", fmt.Errorf("unsupported SQL dialect %q", string(d))
+	}
+}
+
+// DB wraps a database handle together with its dialect. It is shared by all
+// DAOs in this package, similar to the Firestore client.
+type DB struct {
+	db      *sql.DB
+	dialect Dialect
+}
+
+// Open connects to the database described by dsn and applies all pending
+// schema migrations.
+func Open(ctx context.Context, dialect Dialect, dsn string) (*DB, error) {
+	driver, err := dialect.driverName()
+	if err != nil {
+		return nil, err
+	}
+
+	if dialect == DialectSQLite {
+		dsn = withSQLiteForeignKeys(dsn)
+	}
+
+	db, err := sql.Open(driver, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("opening %s database: %w", dialect, err)
+	}
+
+	if dialect == DialectSQLite {
+		// SQLite allows a single writer; serialising access avoids "database is
+		// locked" errors and keeps ":memory:" databases on one connection.
+		db.SetMaxOpenConns(1)
+	}
+
+	wrapped, err := New(ctx, db, dialect)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return wrapped, nil
+}
+
+// New wraps an existing database handle and applies all pending schema migrations.
+// SQLite handles must enforce foreign keys, which Open takes care of.
+func New(ctx context.Context, db *sql.DB, dialect Dialect) (*DB, error) {
+	if _, err := dialect.driverName(); err != nil {
+		return nil, err
+	}
+
+	if dialect == DialectSQLite {
+		var enabled bool
+		if err := db.QueryRowContext(ctx, `PRAGMA foreign_keys`).Scan(&enabled); err != nil {
+			return nil, fmt.Errorf("checking foreign key enforcement: %w", err)
+		}
+		if !enabled {
+			return nil, errors.New("sqlite database does not enforce foreign keys; open it with _pragma=foreign_keys(1)")
+		}
+	}
+
+	d := &DB{db: db, dialect: dialect}
+	if err := d.migrate(ctx); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// withSQLiteForeignKeys adds the pragma enabling foreign keys to a SQLite
+// DSN, so that every connection deletes dependent rows ON DELETE CASCADE.
+func withSQLiteForeignKeys(dsn string) string {
+	sep := "?"
+	if strings.Contains(dsn, "?") {
+		sep = "&"
+	}
+	return dsn + sep + "_pragma=foreign_keys(1)"
+}
+
+// Close closes the underlying database handle.
+func (d *DB) Close() error {
+	return d.db.Close()
+}
+
+// rebind rewrites "?" placeholders into the form expected by the dialect.
+func (d *DB) rebind(query string) string {
+	if d.dialect != DialectPostgres {
+		return query
+	}
+
+	var b strings.Builder
+	n := 0
+	for _, c := range query {
+		if c == '?' {
+			n++
+			b.WriteByte('$')
+			b.WriteString(strconv.Itoa(n))
+			continue
+		}
+		b.WriteRune(c)
+	}
+	return b.String()
+}
+
+// withTx runs fn inside a transaction, committing on success and rolling back
+// on error.
+func (d *DB) withTx(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// isUniqueViolation reports whether err was caused by a unique constraint.
+func isUniqueViolation(err error) bool {
+	var pgErr interface{ SQLState() string }
+	if errors.As(err, &pgErr) {
+		return pgErr.SQLState() == "23505"
+	}
+	return strings.Contains(err.Error(), "UNIQUE constraint failed")
+}
+
+// toUnixMicro converts a timestamp to the representation stored in the database.
+func toUnixMicro(t time.Time) int64 {
+	return t.UnixMicro()
+}
+
+// fromUnixMicro converts a stored timestamp back to a UTC time.Time.
+func fromUnixMicro(v int64) time.Time {
+	return time.UnixMicro(v).UTC()
+}
+
+// toOptionalUnixMicro converts an optional timestamp to its stored
+// representation, storing the zero time as 0.
+func toOptionalUnixMicro(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixMicro()
+}
+
+// fromOptionalUnixMicro converts a timestamp stored by toOptionalUnixMicro
+// back to a UTC time.Time, mapping 0 to the zero time.
+func fromOptionalUnixMicro(v int64) time.Time {
+	if v == 0 {
+		return time.Time{}
+	}
+	return fromUnixMicro(v)
+}
+
+// Truncate deletes all rows from every table while keeping the schema. It is
+// intended for resetting shared databases between tests.
+func Truncate(ctx context.Context, d *DB) error {
+	return d.withTx(ctx, func(tx *sql.Tx) error {
+		for _, table := range []string{"vote_points", "vote_ratings", "votes", "prediction_picks", "predictions", "prediction_outcome_picks", "prediction_outcomes", "guests", "party_members", "parties", "users", "accounts", "acts", "scoreboard_entries", "scoreboards"} {
+			if _, err := tx.ExecContext(ctx, `DELETE FROM `+table); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,169 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/persistence"
+)
+
+// GuestDAO is the SQL implementation of persistence.GuestDAO.
+type GuestDAO struct {
+	db *DB
+}
+
+// NewGuestDAO creates a new GuestDAO.
+func NewGuestDAO(db *DB) *GuestDAO {
+	return &GuestDAO{db: db}
+}
+
+const guestColumns = `id, party_id, username, status, created_at, session_id`
+
+// Create stores a new guest.
+// Returns persistence.ErrUsernameExists if the party already has a guest with the same username.
+func (d *GuestDAO) Create(ctx context.Context, guest *models.Guest) error {
+	_, err := d.db.db.ExecContext(ctx, d.db.rebind(`INSERT INTO guests (`+guestColumns+`) VALUES (?, ?, ?, ?, ?, ?)`),
+		guest.ID, guest.PartyID, guest.Username, string(guest.Status), toUnixMicro(guest.CreatedAt), guest.SessionID)
+	if err != nil && isUniqueViolation(err) {
+		if exists, existsErr := d.ExistsByPartyAndUsername(ctx, guest.PartyID, guest.Username); existsErr == nil && exists {
+			return persistence.ErrUsernameExists
+		}
+	}
+	return err
+}
+
+// CreatePending stores a new guest unless its party already has maxPending
+// pending guests. Returns persistence.ErrPendingLimit in that case, or
+// persistence.ErrUsernameExists like Create, and persistence.ErrNotFound if
+// the party does not exist. On PostgreSQL the party row is locked while
+// counting so that concurrent joins cannot overshoot the limit or outlive
+// the party's deletion; SQLite serialises writers anyway.
+func (d *GuestDAO) CreatePending(ctx context.Context, guest *models.Guest, maxPending int) error {
+	err := d.db.withTx(ctx, func(tx *sql.Tx) error {
+		query := `SELECT id FROM parties WHERE id = ?`
+		if d.db.dialect == DialectPostgres {
+			query += ` FOR UPDATE`
+		}
+		var partyID string
+		if err := tx.QueryRowContext(ctx, d.db.rebind(query), guest.PartyID).Scan(&partyID); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return persistence.ErrNotFound
+			}
+			return err
+		}
+
+		var pending int
+		err := tx.QueryRowContext(ctx, d.db.rebind(`SELECT COUNT(*) FROM guests WHERE party_id = ? AND status = ?`),
+			guest.PartyID, string(models.GuestStatusPending)).Scan(&pending)
+		if err != nil {
+			return err
+		}
+		if pending >= maxPending {
+			return persistence.ErrPendingLimit
+		}
+
+		_, err = tx.ExecContext(ctx, d.db.rebind(`INSERT INTO guests (`+guestColumns+`) VALUES (?, ?, ?, ?, ?, ?)`),
+			guest.ID, guest.PartyID, guest.Username, string(guest.Status), toUnixMicro(guest.CreatedAt), guest.SessionID)
+		return err
+	})
+	if err != nil && isUniqueViolation(err) {
+		if exists, existsErr := d.ExistsByPartyAndUsername(ctx, guest.PartyID, guest.Username); existsErr == nil && exists {
+			return persistence.ErrUsernameExists
+		}
+	}
+	return err
+}
+
+// GetByID retrieves a guest by its ID.
+// Returns persistence.ErrNotFound if the guest does not exist.
+func (d *GuestDAO) GetByID(ctx context.Context, id string) (*models.Guest, error) {
+	row := d.db.db.QueryRowContext(ctx, d.db.rebind(`SELECT `+guestColumns+` FROM guests WHERE id = ?`), id)
+	guest, err := scanGuest(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, persistence.ErrNotFound
+	}
+	return guest, err
+}
+
+// ListByPartyID retrieves all guests for a given party, ordered by ID.
+// Returns an empty slice if no guests are found.
+func (d *GuestDAO) ListByPartyID(ctx context.Context, partyID string) ([]*models.Guest, error) {
+	return d.list(ctx, `SELECT `+guestColumns+` FROM guests WHERE party_id = ? ORDER BY id`, partyID)
+}
+
+// ListByPartyIDAndStatus retrieves all guests for a given party filtered by status, ordered by ID.
+// Returns an empty slice if no guests are found.
+func (d *GuestDAO) ListByPartyIDAndStatus(ctx context.Context, partyID string, status models.GuestStatus) ([]*models.Guest, error) {
+	return d.list(ctx, `SELECT `+guestColumns+` FROM guests WHERE party_id = ? AND status = ? ORDER BY id`, partyID, string(status))
+}
+
+// UpdateStatus updates the status field of an existing guest.
+// Returns persistence.ErrNotFound if the guest does not exist.
+func (d *GuestDAO) UpdateStatus(ctx context.Context, id string, status models.GuestStatus) error {
+	res, err := d.db.db.ExecContext(ctx, d.db.rebind(`UPDATE guests SET status = ? WHERE id = ?`), string(status), id)
+	if err != nil {
+		return err
+	}
+	return requireAffected(res)
+}
+
+// UpdateSessionID replaces the session identifier of an existing guest.
+// Returns persistence.ErrNotFound if the guest does not exist.
+func (d *GuestDAO) UpdateSessionID(ctx context.Context, id, sessionID string) error {
+	res, err := d.db.db.ExecContext(ctx, d.db.rebind(`UPDATE guests SET session_id = ? WHERE id = ?`), sessionID, id)
+	if err != nil {
+		return err
+	}
+	return requireAffected(res)
+}
+
+// Delete removes a guest.
+// Returns persistence.ErrNotFound if the guest does not exist.
+func (d *GuestDAO) Delete(ctx context.Context, id string) error {
+	res, err := d.db.db.ExecContext(ctx, d.db.rebind(`DELETE FROM guests WHERE id = ?`), id)
+	if err != nil {
+		return err
+	}
+	return requireAffected(res)
+}
+
+// ExistsByPartyAndUsername checks whether a guest with the given partyID and username exists.
+func (d *GuestDAO) ExistsByPartyAndUsername(ctx context.Context, partyID, username string) (bool, error) {
+	var n int
+	err := d.db.db.QueryRowContext(ctx, d.db.rebind(`SELECT COUNT(*) FROM guests WHERE party_id = ? AND username = ?`), partyID, username).Scan(&n)
+	return n > 0, err
+}
+
+func (d *GuestDAO) list(ctx context.Context, query string, args ...any) ([]*models.Guest, error) {
+	rows, err := d.db.db.QueryContext(ctx, d.db.rebind(query), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	guests := make([]*models.Guest, 0)
+	for rows.Next() {
+		guest, err := scanGuest(rows)
+		if err != nil {
+			return nil, err
+		}
+		guests = append(guests, guest)
+	}
+	return guests, rows.Err()
+}
+
+func scanGuest(row rowScanner) (*models.Guest, error) {
+	var (
+		guest     models.Guest
+		status    string
+		createdAt int64
+	)
+	if err := row.Scan(&guest.ID, &guest.PartyID, &guest.Username, &status, &createdAt, &guest.SessionID); err != nil {
+		return nil, err
+	}
+	guest.Status = models.GuestStatus(status)
+	guest.CreatedAt = fromUnixMicro(createdAt)
+	return &guest, nil
+}
@@ -0,0 +1,165 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// migrations lists the schema changes in the order they are applied. Each
+// entry is applied at most once and recorded in schema_migrations under its
+// 1-based position, so existing entries must never be edited or reordered.
+// The statements use only types understood by both SQLite and PostgreSQL.
+var migrations = []string{
+	`CREATE TABLE parties (
+		id TEXT PRIMARY KEY,
+		name TEXT NOT NULL,
+		code TEXT NOT NULL,
+		event_type TEXT NOT NULL,
+		admin_id TEXT NOT NULL,
+		status TEXT NOT NULL,
+		created_at BIGINT NOT NULL,
+		CONSTRAINT parties_code_key UNIQUE (code)
+	)`,
+	`CREATE INDEX parties_admin_id_idx ON parties (admin_id)`,
+	`CREATE TABLE guests (
+		id TEXT PRIMARY KEY,
+		party_id TEXT NOT NULL,
+		username TEXT NOT NULL,
+		status TEXT NOT NULL,
+		created_at BIGINT NOT NULL,
+		CONSTRAINT guests_party_id_username_key UNIQUE (party_id, username)
+	)`,
+	`CREATE TABLE votes (
+		id TEXT PRIMARY KEY,
+		guest_id TEXT NOT NULL,
+		party_id TEXT NOT NULL,
+		created_at BIGINT NOT NULL
+	)`,
+	`CREATE INDEX votes_party_id_idx ON votes (party_id)`,
+	`CREATE INDEX votes_guest_id_party_id_idx ON votes (guest_id, party_id)`,
+	`CREATE TABLE vote_points (
+		vote_id TEXT NOT NULL REFERENCES votes (id) ON DELETE CASCADE,
+		points INTEGER NOT NULL,
+		act_id TEXT NOT NULL,
+		PRIMARY KEY (vote_id, points)
+	)`,
+	`CREATE TABLE users (
+		id TEXT PRIMARY KEY,
+		username TEXT NOT NULL,
+		email TEXT NOT NULL
+	)`,
+	`DROP INDEX votes_guest_id_party_id_idx`,
+	`CREATE UNIQUE INDEX votes_guest_id_party_id_key ON votes (guest_id, party_id)`,
+	`ALTER TABLE guests ADD COLUMN session_id TEXT NOT NULL DEFAULT ''`,
+	`ALTER TABLE parties ADD COLUMN reveal_step INTEGER NOT NULL DEFAULT 0`,
+	`ALTER TABLE parties ADD COLUMN scoring_system TEXT NOT NULL DEFAULT ''`,
+	`CREATE TABLE vote_ratings (
+		vote_id TEXT NOT NULL REFERENCES votes (id) ON DELETE CASCADE,
+		act_id TEXT NOT NULL,
+		rating INTEGER NOT NULL,
+		PRIMARY KEY (vote_id, act_id)
+	)`,
+	`ALTER TABLE votes ADD COLUMN draft BOOLEAN NOT NULL DEFAULT FALSE`,
+	`ALTER TABLE parties ADD COLUMN contest TEXT NOT NULL DEFAULT ''`,
+	`CREATE TABLE acts (
+		contest TEXT NOT NULL,
+		id TEXT NOT NULL,
+		country TEXT NOT NULL,
+		artist TEXT NOT NULL,
+		song TEXT NOT NULL,
+		running_order INTEGER NOT NULL,
+		event_type TEXT NOT NULL,
+		PRIMARY KEY (contest, id)
+	)`,
+	`ALTER TABLE acts ADD COLUMN qualification TEXT NOT NULL DEFAULT ''`,
+	`ALTER TABLE acts ADD COLUMN final_running_order INTEGER NOT NULL DEFAULT 0`,
+	`ALTER TABLE parties ADD COLUMN voting_started_at BIGINT NOT NULL DEFAULT 0`,
+	`CREATE TABLE predictions (
+		id TEXT PRIMARY KEY,
+		party_id TEXT NOT NULL,
+		guest_id TEXT NOT NULL,
+		updated_at BIGINT NOT NULL
+	)`,
+	`CREATE INDEX predictions_party_id_idx ON predictions (party_id)`,
+	`CREATE TABLE prediction_picks (
+		prediction_id TEXT NOT NULL REFERENCES predictions (id) ON DELETE CASCADE,
+		pick_order INTEGER NOT NULL,
+		act_id TEXT NOT NULL,
+		PRIMARY KEY (prediction_id, pick_order)
+	)`,
+	`CREATE TABLE prediction_outcomes (
+		party_id TEXT PRIMARY KEY,
+		recorded_at BIGINT NOT NULL
+	)`,
+	`CREATE TABLE prediction_outcome_picks (
+		party_id TEXT NOT NULL REFERENCES prediction_outcomes (party_id) ON DELETE CASCADE,
+		pick_order INTEGER NOT NULL,
+		act_id TEXT NOT NULL,
+		PRIMARY KEY (party_id, pick_order)
+	)`,
+	`CREATE TABLE scoreboards (
+		contest TEXT NOT NULL,
+		event_type TEXT NOT NULL,
+		imported_at BIGINT NOT NULL,
+		PRIMARY KEY (contest, event_type)
+	)`,
+	`CREATE TABLE scoreboard_entries (
+		contest TEXT NOT NULL,
+		event_type TEXT NOT NULL,
+		act_id TEXT NOT NULL,
+		jury_points INTEGER NOT NULL,
+		televote_points INTEGER NOT NULL,
+		PRIMARY KEY (contest, event_type, act_id)
+	)`,
+	`ALTER TABLE parties ADD COLUMN anonymous_results BOOLEAN NOT NULL DEFAULT FALSE`,
+	`CREATE TABLE party_members (
+		party_id TEXT NOT NULL,
+		user_id TEXT NOT NULL,
+		member_order INTEGER NOT NULL,
+		username TEXT NOT NULL,
+		role TEXT NOT NULL,
+		status TEXT NOT NULL,
+		invited_by TEXT NOT NULL,
+		invited_at BIGINT NOT NULL,
+		PRIMARY KEY (party_id, user_id)
+	)`,
+	`CREATE INDEX party_members_user_id_idx ON party_members (user_id)`,
+	`CREATE INDEX users_email_idx ON users (email)`,
+	`CREATE INDEX users_username_idx ON users (username)`,
+	`CREATE TABLE accounts (
+		id TEXT PRIMARY KEY,
+		email TEXT NOT NULL,
+		password_hash TEXT NOT NULL,
+		created_at BIGINT NOT NULL,
+		session_id TEXT NOT NULL,
+		CONSTRAINT accounts_email_key UNIQUE (email)
+	)`,
+}
+
+// migrate applies all migrations that have not been recorded yet.
+func (d *DB) migrate(ctx context.Context) error {
+	if _, err := d.db.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (version INTEGER PRIMARY KEY)`); err != nil {
+		return fmt.Errorf("creating schema_migrations table: %w", err)
+	}
+
+	var current int
+	if err := d.db.QueryRowContext(ctx, `SELECT COALESCE(MAX(version), 0) FROM schema_migrations`).Scan(&current); err != nil {
+		return fmt.Errorf("reading schema version: %w", err)
+	}
+
+	for i := current; i < len(migrations); i++ {
+		version := i + 1
+		err := d.withTx(ctx, func(tx *sql.Tx) error {
+			if _, err := tx.ExecContext(ctx, migrations[i]); err != nil {
+				return err
+			}
+			_, err := tx.ExecContext(ctx, d.rebind(`INSERT INTO schema_migrations (version) VALUES (?)`), version)
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("applying migration %d: %w", version, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,311 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/persistence"
+)
+
+// PartyDAO is the SQL implementation of persistence.PartyDAO.
+type PartyDAO struct {
+	db *DB
+}
+
+// NewPartyDAO creates a new PartyDAO.
+func NewPartyDAO(db *DB) *PartyDAO {
+	return &PartyDAO{db: db}
+}
+
+const partyColumns = `id, name, code, event_type, admin_id, status, created_at, reveal_step, scoring_system, contest, voting_started_at, anonymous_results`
+
+// Create stores a new party and its members in a single transaction.
+// Returns persistence.ErrCodeExists if a party with the same code already exists.
+func (d *PartyDAO) Create(ctx context.Context, party *models.Party) error {
+	err := d.db.withTx(ctx, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, d.db.rebind(`INSERT INTO parties (`+partyColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`),
+			party.ID, party.Name, party.Code, string(party.EventType), party.AdminID, string(party.Status), toUnixMicro(party.CreatedAt), party.RevealStep, string(party.ScoringSystem), party.Contest, toOptionalUnixMicro(party.VotingStartedAt), party.AnonymousResults)
+		if err != nil {
+			return err
+		}
+		return d.insertMembers(ctx, tx, party.ID, party.Members)
+	})
+	if err != nil && isUniqueViolation(err) {
+		if exists, existsErr := d.CodeExists(ctx, party.Code); existsErr == nil && exists {
+			return persistence.ErrCodeExists
+		}
+	}
+	return err
+}
+
+// GetByID retrieves a party by its ID.
+// Returns persistence.ErrNotFound if the party does not exist.
+func (d *PartyDAO) GetByID(ctx context.Context, id string) (*models.Party, error) {
+	return d.get(ctx, `WHERE id = ?`, id)
+}
+
+// GetByCode retrieves a party by its unique code.
+// Returns persistence.ErrNotFound if no party with the given code exists.
+func (d *PartyDAO) GetByCode(ctx context.Context, code string) (*models.Party, error) {
+	return d.get(ctx, `WHERE code = ?`, code)
+}
+
+// ListByAdminID retrieves all parties created by a given admin, ordered by ID.
+// Returns an empty slice if no parties are found.
+func (d *PartyDAO) ListByAdminID(ctx context.Context, adminID string) ([]*models.Party, error) {
+	return d.query(ctx, `WHERE admin_id = ?`, adminID)
+}
+
+// ListByMemberID retrieves all parties that list the given user as a member,
+// including pending invitations, ordered by ID.
+// Returns an empty slice if no parties are found.
+func (d *PartyDAO) ListByMemberID(ctx context.Context, userID string) ([]*models.Party, error) {
+	return d.query(ctx, `WHERE id IN (SELECT party_id FROM party_members WHERE user_id = ?)`, userID)
+}
+
+// Delete removes a party.
+// Returns persistence.ErrNotFound if the party does not exist.
+func (d *PartyDAO) Delete(ctx context.Context, id string) error {
+	res, err := d.db.db.ExecContext(ctx, d.db.rebind(`DELETE FROM parties WHERE id = ?`), id)
+	if err != nil {
+		return err
+	}
+	return requireAffected(res)
+}
+
+// DeleteCascade removes a party together with all of its guests, votes and predictions in a single transaction.
+// Returns persistence.ErrNotFound if the party does not exist.
+func (d *PartyDAO) DeleteCascade(ctx context.Context, id string) error {
+	return d.db.withTx(ctx, func(tx *sql.Tx) error {
+		res, err := tx.ExecContext(ctx, d.db.rebind(`DELETE FROM parties WHERE id = ?`), id)
+		if err != nil {
+			return err
+		}
+		if err := requireAffected(res); err != nil {
+			return err
+		}
+
+		// Points, ratings and picks follow their votes, predictions and
+		// outcome through ON DELETE CASCADE.
+		for _, stmt := range []string{
+			`DELETE FROM votes WHERE party_id = ?`,
+			`DELETE FROM predictions WHERE party_id = ?`,
+			`DELETE FROM prediction_outcomes WHERE party_id = ?`,
+			`DELETE FROM guests WHERE party_id = ?`,
+			`DELETE FROM party_members WHERE party_id = ?`,
+		} {
+			if _, err := tx.ExecContext(ctx, d.db.rebind(stmt), id); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// UpdateStatus updates the status of a party.
+// Returns persistence.ErrNotFound if the party does not exist.
+func (d *PartyDAO) UpdateStatus(ctx context.Context, id string, status models.PartyStatus) error {
+	res, err := d.db.db.ExecContext(ctx, d.db.rebind(`UPDATE parties SET status = ? WHERE id = ?`), string(status), id)
+	if err != nil {
+		return err
+	}
+	return requireAffected(res)
+}
+
+// UpdateRevealStep records how many result reveal steps of a party have been announced.
+// Returns persistence.ErrNotFound if the party does not exist.
+func (d *PartyDAO) UpdateRevealStep(ctx context.Context, id string, step int) error {
+	res, err := d.db.db.ExecContext(ctx, d.db.rebind(`UPDATE parties SET reveal_step = ? WHERE id = ?`), step, id)
+	if err != nil {
+		return err
+	}
+	return requireAffected(res)
+}
+
+// UpdateVotingStartedAt records when voting in a party was opened.
+// Returns persistence.ErrNotFound if the party does not exist.
+func (d *PartyDAO) UpdateVotingStartedAt(ctx context.Context, id string, startedAt time.Time) error {
+	res, err := d.db.db.ExecContext(ctx, d.db.rebind(`UPDATE parties SET voting_started_at = ? WHERE id = ?`), toOptionalUnixMicro(startedAt), id)
+	if err != nil {
+		return err
+	}
+	return requireAffected(res)
+}
+
+// UpdateMembers replaces the members of a party with those update derives
+// from the current ones, in a single transaction. On PostgreSQL the party row
+// is locked meanwhile; SQLite serialises writers anyway. Errors of update are
+// returned as they are.
+// Returns persistence.ErrNotFound if the party does not exist.
+func (d *PartyDAO) UpdateMembers(ctx context.Context, id string, update func(members []models.PartyMember) ([]models.PartyMember, error)) error {
+	return d.db.withTx(ctx, func(tx *sql.Tx) error {
+		query := `SELECT id FROM parties WHERE id = ?`
+		if d.db.dialect == DialectPostgres {
+			query += ` FOR UPDATE`
+		}
+		var found string
+		if err := tx.QueryRowContext(ctx, d.db.rebind(query), id).Scan(&found); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return persistence.ErrNotFound
+			}
+			return err
+		}
+
+		party := &models.Party{ID: id}
+		if err := d.loadMembers(ctx, tx, []*models.Party{party}, `WHERE id = ?`, id); err != nil {
+			return err
+		}
+		members, err := update(party.Members)
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx, d.db.rebind(`DELETE FROM party_members WHERE party_id = ?`), id); err != nil {
+			return err
+		}
+		return d.insertMembers(ctx, tx, id, members)
+	})
+}
+
+// CodeExists checks whether a party with the given code exists.
+func (d *PartyDAO) CodeExists(ctx context.Context, code string) (bool, error) {
+	var n int
+	err := d.db.db.QueryRowContext(ctx, d.db.rebind(`SELECT COUNT(*) FROM parties WHERE code = ?`), code).Scan(&n)
+	return n > 0, err
+}
+
+func (d *PartyDAO) insertMembers(ctx context.Context, tx *sql.Tx, partyID string, members []models.PartyMember) error {
+	stmt := d.db.rebind(`INSERT INTO party_members (party_id, user_id, member_order, username, role, status, invited_by, invited_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`)
+	for i, m := range members {
+		if _, err := tx.ExecContext(ctx, stmt, partyID, m.UserID, i, m.Username, string(m.Role), string(m.Status), m.InvitedBy, toUnixMicro(m.InvitedAt)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// get loads the single party matching the given WHERE clause.
+// Returns persistence.ErrNotFound if no party matches.
+func (d *PartyDAO) get(ctx context.Context, where string, args ...any) (*models.Party, error) {
+	parties, err := d.query(ctx, where, args...)
+	if err != nil {
+		return nil, err
+	}
+	if len(parties) == 0 {
+		return nil, persistence.ErrNotFound
+	}
+	return parties[0], nil
+}
+
+// query loads the parties matching the given WHERE clause together with their members.
+func (d *PartyDAO) query(ctx context.Context, where string, args ...any) ([]*models.Party, error) {
+	rows, err := d.db.db.QueryContext(ctx, d.db.rebind(`SELECT `+partyColumns+` FROM parties `+where+` ORDER BY id`), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	parties := make([]*models.Party, 0)
+	for rows.Next() {
+		party, err := scanParty(rows)
+		if err != nil {
+			return nil, err
+		}
+		parties = append(parties, party)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(parties) == 0 {
+		return parties, nil
+	}
+	if err := d.loadMembers(ctx, d.db.db, parties, where, args...); err != nil {
+		return nil, err
+	}
+	return parties, nil
+}
+
+// loadMembers attaches the members of the parties matching the given WHERE clause.
+func (d *PartyDAO) loadMembers(ctx context.Context, q queryer, parties []*models.Party, where string, args ...any) error {
+	rows, err := q.QueryContext(ctx, d.db.rebind(`SELECT party_id, user_id, username, role, status, invited_by, invited_at
+		FROM party_members WHERE party_id IN (SELECT id FROM parties `+where+`) ORDER BY party_id, member_order`), args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	byID := make(map[string]*models.Party, len(parties))
+	for _, party := range parties {
+		byID[party.ID] = party
+	}
+	for rows.Next() {
+		var (
+			partyID   string
+			m         models.PartyMember
+			role      string
+			status    string
+			invitedAt int64
+		)
+		if err := rows.Scan(&partyID, &m.UserID, &m.Username, &role, &status, &m.InvitedBy, &invitedAt); err != nil {
+			return err
+		}
+		party, ok := byID[partyID]
+		if !ok {
+			continue
+		}
+		m.Role = models.PartyRole(role)
+		m.Status = models.MemberStatus(status)
+		m.InvitedAt = fromUnixMicro(invitedAt)
+		party.Members = append(party.Members, m)
+	}
+	return rows.Err()
+}
+
+// queryer is implemented by *sql.DB and *sql.Tx.
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+// rowScanner is implemented by *sql.Row and *sql.Rows.
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanParty(row rowScanner) (*models.Party, error) {
+	var (
+		party     models.Party
+		eventType string
+		status    string
+		createdAt int64
+		scoring   string
+		started   int64
+	)
+	err := row.Scan(&party.ID, &party.Name, &party.Code, &eventType, &party.AdminID, &status, &createdAt, &party.RevealStep, &scoring, &party.Contest, &started, &party.AnonymousResults)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, persistence.ErrNotFound
+		}
+		return nil, err
+	}
+	party.EventType = models.EventType(eventType)
+	party.Status = models.PartyStatus(status)
+	party.ScoringSystem = models.ScoringSystem(scoring)
+	party.CreatedAt = fromUnixMicro(createdAt)
+	party.VotingStartedAt = fromOptionalUnixMicro(started)
+	return &party, nil
+}
+
+// requireAffected returns persistence.ErrNotFound if the statement changed no rows.
+func requireAffected(res sql.Result) error {
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return persistence.ErrNotFound
+	}
+	return nil
+}
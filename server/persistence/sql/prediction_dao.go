@@ -0,0 +1,155 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/persistence"
+)
+
+// PredictionDAO is the SQL implementation of persistence.PredictionDAO.
+// Picks are normalised into one row per pick, keeping their order.
+type PredictionDAO struct {
+	db *DB
+}
+
+// NewPredictionDAO creates a new PredictionDAO.
+func NewPredictionDAO(db *DB) *PredictionDAO {
+	return &PredictionDAO{db: db}
+}
+
+// Upsert stores a prediction and replaces its picks in a single transaction.
+func (d *PredictionDAO) Upsert(ctx context.Context, prediction *models.Prediction) error {
+	return d.db.withTx(ctx, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, d.db.rebind(`INSERT INTO predictions (id, party_id, guest_id, updated_at) VALUES (?, ?, ?, ?)
+			ON CONFLICT (id) DO UPDATE SET party_id = excluded.party_id, guest_id = excluded.guest_id, updated_at = excluded.updated_at`),
+			prediction.ID, prediction.PartyID, prediction.GuestID, toUnixMicro(prediction.UpdatedAt))
+		if err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, d.db.rebind(`DELETE FROM prediction_picks WHERE prediction_id = ?`), prediction.ID); err != nil {
+			return err
+		}
+		return d.insertPicks(ctx, tx, `INSERT INTO prediction_picks (prediction_id, pick_order, act_id) VALUES (?, ?, ?)`, prediction.ID, prediction.Picks)
+	})
+}
+
+// GetByGuestAndParty retrieves a guest's prediction in a party.
+// Returns persistence.ErrNotFound if the guest has not made a prediction.
+func (d *PredictionDAO) GetByGuestAndParty(ctx context.Context, guestID, partyID string) (*models.Prediction, error) {
+	predictions, err := d.query(ctx, `WHERE p.guest_id = ? AND p.party_id = ?`, guestID, partyID)
+	if err != nil {
+		return nil, err
+	}
+	if len(predictions) == 0 {
+		return nil, persistence.ErrNotFound
+	}
+	return predictions[0], nil
+}
+
+// ListByPartyID retrieves all predictions made in a party, ordered by ID.
+func (d *PredictionDAO) ListByPartyID(ctx context.Context, partyID string) ([]*models.Prediction, error) {
+	return d.query(ctx, `WHERE p.party_id = ?`, partyID)
+}
+
+// SetOutcome stores the official outcome of a party's show and replaces its
+// picks in a single transaction.
+func (d *PredictionDAO) SetOutcome(ctx context.Context, outcome *models.PredictionOutcome) error {
+	return d.db.withTx(ctx, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, d.db.rebind(`INSERT INTO prediction_outcomes (party_id, recorded_at) VALUES (?, ?)
+			ON CONFLICT (party_id) DO UPDATE SET recorded_at = excluded.recorded_at`),
+			outcome.PartyID, toUnixMicro(outcome.RecordedAt))
+		if err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, d.db.rebind(`DELETE FROM prediction_outcome_picks WHERE party_id = ?`), outcome.PartyID); err != nil {
+			return err
+		}
+		return d.insertPicks(ctx, tx, `INSERT INTO prediction_outcome_picks (party_id, pick_order, act_id) VALUES (?, ?, ?)`, outcome.PartyID, outcome.Picks)
+	})
+}
+
+// GetOutcome retrieves the official outcome of a party's show.
+// Returns persistence.ErrNotFound if no outcome has been recorded.
+func (d *PredictionDAO) GetOutcome(ctx context.Context, partyID string) (*models.PredictionOutcome, error) {
+	rows, err := d.db.db.QueryContext(ctx, d.db.rebind(`SELECT o.recorded_at, p.act_id
+		FROM prediction_outcomes o LEFT JOIN prediction_outcome_picks p ON p.party_id = o.party_id
+		WHERE o.party_id = ? ORDER BY p.pick_order`), partyID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var outcome *models.PredictionOutcome
+	for rows.Next() {
+		var (
+			recordedAt int64
+			actID      sql.NullString
+		)
+		if err := rows.Scan(&recordedAt, &actID); err != nil {
+			return nil, err
+		}
+		if outcome == nil {
+			outcome = &models.PredictionOutcome{PartyID: partyID, RecordedAt: fromUnixMicro(recordedAt)}
+		}
+		if actID.Valid {
+			outcome.Picks = append(outcome.Picks, actID.String)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if outcome == nil {
+		return nil, persistence.ErrNotFound
+	}
+	return outcome, nil
+}
+
+// insertPicks stores picks in order under the given owner using stmt, which
+// takes the owner, the position of the pick and its act ID.
+func (d *PredictionDAO) insertPicks(ctx context.Context, tx *sql.Tx, stmt, owner string, picks []string) error {
+	stmt = d.db.rebind(stmt)
+	for i, actID := range picks {
+		if _, err := tx.ExecContext(ctx, stmt, owner, i, actID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// query loads predictions matching the given WHERE clause together with their picks.
+func (d *PredictionDAO) query(ctx context.Context, where string, args ...any) ([]*models.Prediction, error) {
+	rows, err := d.db.db.QueryContext(ctx, d.db.rebind(`SELECT p.id, p.party_id, p.guest_id, p.updated_at, k.act_id
+		FROM predictions p LEFT JOIN prediction_picks k ON k.prediction_id = p.id `+where+` ORDER BY p.id, k.pick_order`), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	predictions := make([]*models.Prediction, 0)
+	var current *models.Prediction
+	for rows.Next() {
+		var (
+			id, partyID, guestID string
+			updatedAt            int64
+			actID                sql.NullString
+		)
+		if err := rows.Scan(&id, &partyID, &guestID, &updatedAt, &actID); err != nil {
+			return nil, err
+		}
+		if current == nil || current.ID != id {
+			current = &models.Prediction{
+				ID:        id,
+				PartyID:   partyID,
+				GuestID:   guestID,
+				UpdatedAt: fromUnixMicro(updatedAt),
+			}
+			predictions = append(predictions, current)
+		}
+		if actID.Valid {
+			current.Picks = append(current.Picks, actID.String)
+		}
+	}
+	return predictions, rows.Err()
+}
@@ -0,0 +1,80 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/persistence"
+)
+
+// ScoreboardDAO is the SQL implementation of persistence.ScoreboardDAO.
+// Each scoreboard row has one scoreboard_entries row per act.
+type ScoreboardDAO struct {
+	db *DB
+}
+
+// NewScoreboardDAO creates a new ScoreboardDAO.
+func NewScoreboardDAO(db *DB) *ScoreboardDAO {
+	return &ScoreboardDAO{db: db}
+}
+
+// Replace stores a scoreboard and replaces its entries in a single transaction.
+func (d *ScoreboardDAO) Replace(ctx context.Context, scoreboard *models.Scoreboard) error {
+	return d.db.withTx(ctx, func(tx *sql.Tx) error {
+		contest, eventType := scoreboard.Contest, string(scoreboard.EventType)
+		_, err := tx.ExecContext(ctx, d.db.rebind(`INSERT INTO scoreboards (contest, event_type, imported_at) VALUES (?, ?, ?)
+			ON CONFLICT (contest, event_type) DO UPDATE SET imported_at = excluded.imported_at`),
+			contest, eventType, toUnixMicro(scoreboard.ImportedAt))
+		if err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, d.db.rebind(`DELETE FROM scoreboard_entries WHERE contest = ? AND event_type = ?`), contest, eventType); err != nil {
+			return err
+		}
+
+		stmt := d.db.rebind(`INSERT INTO scoreboard_entries (contest, event_type, act_id, jury_points, televote_points) VALUES (?, ?, ?, ?, ?)`)
+		for _, entry := range scoreboard.Entries {
+			if _, err := tx.ExecContext(ctx, stmt, contest, eventType, entry.ActID, entry.JuryPoints, entry.TelevotePoints); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Get retrieves the scoreboard of a show with its entries ordered by act ID.
+// Returns persistence.ErrNotFound if no scoreboard has been stored for the show.
+func (d *ScoreboardDAO) Get(ctx context.Context, contest string, eventType models.EventType) (*models.Scoreboard, error) {
+	var importedAt int64
+	err := d.db.db.QueryRowContext(ctx, d.db.rebind(`SELECT imported_at FROM scoreboards WHERE contest = ? AND event_type = ?`),
+		contest, string(eventType)).Scan(&importedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, persistence.ErrNotFound
+		}
+		return nil, err
+	}
+
+	rows, err := d.db.db.QueryContext(ctx, d.db.rebind(`SELECT act_id, jury_points, televote_points FROM scoreboard_entries
+		WHERE contest = ? AND event_type = ? ORDER BY act_id`), contest, string(eventType))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	scoreboard := &models.Scoreboard{
+		Contest:    contest,
+		EventType:  eventType,
+		Entries:    make([]models.ScoreboardEntry, 0),
+		ImportedAt: fromUnixMicro(importedAt),
+	}
+	for rows.Next() {
+		var entry models.ScoreboardEntry
+		if err := rows.Scan(&entry.ActID, &entry.JuryPoints, &entry.TelevotePoints); err != nil {
+			return nil, err
+		}
+		scoreboard.Entries = append(scoreboard.Entries, entry)
+	}
+	return scoreboard, rows.Err()
+}
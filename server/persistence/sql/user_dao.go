@@ -0,0 +1,73 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/persistence"
+)
+
+// UserDAO is the SQL implementation of persistence.UserDAO.
+type UserDAO struct {
+	db *DB
+}
+
+// NewUserDAO creates a new UserDAO.
+func NewUserDAO(db *DB) *UserDAO {
+	return &UserDAO{db: db}
+}
+
+// Upsert creates or updates a user.
+func (d *UserDAO) Upsert(ctx context.Context, user *models.User) error {
+	_, err := d.db.db.ExecContext(ctx, d.db.rebind(`INSERT INTO users (id, username, email) VALUES (?, ?, ?)
+		ON CONFLICT (id) DO UPDATE SET username = excluded.username, email = excluded.email`),
+		user.ID, user.Username, user.Email)
+	return err
+}
+
+// GetByID retrieves a user by its ID.
+// Returns persistence.ErrNotFound if the user does not exist.
+func (d *UserDAO) GetByID(ctx context.Context, id string) (*models.User, error) {
+	var user models.User
+	err := d.db.db.QueryRowContext(ctx, d.db.rebind(`SELECT id, username, email FROM users WHERE id = ?`), id).
+		Scan(&user.ID, &user.Username, &user.Email)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, persistence.ErrNotFound
+		}
+		return nil, err
+	}
+	return &user, nil
+}
+
+// ListByEmail retrieves all users with the given email address, ordered by ID.
+// Returns an empty slice if no users are found.
+func (d *UserDAO) ListByEmail(ctx context.Context, email string) ([]*models.User, error) {
+	return d.query(ctx, `WHERE email = ?`, email)
+}
+
+// ListByUsername retrieves all users with the given username, ordered by ID.
+// Returns an empty slice if no users are found.
+func (d *UserDAO) ListByUsername(ctx context.Context, username string) ([]*models.User, error) {
+	return d.query(ctx, `WHERE username = ?`, username)
+}
+
+func (d *UserDAO) query(ctx context.Context, where string, args ...any) ([]*models.User, error) {
+	rows, err := d.db.db.QueryContext(ctx, d.db.rebind(`SELECT id, username, email FROM users `+where+` ORDER BY id`), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	users := make([]*models.User, 0)
+	for rows.Next() {
+		var user models.User
+		if err := rows.Scan(&user.ID, &user.Username, &user.Email); err != nil {
+			return nil, err
+		}
+		users = append(users, &user)
+	}
+	return users, rows.Err()
+}
@@ -0,0 +1,200 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/persistence"
+)
+
+// VoteDAO is the SQL implementation of persistence.VoteDAO.
+// Ballots are normalised into one vote_points row per awarded point value and
+// one vote_ratings row per rated act.
+type VoteDAO struct {
+	db *DB
+}
+
+// NewVoteDAO creates a new VoteDAO.
+func NewVoteDAO(db *DB) *VoteDAO {
+	return &VoteDAO{db: db}
+}
+
+// Create stores a new vote and its points in a single transaction.
+// Returns persistence.ErrVoteExists if a vote with the same ID or for the same
+// guest and party already exists.
+func (d *VoteDAO) Create(ctx context.Context, vote *models.Vote) error {
+	return d.db.withTx(ctx, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, d.db.rebind(`INSERT INTO votes (id, guest_id, party_id, created_at, draft) VALUES (?, ?, ?, ?, ?)`),
+			vote.ID, vote.GuestID, vote.PartyID, toUnixMicro(vote.CreatedAt), vote.Draft)
+		if err != nil {
+			if isUniqueViolation(err) {
+				return persistence.ErrVoteExists
+			}
+			return err
+		}
+		return d.insertPoints(ctx, tx, vote)
+	})
+}
+
+// GetByGuestAndParty retrieves a vote by guest ID and party ID.
+// Returns persistence.ErrNotFound if no vote exists for the given guest and party.
+func (d *VoteDAO) GetByGuestAndParty(ctx context.Context, guestID, partyID string) (*models.Vote, error) {
+	votes, err := d.query(ctx, `WHERE v.guest_id = ? AND v.party_id = ?`, guestID, partyID)
+	if err != nil {
+		return nil, err
+	}
+	if len(votes) == 0 {
+		return nil, persistence.ErrNotFound
+	}
+	return votes[0], nil
+}
+
+// Update overwrites a vote and replaces its points, creating it if necessary.
+func (d *VoteDAO) Update(ctx context.Context, vote *models.Vote) error {
+	return d.db.withTx(ctx, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, d.db.rebind(`INSERT INTO votes (id, guest_id, party_id, created_at, draft) VALUES (?, ?, ?, ?, ?)
+			ON CONFLICT (id) DO UPDATE SET guest_id = excluded.guest_id, party_id = excluded.party_id, created_at = excluded.created_at, draft = excluded.draft`),
+			vote.ID, vote.GuestID, vote.PartyID, toUnixMicro(vote.CreatedAt), vote.Draft)
+		if err != nil {
+			return err
+		}
+		for _, stmt := range []string{`DELETE FROM vote_points WHERE vote_id = ?`, `DELETE FROM vote_ratings WHERE vote_id = ?`} {
+			if _, err := tx.ExecContext(ctx, d.db.rebind(stmt), vote.ID); err != nil {
+				return err
+			}
+		}
+		return d.insertPoints(ctx, tx, vote)
+	})
+}
+
+// UpdateDraft overwrites a vote and replaces its points as long as the stored
+// vote is still a draft.
+// Returns persistence.ErrNotFound if the vote does not exist and
+// persistence.ErrVoteExists if it has been finalized.
+func (d *VoteDAO) UpdateDraft(ctx context.Context, vote *models.Vote) error {
+	return d.db.withTx(ctx, func(tx *sql.Tx) error {
+		res, err := tx.ExecContext(ctx, d.db.rebind(`UPDATE votes SET guest_id = ?, party_id = ?, created_at = ?, draft = ? WHERE id = ? AND draft = ?`),
+			vote.GuestID, vote.PartyID, toUnixMicro(vote.CreatedAt), vote.Draft, vote.ID, true)
+		if err != nil {
+			return err
+		}
+		if err := requireAffected(res); err != nil {
+			var exists bool
+			if err := tx.QueryRowContext(ctx, d.db.rebind(`SELECT EXISTS (SELECT 1 FROM votes WHERE id = ?)`), vote.ID).Scan(&exists); err != nil {
+				return err
+			}
+			if exists {
+				return persistence.ErrVoteExists
+			}
+			return err
+		}
+
+		for _, stmt := range []string{`DELETE FROM vote_points WHERE vote_id = ?`, `DELETE FROM vote_ratings WHERE vote_id = ?`} {
+			if _, err := tx.ExecContext(ctx, d.db.rebind(stmt), vote.ID); err != nil {
+				return err
+			}
+		}
+		return d.insertPoints(ctx, tx, vote)
+	})
+}
+
+// ListByPartyID retrieves all votes for a given party, ordered by ID.
+func (d *VoteDAO) ListByPartyID(ctx context.Context, partyID string) ([]*models.Vote, error) {
+	return d.query(ctx, `WHERE v.party_id = ?`, partyID)
+}
+
+func (d *VoteDAO) insertPoints(ctx context.Context, tx *sql.Tx, vote *models.Vote) error {
+	stmt := d.db.rebind(`INSERT INTO vote_points (vote_id, points, act_id) VALUES (?, ?, ?)`)
+	for points, actID := range vote.Votes {
+		if _, err := tx.ExecContext(ctx, stmt, vote.ID, points, actID); err != nil {
+			return err
+		}
+	}
+	stmt = d.db.rebind(`INSERT INTO vote_ratings (vote_id, act_id, rating) VALUES (?, ?, ?)`)
+	for actID, rating := range vote.Ratings {
+		if _, err := tx.ExecContext(ctx, stmt, vote.ID, actID, rating); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// query loads votes matching the given WHERE clause together with their points and ratings.
+func (d *VoteDAO) query(ctx context.Context, where string, args ...any) ([]*models.Vote, error) {
+	rows, err := d.db.db.QueryContext(ctx, d.db.rebind(`SELECT v.id, v.guest_id, v.party_id, v.created_at, v.draft, p.points, p.act_id
+		FROM votes v LEFT JOIN vote_points p ON p.vote_id = v.id `+where+` ORDER BY v.id`), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	votes := make([]*models.Vote, 0)
+	var current *models.Vote
+	for rows.Next() {
+		var (
+			id, guestID, partyID string
+			createdAt            int64
+			draft                bool
+			points               sql.NullInt64
+			actID                sql.NullString
+		)
+		if err := rows.Scan(&id, &guestID, &partyID, &createdAt, &draft, &points, &actID); err != nil {
+			return nil, err
+		}
+		if current == nil || current.ID != id {
+			current = &models.Vote{
+				ID:        id,
+				GuestID:   guestID,
+				PartyID:   partyID,
+				Votes:     make(map[int]string),
+				Draft:     draft,
+				CreatedAt: fromUnixMicro(createdAt),
+			}
+			votes = append(votes, current)
+		}
+		if points.Valid {
+			current.Votes[int(points.Int64)] = actID.String
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if err := d.loadRatings(ctx, votes, where, args...); err != nil {
+		return nil, err
+	}
+	return votes, nil
+}
+
+// loadRatings attaches the ratings of the votes matching the given WHERE clause.
+func (d *VoteDAO) loadRatings(ctx context.Context, votes []*models.Vote, where string, args ...any) error {
+	rows, err := d.db.db.QueryContext(ctx, d.db.rebind(`SELECT r.vote_id, r.act_id, r.rating
+		FROM votes v JOIN vote_ratings r ON r.vote_id = v.id `+where), args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	byID := make(map[string]*models.Vote, len(votes))
+	for _, vote := range votes {
+		byID[vote.ID] = vote
+	}
+	for rows.Next() {
+		var (
+			voteID, actID string
+			rating        int
+		)
+		if err := rows.Scan(&voteID, &actID, &rating); err != nil {
+			return err
+		}
+		vote, ok := byID[voteID]
+		if !ok {
+			continue
+		}
+		if vote.Ratings == nil {
+			vote.Ratings = make(map[string]int)
+		}
+		vote.Ratings[actID] = rating
+	}
+	return rows.Err()
+}
@@ -14,6 +14,8 @@ import (
 type UserDAO interface {
 	Upsert(ctx context.Context, user *models.User) error
 	GetByID(ctx context.Context, id string) (*models.User, error)
+	ListByEmail(ctx context.Context, email string) ([]*models.User, error)
+	ListByUsername(ctx context.Context, username string) ([]*models.User, error)
 }
 
 // FirestoreUserDAO is the Firestore implementation of UserDAO.
@@ -52,3 +54,36 @@ func (d *FirestoreUserDAO) GetByID(ctx context.Context, id string) (*models.User
 
 	return &user, nil
 }
+
+// ListByEmail retrieves all users with the given email address.
+// Returns an empty slice if no users are found.
+func (d *FirestoreUserDAO) ListByEmail(ctx context.Context, email string) ([]*models.User, error) {
+	return d.listWhere(ctx, "email", email)
+}
+
+// ListByUsername retrieves all users with the given username.
+// Returns an empty slice if no users are found.
+func (d *FirestoreUserDAO) ListByUsername(ctx context.Context, username string) ([]*models.User, error) {
+	return d.listWhere(ctx, "username", username)
+}
+
+func (d *FirestoreUserDAO) listWhere(ctx context.Context, field, value string) ([]*models.User, error) {
+	iter := d.client.Collection(usersCollection).Where(field, "==", value).Documents(ctx)
+	defer iter.Stop()
+
+	docs, err := iter.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]*models.User, 0, len(docs))
+	for _, doc := range docs {
+		var user models.User
+		if err := doc.DataTo(&user); err != nil {
+			return nil, err
+		}
+		users = append(users, &user)
+	}
+
+	return users, nil
+}
@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"cloud.google.com/go/firestore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/sipgate/eurovision-vote-party/server/models"
 )
@@ -16,6 +18,7 @@ type VoteDAO interface {
 	Create(ctx context.Context, vote *models.Vote) error
 	GetByGuestAndParty(ctx context.Context, guestID, partyID string) (*models.Vote, error)
 	Update(ctx context.Context, vote *models.Vote) error
+	UpdateDraft(ctx context.Context, vote *models.Vote) error
 	ListByPartyID(ctx context.Context, partyID string) ([]*models.Vote, error)
 }
 
@@ -39,6 +42,8 @@ type firestoreVote struct {
 	GuestID   string            `firestore:"guestId"`
 	PartyID   string            `firestore:"partyId"`
 	Votes     map[string]string `firestore:"votes"`
+	Ratings   map[string]int    `firestore:"ratings,omitempty"`
+	Draft     bool              `firestore:"draft"`
 	CreatedAt time.Time         `firestore:"createdAt"`
 }
 
@@ -52,6 +57,8 @@ func toFirestoreVote(v *models.Vote) *firestoreVote {
 		GuestID:   v.GuestID,
 		PartyID:   v.PartyID,
 		Votes:     votes,
+		Ratings:   v.Ratings,
+		Draft:     v.Draft,
 		CreatedAt: v.CreatedAt,
 	}
 }
@@ -70,13 +77,21 @@ func fromFirestoreVote(fv *firestoreVote) (*models.Vote, error) {
 		GuestID:   fv.GuestID,
 		PartyID:   fv.PartyID,
 		Votes:     votes,
+		Ratings:   fv.Ratings,
+		Draft:     fv.Draft,
 		CreatedAt: fv.CreatedAt,
 	}, nil
 }
 
-// Create stores a new vote in Firestore.
+// Create stores a new vote in Firestore. The document is only written if no
+// document with the vote's ID exists yet, so callers using models.VoteIDFor
+// get at most one vote per guest and party.
+// Returns ErrVoteExists if a vote with the same ID already exists.
 func (d *FirestoreVoteDAO) Create(ctx context.Context, vote *models.Vote) error {
-	_, err := d.client.Collection(votesCollection).Doc(vote.ID).Set(ctx, toFirestoreVote(vote))
+	_, err := d.client.Collection(votesCollection).Doc(vote.ID).Create(ctx, toFirestoreVote(vote))
+	if status.Code(err) == codes.AlreadyExists {
+		return ErrVoteExists
+	}
 	return err
 }
 
@@ -105,6 +120,33 @@ func (d *FirestoreVoteDAO) Update(ctx context.Context, vote *models.Vote) error
 	return err
 }
 
+// UpdateDraft overwrites a vote in Firestore as long as the stored vote is
+// still a draft, in one transaction.
+// Returns ErrNotFound if the vote does not exist and ErrVoteExists if it has
+// been finalized.
+func (d *FirestoreVoteDAO) UpdateDraft(ctx context.Context, vote *models.Vote) error {
+	ref := d.client.Collection(votesCollection).Doc(vote.ID)
+	return d.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		doc, err := tx.Get(ref)
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return ErrNotFound
+			}
+			return err
+		}
+
+		var stored firestoreVote
+		if err := doc.DataTo(&stored); err != nil {
+			return err
+		}
+		if !stored.Draft {
+			return ErrVoteExists
+		}
+
+		return tx.Set(ref, toFirestoreVote(vote))
+	})
+}
+
 // ListByPartyID retrieves all votes for a given party.
 func (d *FirestoreVoteDAO) ListByPartyID(ctx context.Context, partyID string) ([]*models.Vote, error) {
 	iter := d.client.Collection(votesCollection).Where("partyId", "==", partyID).Documents(ctx)
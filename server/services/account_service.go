@@ -0,0 +1,246 @@
+package services
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"sync"
+	"time"
+
+	firebaseauth "firebase.google.com/go/v4/auth"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/persistence"
+)
+
+const (
+	// minPasswordLength is the shortest password accepted for a local account.
+	minPasswordLength = 8
+	// maxPasswordLength is the longest password bcrypt can hash, in bytes.
+	maxPasswordLength = 72
+)
+
+// AccountDAO defines the persistence operations needed by the account service.
+type AccountDAO interface {
+	Create(ctx context.Context, account *models.Account) error
+	GetByID(ctx context.Context, id string) (*models.Account, error)
+	GetByEmail(ctx context.Context, email string) (*models.Account, error)
+	UpdateSessionID(ctx context.Context, id, sessionID string) error
+	ReplaceSessionID(ctx context.Context, id, oldSessionID, newSessionID string) error
+}
+
+// AuthTokens are issued to a local account on sign-up, login and refresh.
+// The access token is sent as a bearer token with every request; the refresh
+// token is exchanged for new tokens before the access token expires.
+type AuthTokens struct {
+	AccessToken  string `json:"accessToken"`
+	RefreshToken string `json:"refreshToken"`
+	TokenType    string `json:"tokenType"`
+	// ExpiresIn is the lifetime of the access token in seconds.
+	ExpiresIn int `json:"expiresIn"`
+}
+
+// AccountService defines the business logic operations for local accounts.
+type AccountService interface {
+	Register(ctx context.Context, email, password string) (*AuthTokens, error)
+	Login(ctx context.Context, email, password string) (*AuthTokens, error)
+	Refresh(ctx context.Context, refreshToken string) (*AuthTokens, error)
+	Logout(ctx context.Context, refreshToken string) error
+	VerifyIDToken(ctx context.Context, idToken string) (*firebaseauth.Token, error)
+}
+
+// accountService is the default implementation.
+type accountService struct {
+	dao      AccountDAO
+	tokenKey []byte
+}
+
+// NewAccountService creates a new AccountService.
+// tokenKey signs the access and refresh tokens it issues.
+func NewAccountService(dao AccountDAO, tokenKey []byte) AccountService {
+	return &accountService{dao: dao, tokenKey: tokenKey}
+}
+
+// Register creates an account and signs it in.
+// Returns ErrInvalidEmail or ErrInvalidPassword for unacceptable credentials
+// and ErrEmailTaken if an account already uses the email address.
+func (s *accountService) Register(ctx context.Context, email, password string) (*AuthTokens, error) {
+	email = models.NormalizeEmail(email)
+	if err := models.ValidateEmail(email); err != nil {
+		return nil, ErrInvalidEmail
+	}
+	if len(password) < minPasswordLength || len(password) > maxPasswordLength {
+		return nil, ErrInvalidPassword
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+	sessionID, err := newSessionID()
+	if err != nil {
+		return nil, err
+	}
+
+	account := &models.Account{
+		ID:           uuid.New().String(),
+		Email:        email,
+		PasswordHash: string(hash),
+		CreatedAt:    time.Now(),
+		SessionID:    sessionID,
+	}
+	if err := account.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.dao.Create(ctx, account); err != nil {
+		if errors.Is(err, persistence.ErrEmailExists) {
+			return nil, ErrEmailTaken
+		}
+		return nil, err
+	}
+
+	return s.issueTokens(account)
+}
+
+// dummyPasswordHash is compared against when no account matches a login, so
+// that unknown addresses take as long to refuse as wrong passwords.
+var dummyPasswordHash = sync.OnceValue(func() []byte {
+	hash, _ := bcrypt.GenerateFromPassword([]byte("not a password"), bcrypt.DefaultCost)
+	return hash
+})
+
+// Login signs an account in with its email address and password.
+// Returns ErrInvalidCredentials if either does not match.
+func (s *accountService) Login(ctx context.Context, email, password string) (*AuthTokens, error) {
+	account, err := s.dao.GetByEmail(ctx, models.NormalizeEmail(email))
+	if err != nil {
+		if errors.Is(err, persistence.ErrNotFound) {
+			bcrypt.CompareHashAndPassword(dummyPasswordHash(), []byte(password))
+			return nil, ErrInvalidCredentials
+		}
+		return nil, err
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(account.PasswordHash), []byte(password)); err != nil {
+		return nil, ErrInvalidCredentials
+	}
+
+	return s.issueTokens(account)
+}
+
+// Refresh exchanges a refresh token for new tokens. The account gets a new
+// session, which revokes the exchanged token and every other refresh token
+// issued for the account, so a refresh token works only once.
+// Returns ErrInvalidAuthToken if the token is malformed, expired, revoked or
+// the account no longer exists.
+func (s *accountService) Refresh(ctx context.Context, refreshToken string) (*AuthTokens, error) {
+	account, err := s.accountForRefreshToken(ctx, refreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionID, err := newSessionID()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.dao.ReplaceSessionID(ctx, account.ID, account.SessionID, sessionID); err != nil {
+		if errors.Is(err, persistence.ErrNotFound) {
+			return nil, ErrInvalidAuthToken
+		}
+		return nil, err
+	}
+	account.SessionID = sessionID
+
+	return s.issueTokens(account)
+}
+
+// Logout revokes every refresh token issued for the account of the given
+// one, signing it out on all devices. Access tokens already issued stay
+// valid until they expire.
+// Returns ErrInvalidAuthToken under the same conditions as Refresh.
+func (s *accountService) Logout(ctx context.Context, refreshToken string) error {
+	account, err := s.accountForRefreshToken(ctx, refreshToken)
+	if err != nil {
+		return err
+	}
+
+	sessionID, err := newSessionID()
+	if err != nil {
+		return err
+	}
+	return s.dao.UpdateSessionID(ctx, account.ID, sessionID)
+}
+
+// VerifyIDToken verifies an access token issued by this service and returns
+// it in the shape of a Firebase ID token, so that the auth middleware can use
+// the service in place of Firebase. The account ID becomes the user ID.
+func (s *accountService) VerifyIDToken(_ context.Context, idToken string) (*firebaseauth.Token, error) {
+	claims, err := parseAccountToken(s.tokenKey, idToken, tokenUseAccess)
+	if err != nil {
+		return nil, ErrInvalidAuthToken
+	}
+
+	token := &firebaseauth.Token{
+		Issuer:  claims.Issuer,
+		Subject: claims.Subject,
+		UID:     claims.Subject,
+		Expires: claims.ExpiresAt.Unix(),
+		Claims:  map[string]interface{}{},
+	}
+	if claims.IssuedAt != nil {
+		token.IssuedAt = claims.IssuedAt.Unix()
+	}
+	if claims.Email != "" {
+		token.Claims["email"] = claims.Email
+	}
+	return token, nil
+}
+
+// accountForRefreshToken resolves a refresh token into the account it was
+// issued for, checking that its session has not been revoked.
+func (s *accountService) accountForRefreshToken(ctx context.Context, refreshToken string) (*models.Account, error) {
+	claims, err := parseAccountToken(s.tokenKey, refreshToken, tokenUseRefresh)
+	if err != nil {
+		return nil, ErrInvalidAuthToken
+	}
+
+	account, err := s.dao.GetByID(ctx, claims.Subject)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNotFound) {
+			return nil, ErrInvalidAuthToken
+		}
+		return nil, err
+	}
+
+	if subtle.ConstantTimeCompare([]byte(account.SessionID), []byte(claims.SessionID)) != 1 {
+		return nil, ErrInvalidAuthToken
+	}
+	return account, nil
+}
+
+// issueTokens signs a fresh access and refresh token for the account.
+func (s *accountService) issueTokens(account *models.Account) (*AuthTokens, error) {
+	access := accountTokenClaims{Use: tokenUseAccess, Email: account.Email}
+	access.Subject = account.ID
+	accessToken, err := signAccountToken(s.tokenKey, access, accessTokenTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	refresh := accountTokenClaims{Use: tokenUseRefresh, SessionID: account.SessionID}
+	refresh.Subject = account.ID
+	refreshToken, err := signAccountToken(s.tokenKey, refresh, refreshTokenTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuthTokens{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int(accessTokenTTL / time.Second),
+	}, nil
+}
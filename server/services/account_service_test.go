@@ -0,0 +1,228 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sipgate/eurovision-vote-party/server/persistence/memory"
+	"github.com/sipgate/eurovision-vote-party/server/services"
+)
+
+var accountTokenKey = []byte("account-token-key")
+
+func newAccountService() services.AccountService {
+	return services.NewAccountService(memory.NewAccountDAO(memory.NewStore()), accountTokenKey)
+}
+
+func TestAccountService_Register(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("issues tokens for the new account", func(t *testing.T) {
+		svc := newAccountService()
+
+		tokens, err := svc.Register(ctx, " Alice@Example.com ", "correct horse")
+		require.NoError(t, err)
+		assert.Equal(t, "Bearer", tokens.TokenType)
+		assert.Equal(t, 15*60, tokens.ExpiresIn)
+		assert.NotEmpty(t, tokens.RefreshToken)
+
+		token, err := svc.VerifyIDToken(ctx, tokens.AccessToken)
+		require.NoError(t, err)
+		assert.NotEmpty(t, token.UID)
+		assert.Equal(t, "alice@example.com", token.Claims["email"])
+	})
+
+	t.Run("rejects a taken email address regardless of case", func(t *testing.T) {
+		svc := newAccountService()
+		_, err := svc.Register(ctx, "alice@example.com", "correct horse")
+		require.NoError(t, err)
+
+		_, err = svc.Register(ctx, "ALICE@example.com", "battery staple")
+
+		assert.ErrorIs(t, err, services.ErrEmailTaken)
+	})
+
+	t.Run("rejects invalid email addresses", func(t *testing.T) {
+		_, err := newAccountService().Register(ctx, "alice", "correct horse")
+
+		assert.ErrorIs(t, err, services.ErrInvalidEmail)
+	})
+
+	t.Run("rejects passwords bcrypt cannot handle", func(t *testing.T) {
+		svc := newAccountService()
+
+		_, err := svc.Register(ctx, "alice@example.com", "short")
+		assert.ErrorIs(t, err, services.ErrInvalidPassword)
+
+		_, err = svc.Register(ctx, "alice@example.com", string(make([]byte, 73)))
+		assert.ErrorIs(t, err, services.ErrInvalidPassword)
+	})
+}
+
+func TestAccountService_Login(t *testing.T) {
+	ctx := context.Background()
+	svc := newAccountService()
+	registered, err := svc.Register(ctx, "alice@example.com", "correct horse")
+	require.NoError(t, err)
+	want, err := svc.VerifyIDToken(ctx, registered.AccessToken)
+	require.NoError(t, err)
+
+	t.Run("signs in with the right password", func(t *testing.T) {
+		tokens, err := svc.Login(ctx, "Alice@example.com", "correct horse")
+		require.NoError(t, err)
+
+		token, err := svc.VerifyIDToken(ctx, tokens.AccessToken)
+		require.NoError(t, err)
+		assert.Equal(t, want.UID, token.UID)
+	})
+
+	t.Run("refuses a wrong password", func(t *testing.T) {
+		_, err := svc.Login(ctx, "alice@example.com", "battery staple")
+
+		assert.ErrorIs(t, err, services.ErrInvalidCredentials)
+	})
+
+	t.Run("refuses an unknown email address", func(t *testing.T) {
+		_, err := svc.Login(ctx, "bob@example.com", "correct horse")
+
+		assert.ErrorIs(t, err, services.ErrInvalidCredentials)
+	})
+}
+
+func TestAccountService_RefreshAndLogout(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("refresh issues new tokens for the same account", func(t *testing.T) {
+		svc := newAccountService()
+		tokens, err := svc.Register(ctx, "alice@example.com", "correct horse")
+		require.NoError(t, err)
+		original, err := svc.VerifyIDToken(ctx, tokens.AccessToken)
+		require.NoError(t, err)
+
+		refreshed, err := svc.Refresh(ctx, tokens.RefreshToken)
+		require.NoError(t, err)
+
+		token, err := svc.VerifyIDToken(ctx, refreshed.AccessToken)
+		require.NoError(t, err)
+		assert.Equal(t, original.UID, token.UID)
+	})
+
+	t.Run("refresh revokes the exchanged refresh token", func(t *testing.T) {
+		svc := newAccountService()
+		tokens, err := svc.Register(ctx, "alice@example.com", "correct horse")
+		require.NoError(t, err)
+
+		refreshed, err := svc.Refresh(ctx, tokens.RefreshToken)
+		require.NoError(t, err)
+
+		_, err = svc.Refresh(ctx, tokens.RefreshToken)
+		assert.ErrorIs(t, err, services.ErrInvalidAuthToken)
+		_, err = svc.Refresh(ctx, refreshed.RefreshToken)
+		assert.NoError(t, err)
+	})
+
+	t.Run("access and refresh tokens cannot stand in for each other", func(t *testing.T) {
+		svc := newAccountService()
+		tokens, err := svc.Register(ctx, "alice@example.com", "correct horse")
+		require.NoError(t, err)
+
+		_, err = svc.Refresh(ctx, tokens.AccessToken)
+		assert.ErrorIs(t, err, services.ErrInvalidAuthToken)
+
+		_, err = svc.VerifyIDToken(ctx, tokens.RefreshToken)
+		assert.ErrorIs(t, err, services.ErrInvalidAuthToken)
+	})
+
+	t.Run("logout revokes every refresh token of the account", func(t *testing.T) {
+		svc := newAccountService()
+		first, err := svc.Register(ctx, "alice@example.com", "correct horse")
+		require.NoError(t, err)
+		second, err := svc.Login(ctx, "alice@example.com", "correct horse")
+		require.NoError(t, err)
+
+		require.NoError(t, svc.Logout(ctx, first.RefreshToken))
+
+		_, err = svc.Refresh(ctx, first.RefreshToken)
+		assert.ErrorIs(t, err, services.ErrInvalidAuthToken)
+		_, err = svc.Refresh(ctx, second.RefreshToken)
+		assert.ErrorIs(t, err, services.ErrInvalidAuthToken)
+
+		_, err = svc.Login(ctx, "alice@example.com", "correct horse")
+		assert.NoError(t, err, "the account can sign in again")
+	})
+
+	t.Run("tokens signed with another key are refused", func(t *testing.T) {
+		tokens, err := newAccountService().Register(ctx, "alice@example.com", "correct horse")
+		require.NoError(t, err)
+		other := services.NewAccountService(memory.NewAccountDAO(memory.NewStore()), []byte("other-key"))
+
+		_, err = other.VerifyIDToken(ctx, tokens.AccessToken)
+		assert.ErrorIs(t, err, services.ErrInvalidAuthToken)
+		_, err = other.Refresh(ctx, tokens.RefreshToken)
+		assert.ErrorIs(t, err, services.ErrInvalidAuthToken)
+	})
+}
+
+func TestAccountService_VerifyIDToken(t *testing.T) {
+	ctx := context.Background()
+	svc := newAccountService()
+
+	sign := func(t *testing.T, method jwt.SigningMethod, key any, claims jwt.MapClaims) string {
+		t.Helper()
+		token, err := jwt.NewWithClaims(method, claims).SignedString(key)
+		require.NoError(t, err)
+		return token
+	}
+	validClaims := func() jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss": "eurovision-vote-party",
+			"sub": "account-1",
+			"use": "access",
+			"iat": time.Now().Unix(),
+			"exp": time.Now().Add(time.Minute).Unix(),
+		}
+	}
+
+	t.Run("accepts a valid access token", func(t *testing.T) {
+		token, err := svc.VerifyIDToken(ctx, sign(t, jwt.SigningMethodHS256, accountTokenKey, validClaims()))
+
+		require.NoError(t, err)
+		assert.Equal(t, "account-1", token.UID)
+		assert.NotContains(t, token.Claims, "email")
+	})
+
+	t.Run("refuses expired tokens", func(t *testing.T) {
+		claims := validClaims()
+		claims["exp"] = time.Now().Add(-time.Minute).Unix()
+
+		_, err := svc.VerifyIDToken(ctx, sign(t, jwt.SigningMethodHS256, accountTokenKey, claims))
+
+		assert.ErrorIs(t, err, services.ErrInvalidAuthToken)
+	})
+
+	t.Run("refuses tokens from another issuer", func(t *testing.T) {
+		claims := validClaims()
+		claims["iss"] = "someone-else"
+
+		_, err := svc.VerifyIDToken(ctx, sign(t, jwt.SigningMethodHS256, accountTokenKey, claims))
+
+		assert.ErrorIs(t, err, services.ErrInvalidAuthToken)
+	})
+
+	t.Run("refuses unsigned tokens", func(t *testing.T) {
+		_, err := svc.VerifyIDToken(ctx, sign(t, jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, validClaims()))
+
+		assert.ErrorIs(t, err, services.ErrInvalidAuthToken)
+	})
+
+	t.Run("refuses garbage", func(t *testing.T) {
+		_, err := svc.VerifyIDToken(ctx, "not-a-token")
+
+		assert.ErrorIs(t, err, services.ErrInvalidAuthToken)
+	})
+}
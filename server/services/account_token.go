@@ -0,0 +1,71 @@
+package services
+
+import (
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+const (
+	// accountTokenIssuer is the iss claim of every token issued for a local account.
+	accountTokenIssuer = "eurovision-vote-party"
+
+	// accessTokenTTL is how long an access token is accepted. Access tokens
+	// are not checked against the account, so this bounds how long a token
+	// outlives a logout.
+	accessTokenTTL = 15 * time.Minute
+	// refreshTokenTTL is how long a refresh token may be exchanged for new tokens.
+	refreshTokenTTL = 30 * 24 * time.Hour
+)
+
+// tokenUse tells access and refresh tokens apart, so that neither can stand
+// in for the other.
+type tokenUse string
+
+const (
+	tokenUseAccess  tokenUse = "access"
+	tokenUseRefresh tokenUse = "refresh"
+)
+
+// errMalformedAccountToken is returned when a token cannot be decoded, its
+// signature does not match or it has expired.
+var errMalformedAccountToken = errors.New("malformed account token")
+
+// accountTokenClaims is the payload of the JWTs issued for local accounts.
+// Refresh tokens carry the account's session ID instead of its email.
+type accountTokenClaims struct {
+	jwt.RegisteredClaims
+	Use       tokenUse `json:"use"`
+	Email     string   `json:"email,omitempty"`
+	SessionID string   `json:"sid,omitempty"`
+}
+
+// signAccountToken issues an HS256 JWT of the given use for the account,
+// valid for ttl.
+func signAccountToken(key []byte, claims accountTokenClaims, ttl time.Duration) (string, error) {
+	now := time.Now()
+	claims.Issuer = accountTokenIssuer
+	claims.IssuedAt = jwt.NewNumericDate(now)
+	claims.ExpiresAt = jwt.NewNumericDate(now.Add(ttl))
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(key)
+}
+
+// parseAccountToken verifies the signature, expiry and use of the token and
+// returns its claims.
+func parseAccountToken(key []byte, token string, use tokenUse) (*accountTokenClaims, error) {
+	var claims accountTokenClaims
+	_, err := jwt.ParseWithClaims(token, &claims, func(*jwt.Token) (any, error) {
+		return key, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
+	if err != nil {
+		return nil, errMalformedAccountToken
+	}
+	if claims.Issuer != accountTokenIssuer || claims.Use != use || claims.Subject == "" || claims.ExpiresAt == nil {
+		return nil, errMalformedAccountToken
+	}
+	if use == tokenUseRefresh && claims.SessionID == "" {
+		return nil, errMalformedAccountToken
+	}
+	return &claims, nil
+}
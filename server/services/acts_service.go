@@ -1,59 +1,700 @@
 package services
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"maps"
 	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/persistence"
 )
 
-// ActsService provides access to Eurovision acts.
+// ActDAO defines the persistence operations needed by the acts service.
+type ActDAO interface {
+	Create(ctx context.Context, act *models.Act) error
+	ListByContest(ctx context.Context, contest string) ([]*models.Act, error)
+	Update(ctx context.Context, act *models.Act) error
+	Delete(ctx context.Context, contest, id string) error
+	ReplaceContest(ctx context.Context, contest string, acts []*models.Act) error
+}
+
+// ReorderActsRequest sets the running order of one show of a contest edition.
+type ReorderActsRequest struct {
+	Contest   string
+	EventType models.EventType
+	// ActIDs lists every act of the show in its new running order.
+	ActIDs []string
+}
+
+// RecordQualifiersRequest records which acts of a semifinal advanced to the grand final.
+type RecordQualifiersRequest struct {
+	Contest   string
+	EventType models.EventType
+	// ActIDs lists the qualified acts; every other act of the semifinal is eliminated.
+	ActIDs []string
+}
+
+// ActsService provides access to the acts of every known contest edition and
+// lets catalogue editors maintain them.
 type ActsService interface {
-	ListActs(eventType string) ([]models.Act, error)
+	ListContests() []models.Contest
+	ListActs(ctx context.Context, contest, eventType string) ([]models.Act, error)
+	CreateAct(ctx context.Context, userID string, act models.Act) (*models.Act, error)
+	UpdateAct(ctx context.Context, userID string, act models.Act) (*models.Act, error)
+	DeleteAct(ctx context.Context, userID, contest, actID string) error
+	ReorderActs(ctx context.Context, userID string, req ReorderActsRequest) ([]models.Act, error)
+	RecordQualifiers(ctx context.Context, userID string, req RecordQualifiersRequest) ([]models.Act, error)
+	Reload(ctx context.Context) error
 }
 
 type actsService struct {
-	acts []models.Act
+	dao     ActDAO
+	path    string
+	editors map[string]bool
+
+	// mu guards the catalogue metadata and serialises writes to the store.
+	mu       sync.RWMutex
+	contests map[string]models.Contest
+	files    map[string]catalogueFile
+	// loaded holds the acts each edition's file had when it was last read,
+	// which tells Reload whether the stored acts were edited since.
+	loaded map[string][]models.Act
+}
+
+// catalogueFile records the state of a loaded catalogue file so that Reload
+// only re-imports files that changed.
+type catalogueFile struct {
+	modTime time.Time
+	size    int64
+	contest string
 }
 
-// actsFileWrapper represents the JSON structure of the acts file.
+// actsFileWrapper represents the JSON structure of an acts file.
 type actsFileWrapper struct {
-	Acts []models.Act `json:"acts"`
+	Contest *models.Contest `json:"contest"`
+	Acts    []models.Act    `json:"acts"`
+}
+
+// NewActsService loads the contest catalogue and returns an ActsService that
+// keeps the acts in dao.
+//
+// path is either a directory holding one JSON file per contest edition or a
+// single JSON file. Each file has the structure {"contest": {...}, "acts": [...]}.
+// A file without a contest describes the edition named after the file in a
+// directory, or models.DefaultContest when loaded on its own; its events are
+// taken from its acts.
+//
+// The files define which contest editions exist. Their acts seed the store for
+// editions that have no stored acts yet; afterwards the store is authoritative.
+// Reload re-imports changed files only for editions whose stored acts were not
+// edited.
+//
+// editors lists the IDs of the users allowed to change the catalogue.
+func NewActsService(ctx context.Context, dao ActDAO, path string, editors []string) (ActsService, error) {
+	s := &actsService{
+		dao:      dao,
+		path:     path,
+		editors:  make(map[string]bool, len(editors)),
+		contests: make(map[string]models.Contest),
+		files:    make(map[string]catalogueFile),
+		loaded:   make(map[string][]models.Act),
+	}
+	for _, id := range editors {
+		s.editors[id] = true
+	}
+
+	if err := s.sync(ctx, true); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Reload re-reads the catalogue files. The stored acts of every edition whose
+// file changed since it was last loaded are replaced by the file's acts, and
+// editions whose file was removed are no longer listed.
+//
+// Editions whose stored acts differ from what their file held when it was
+// last read have been edited through the API. Reload keeps their stored acts
+// and contest details rather than discard the edits, and reports each of them
+// with ErrCatalogueEdited once the rest of the catalogue is reloaded.
+func (s *actsService) Reload(ctx context.Context) error {
+	return s.sync(ctx, false)
+}
+
+// sync reads the catalogue files that changed since the last call. On the
+// initial load it only imports acts for editions without stored acts.
+func (s *actsService) sync(ctx context.Context, initial bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	paths, isDir, err := catalogueFiles(s.path)
+	if err != nil {
+		return err
+	}
+
+	contests := make(map[string]models.Contest, len(paths))
+	files := make(map[string]catalogueFile, len(paths))
+	changed := make(map[string][]*models.Act)
+	for _, path := range paths {
+		stat, err := os.Stat(path)
+		if err != nil {
+			return fmt.Errorf("reading acts file: %w", err)
+		}
+
+		file := catalogueFile{modTime: stat.ModTime(), size: stat.Size()}
+		if prev, ok := s.files[path]; ok && prev.modTime.Equal(file.modTime) && prev.size == file.size {
+			if _, dup := contests[prev.contest]; dup {
+				return fmt.Errorf("acts file %s: duplicate contest %q", path, prev.contest)
+			}
+			contests[prev.contest] = s.contests[prev.contest]
+			files[path] = prev
+			continue
+		}
+
+		contest, acts, err := readActsFile(path, defaultContestID(path, isDir))
+		if err != nil {
+			return err
+		}
+		if _, dup := contests[contest.ID]; dup {
+			return fmt.Errorf("acts file %s: duplicate contest %q", path, contest.ID)
+		}
+
+		contests[contest.ID] = contest
+		file.contest = contest.ID
+		files[path] = file
+		changed[contest.ID] = acts
+	}
+
+	loaded := make(map[string][]models.Act, len(contests))
+	for id := range contests {
+		if acts, ok := s.loaded[id]; ok {
+			loaded[id] = acts
+		}
+	}
+
+	var edited []error
+	for _, id := range slices.Sorted(maps.Keys(changed)) {
+		acts := changed[id]
+		stored, err := s.dao.ListByContest(ctx, id)
+		if err != nil {
+			return err
+		}
+		prev, known := loaded[id]
+		loaded[id] = actValues(acts)
+
+		if len(stored) == 0 || (!initial && known && sameActs(stored, prev)) {
+			if err := s.dao.ReplaceContest(ctx, id, acts); err != nil {
+				return fmt.Errorf("storing acts of contest %q: %w", id, err)
+			}
+			continue
+		}
+		if initial || sameActs(stored, loaded[id]) {
+			continue
+		}
+
+		// The stored acts were edited: keep them along with the contest
+		// details they were validated against.
+		if known {
+			loaded[id] = prev
+		} else {
+			delete(loaded, id)
+		}
+		if c, ok := s.contests[id]; ok {
+			contests[id] = c
+		}
+		edited = append(edited, fmt.Errorf("contest %q: %w", id, ErrCatalogueEdited))
+	}
+
+	s.contests = contests
+	s.files = files
+	s.loaded = loaded
+	return errors.Join(edited...)
+}
+
+// actValues copies acts sorted by ID, for comparing them with sameActs.
+func actValues(acts []*models.Act) []models.Act {
+	values := make([]models.Act, 0, len(acts))
+	for _, act := range acts {
+		values = append(values, *act)
+	}
+	slices.SortFunc(values, func(a, b models.Act) int { return strings.Compare(a.ID, b.ID) })
+	return values
+}
+
+// sameActs reports whether stored holds exactly the acts in loaded, which
+// must be sorted by ID.
+func sameActs(stored []*models.Act, loaded []models.Act) bool {
+	return slices.Equal(actValues(stored), loaded)
+}
+
+// catalogueFiles lists the catalogue files at path, which is either a single
+// file or a directory of per-edition JSON files, and reports which it is.
+func catalogueFiles(path string) ([]string, bool, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, false, fmt.Errorf("reading acts file: %w", err)
+	}
+	if !info.IsDir() {
+		return []string{path}, false, nil
+	}
+
+	paths, err := filepath.Glob(filepath.Join(path, "*.json"))
+	if err != nil {
+		return nil, false, fmt.Errorf("listing acts files: %w", err)
+	}
+	return paths, true, nil
+}
+
+// defaultContestID returns the contest ID of a catalogue file without a
+// contest header: its base name when it is part of a directory, otherwise
+// models.DefaultContest.
+func defaultContestID(path string, inDir bool) string {
+	if !inDir {
+		return models.DefaultContest
+	}
+	return strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+}
+
+// CatalogueReport summarizes one file of the acts catalogue.
+type CatalogueReport struct {
+	File    string         `json:"file"`
+	Contest models.Contest `json:"contest"`
+	Acts    int            `json:"acts"`
+	// Problems lists everything that is wrong with the file; it is empty for valid files.
+	Problems []string `json:"problems,omitempty"`
+}
+
+// ValidateCatalogue checks the catalogue files at path without storing their
+// acts. Each act is validated as if an editor created it. Problems with a file
+// are reported rather than returned, so that every file is checked; the error
+// is only set when path cannot be read.
+func ValidateCatalogue(path string) ([]CatalogueReport, error) {
+	paths, isDir, err := catalogueFiles(path)
+	if err != nil {
+		return nil, err
+	}
+
+	reports := make([]CatalogueReport, 0, len(paths))
+	files := make(map[string]string, len(paths))
+	for _, file := range paths {
+		report := CatalogueReport{File: file}
+		contest, acts, err := readActsFile(file, defaultContestID(file, isDir))
+		if err != nil {
+			report.Problems = append(report.Problems, err.Error())
+			reports = append(reports, report)
+			continue
+		}
+		report.Contest = contest
+		report.Acts = len(acts)
+
+		if other, dup := files[contest.ID]; dup {
+			report.Problems = append(report.Problems, fmt.Sprintf("duplicate contest %q, also defined in %s", contest.ID, other))
+		}
+		files[contest.ID] = file
+
+		ids := make(map[string]bool, len(acts))
+		for _, act := range acts {
+			if ids[act.ID] {
+				report.Problems = append(report.Problems, fmt.Sprintf("duplicate act %q", act.ID))
+			}
+			ids[act.ID] = true
+			if err := validateAct(contest, *act); err != nil {
+				report.Problems = append(report.Problems, fmt.Sprintf("act %q: %v", act.ID, err))
+			}
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
 }
 
-// NewActsService loads acts from a JSON file and returns an ActsService.
-// The JSON file must have the structure: {"acts": [...]}
-func NewActsService(filePath string) (ActsService, error) {
-	data, err := os.ReadFile(filePath)
+// readActsFile reads one contest edition from file.
+func readActsFile(file, defaultID string) (models.Contest, []*models.Act, error) {
+	data, err := os.ReadFile(file)
 	if err != nil {
-		return nil, fmt.Errorf("reading acts file: %w", err)
+		return models.Contest{}, nil, fmt.Errorf("reading acts file: %w", err)
 	}
 
 	var wrapper actsFileWrapper
 	if err := json.Unmarshal(data, &wrapper); err != nil {
-		return nil, fmt.Errorf("parsing acts file: %w", err)
+		return models.Contest{}, nil, fmt.Errorf("parsing acts file %s: %w", file, err)
+	}
+
+	contest := models.Contest{ID: defaultID}
+	if wrapper.Contest != nil {
+		contest = *wrapper.Contest
+	}
+	if contest.Name == "" {
+		contest.Name = contest.ID
+	}
+	if len(contest.Events) == 0 {
+		for _, act := range wrapper.Acts {
+			if !contest.HasEvent(act.EventType) {
+				contest.Events = append(contest.Events, act.EventType)
+			}
+		}
+		for _, act := range wrapper.Acts {
+			if act.Qualified() && !contest.HasEvent(models.EventGrandFinal) {
+				contest.Events = append(contest.Events, models.EventGrandFinal)
+			}
+		}
+	}
+	if contest.Year == 0 {
+		contest.Year = contestYear(contest.ID)
+	}
+	if err := contest.Validate(); err != nil {
+		return models.Contest{}, nil, fmt.Errorf("acts file %s: %w", file, err)
+	}
+
+	acts := make([]*models.Act, 0, len(wrapper.Acts))
+	for _, act := range wrapper.Acts {
+		if act.Contest != "" && act.Contest != contest.ID {
+			return models.Contest{}, nil, fmt.Errorf("acts file %s: act %q belongs to contest %q", file, act.ID, act.Contest)
+		}
+		act.Contest = contest.ID
+		if !contest.HasEvent(act.EventType) {
+			return models.Contest{}, nil, fmt.Errorf("acts file %s: act %q performs in event %q outside the contest", file, act.ID, act.EventType)
+		}
+		acts = append(acts, &act)
+	}
+
+	return contest, acts, nil
+}
+
+// contestYear extracts the year from contest IDs ending in a four-digit year, such as "esc-2025".
+func contestYear(id string) int {
+	i := strings.LastIndex(id, "-")
+	if i < 0 || len(id)-i-1 != 4 {
+		return 0
+	}
+	year, err := strconv.Atoi(id[i+1:])
+	if err != nil {
+		return 0
 	}
+	return year
+}
+
+// ListContests returns the known contest editions, most recent first.
+func (s *actsService) ListContests() []models.Contest {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 
-	return &actsService{acts: wrapper.Acts}, nil
+	contests := make([]models.Contest, 0, len(s.contests))
+	for _, contest := range s.contests {
+		contests = append(contests, contest)
+	}
+	sort.Slice(contests, func(i, j int) bool {
+		if contests[i].Year != contests[j].Year {
+			return contests[i].Year > contests[j].Year
+		}
+		return contests[i].ID < contests[j].ID
+	})
+	return contests
 }
 
-func (s *actsService) ListActs(eventType string) ([]models.Act, error) {
-	if eventType == "" {
-		return s.acts, nil
+// ListActs returns the acts of a contest edition show by show in running
+// order, optionally filtered by event type. The grand final lineup includes
+// the semifinal acts that qualified for it. An empty contest selects
+// models.DefaultContest.
+// Returns ErrUnknownContest for contests that are not in the catalogue.
+func (s *actsService) ListActs(ctx context.Context, contest, eventType string) ([]models.Act, error) {
+	if contest == "" {
+		contest = models.DefaultContest
+	}
+	s.mu.RLock()
+	c, ok := s.contests[contest]
+	s.mu.RUnlock()
+	if !ok {
+		return nil, ErrUnknownContest
 	}
 
 	et := models.EventType(eventType)
-	if !et.IsValid() {
+	if eventType != "" && !et.IsValid() {
 		return nil, ErrInvalidEventType
 	}
 
-	result := make([]models.Act, 0)
-	for _, act := range s.acts {
-		if act.EventType == et {
-			result = append(result, act)
-		}
+	stored, err := s.dao.ListByContest(ctx, c.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if eventType != "" {
+		return showLineup(stored, et), nil
 	}
 
+	result := make([]models.Act, 0, len(stored))
+	for _, event := range c.Events {
+		result = append(result, showLineup(stored, event)...)
+	}
 	return result, nil
 }
+
+// showLineup returns the acts performing in a show in running order. The grand
+// final combines the acts that qualified for it directly with the qualified
+// semifinal acts, which take their final running order; finalists without a
+// running order yet come last.
+func showLineup(acts []*models.Act, event models.EventType) []models.Act {
+	lineup := make([]models.Act, 0)
+	for _, act := range acts {
+		switch {
+		case act.EventType == event:
+			lineup = append(lineup, *act)
+		case event == models.EventGrandFinal && act.Qualified():
+			finalist := *act
+			finalist.EventType = models.EventGrandFinal
+			finalist.RunningOrder = act.FinalRunningOrder
+			lineup = append(lineup, finalist)
+		}
+	}
+
+	sort.SliceStable(lineup, func(i, j int) bool {
+		a, b := lineup[i].RunningOrder, lineup[j].RunningOrder
+		if (a == 0) != (b == 0) {
+			return b == 0
+		}
+		if a != b {
+			return a < b
+		}
+		return lineup[i].ID < lineup[j].ID
+	})
+	return lineup
+}
+
+// CreateAct adds an act to a contest edition. An empty contest selects models.DefaultContest.
+// Returns ErrUnauthorized unless userID is a catalogue editor and ErrActExists
+// if the edition already has an act with the same ID.
+func (s *actsService) CreateAct(ctx context.Context, userID string, act models.Act) (*models.Act, error) {
+	if !s.editors[userID] {
+		return nil, ErrUnauthorized
+	}
+	if act.Contest == "" {
+		act.Contest = models.DefaultContest
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.checkAct(act); err != nil {
+		return nil, err
+	}
+	if err := s.dao.Create(ctx, &act); err != nil {
+		if errors.Is(err, persistence.ErrActExists) {
+			return nil, ErrActExists
+		}
+		return nil, err
+	}
+	return &act, nil
+}
+
+// UpdateAct replaces the details of an existing act.
+// Returns ErrUnauthorized unless userID is a catalogue editor and ErrActNotFound
+// if the act does not exist.
+func (s *actsService) UpdateAct(ctx context.Context, userID string, act models.Act) (*models.Act, error) {
+	if !s.editors[userID] {
+		return nil, ErrUnauthorized
+	}
+	if act.Contest == "" {
+		act.Contest = models.DefaultContest
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.checkAct(act); err != nil {
+		return nil, err
+	}
+	if err := s.dao.Update(ctx, &act); err != nil {
+		if errors.Is(err, persistence.ErrNotFound) {
+			return nil, ErrActNotFound
+		}
+		return nil, err
+	}
+	return &act, nil
+}
+
+// DeleteAct removes an act from a contest edition.
+// Returns ErrUnauthorized unless userID is a catalogue editor and ErrActNotFound
+// if the act does not exist.
+func (s *actsService) DeleteAct(ctx context.Context, userID, contest, actID string) error {
+	if !s.editors[userID] {
+		return ErrUnauthorized
+	}
+	if contest == "" {
+		contest = models.DefaultContest
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.contests[contest]; !ok {
+		return ErrUnknownContest
+	}
+	if err := s.dao.Delete(ctx, contest, actID); err != nil {
+		if errors.Is(err, persistence.ErrNotFound) {
+			return ErrActNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// ReorderActs sets the running order of a show to the order of req.ActIDs,
+// which must list every act of the show exactly once. For the grand final this
+// includes the qualified semifinal acts. It returns the show's acts in their
+// new order.
+// Returns ErrUnauthorized unless userID is a catalogue editor.
+func (s *actsService) ReorderActs(ctx context.Context, userID string, req ReorderActsRequest) ([]models.Act, error) {
+	if !s.editors[userID] {
+		return nil, ErrUnauthorized
+	}
+	if req.Contest == "" {
+		req.Contest = models.DefaultContest
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	contest, ok := s.contests[req.Contest]
+	if !ok {
+		return nil, ErrUnknownContest
+	}
+	if !contest.HasEvent(req.EventType) {
+		return nil, ErrInvalidEventType
+	}
+
+	stored, err := s.dao.ListByContest(ctx, contest.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := actPositions(req.ActIDs)
+	if err != nil {
+		return nil, err
+	}
+	lineup := showLineup(stored, req.EventType)
+	for _, act := range lineup {
+		if _, ok := order[act.ID]; !ok {
+			return nil, fmt.Errorf("%w: act %q missing from running order", ErrInvalidAct, act.ID)
+		}
+	}
+	if len(lineup) != len(order) {
+		return nil, fmt.Errorf("%w: running order lists acts outside the show", ErrInvalidAct)
+	}
+
+	for _, act := range stored {
+		switch {
+		case act.EventType == req.EventType:
+			act.RunningOrder = order[act.ID]
+		case req.EventType == models.EventGrandFinal && act.Qualified():
+			act.FinalRunningOrder = order[act.ID]
+		}
+	}
+
+	if err := s.dao.ReplaceContest(ctx, contest.ID, stored); err != nil {
+		return nil, err
+	}
+	return showLineup(stored, req.EventType), nil
+}
+
+// RecordQualifiers marks the acts in req.ActIDs as qualified for the grand
+// final and every other act of the semifinal as eliminated. Acts that lose
+// their qualification also lose their final running order. It returns the
+// updated grand final lineup.
+// Returns ErrUnauthorized unless userID is a catalogue editor.
+func (s *actsService) RecordQualifiers(ctx context.Context, userID string, req RecordQualifiersRequest) ([]models.Act, error) {
+	if !s.editors[userID] {
+		return nil, ErrUnauthorized
+	}
+	if req.Contest == "" {
+		req.Contest = models.DefaultContest
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	contest, ok := s.contests[req.Contest]
+	if !ok {
+		return nil, ErrUnknownContest
+	}
+	if req.EventType == models.EventGrandFinal || !contest.HasEvent(req.EventType) || !contest.HasEvent(models.EventGrandFinal) {
+		return nil, ErrInvalidEventType
+	}
+
+	stored, err := s.dao.ListByContest(ctx, contest.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	qualifiers, err := actPositions(req.ActIDs)
+	if err != nil {
+		return nil, err
+	}
+	matched := 0
+	for _, act := range stored {
+		if act.EventType != req.EventType {
+			continue
+		}
+		if _, ok := qualifiers[act.ID]; ok {
+			act.Qualification = models.QualificationQualified
+			matched++
+			continue
+		}
+		act.Qualification = models.QualificationEliminated
+		act.FinalRunningOrder = 0
+	}
+	if matched != len(qualifiers) {
+		return nil, fmt.Errorf("%w: qualifiers include acts outside the show", ErrInvalidAct)
+	}
+
+	if err := s.dao.ReplaceContest(ctx, contest.ID, stored); err != nil {
+		return nil, err
+	}
+	return showLineup(stored, models.EventGrandFinal), nil
+}
+
+// actPositions maps each act ID to its 1-based position in ids.
+func actPositions(ids []string) (map[string]int, error) {
+	positions := make(map[string]int, len(ids))
+	for i, id := range ids {
+		if _, dup := positions[id]; dup {
+			return nil, fmt.Errorf("%w: act %q listed twice", ErrInvalidAct, id)
+		}
+		positions[id] = i + 1
+	}
+	return positions, nil
+}
+
+// checkAct validates an act against its contest edition. Callers must hold s.mu.
+func (s *actsService) checkAct(act models.Act) error {
+	contest, ok := s.contests[act.Contest]
+	if !ok {
+		return ErrUnknownContest
+	}
+	return validateAct(contest, act)
+}
+
+// validateAct validates an act against the given contest edition.
+func validateAct(contest models.Contest, act models.Act) error {
+	if strings.TrimSpace(act.ID) == "" || strings.Contains(act.ID, "/") {
+		return fmt.Errorf("%w: act id %q is invalid", ErrInvalidAct, act.ID)
+	}
+	if err := act.Validate(); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidAct, err)
+	}
+	if !contest.HasEvent(act.EventType) {
+		return fmt.Errorf("%w: event %q is not part of contest %q", ErrInvalidAct, act.EventType, contest.ID)
+	}
+	if act.Qualified() && !contest.HasEvent(models.EventGrandFinal) {
+		return fmt.Errorf("%w: contest %q has no grand final to qualify for", ErrInvalidAct, contest.ID)
+	}
+	return nil
+}
@@ -1,18 +1,30 @@
 package services_test
 
 import (
+	"context"
 	"encoding/json"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/persistence/memory"
 	"github.com/sipgate/eurovision-vote-party/server/services"
 )
 
+// catalogueEditor is the only user allowed to change the catalogue in these tests.
+const catalogueEditor = "editor-1"
+
+// newActsService loads the catalogue at path into an empty in-memory store.
+func newActsService(path string) (services.ActsService, error) {
+	return services.NewActsService(context.Background(), memory.NewActDAO(memory.NewStore()), path, []string{catalogueEditor})
+}
+
 // createTestActsFile creates a temporary JSON file containing the given acts
 // wrapped in an {"acts": [...]} structure. It returns the path to the file.
 func createTestActsFile(t *testing.T, acts []models.Act) string {
@@ -57,18 +69,18 @@ func TestNewActsService_LoadsValidFile(t *testing.T) {
 
 	filePath := createTestActsFile(t, acts)
 
-	svc, err := services.NewActsService(filePath)
+	svc, err := newActsService(filePath)
 
 	require.NoError(t, err)
 	require.NotNil(t, svc)
 
-	result, err := svc.ListActs("")
+	result, err := svc.ListActs(context.Background(), "", "")
 	require.NoError(t, err)
 	assert.Len(t, result, 2)
 }
 
 func TestNewActsService_ErrorsOnMissingFile(t *testing.T) {
-	svc, err := services.NewActsService("/nonexistent/path/acts.json")
+	svc, err := newActsService("/nonexistent/path/acts.json")
 
 	assert.Error(t, err)
 	assert.Nil(t, svc)
@@ -80,7 +92,7 @@ func TestNewActsService_ErrorsOnInvalidJSON(t *testing.T) {
 	err := os.WriteFile(filePath, []byte("not valid json{{{"), 0644)
 	require.NoError(t, err)
 
-	svc, err := services.NewActsService(filePath)
+	svc, err := newActsService(filePath)
 
 	assert.Error(t, err)
 	assert.Nil(t, svc)
@@ -115,13 +127,17 @@ func TestActsService_ListActs_ReturnsAllActsWithEmptyFilter(t *testing.T) {
 	}
 
 	filePath := createTestActsFile(t, acts)
-	svc, err := services.NewActsService(filePath)
+	svc, err := newActsService(filePath)
 	require.NoError(t, err)
 
-	result, err := svc.ListActs("")
+	result, err := svc.ListActs(context.Background(), "", "")
 
 	require.NoError(t, err)
 	assert.Len(t, result, 3)
+	// A file without a contest holds the acts of the default contest.
+	for i := range acts {
+		acts[i].Contest = models.DefaultContest
+	}
 	assert.Equal(t, acts, result)
 }
 
@@ -154,10 +170,10 @@ func TestActsService_ListActs_FiltersBySemifinal1(t *testing.T) {
 	}
 
 	filePath := createTestActsFile(t, acts)
-	svc, err := services.NewActsService(filePath)
+	svc, err := newActsService(filePath)
 	require.NoError(t, err)
 
-	result, err := svc.ListActs("semifinal1")
+	result, err := svc.ListActs(context.Background(), "", "semifinal1")
 
 	require.NoError(t, err)
 	assert.Len(t, result, 1)
@@ -194,10 +210,10 @@ func TestActsService_ListActs_FiltersByGrandfinal(t *testing.T) {
 	}
 
 	filePath := createTestActsFile(t, acts)
-	svc, err := services.NewActsService(filePath)
+	svc, err := newActsService(filePath)
 	require.NoError(t, err)
 
-	result, err := svc.ListActs("grandfinal")
+	result, err := svc.ListActs(context.Background(), "", "grandfinal")
 
 	require.NoError(t, err)
 	assert.Len(t, result, 1)
@@ -218,10 +234,10 @@ func TestActsService_ListActs_ReturnsErrorForInvalidEventType(t *testing.T) {
 	}
 
 	filePath := createTestActsFile(t, acts)
-	svc, err := services.NewActsService(filePath)
+	svc, err := newActsService(filePath)
 	require.NoError(t, err)
 
-	result, err := svc.ListActs("invalid")
+	result, err := svc.ListActs(context.Background(), "", "invalid")
 
 	assert.ErrorIs(t, err, services.ErrInvalidEventType)
 	assert.Nil(t, result)
@@ -248,12 +264,557 @@ func TestActsService_ListActs_ReturnsEmptySliceForValidEventWithNoMatches(t *tes
 	}
 
 	filePath := createTestActsFile(t, acts)
-	svc, err := services.NewActsService(filePath)
+	svc, err := newActsService(filePath)
 	require.NoError(t, err)
 
-	result, err := svc.ListActs("semifinal2")
+	result, err := svc.ListActs(context.Background(), "", "semifinal2")
 
 	require.NoError(t, err)
 	assert.Empty(t, result)
 	assert.NotNil(t, result)
 }
+
+// writeContestFile writes a per-edition acts file into dir.
+func writeContestFile(t *testing.T, dir, name string, contest *models.Contest, acts []models.Act) {
+	t.Helper()
+
+	data, err := json.Marshal(struct {
+		Contest *models.Contest `json:"contest,omitempty"`
+		Acts    []models.Act    `json:"acts"`
+	}{Contest: contest, Acts: acts})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), data, 0644))
+}
+
+func TestNewActsService_LoadsContestDirectory(t *testing.T) {
+	dir := t.TempDir()
+	writeContestFile(t, dir, "esc-2026.json", &models.Contest{
+		ID: "esc-2026", Name: "Eurovision Song Contest 2026", Year: 2026,
+		Events: []models.EventType{models.EventSemifinal1, models.EventSemifinal2, models.EventGrandFinal},
+	}, []models.Act{
+		{ID: "se-2026", Country: "Sweden", Artist: "A", Song: "S", RunningOrder: 1, EventType: models.EventGrandFinal},
+		{ID: "it-2026", Country: "Italy", Artist: "B", Song: "T", RunningOrder: 1, EventType: models.EventSemifinal1},
+	})
+	writeContestFile(t, dir, "jesc-2025.json", &models.Contest{
+		ID: "jesc-2025", Name: "Junior Eurovision Song Contest 2025", Year: 2025,
+		Events: []models.EventType{models.EventGrandFinal},
+	}, []models.Act{
+		{ID: "fr-jesc-2025", Country: "France", Artist: "C", Song: "U", RunningOrder: 1, EventType: models.EventGrandFinal},
+	})
+	// Files without a contest are named after their edition.
+	writeContestFile(t, dir, "mello-2026.json", nil, []models.Act{
+		{ID: "mello-1", Country: "Sweden", Artist: "D", Song: "V", RunningOrder: 1, EventType: models.EventGrandFinal},
+	})
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("not a contest"), 0644))
+
+	svc, err := newActsService(dir)
+	require.NoError(t, err)
+
+	contests := svc.ListContests()
+	require.Len(t, contests, 3)
+	assert.Equal(t, "esc-2026", contests[0].ID)
+	assert.Equal(t, "mello-2026", contests[1].ID)
+	assert.Equal(t, 2026, contests[1].Year)
+	assert.Equal(t, []models.EventType{models.EventGrandFinal}, contests[1].Events)
+	assert.Equal(t, "jesc-2025", contests[2].ID)
+
+	final, err := svc.ListActs(context.Background(), "esc-2026", "grandfinal")
+	require.NoError(t, err)
+	require.Len(t, final, 1)
+	assert.Equal(t, "se-2026", final[0].ID)
+	assert.Equal(t, "esc-2026", final[0].Contest)
+
+	junior, err := svc.ListActs(context.Background(), "jesc-2025", "")
+	require.NoError(t, err)
+	assert.Len(t, junior, 1)
+
+	_, err = svc.ListActs(context.Background(), "esc-1956", "")
+	assert.ErrorIs(t, err, services.ErrUnknownContest)
+}
+
+func TestNewActsService_LoadsShippedCatalogue(t *testing.T) {
+	ctx := context.Background()
+	svc, err := newActsService(filepath.Join("..", "data", "contests"))
+	require.NoError(t, err)
+
+	contests := svc.ListContests()
+	require.GreaterOrEqual(t, len(contests), 2)
+	ids := make([]string, 0, len(contests))
+	for _, c := range contests {
+		ids = append(ids, c.ID)
+	}
+	assert.Less(t, slices.Index(ids, "esc-2025"), slices.Index(ids, "esc-2024"), "most recent edition first")
+	assert.NotContains(t, ids, "")
+
+	for _, tt := range []struct {
+		contest   string
+		eventType string
+		count     int
+		first     string
+	}{
+		{"esc-2024", "semifinal1", 15, "Cyprus"},
+		{"esc-2024", "semifinal2", 16, "Malta"},
+		{"esc-2024", "grandfinal", 26, "Sweden"},
+		{"esc-2025", "semifinal1", 15, "Iceland"},
+		{"esc-2025", "semifinal2", 16, "Australia"},
+		{"esc-2025", "grandfinal", 26, "Norway"},
+	} {
+		acts, err := svc.ListActs(ctx, tt.contest, tt.eventType)
+		require.NoError(t, err, tt.contest)
+		require.Len(t, acts, tt.count, "%s %s", tt.contest, tt.eventType)
+		assert.Equal(t, tt.first, acts[0].Country, "%s %s", tt.contest, tt.eventType)
+		for _, act := range acts {
+			assert.Equal(t, tt.contest, act.Contest, act.ID)
+			assert.True(t, strings.HasSuffix(act.ID, tt.contest[len("esc"):]), "act %s is listed in %s", act.ID, tt.contest)
+		}
+	}
+
+	all, err := svc.ListActs(ctx, "esc-2024", "")
+	require.NoError(t, err)
+	assert.Len(t, all, 15+16+26)
+}
+
+func TestNewActsService_RejectsActOutsideContestEvents(t *testing.T) {
+	dir := t.TempDir()
+	writeContestFile(t, dir, "jesc-2025.json", &models.Contest{
+		ID: "jesc-2025", Name: "Junior Eurovision Song Contest 2025", Year: 2025,
+		Events: []models.EventType{models.EventGrandFinal},
+	}, []models.Act{
+		{ID: "fr-jesc-2025", Country: "France", Artist: "C", Song: "U", RunningOrder: 1, EventType: models.EventSemifinal1},
+	})
+
+	_, err := newActsService(dir)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "outside the contest")
+}
+
+func TestNewActsService_RejectsDuplicateContest(t *testing.T) {
+	dir := t.TempDir()
+	contest := &models.Contest{ID: "esc-2026", Name: "Eurovision 2026", Year: 2026, Events: []models.EventType{models.EventGrandFinal}}
+	act := models.Act{ID: "se-2026", Country: "Sweden", Artist: "A", Song: "S", RunningOrder: 1, EventType: models.EventGrandFinal}
+	writeContestFile(t, dir, "a.json", contest, []models.Act{act})
+	writeContestFile(t, dir, "b.json", contest, []models.Act{act})
+
+	_, err := newActsService(dir)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "duplicate contest")
+}
+
+func TestValidateCatalogue(t *testing.T) {
+	dir := t.TempDir()
+	contest := &models.Contest{ID: "esc-2026", Name: "Eurovision 2026", Year: 2026, Events: []models.EventType{models.EventGrandFinal}}
+	writeContestFile(t, dir, "a.json", contest, []models.Act{
+		{ID: "se-2026", Country: "Sweden", Artist: "A", Song: "S", RunningOrder: 1, EventType: models.EventGrandFinal},
+		{ID: "se-2026", Country: "Sweden", Artist: "A", Song: "S", RunningOrder: 2, EventType: models.EventGrandFinal},
+		{ID: "no-2026", Country: "Norway", Artist: "B", RunningOrder: 3, EventType: models.EventGrandFinal},
+	})
+	writeContestFile(t, dir, "b.json", contest, []models.Act{
+		{ID: "fi-2026", Country: "Finland", Artist: "C", Song: "U", RunningOrder: 1, EventType: models.EventGrandFinal},
+	})
+	writeContestFile(t, dir, "mello-2026.json", nil, []models.Act{
+		{ID: "mello-1", Country: "Sweden", Artist: "D", Song: "V", RunningOrder: 1, EventType: models.EventGrandFinal},
+	})
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "broken.json"), []byte("{"), 0644))
+
+	reports, err := services.ValidateCatalogue(dir)
+	require.NoError(t, err)
+	require.Len(t, reports, 4)
+
+	assert.Equal(t, "esc-2026", reports[0].Contest.ID)
+	assert.Equal(t, 3, reports[0].Acts)
+	assert.Equal(t, []string{`duplicate act "se-2026"`, `act "no-2026": invalid act: song is required`}, reports[0].Problems)
+
+	assert.Equal(t, []string{`duplicate contest "esc-2026", also defined in ` + filepath.Join(dir, "a.json")}, reports[1].Problems)
+
+	require.Len(t, reports[2].Problems, 1)
+	assert.Contains(t, reports[2].Problems[0], "parsing acts file")
+
+	assert.Equal(t, "mello-2026", reports[3].Contest.ID)
+	assert.Empty(t, reports[3].Problems)
+
+	_, err = services.ValidateCatalogue(filepath.Join(dir, "missing"))
+	assert.Error(t, err)
+}
+
+// finalActs returns a small grand final lineup for the default contest.
+func finalActs() []models.Act {
+	return []models.Act{
+		{ID: "se", Country: "Sweden", Artist: "A", Song: "S", RunningOrder: 1, EventType: models.EventGrandFinal},
+		{ID: "no", Country: "Norway", Artist: "B", Song: "T", RunningOrder: 2, EventType: models.EventGrandFinal},
+		{ID: "fi", Country: "Finland", Artist: "C", Song: "U", RunningOrder: 3, EventType: models.EventGrandFinal},
+		{ID: "it", Country: "Italy", Artist: "D", Song: "V", RunningOrder: 1, EventType: models.EventSemifinal1},
+	}
+}
+
+func actIDs(acts []models.Act) []string {
+	ids := make([]string, len(acts))
+	for i, act := range acts {
+		ids[i] = act.ID
+	}
+	return ids
+}
+
+func TestNewActsService_KeepsStoredActs(t *testing.T) {
+	ctx := context.Background()
+	dao := memory.NewActDAO(memory.NewStore())
+	require.NoError(t, dao.Create(ctx, &models.Act{
+		ID: "dk", Contest: models.DefaultContest, Country: "Denmark", Artist: "E", Song: "W", RunningOrder: 1, EventType: models.EventGrandFinal,
+	}))
+
+	svc, err := services.NewActsService(ctx, dao, createTestActsFile(t, finalActs()), nil)
+	require.NoError(t, err)
+
+	result, err := svc.ListActs(ctx, "", "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"dk"}, actIDs(result))
+}
+
+func TestActsService_EditActs(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("creates act", func(t *testing.T) {
+		svc, err := newActsService(createTestActsFile(t, finalActs()))
+		require.NoError(t, err)
+
+		act, err := svc.CreateAct(ctx, catalogueEditor, models.Act{
+			ID: "dk", Country: "Denmark", Artist: "E", Song: "W", RunningOrder: 4, EventType: models.EventGrandFinal,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, models.DefaultContest, act.Contest)
+
+		result, err := svc.ListActs(ctx, "", "grandfinal")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"se", "no", "fi", "dk"}, actIDs(result))
+	})
+
+	t.Run("rejects users who are not editors", func(t *testing.T) {
+		svc, err := newActsService(createTestActsFile(t, finalActs()))
+		require.NoError(t, err)
+		act := models.Act{ID: "dk", Country: "Denmark", Artist: "E", Song: "W", RunningOrder: 4, EventType: models.EventGrandFinal}
+
+		_, err = svc.CreateAct(ctx, "admin-1", act)
+		assert.ErrorIs(t, err, services.ErrUnauthorized)
+		_, err = svc.UpdateAct(ctx, "admin-1", act)
+		assert.ErrorIs(t, err, services.ErrUnauthorized)
+		assert.ErrorIs(t, svc.DeleteAct(ctx, "admin-1", "", "se"), services.ErrUnauthorized)
+		_, err = svc.ReorderActs(ctx, "admin-1", services.ReorderActsRequest{EventType: models.EventGrandFinal})
+		assert.ErrorIs(t, err, services.ErrUnauthorized)
+	})
+
+	t.Run("rejects invalid and duplicate acts", func(t *testing.T) {
+		svc, err := newActsService(createTestActsFile(t, finalActs()))
+		require.NoError(t, err)
+
+		_, err = svc.CreateAct(ctx, catalogueEditor, models.Act{ID: "dk", Country: "Denmark", EventType: models.EventGrandFinal})
+		assert.ErrorIs(t, err, services.ErrInvalidAct)
+
+		_, err = svc.CreateAct(ctx, catalogueEditor, models.Act{
+			ID: "dk", Country: "Denmark", Artist: "E", Song: "W", RunningOrder: 1, EventType: models.EventSemifinal2,
+		})
+		assert.ErrorIs(t, err, services.ErrInvalidAct, "semifinal 2 is not part of the contest")
+
+		_, err = svc.CreateAct(ctx, catalogueEditor, models.Act{
+			ID: "se", Country: "Sweden", Artist: "A", Song: "S", RunningOrder: 1, EventType: models.EventGrandFinal,
+		})
+		assert.ErrorIs(t, err, services.ErrActExists)
+
+		_, err = svc.CreateAct(ctx, catalogueEditor, models.Act{
+			ID: "dk", Contest: "esc-1956", Country: "Denmark", Artist: "E", Song: "W", RunningOrder: 1, EventType: models.EventGrandFinal,
+		})
+		assert.ErrorIs(t, err, services.ErrUnknownContest)
+	})
+
+	t.Run("updates and deletes act", func(t *testing.T) {
+		svc, err := newActsService(createTestActsFile(t, finalActs()))
+		require.NoError(t, err)
+
+		updated, err := svc.UpdateAct(ctx, catalogueEditor, models.Act{
+			ID: "se", Country: "Sweden", Artist: "A", Song: "New Song", RunningOrder: 1, EventType: models.EventGrandFinal,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "New Song", updated.Song)
+
+		require.NoError(t, svc.DeleteAct(ctx, catalogueEditor, "", "no"))
+		assert.ErrorIs(t, svc.DeleteAct(ctx, catalogueEditor, "", "no"), services.ErrActNotFound)
+
+		_, err = svc.UpdateAct(ctx, catalogueEditor, models.Act{
+			ID: "no", Country: "Norway", Artist: "B", Song: "T", RunningOrder: 2, EventType: models.EventGrandFinal,
+		})
+		assert.ErrorIs(t, err, services.ErrActNotFound)
+
+		result, err := svc.ListActs(ctx, "", "grandfinal")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"se", "fi"}, actIDs(result))
+		assert.Equal(t, "New Song", result[0].Song)
+	})
+
+	t.Run("reorders a show", func(t *testing.T) {
+		svc, err := newActsService(createTestActsFile(t, finalActs()))
+		require.NoError(t, err)
+
+		reordered, err := svc.ReorderActs(ctx, catalogueEditor, services.ReorderActsRequest{
+			EventType: models.EventGrandFinal,
+			ActIDs:    []string{"fi", "se", "no"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"fi", "se", "no"}, actIDs(reordered))
+		assert.Equal(t, 1, reordered[0].RunningOrder)
+		assert.Equal(t, 3, reordered[2].RunningOrder)
+
+		result, err := svc.ListActs(ctx, "", "")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"fi", "se", "no", "it"}, actIDs(result))
+	})
+
+	t.Run("rejects incomplete running orders", func(t *testing.T) {
+		svc, err := newActsService(createTestActsFile(t, finalActs()))
+		require.NoError(t, err)
+
+		for name, ids := range map[string][]string{
+			"missing act":   {"fi", "se"},
+			"duplicate act": {"fi", "se", "se", "no"},
+			"other show":    {"fi", "se", "no", "it"},
+		} {
+			_, err := svc.ReorderActs(ctx, catalogueEditor, services.ReorderActsRequest{
+				EventType: models.EventGrandFinal,
+				ActIDs:    ids,
+			})
+			assert.ErrorIs(t, err, services.ErrInvalidAct, name)
+		}
+
+		result, err := svc.ListActs(ctx, "", "grandfinal")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"se", "no", "fi"}, actIDs(result))
+	})
+}
+
+func TestActsService_Reload(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	contest := &models.Contest{ID: "esc-2026", Name: "Eurovision 2026", Year: 2026, Events: []models.EventType{models.EventGrandFinal}}
+	writeContestFile(t, dir, "esc-2026.json", contest, []models.Act{
+		{ID: "se", Country: "Sweden", Artist: "A", Song: "S", RunningOrder: 1, EventType: models.EventGrandFinal},
+	})
+
+	svc, err := newActsService(dir)
+	require.NoError(t, err)
+
+	// Unchanged files keep the stored acts.
+	require.NoError(t, svc.Reload(ctx))
+	result, err := svc.ListActs(ctx, "esc-2026", "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"se"}, actIDs(result))
+
+	// A changed file replaces the stored acts of its edition.
+	writeContestFile(t, dir, "esc-2026.json", contest, []models.Act{
+		{ID: "fi", Country: "Finland", Artist: "C", Song: "U", RunningOrder: 1, EventType: models.EventGrandFinal},
+		{ID: "se", Country: "Sweden", Artist: "A", Song: "S", RunningOrder: 2, EventType: models.EventGrandFinal},
+	})
+	writeContestFile(t, dir, "mello-2026.json", nil, []models.Act{
+		{ID: "m1", Country: "Sweden", Artist: "D", Song: "V", RunningOrder: 1, EventType: models.EventGrandFinal},
+	})
+	require.NoError(t, svc.Reload(ctx))
+
+	result, err = svc.ListActs(ctx, "esc-2026", "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"fi", "se"}, actIDs(result))
+	assert.Len(t, svc.ListContests(), 2)
+
+	// Removed files drop their edition.
+	require.NoError(t, os.Remove(filepath.Join(dir, "mello-2026.json")))
+	require.NoError(t, svc.Reload(ctx))
+	_, err = svc.ListActs(ctx, "mello-2026", "")
+	assert.ErrorIs(t, err, services.ErrUnknownContest)
+}
+
+func TestActsService_ReloadKeepsEdits(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	contest := &models.Contest{ID: "esc-2026", Name: "Eurovision 2026", Year: 2026, Events: []models.EventType{models.EventGrandFinal}}
+	writeContestFile(t, dir, "esc-2026.json", contest, []models.Act{
+		{ID: "se", Country: "Sweden", Artist: "A", Song: "S", RunningOrder: 1, EventType: models.EventGrandFinal},
+		{ID: "no", Country: "Norway", Artist: "B", Song: "T", RunningOrder: 2, EventType: models.EventGrandFinal},
+	})
+	writeContestFile(t, dir, "mello-2026.json", nil, []models.Act{
+		{ID: "m1", Country: "Sweden", Artist: "D", Song: "V", RunningOrder: 1, EventType: models.EventGrandFinal},
+	})
+
+	dao := memory.NewActDAO(memory.NewStore())
+	svc, err := services.NewActsService(ctx, dao, dir, []string{catalogueEditor})
+	require.NoError(t, err)
+
+	_, err = svc.UpdateAct(ctx, catalogueEditor, models.Act{
+		ID: "no", Contest: "esc-2026", Country: "Norway", Artist: "B", Song: "Corrected", RunningOrder: 2, EventType: models.EventGrandFinal,
+	})
+	require.NoError(t, err)
+
+	writeContestFile(t, dir, "esc-2026.json", contest, []models.Act{
+		{ID: "fi", Country: "Finland", Artist: "C", Song: "U", RunningOrder: 1, EventType: models.EventGrandFinal},
+	})
+	writeContestFile(t, dir, "mello-2026.json", nil, []models.Act{
+		{ID: "m2", Country: "Sweden", Artist: "E", Song: "W", RunningOrder: 1, EventType: models.EventGrandFinal},
+	})
+	err = svc.Reload(ctx)
+	assert.ErrorIs(t, err, services.ErrCatalogueEdited)
+	assert.ErrorContains(t, err, `"esc-2026"`)
+
+	result, err := svc.ListActs(ctx, "esc-2026", "")
+	require.NoError(t, err)
+	require.Equal(t, []string{"se", "no"}, actIDs(result), "the edited edition keeps its stored acts")
+	assert.Equal(t, "Corrected", result[1].Song)
+
+	result, err = svc.ListActs(ctx, "mello-2026", "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"m2"}, actIDs(result), "unedited editions are reloaded")
+
+	// The edits are reported once per change of the file and survive a restart.
+	require.NoError(t, svc.Reload(ctx))
+	restarted, err := services.NewActsService(ctx, dao, dir, []string{catalogueEditor})
+	require.NoError(t, err)
+	writeContestFile(t, dir, "esc-2026.json", contest, []models.Act{
+		{ID: "dk", Country: "Denmark", Artist: "F", Song: "X", RunningOrder: 1, EventType: models.EventGrandFinal},
+	})
+	assert.ErrorIs(t, restarted.Reload(ctx), services.ErrCatalogueEdited)
+	result, err = restarted.ListActs(ctx, "esc-2026", "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"se", "no"}, actIDs(result))
+}
+
+// qualifierActs returns a contest with two semifinals and two automatic finalists.
+func qualifierActs() []models.Act {
+	return []models.Act{
+		{ID: "se", Country: "Sweden", Artist: "A", Song: "S", RunningOrder: 1, EventType: models.EventSemifinal1},
+		{ID: "no", Country: "Norway", Artist: "B", Song: "T", RunningOrder: 2, EventType: models.EventSemifinal1},
+		{ID: "fi", Country: "Finland", Artist: "C", Song: "U", RunningOrder: 1, EventType: models.EventSemifinal2},
+		{ID: "dk", Country: "Denmark", Artist: "D", Song: "V", RunningOrder: 2, EventType: models.EventSemifinal2},
+		{ID: "gb", Country: "United Kingdom", Artist: "E", Song: "W", RunningOrder: 1, EventType: models.EventGrandFinal},
+		{ID: "ch", Country: "Switzerland", Artist: "F", Song: "X", RunningOrder: 2, EventType: models.EventGrandFinal},
+	}
+}
+
+func TestActsService_GrandFinalLineup(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("includes semifinal acts marked as qualified in the file", func(t *testing.T) {
+		acts := qualifierActs()
+		acts[1].Qualification = models.QualificationQualified
+		acts[1].FinalRunningOrder = 2
+		acts[0].Qualification = models.QualificationEliminated
+		acts[3].Qualification = models.QualificationQualified
+		acts[5].RunningOrder = 3
+		svc, err := newActsService(createTestActsFile(t, acts))
+		require.NoError(t, err)
+
+		final, err := svc.ListActs(ctx, "", "grandfinal")
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"gb", "no", "ch", "dk"}, actIDs(final), "finalists without a running order come last")
+		assert.Equal(t, models.EventGrandFinal, final[1].EventType)
+		assert.Equal(t, 2, final[1].RunningOrder)
+
+		semifinal, err := svc.ListActs(ctx, "", "semifinal1")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"se", "no"}, actIDs(semifinal))
+		assert.Equal(t, 2, semifinal[1].RunningOrder)
+
+		all, err := svc.ListActs(ctx, "", "")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"se", "no", "fi", "dk", "gb", "no", "ch", "dk"}, actIDs(all))
+	})
+
+	t.Run("derives the 2025 final from the shipped catalogue", func(t *testing.T) {
+		svc, err := newActsService(filepath.Join("..", "data", "contests"))
+		require.NoError(t, err)
+
+		final, err := svc.ListActs(ctx, "esc-2025", "grandfinal")
+		require.NoError(t, err)
+
+		countries := make([]string, 0, len(final))
+		for i, act := range final {
+			countries = append(countries, act.Country)
+			assert.Equal(t, i+1, act.RunningOrder, act.ID)
+			assert.Equal(t, models.EventGrandFinal, act.EventType, act.ID)
+		}
+		assert.Equal(t, []string{
+			"Norway", "Luxembourg", "Estonia", "Israel", "Lithuania", "Spain", "Ukraine",
+			"United Kingdom", "Austria", "Iceland", "Latvia", "Netherlands", "Finland",
+			"Italy", "Poland", "Germany", "Greece", "Armenia", "Switzerland", "Malta",
+			"Portugal", "Denmark", "Sweden", "France", "San Marino", "Albania",
+		}, countries)
+	})
+
+	t.Run("records qualifiers and sets the final running order", func(t *testing.T) {
+		svc, err := newActsService(createTestActsFile(t, qualifierActs()))
+		require.NoError(t, err)
+
+		final, err := svc.RecordQualifiers(ctx, catalogueEditor, services.RecordQualifiersRequest{
+			EventType: models.EventSemifinal1,
+			ActIDs:    []string{"no"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"gb", "ch", "no"}, actIDs(final))
+
+		_, err = svc.RecordQualifiers(ctx, catalogueEditor, services.RecordQualifiersRequest{
+			EventType: models.EventSemifinal2,
+			ActIDs:    []string{"fi", "dk"},
+		})
+		require.NoError(t, err)
+
+		final, err = svc.ReorderActs(ctx, catalogueEditor, services.ReorderActsRequest{
+			EventType: models.EventGrandFinal,
+			ActIDs:    []string{"dk", "gb", "no", "fi", "ch"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"dk", "gb", "no", "fi", "ch"}, actIDs(final))
+
+		semifinal, err := svc.ListActs(ctx, "", "semifinal2")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"fi", "dk"}, actIDs(semifinal), "semifinal running order is unchanged")
+		assert.Equal(t, 4, semifinal[0].FinalRunningOrder)
+
+		// Losing the qualification drops the act from the final.
+		_, err = svc.RecordQualifiers(ctx, catalogueEditor, services.RecordQualifiersRequest{
+			EventType: models.EventSemifinal1,
+			ActIDs:    []string{"se"},
+		})
+		require.NoError(t, err)
+
+		final, err = svc.ListActs(ctx, "", "grandfinal")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"dk", "gb", "fi", "ch", "se"}, actIDs(final))
+
+		semifinal, err = svc.ListActs(ctx, "", "semifinal1")
+		require.NoError(t, err)
+		assert.Equal(t, models.QualificationEliminated, semifinal[1].Qualification)
+		assert.Zero(t, semifinal[1].FinalRunningOrder)
+	})
+
+	t.Run("rejects invalid qualifiers", func(t *testing.T) {
+		svc, err := newActsService(createTestActsFile(t, qualifierActs()))
+		require.NoError(t, err)
+
+		_, err = svc.RecordQualifiers(ctx, catalogueEditor, services.RecordQualifiersRequest{
+			EventType: models.EventGrandFinal,
+			ActIDs:    []string{"gb"},
+		})
+		assert.ErrorIs(t, err, services.ErrInvalidEventType)
+
+		_, err = svc.RecordQualifiers(ctx, catalogueEditor, services.RecordQualifiersRequest{
+			EventType: models.EventSemifinal1,
+			ActIDs:    []string{"no", "fi"},
+		})
+		assert.ErrorIs(t, err, services.ErrInvalidAct)
+
+		_, err = svc.RecordQualifiers(ctx, "admin-1", services.RecordQualifiersRequest{
+			EventType: models.EventSemifinal1,
+			ActIDs:    []string{"no"},
+		})
+		assert.ErrorIs(t, err, services.ErrUnauthorized)
+
+		final, err := svc.ListActs(ctx, "", "grandfinal")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"gb", "ch"}, actIDs(final))
+	})
+}
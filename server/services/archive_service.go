@@ -0,0 +1,256 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sipgate/eurovision-vote-party/server/authz"
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/persistence"
+)
+
+// ArchivePartyDAO defines the party operations needed by the archive service.
+type ArchivePartyDAO interface {
+	Create(ctx context.Context, party *models.Party) error
+	GetByID(ctx context.Context, id string) (*models.Party, error)
+	CodeExists(ctx context.Context, code string) (bool, error)
+	DeleteCascade(ctx context.Context, id string) error
+}
+
+// ArchiveGuestDAO defines the guest operations needed by the archive service.
+type ArchiveGuestDAO interface {
+	Create(ctx context.Context, guest *models.Guest) error
+	GetByID(ctx context.Context, id string) (*models.Guest, error)
+	ListByPartyID(ctx context.Context, partyID string) ([]*models.Guest, error)
+}
+
+// ArchiveVoteDAO defines the vote operations needed by the archive service.
+type ArchiveVoteDAO interface {
+	Create(ctx context.Context, vote *models.Vote) error
+	ListByPartyID(ctx context.Context, partyID string) ([]*models.Vote, error)
+}
+
+// ArchivePredictionDAO defines the prediction operations needed by the archive service.
+type ArchivePredictionDAO interface {
+	Upsert(ctx context.Context, prediction *models.Prediction) error
+	ListByPartyID(ctx context.Context, partyID string) ([]*models.Prediction, error)
+	SetOutcome(ctx context.Context, outcome *models.PredictionOutcome) error
+	GetOutcome(ctx context.Context, partyID string) (*models.PredictionOutcome, error)
+}
+
+// ArchiveService exports parties into archives and recreates parties from them.
+type ArchiveService interface {
+	ExportParty(ctx context.Context, partyID string) (*models.PartyArchive, error)
+	ImportParty(ctx context.Context, adminID string, archive *models.PartyArchive) (*models.Party, error)
+}
+
+type archiveService struct {
+	partyDAO      ArchivePartyDAO
+	guestDAO      ArchiveGuestDAO
+	voteDAO       ArchiveVoteDAO
+	predictionDAO ArchivePredictionDAO
+	actsService   VoteActsService
+}
+
+// NewArchiveService creates a new ArchiveService.
+func NewArchiveService(partyDAO ArchivePartyDAO, guestDAO ArchiveGuestDAO, voteDAO ArchiveVoteDAO, predictionDAO ArchivePredictionDAO, actsService VoteActsService) ArchiveService {
+	return &archiveService{
+		partyDAO:      partyDAO,
+		guestDAO:      guestDAO,
+		voteDAO:       voteDAO,
+		predictionDAO: predictionDAO,
+		actsService:   actsService,
+	}
+}
+
+// ExportParty bundles a party with its guests, all ballots including drafts,
+// its predictions and the acts of its show, ensuring the requester may export it.
+func (s *archiveService) ExportParty(ctx context.Context, partyID string) (*models.PartyArchive, error) {
+	party, err := s.partyDAO.GetByID(ctx, partyID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	if err := authorize(ctx, authz.ExportParty, party); err != nil {
+		return nil, err
+	}
+
+	guests, err := s.guestDAO.ListByPartyID(ctx, partyID)
+	if err != nil {
+		return nil, err
+	}
+	votes, err := s.voteDAO.ListByPartyID(ctx, partyID)
+	if err != nil {
+		return nil, err
+	}
+	predictions, err := s.predictionDAO.ListByPartyID(ctx, partyID)
+	if err != nil {
+		return nil, err
+	}
+	outcome, err := s.predictionDAO.GetOutcome(ctx, partyID)
+	if err != nil && !errors.Is(err, persistence.ErrNotFound) {
+		return nil, err
+	}
+	acts, err := s.actsService.ListActs(ctx, party.Edition(), string(party.EventType))
+	if err != nil {
+		return nil, err
+	}
+
+	archive := &models.PartyArchive{
+		Version:     models.ArchiveVersion,
+		ExportedAt:  time.Now(),
+		Party:       *party,
+		Guests:      make([]models.Guest, 0, len(guests)),
+		Votes:       make([]models.Vote, 0, len(votes)),
+		Predictions: make([]models.Prediction, 0, len(predictions)),
+		Outcome:     outcome,
+		Acts:        acts,
+	}
+	if archive.Acts == nil {
+		archive.Acts = []models.Act{}
+	}
+	for _, g := range guests {
+		archive.Guests = append(archive.Guests, *g)
+	}
+	for _, v := range votes {
+		archive.Votes = append(archive.Votes, *v)
+	}
+	for _, p := range predictions {
+		archive.Predictions = append(archive.Predictions, *p)
+	}
+
+	sort.Slice(archive.Guests, func(i, j int) bool { return archive.Guests[i].ID < archive.Guests[j].ID })
+	sort.Slice(archive.Votes, func(i, j int) bool { return archive.Votes[i].GuestID < archive.Votes[j].GuestID })
+	sort.Slice(archive.Predictions, func(i, j int) bool { return archive.Predictions[i].GuestID < archive.Predictions[j].GuestID })
+
+	return archive, nil
+}
+
+// ImportParty recreates an archived party, owned by the importing admin.
+// Party and guest IDs and the party code are kept unless they are already
+// taken, in which case new ones are generated. The acts catalogue is left
+// untouched; the archived acts only document what the party was scored against.
+// The party's members are not imported.
+// Returns ErrInvalidArchive if the archive is not internally consistent.
+func (s *archiveService) ImportParty(ctx context.Context, adminID string, archive *models.PartyArchive) (*models.Party, error) {
+	if adminID == "" {
+		return nil, ErrUnauthorized
+	}
+	if err := archive.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidArchive, err)
+	}
+
+	party := archive.Party
+	party.AdminID = adminID
+	// Members refer to user accounts of the exporting server; the new owner
+	// invites their own co-hosts.
+	party.Members = nil
+
+	taken, err := s.partyIDTaken(ctx, party.ID)
+	if err != nil {
+		return nil, err
+	}
+	if taken {
+		party.ID = uuid.New().String()
+	}
+
+	exists, err := s.partyDAO.CodeExists(ctx, party.Code)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		if party.Code, err = uniquePartyCode(ctx, s.partyDAO); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.partyDAO.Create(ctx, &party); err != nil {
+		return nil, err
+	}
+
+	if err := s.importRecords(ctx, party.ID, archive); err != nil {
+		// Do not leave a partially imported party behind.
+		s.partyDAO.DeleteCascade(ctx, party.ID)
+		return nil, err
+	}
+
+	return &party, nil
+}
+
+// importRecords recreates the guests, ballots and predictions of the archive
+// for the party with the given ID.
+func (s *archiveService) importRecords(ctx context.Context, partyID string, archive *models.PartyArchive) error {
+	guestIDs := make(map[string]string, len(archive.Guests))
+	for _, g := range archive.Guests {
+		guest := g
+		guest.PartyID = partyID
+		guest.SessionID = ""
+
+		taken, err := s.guestIDTaken(ctx, guest.ID)
+		if err != nil {
+			return err
+		}
+		if taken {
+			guest.ID = uuid.New().String()
+		}
+		guestIDs[g.ID] = guest.ID
+
+		if err := s.guestDAO.Create(ctx, &guest); err != nil {
+			return err
+		}
+	}
+
+	for _, v := range archive.Votes {
+		vote := v
+		vote.PartyID = partyID
+		vote.GuestID = guestIDs[v.GuestID]
+		vote.ID = models.VoteIDFor(partyID, vote.GuestID)
+		if err := s.voteDAO.Create(ctx, &vote); err != nil {
+			return err
+		}
+	}
+
+	for _, p := range archive.Predictions {
+		prediction := p
+		prediction.PartyID = partyID
+		prediction.GuestID = guestIDs[p.GuestID]
+		prediction.ID = models.PredictionIDFor(partyID, prediction.GuestID)
+		if err := s.predictionDAO.Upsert(ctx, &prediction); err != nil {
+			return err
+		}
+	}
+
+	if archive.Outcome != nil {
+		outcome := *archive.Outcome
+		outcome.PartyID = partyID
+		if err := s.predictionDAO.SetOutcome(ctx, &outcome); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *archiveService) partyIDTaken(ctx context.Context, id string) (bool, error) {
+	_, err := s.partyDAO.GetByID(ctx, id)
+	if errors.Is(err, persistence.ErrNotFound) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (s *archiveService) guestIDTaken(ctx context.Context, id string) (bool, error) {
+	_, err := s.guestDAO.GetByID(ctx, id)
+	if errors.Is(err, persistence.ErrNotFound) {
+		return false, nil
+	}
+	return err == nil, err
+}
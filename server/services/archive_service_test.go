@@ -0,0 +1,203 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/persistence/memory"
+	"github.com/sipgate/eurovision-vote-party/server/services"
+)
+
+// archiveFixture wraps a memory store holding a closed top 3 party with two
+// guests: alice submitted a ballot and a prediction, bob only a draft.
+type archiveFixture struct {
+	partyDAO      *memory.PartyDAO
+	guestDAO      *memory.GuestDAO
+	voteDAO       *memory.VoteDAO
+	predictionDAO *memory.PredictionDAO
+	svc           services.ArchiveService
+}
+
+func newArchiveStore(t *testing.T) archiveFixture {
+	t.Helper()
+
+	store := memory.NewStore()
+	f := archiveFixture{
+		partyDAO:      memory.NewPartyDAO(store),
+		guestDAO:      memory.NewGuestDAO(store),
+		voteDAO:       memory.NewVoteDAO(store),
+		predictionDAO: memory.NewPredictionDAO(store),
+	}
+	actsService := &mockVoteActsService{
+		listActsFunc: func(ctx context.Context, contest, eventType string) ([]models.Act, error) {
+			return testActs()[:3], nil
+		},
+	}
+	f.svc = services.NewArchiveService(f.partyDAO, f.guestDAO, f.voteDAO, f.predictionDAO, actsService)
+	return f
+}
+
+func newArchiveFixture(t *testing.T) archiveFixture {
+	t.Helper()
+	ctx := context.Background()
+
+	f := newArchiveStore(t)
+	now := time.Now()
+	require.NoError(t, f.partyDAO.Create(ctx, &models.Party{
+		ID: "party-1", Name: "Party", Code: "ABC234", EventType: models.EventGrandFinal,
+		ScoringSystem: models.ScoringTop3, AdminID: "admin-1", Status: models.PartyStatusClosed, CreatedAt: now,
+	}))
+	for _, g := range []models.Guest{
+		{ID: "guest-2", PartyID: "party-1", Username: "bob", Status: models.GuestStatusApproved, CreatedAt: now, SessionID: "session-2"},
+		{ID: "guest-1", PartyID: "party-1", Username: "alice", Status: models.GuestStatusApproved, CreatedAt: now, SessionID: "session-1"},
+	} {
+		require.NoError(t, f.guestDAO.Create(ctx, &g))
+	}
+	acts := testActs()
+	require.NoError(t, f.voteDAO.Create(ctx, &models.Vote{
+		ID: models.VoteIDFor("party-1", "guest-1"), GuestID: "guest-1", PartyID: "party-1",
+		Votes: map[int]string{3: acts[0].ID, 2: acts[1].ID, 1: acts[2].ID}, CreatedAt: now,
+	}))
+	require.NoError(t, f.voteDAO.Create(ctx, &models.Vote{
+		ID: models.VoteIDFor("party-1", "guest-2"), GuestID: "guest-2", PartyID: "party-1",
+		Votes: map[int]string{3: acts[2].ID}, Draft: true, CreatedAt: now,
+	}))
+	require.NoError(t, f.predictionDAO.Upsert(ctx, &models.Prediction{
+		ID: models.PredictionIDFor("party-1", "guest-1"), PartyID: "party-1", GuestID: "guest-1",
+		Picks: []string{acts[0].ID, acts[1].ID, acts[2].ID}, UpdatedAt: now,
+	}))
+	require.NoError(t, f.predictionDAO.SetOutcome(ctx, &models.PredictionOutcome{
+		PartyID: "party-1", Picks: []string{acts[1].ID, acts[0].ID, acts[2].ID}, RecordedAt: now,
+	}))
+	return f
+}
+
+func TestArchiveService_ExportParty(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("bundles the party with its records and acts", func(t *testing.T) {
+		f := newArchiveFixture(t)
+
+		archive, err := f.svc.ExportParty(asUser(ctx, "admin-1"), "party-1")
+		require.NoError(t, err)
+		require.NoError(t, archive.Validate())
+
+		assert.Equal(t, models.ArchiveVersion, archive.Version)
+		assert.Equal(t, "party-1", archive.Party.ID)
+		require.Len(t, archive.Guests, 2)
+		assert.Equal(t, "guest-1", archive.Guests[0].ID, "guests should be ordered by ID")
+		require.Len(t, archive.Votes, 2)
+		assert.Equal(t, "guest-1", archive.Votes[0].GuestID)
+		assert.True(t, archive.Votes[1].Draft, "drafts should be archived too")
+		require.Len(t, archive.Predictions, 1)
+		require.NotNil(t, archive.Outcome)
+		assert.Equal(t, testActs()[:3], archive.Acts)
+	})
+
+	t.Run("rejects other users", func(t *testing.T) {
+		f := newArchiveFixture(t)
+
+		_, err := f.svc.ExportParty(asUser(ctx, "other-admin"), "party-1")
+		assert.ErrorIs(t, err, services.ErrUnauthorized)
+	})
+
+	t.Run("returns not found for unknown parties", func(t *testing.T) {
+		f := newArchiveFixture(t)
+
+		_, err := f.svc.ExportParty(asUser(ctx, "admin-1"), "missing")
+		assert.ErrorIs(t, err, services.ErrNotFound)
+	})
+}
+
+func TestArchiveService_ImportParty(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("keeps IDs and code in an empty database", func(t *testing.T) {
+		archive, err := newArchiveFixture(t).svc.ExportParty(asUser(ctx, "admin-1"), "party-1")
+		require.NoError(t, err)
+		f := newArchiveStore(t)
+
+		party, err := f.svc.ImportParty(ctx, "admin-2", archive)
+		require.NoError(t, err)
+
+		assert.Equal(t, "party-1", party.ID)
+		assert.Equal(t, "ABC234", party.Code)
+		assert.Equal(t, "admin-2", party.AdminID, "the importing admin should own the party")
+		assert.Equal(t, models.PartyStatusClosed, party.Status)
+
+		stored, err := f.partyDAO.GetByID(ctx, "party-1")
+		require.NoError(t, err)
+		assert.Equal(t, party, stored)
+
+		guest, err := f.guestDAO.GetByID(ctx, "guest-1")
+		require.NoError(t, err)
+		assert.Equal(t, "alice", guest.Username)
+		assert.Empty(t, guest.SessionID, "guest sessions should not be archived")
+
+		vote, err := f.voteDAO.GetByGuestAndParty(ctx, "guest-1", "party-1")
+		require.NoError(t, err)
+		assert.Equal(t, archive.Votes[0].Votes, vote.Votes)
+
+		outcome, err := f.predictionDAO.GetOutcome(ctx, "party-1")
+		require.NoError(t, err)
+		assert.Equal(t, archive.Outcome.Picks, outcome.Picks)
+	})
+
+	t.Run("generates new IDs and code on collision", func(t *testing.T) {
+		f := newArchiveFixture(t)
+		archive, err := f.svc.ExportParty(asUser(ctx, "admin-1"), "party-1")
+		require.NoError(t, err)
+
+		party, err := f.svc.ImportParty(ctx, "admin-1", archive)
+		require.NoError(t, err)
+
+		assert.NotEqual(t, "party-1", party.ID)
+		assert.NotEqual(t, "ABC234", party.Code)
+
+		guests, err := f.guestDAO.ListByPartyID(ctx, party.ID)
+		require.NoError(t, err)
+		require.Len(t, guests, 2)
+		guestIDs := make(map[string]string)
+		for _, g := range guests {
+			assert.NotContains(t, []string{"guest-1", "guest-2"}, g.ID)
+			guestIDs[g.Username] = g.ID
+		}
+
+		vote, err := f.voteDAO.GetByGuestAndParty(ctx, guestIDs["alice"], party.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.VoteIDFor(party.ID, guestIDs["alice"]), vote.ID)
+		assert.False(t, vote.Draft)
+
+		prediction, err := f.predictionDAO.GetByGuestAndParty(ctx, guestIDs["alice"], party.ID)
+		require.NoError(t, err)
+		assert.Equal(t, archive.Predictions[0].Picks, prediction.Picks)
+
+		original, err := f.guestDAO.ListByPartyID(ctx, "party-1")
+		require.NoError(t, err)
+		assert.Len(t, original, 2, "the original party should be left alone")
+	})
+
+	t.Run("rejects inconsistent archives", func(t *testing.T) {
+		f := newArchiveFixture(t)
+		archive, err := f.svc.ExportParty(asUser(ctx, "admin-1"), "party-1")
+		require.NoError(t, err)
+		archive.Votes[0].GuestID = "unknown-guest"
+
+		_, err = f.svc.ImportParty(ctx, "admin-1", archive)
+		assert.ErrorIs(t, err, services.ErrInvalidArchive)
+	})
+
+	t.Run("requires an admin", func(t *testing.T) {
+		f := newArchiveFixture(t)
+		archive, err := f.svc.ExportParty(asUser(ctx, "admin-1"), "party-1")
+		require.NoError(t, err)
+
+		_, err = f.svc.ImportParty(ctx, "", archive)
+		assert.ErrorIs(t, err, services.ErrUnauthorized)
+	})
+}
@@ -0,0 +1,41 @@
+package services
+
+import (
+	"context"
+	"strings"
+
+	"github.com/sipgate/eurovision-vote-party/server/authz"
+	"github.com/sipgate/eurovision-vote-party/server/models"
+)
+
+// authorize checks that the principal of the context may perform the action
+// on the party and returns ErrUnauthorized otherwise.
+func authorize(ctx context.Context, action authz.Action, party *models.Party) error {
+	if !authz.Can(authz.FromContext(ctx), action, party) {
+		return ErrUnauthorized
+	}
+	return nil
+}
+
+// actingGuest resolves the guest a ballot or prediction request is about.
+// Guests of the party act for themselves under own and may omit guestID;
+// everyone else needs onBehalf and must name the guest.
+// Returns ErrUnauthorized if the principal may do neither and ErrGuestIDRequired
+// if the guest is not named.
+func actingGuest(ctx context.Context, party *models.Party, guestID string, own, onBehalf authz.Action) (string, error) {
+	p := authz.FromContext(ctx)
+	if p.IsGuestOf(party.ID) && (guestID == "" || guestID == p.GuestID) {
+		if err := authorize(ctx, own, party); err != nil {
+			return "", err
+		}
+		return p.GuestID, nil
+	}
+
+	if err := authorize(ctx, onBehalf, party); err != nil {
+		return "", err
+	}
+	if strings.TrimSpace(guestID) == "" {
+		return "", ErrGuestIDRequired
+	}
+	return guestID, nil
+}
@@ -0,0 +1,124 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sipgate/eurovision-vote-party/server/authz"
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/persistence/memory"
+	"github.com/sipgate/eurovision-vote-party/server/services"
+)
+
+// asUser returns a context whose caller is the signed-in user.
+func asUser(ctx context.Context, userID string) context.Context {
+	return authz.WithPrincipal(ctx, authz.User(userID))
+}
+
+// asGuest returns a context whose caller holds a guest session for the party.
+func asGuest(ctx context.Context, guestID, partyID string) context.Context {
+	return authz.WithPrincipal(ctx, authz.Guest(guestID, partyID))
+}
+
+func TestActingGuest(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func(t *testing.T) services.VoteService {
+		store := memory.NewStore()
+		partyDAO := memory.NewPartyDAO(store)
+		guestDAO := memory.NewGuestDAO(store)
+		require.NoError(t, partyDAO.Create(ctx, &models.Party{
+			ID:        "party-1",
+			Name:      "Policy Party",
+			Code:      "POLICY",
+			EventType: models.EventGrandFinal,
+			AdminID:   "admin-1",
+			Status:    models.PartyStatusActive,
+			Members: []models.PartyMember{
+				{UserID: "moderator-1", Role: models.PartyRoleModerator, Status: models.MemberStatusActive, InvitedAt: time.Now()},
+			},
+			CreatedAt: time.Now(),
+		}))
+		for _, id := range []string{"guest-1", "guest-2"} {
+			require.NoError(t, guestDAO.Create(ctx, &models.Guest{
+				ID:        id,
+				PartyID:   "party-1",
+				Username:  id,
+				Status:    models.GuestStatusApproved,
+				CreatedAt: time.Now(),
+			}))
+		}
+		return services.NewVoteService(memory.NewVoteDAO(store), partyDAO, guestDAO, &mockVoteActsService{
+			listActsFunc: func(ctx context.Context, contest, eventType string) ([]models.Act, error) {
+				return testActs(), nil
+			},
+		}, nil)
+	}
+
+	t.Run("guests act for themselves without naming themselves", func(t *testing.T) {
+		svc := setup(t)
+
+		vote, err := svc.SubmitVote(asGuest(ctx, "guest-1", "party-1"), "party-1", services.SubmitVoteRequest{Votes: validVotes()})
+		require.NoError(t, err)
+		assert.Equal(t, "guest-1", vote.GuestID)
+
+		vote, err = svc.GetVotes(asGuest(ctx, "guest-1", "party-1"), "party-1", "")
+		require.NoError(t, err)
+		assert.Equal(t, "guest-1", vote.GuestID)
+	})
+
+	t.Run("guests cannot act for other guests", func(t *testing.T) {
+		svc := setup(t)
+		_, err := svc.SubmitVote(asGuest(ctx, "guest-2", "party-1"), "party-1", services.SubmitVoteRequest{Votes: validVotes()})
+		require.NoError(t, err)
+
+		_, err = svc.GetVotes(asGuest(ctx, "guest-1", "party-1"), "party-1", "guest-2")
+		assert.ErrorIs(t, err, services.ErrUnauthorized)
+
+		_, err = svc.SubmitVote(asGuest(ctx, "guest-1", "party-1"), "party-1", services.SubmitVoteRequest{GuestID: "guest-2", Votes: validVotes()})
+		assert.ErrorIs(t, err, services.ErrUnauthorized)
+	})
+
+	t.Run("guests of another party are refused", func(t *testing.T) {
+		svc := setup(t)
+
+		_, err := svc.SubmitVote(asGuest(ctx, "guest-1", "party-2"), "party-1", services.SubmitVoteRequest{Votes: validVotes()})
+
+		assert.ErrorIs(t, err, services.ErrUnauthorized)
+	})
+
+	t.Run("hosts must name the guest", func(t *testing.T) {
+		svc := setup(t)
+
+		_, err := svc.SubmitVote(asUser(ctx, "admin-1"), "party-1", services.SubmitVoteRequest{Votes: validVotes()})
+		assert.ErrorIs(t, err, services.ErrGuestIDRequired)
+
+		vote, err := svc.SubmitVote(asUser(ctx, "admin-1"), "party-1", services.SubmitVoteRequest{GuestID: "guest-2", Votes: validVotes()})
+		require.NoError(t, err)
+		assert.Equal(t, "guest-2", vote.GuestID)
+	})
+
+	t.Run("moderators read ballots but cannot cast them", func(t *testing.T) {
+		svc := setup(t)
+		_, err := svc.SubmitVote(asGuest(ctx, "guest-1", "party-1"), "party-1", services.SubmitVoteRequest{Votes: validVotes()})
+		require.NoError(t, err)
+
+		_, err = svc.GetVotes(asUser(ctx, "moderator-1"), "party-1", "guest-1")
+		require.NoError(t, err)
+
+		_, err = svc.SubmitVote(asUser(ctx, "moderator-1"), "party-1", services.SubmitVoteRequest{GuestID: "guest-2", Votes: validVotes()})
+		assert.ErrorIs(t, err, services.ErrUnauthorized)
+	})
+
+	t.Run("anonymous callers are refused", func(t *testing.T) {
+		svc := setup(t)
+
+		_, err := svc.SubmitVote(ctx, "party-1", services.SubmitVoteRequest{GuestID: "guest-1", Votes: validVotes()})
+
+		assert.ErrorIs(t, err, services.ErrUnauthorized)
+	})
+}
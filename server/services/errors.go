@@ -7,10 +7,41 @@ var (
 	ErrNotFound          = errors.New("party not found")
 	ErrDuplicateUsername = errors.New("duplicate username")
 	ErrInvalidEventType  = errors.New("invalid event type")
+	ErrUnknownContest    = errors.New("unknown contest")
 	ErrGuestNotApproved  = errors.New("guest not approved")
 	ErrPartyClosed       = errors.New("party is not active")
 	ErrVoteAlreadyExists = errors.New("vote already exists")
 	ErrInvalidVotes      = errors.New("invalid votes")
 	ErrVotingNotEnded    = errors.New("voting has not ended")
 	ErrInvalidUsername   = errors.New("invalid username")
+	ErrInvalidGuestToken = errors.New("invalid guest token")
+	ErrRevealInProgress  = errors.New("results reveal in progress")
+	ErrRevealComplete    = errors.New("results reveal already complete")
+	ErrActNotFound       = errors.New("act not found")
+	ErrActExists         = errors.New("act already exists")
+	ErrInvalidAct        = errors.New("invalid act")
+	ErrPredictionsLocked = errors.New("predictions are locked")
+	ErrPredictionsOpen   = errors.New("predictions are still open")
+	ErrInvalidPrediction = errors.New("invalid prediction")
+	ErrNoOutcome         = errors.New("prediction outcome not recorded")
+	ErrInvalidScoreboard = errors.New("invalid scoreboard")
+	ErrNoScoreboard      = errors.New("official scoreboard not imported")
+	ErrInvalidArchive    = errors.New("invalid party archive")
+	ErrUserNotFound      = errors.New("user not found")
+	ErrAmbiguousUser     = errors.New("more than one user matches")
+	ErrInvalidRole       = errors.New("invalid party role")
+	ErrAlreadyMember     = errors.New("user is already a party member")
+	ErrMemberNotFound    = errors.New("party member not found")
+	ErrGuestIDRequired   = errors.New("guest ID is required")
+	ErrJoinQueueFull     = errors.New("too many pending join requests")
+	ErrCatalogueEdited   = errors.New("acts edited since the catalogue file was loaded")
+)
+
+// Errors returned by the account service for local sign-in.
+var (
+	ErrInvalidEmail       = errors.New("invalid email address")
+	ErrInvalidPassword    = errors.New("invalid password")
+	ErrEmailTaken         = errors.New("email address already registered")
+	ErrInvalidCredentials = errors.New("invalid email or password")
+	ErrInvalidAuthToken   = errors.New("invalid auth token")
 )
@@ -0,0 +1,94 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sipgate/eurovision-vote-party/server/authz"
+	"github.com/sipgate/eurovision-vote-party/server/events"
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/persistence"
+)
+
+// EventPublisher receives notifications about party activity.
+type EventPublisher interface {
+	Publish(partyID string, typ events.Type, data any)
+}
+
+// EventSubscriber defines the event bus operations needed by the event service.
+type EventSubscriber interface {
+	Subscribe(partyID string, lastEventID uint64) (*events.Subscription, []events.Event)
+}
+
+// EventPartyDAO defines the minimal party persistence operations needed by the event service.
+type EventPartyDAO interface {
+	GetByID(ctx context.Context, id string) (*models.Party, error)
+}
+
+// EventGuestDAO defines the minimal guest persistence operations needed by the event service.
+type EventGuestDAO interface {
+	GetByID(ctx context.Context, id string) (*models.Guest, error)
+}
+
+// EventService defines the business logic operations for live party events.
+type EventService interface {
+	Subscribe(ctx context.Context, partyID string, lastEventID uint64) (*events.Subscription, []events.Event, error)
+}
+
+// eventService is the default implementation.
+type eventService struct {
+	partyDAO EventPartyDAO
+	guestDAO EventGuestDAO
+	bus      EventSubscriber
+}
+
+// NewEventService creates a new EventService.
+func NewEventService(partyDAO EventPartyDAO, guestDAO EventGuestDAO, bus EventSubscriber) EventService {
+	return &eventService{partyDAO: partyDAO, guestDAO: guestDAO, bus: bus}
+}
+
+// Subscribe starts streaming the events of a party to its hosts or one of its guests.
+// Guests must still belong to the party.
+func (s *eventService) Subscribe(ctx context.Context, partyID string, lastEventID uint64) (*events.Subscription, []events.Event, error) {
+	party, err := s.partyDAO.GetByID(ctx, partyID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNotFound) {
+			return nil, nil, ErrNotFound
+		}
+		return nil, nil, err
+	}
+
+	if err := authorize(ctx, authz.SubscribeEvents, party); err != nil {
+		return nil, nil, err
+	}
+
+	p := authz.FromContext(ctx)
+	if _, ok := party.RoleOf(p.UserID); !ok {
+		guest, err := s.guestDAO.GetByID(ctx, p.GuestID)
+		if err != nil {
+			if errors.Is(err, persistence.ErrNotFound) {
+				return nil, nil, ErrUnauthorized
+			}
+			return nil, nil, err
+		}
+		if guest.PartyID != partyID {
+			return nil, nil, ErrUnauthorized
+		}
+	}
+
+	sub, backlog := s.bus.Subscribe(partyID, lastEventID)
+	return sub, backlog, nil
+}
+
+// guestEventData is the payload of guest and vote events.
+// It only identifies the guest; clients fetch details through the regular endpoints.
+type guestEventData struct {
+	GuestID string `json:"guestId"`
+}
+
+// publish notifies subscribers of a party if an event publisher is configured.
+func publish(p EventPublisher, partyID string, typ events.Type, data any) {
+	if p != nil {
+		p.Publish(partyID, typ, data)
+	}
+}
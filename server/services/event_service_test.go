@@ -0,0 +1,133 @@
+package services_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sipgate/eurovision-vote-party/server/events"
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/persistence/memory"
+	"github.com/sipgate/eurovision-vote-party/server/services"
+)
+
+func TestEventService_Subscribe(t *testing.T) {
+	ctx := context.Background()
+
+	newService := func(t *testing.T) services.EventService {
+		store := memory.NewStore()
+		partyDAO := memory.NewPartyDAO(store)
+		guestDAO := memory.NewGuestDAO(store)
+		require.NoError(t, partyDAO.Create(ctx, &models.Party{
+			ID: "party-1", Name: "Party", Code: "ABC123", EventType: models.EventGrandFinal,
+			AdminID: "admin-1", Status: models.PartyStatusActive, CreatedAt: time.Now(),
+		}))
+		require.NoError(t, guestDAO.Create(ctx, &models.Guest{
+			ID: "guest-1", PartyID: "party-1", Username: "alice", Status: models.GuestStatusPending, CreatedAt: time.Now(),
+		}))
+		require.NoError(t, guestDAO.Create(ctx, &models.Guest{
+			ID: "guest-2", PartyID: "party-2", Username: "bob", Status: models.GuestStatusApproved, CreatedAt: time.Now(),
+		}))
+		return services.NewEventService(partyDAO, guestDAO, events.NewBus(events.DefaultHistory))
+	}
+
+	t.Run("subscribes the admin", func(t *testing.T) {
+		sub, _, err := newService(t).Subscribe(asUser(ctx, "admin-1"), "party-1", 0)
+
+		require.NoError(t, err)
+		sub.Close()
+	})
+
+	t.Run("subscribes a guest of the party", func(t *testing.T) {
+		sub, _, err := newService(t).Subscribe(asGuest(ctx, "guest-1", "party-1"), "party-1", 0)
+
+		require.NoError(t, err)
+		sub.Close()
+	})
+
+	t.Run("returns ErrUnauthorized for non-owner", func(t *testing.T) {
+		_, _, err := newService(t).Subscribe(asUser(ctx, "other-admin"), "party-1", 0)
+
+		assert.ErrorIs(t, err, services.ErrUnauthorized)
+	})
+
+	t.Run("returns ErrUnauthorized for guest of another party", func(t *testing.T) {
+		_, _, err := newService(t).Subscribe(asGuest(ctx, "guest-2", "party-1"), "party-1", 0)
+
+		assert.ErrorIs(t, err, services.ErrUnauthorized)
+	})
+
+	t.Run("returns ErrUnauthorized without identity", func(t *testing.T) {
+		_, _, err := newService(t).Subscribe(ctx, "party-1", 0)
+
+		assert.ErrorIs(t, err, services.ErrUnauthorized)
+	})
+
+	t.Run("returns ErrNotFound when party not found", func(t *testing.T) {
+		_, _, err := newService(t).Subscribe(asUser(ctx, "admin-1"), "nonexistent", 0)
+
+		assert.ErrorIs(t, err, services.ErrNotFound)
+	})
+}
+
+func TestServices_PublishPartyActivity(t *testing.T) {
+	ctx := context.Background()
+	store := memory.NewStore()
+	partyDAO := memory.NewPartyDAO(store)
+	guestDAO := memory.NewGuestDAO(store)
+	bus := events.NewBus(events.DefaultHistory)
+
+	partySvc := services.NewPartyService(partyDAO, nil)
+	guestSvc := services.NewGuestService(guestDAO, partyDAO, testTokenKey, bus)
+	voteSvc := services.NewVoteService(memory.NewVoteDAO(store), partyDAO, guestDAO, &mockVoteActsService{
+		listActsFunc: func(ctx context.Context, contest, eventType string) ([]models.Act, error) {
+			return testActs(), nil
+		},
+	}, bus)
+
+	party, err := partySvc.CreateParty(ctx, "admin-1", services.CreatePartyRequest{Name: "Live", EventType: models.EventGrandFinal})
+	require.NoError(t, err)
+
+	sub, _ := bus.Subscribe(party.ID, 0)
+	defer sub.Close()
+
+	alice, _, err := guestSvc.JoinParty(ctx, party.Code, "alice")
+	require.NoError(t, err)
+	bob, _, err := guestSvc.JoinParty(ctx, party.Code, "bob")
+	require.NoError(t, err)
+	carol, _, err := guestSvc.JoinParty(ctx, party.Code, "carol")
+	require.NoError(t, err)
+	require.NoError(t, guestSvc.ApproveGuest(asUser(ctx, "admin-1"), party.ID, alice.ID))
+	require.NoError(t, guestSvc.RejectGuest(asUser(ctx, "admin-1"), party.ID, bob.ID))
+	require.NoError(t, guestSvc.RemoveGuest(asUser(ctx, "admin-1"), party.ID, carol.ID))
+	_, err = voteSvc.SubmitVote(asGuest(ctx, alice.ID, party.ID), party.ID, services.SubmitVoteRequest{GuestID: alice.ID, Votes: validVotes()})
+	require.NoError(t, err)
+	_, err = voteSvc.UpdateVote(asGuest(ctx, alice.ID, party.ID), party.ID, services.SubmitVoteRequest{GuestID: alice.ID, Votes: validVotes()})
+	require.NoError(t, err)
+	_, err = voteSvc.EndVoting(asUser(ctx, "admin-1"), party.ID)
+	require.NoError(t, err)
+
+	want := []events.Type{
+		events.GuestJoined, events.GuestJoined, events.GuestJoined,
+		events.GuestApproved, events.GuestRejected, events.GuestRemoved,
+		events.VotingStarted, events.VoteSubmitted, events.VoteUpdated,
+		events.VotingEnded, events.ResultsAvailable,
+	}
+	for i, typ := range want {
+		event := <-sub.Events()
+		assert.Equal(t, uint64(i+1), event.ID)
+		assert.Equal(t, typ, event.Type)
+		assert.Equal(t, party.ID, event.PartyID)
+
+		if typ == events.VoteSubmitted || typ == events.VoteUpdated {
+			data, err := json.Marshal(event.Data)
+			require.NoError(t, err)
+			assert.JSONEq(t, `{"guestId":"`+alice.ID+`"}`, string(data), "vote events must not reveal ballot content")
+		}
+	}
+	assert.Empty(t, sub.Events())
+}
@@ -2,22 +2,31 @@ package services
 
 import (
 	"context"
+	"crypto/subtle"
 	"errors"
 	"time"
 
 	"github.com/google/uuid"
 
+	"github.com/sipgate/eurovision-vote-party/server/authz"
+	"github.com/sipgate/eurovision-vote-party/server/events"
 	"github.com/sipgate/eurovision-vote-party/server/models"
 	"github.com/sipgate/eurovision-vote-party/server/persistence"
 )
 
+// MaxPendingJoinRequests is how many join requests may await the host's
+// decision per party. Further guests are turned away until some are handled.
+const MaxPendingJoinRequests = 50
+
 // GuestDAO defines the persistence operations needed by the guest service.
 type GuestDAO interface {
 	Create(ctx context.Context, guest *models.Guest) error
+	CreatePending(ctx context.Context, guest *models.Guest, maxPending int) error
 	GetByID(ctx context.Context, id string) (*models.Guest, error)
 	ListByPartyID(ctx context.Context, partyID string) ([]*models.Guest, error)
 	ListByPartyIDAndStatus(ctx context.Context, partyID string, status models.GuestStatus) ([]*models.Guest, error)
 	UpdateStatus(ctx context.Context, id string, status models.GuestStatus) error
+	UpdateSessionID(ctx context.Context, id, sessionID string) error
 	Delete(ctx context.Context, id string) error
 	ExistsByPartyAndUsername(ctx context.Context, partyID, username string) (bool, error)
 }
@@ -30,13 +39,14 @@ type GuestPartyDAO interface {
 
 // GuestService defines the business logic operations for guests.
 type GuestService interface {
-	JoinParty(ctx context.Context, code, username string) (*models.Guest, error)
-	ListGuests(ctx context.Context, adminID, partyID string) ([]*models.Guest, error)
-	ListGuestsAsGuest(ctx context.Context, guestID, partyID string) ([]*models.Guest, error)
-	ListJoinRequests(ctx context.Context, adminID, partyID string) ([]*models.Guest, error)
-	ApproveGuest(ctx context.Context, adminID, partyID, guestID string) error
-	RejectGuest(ctx context.Context, adminID, partyID, guestID string) error
-	RemoveGuest(ctx context.Context, adminID, partyID, guestID string) error
+	JoinParty(ctx context.Context, code, username string) (*models.Guest, string, error)
+	VerifyGuestToken(ctx context.Context, token string) (guestID, partyID string, err error)
+	RevokeGuestSession(ctx context.Context, partyID, guestID string) error
+	ListGuests(ctx context.Context, partyID string) ([]*models.Guest, error)
+	ListJoinRequests(ctx context.Context, partyID string) ([]*models.Guest, error)
+	ApproveGuest(ctx context.Context, partyID, guestID string) error
+	RejectGuest(ctx context.Context, partyID, guestID string) error
+	RemoveGuest(ctx context.Context, partyID, guestID string) error
 	GetGuestStatus(ctx context.Context, code, guestID string) (*models.Guest, error)
 }
 
@@ -44,29 +54,40 @@ type GuestService interface {
 type guestService struct {
 	guestDAO GuestDAO
 	partyDAO GuestPartyDAO
+	tokenKey []byte
+	events   EventPublisher
 }
 
 // NewGuestService creates a new GuestService.
-func NewGuestService(guestDAO GuestDAO, partyDAO GuestPartyDAO) GuestService {
-	return &guestService{guestDAO: guestDAO, partyDAO: partyDAO}
+// tokenKey signs the guest session tokens issued by JoinParty.
+// Guest activity is published to publisher, which may be nil.
+func NewGuestService(guestDAO GuestDAO, partyDAO GuestPartyDAO, tokenKey []byte, publisher EventPublisher) GuestService {
+	return &guestService{guestDAO: guestDAO, partyDAO: partyDAO, tokenKey: tokenKey, events: publisher}
 }
 
 // JoinParty allows a guest to request joining a party by its public code.
-func (s *guestService) JoinParty(ctx context.Context, code, username string) (*models.Guest, error) {
+// It returns the created guest together with a session token that identifies the guest in later requests.
+// Returns ErrJoinQueueFull if MaxPendingJoinRequests are already pending.
+func (s *guestService) JoinParty(ctx context.Context, code, username string) (*models.Guest, string, error) {
 	party, err := s.partyDAO.GetByCode(ctx, code)
 	if err != nil {
 		if errors.Is(err, persistence.ErrNotFound) {
-			return nil, ErrNotFound
+			return nil, "", ErrNotFound
 		}
-		return nil, err
+		return nil, "", err
 	}
 
 	exists, err := s.guestDAO.ExistsByPartyAndUsername(ctx, party.ID, username)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	if exists {
-		return nil, ErrDuplicateUsername
+		return nil, "", ErrDuplicateUsername
+	}
+
+	sessionID, err := newSessionID()
+	if err != nil {
+		return nil, "", err
 	}
 
 	guest := &models.Guest{
@@ -75,55 +96,127 @@ func (s *guestService) JoinParty(ctx context.Context, code, username string) (*m
 		Username:  username,
 		Status:    models.GuestStatusPending,
 		CreatedAt: time.Now(),
+		SessionID: sessionID,
 	}
 
 	if err := guest.Validate(); err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
-	if err := s.guestDAO.Create(ctx, guest); err != nil {
-		return nil, err
+	token, err := signGuestToken(s.tokenKey, guestTokenClaims{GuestID: guest.ID, PartyID: guest.PartyID, SessionID: sessionID})
+	if err != nil {
+		return nil, "", err
 	}
 
-	return guest, nil
+	if err := s.guestDAO.CreatePending(ctx, guest, MaxPendingJoinRequests); err != nil {
+		switch {
+		case errors.Is(err, persistence.ErrUsernameExists):
+			return nil, "", ErrDuplicateUsername
+		case errors.Is(err, persistence.ErrPendingLimit):
+			return nil, "", ErrJoinQueueFull
+		case errors.Is(err, persistence.ErrNotFound):
+			// The party was deleted after it was looked up.
+			return nil, "", ErrNotFound
+		}
+		return nil, "", err
+	}
+
+	publish(s.events, guest.PartyID, events.GuestJoined, guestEventData{GuestID: guest.ID})
+	return guest, token, nil
 }
 
-// ListGuests returns all approved guests for a party, ensuring the requester is the admin.
-func (s *guestService) ListGuests(ctx context.Context, adminID, partyID string) ([]*models.Guest, error) {
-	party, err := s.partyDAO.GetByID(ctx, partyID)
+// VerifyGuestToken resolves a guest session token into the guest and party it was issued for.
+// Returns ErrInvalidGuestToken if the token is malformed, forged, revoked or the guest no longer exists.
+func (s *guestService) VerifyGuestToken(ctx context.Context, token string) (string, string, error) {
+	claims, err := parseGuestToken(s.tokenKey, token)
+	if err != nil {
+		return "", "", ErrInvalidGuestToken
+	}
+
+	guest, err := s.guestDAO.GetByID(ctx, claims.GuestID)
 	if err != nil {
 		if errors.Is(err, persistence.ErrNotFound) {
-			return nil, ErrNotFound
+			return "", "", ErrInvalidGuestToken
 		}
-		return nil, err
+		return "", "", err
 	}
 
-	if party.AdminID != adminID {
-		return nil, ErrUnauthorized
+	if guest.PartyID != claims.PartyID || guest.SessionID == "" ||
+		subtle.ConstantTimeCompare([]byte(guest.SessionID), []byte(claims.SessionID)) != 1 {
+		return "", "", ErrInvalidGuestToken
 	}
 
-	return s.guestDAO.ListByPartyIDAndStatus(ctx, partyID, models.GuestStatusApproved)
+	return guest.ID, guest.PartyID, nil
 }
 
-// ListGuestsAsGuest returns all approved guests for a party, ensuring the requester is an approved guest.
-func (s *guestService) ListGuestsAsGuest(ctx context.Context, guestID, partyID string) ([]*models.Guest, error) {
+// RevokeGuestSession invalidates all session tokens issued to a guest, ensuring the requester may manage guests.
+func (s *guestService) RevokeGuestSession(ctx context.Context, partyID, guestID string) error {
+	party, err := s.partyDAO.GetByID(ctx, partyID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNotFound) {
+			return ErrNotFound
+		}
+		return err
+	}
+
+	if err := authorize(ctx, authz.ManageGuests, party); err != nil {
+		return err
+	}
+
 	guest, err := s.guestDAO.GetByID(ctx, guestID)
 	if err != nil {
 		if errors.Is(err, persistence.ErrNotFound) {
-			return nil, ErrUnauthorized
+			return ErrNotFound
+		}
+		return err
+	}
+
+	if guest.PartyID != partyID {
+		return ErrNotFound
+	}
+
+	sessionID, err := newSessionID()
+	if err != nil {
+		return err
+	}
+
+	return s.guestDAO.UpdateSessionID(ctx, guestID, sessionID)
+}
+
+// ListGuests returns all approved guests for a party, ensuring the requester
+// may list them. Guests see their fellow guests once they are approved themselves.
+func (s *guestService) ListGuests(ctx context.Context, partyID string) ([]*models.Guest, error) {
+	party, err := s.partyDAO.GetByID(ctx, partyID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNotFound) {
+			return nil, ErrNotFound
 		}
 		return nil, err
 	}
 
-	if guest.PartyID != partyID || guest.Status != models.GuestStatusApproved {
-		return nil, ErrUnauthorized
+	if err := authorize(ctx, authz.ListGuests, party); err != nil {
+		return nil, err
+	}
+
+	p := authz.FromContext(ctx)
+	if _, ok := party.RoleOf(p.UserID); !ok {
+		guest, err := s.guestDAO.GetByID(ctx, p.GuestID)
+		if err != nil {
+			if errors.Is(err, persistence.ErrNotFound) {
+				return nil, ErrUnauthorized
+			}
+			return nil, err
+		}
+		if guest.PartyID != partyID || guest.Status != models.GuestStatusApproved {
+			return nil, ErrUnauthorized
+		}
 	}
 
 	return s.guestDAO.ListByPartyIDAndStatus(ctx, partyID, models.GuestStatusApproved)
 }
 
-// ListJoinRequests returns all pending guests for a party, ensuring the requester is the admin.
-func (s *guestService) ListJoinRequests(ctx context.Context, adminID, partyID string) ([]*models.Guest, error) {
+// ListJoinRequests returns all pending guests for a party, ensuring the requester may manage guests.
+func (s *guestService) ListJoinRequests(ctx context.Context, partyID string) ([]*models.Guest, error) {
 	party, err := s.partyDAO.GetByID(ctx, partyID)
 	if err != nil {
 		if errors.Is(err, persistence.ErrNotFound) {
@@ -132,15 +225,15 @@ func (s *guestService) ListJoinRequests(ctx context.Context, adminID, partyID st
 		return nil, err
 	}
 
-	if party.AdminID != adminID {
-		return nil, ErrUnauthorized
+	if err := authorize(ctx, authz.ManageGuests, party); err != nil {
+		return nil, err
 	}
 
 	return s.guestDAO.ListByPartyIDAndStatus(ctx, partyID, models.GuestStatusPending)
 }
 
-// ApproveGuest approves a pending guest, ensuring the requester is the admin.
-func (s *guestService) ApproveGuest(ctx context.Context, adminID, partyID, guestID string) error {
+// ApproveGuest approves a pending guest, ensuring the requester may manage guests.
+func (s *guestService) ApproveGuest(ctx context.Context, partyID, guestID string) error {
 	party, err := s.partyDAO.GetByID(ctx, partyID)
 	if err != nil {
 		if errors.Is(err, persistence.ErrNotFound) {
@@ -149,8 +242,8 @@ func (s *guestService) ApproveGuest(ctx context.Context, adminID, partyID, guest
 		return err
 	}
 
-	if party.AdminID != adminID {
-		return ErrUnauthorized
+	if err := authorize(ctx, authz.ManageGuests, party); err != nil {
+		return err
 	}
 
 	guest, err := s.guestDAO.GetByID(ctx, guestID)
@@ -165,11 +258,16 @@ func (s *guestService) ApproveGuest(ctx context.Context, adminID, partyID, guest
 		return ErrNotFound
 	}
 
-	return s.guestDAO.UpdateStatus(ctx, guestID, models.GuestStatusApproved)
+	if err := s.guestDAO.UpdateStatus(ctx, guestID, models.GuestStatusApproved); err != nil {
+		return err
+	}
+
+	publish(s.events, partyID, events.GuestApproved, guestEventData{GuestID: guestID})
+	return nil
 }
 
-// RejectGuest rejects a pending guest, ensuring the requester is the admin.
-func (s *guestService) RejectGuest(ctx context.Context, adminID, partyID, guestID string) error {
+// RejectGuest rejects a pending guest, ensuring the requester may manage guests.
+func (s *guestService) RejectGuest(ctx context.Context, partyID, guestID string) error {
 	party, err := s.partyDAO.GetByID(ctx, partyID)
 	if err != nil {
 		if errors.Is(err, persistence.ErrNotFound) {
@@ -178,8 +276,8 @@ func (s *guestService) RejectGuest(ctx context.Context, adminID, partyID, guestI
 		return err
 	}
 
-	if party.AdminID != adminID {
-		return ErrUnauthorized
+	if err := authorize(ctx, authz.ManageGuests, party); err != nil {
+		return err
 	}
 
 	guest, err := s.guestDAO.GetByID(ctx, guestID)
@@ -194,11 +292,16 @@ func (s *guestService) RejectGuest(ctx context.Context, adminID, partyID, guestI
 		return ErrNotFound
 	}
 
-	return s.guestDAO.UpdateStatus(ctx, guestID, models.GuestStatusRejected)
+	if err := s.guestDAO.UpdateStatus(ctx, guestID, models.GuestStatusRejected); err != nil {
+		return err
+	}
+
+	publish(s.events, partyID, events.GuestRejected, guestEventData{GuestID: guestID})
+	return nil
 }
 
-// RemoveGuest deletes a guest from a party, ensuring the requester is the admin.
-func (s *guestService) RemoveGuest(ctx context.Context, adminID, partyID, guestID string) error {
+// RemoveGuest deletes a guest from a party, ensuring the requester may manage guests.
+func (s *guestService) RemoveGuest(ctx context.Context, partyID, guestID string) error {
 	party, err := s.partyDAO.GetByID(ctx, partyID)
 	if err != nil {
 		if errors.Is(err, persistence.ErrNotFound) {
@@ -207,8 +310,8 @@ func (s *guestService) RemoveGuest(ctx context.Context, adminID, partyID, guestI
 		return err
 	}
 
-	if party.AdminID != adminID {
-		return ErrUnauthorized
+	if err := authorize(ctx, authz.ManageGuests, party); err != nil {
+		return err
 	}
 
 	guest, err := s.guestDAO.GetByID(ctx, guestID)
@@ -223,7 +326,12 @@ func (s *guestService) RemoveGuest(ctx context.Context, adminID, partyID, guestI
 		return ErrNotFound
 	}
 
-	return s.guestDAO.Delete(ctx, guestID)
+	if err := s.guestDAO.Delete(ctx, guestID); err != nil {
+		return err
+	}
+
+	publish(s.events, partyID, events.GuestRemoved, guestEventData{GuestID: guestID})
+	return nil
 }
 
 // GetGuestStatus retrieves a guest's status by party code and guest ID.
@@ -10,16 +10,21 @@ import (
 
 	"github.com/sipgate/eurovision-vote-party/server/models"
 	"github.com/sipgate/eurovision-vote-party/server/persistence"
+	"github.com/sipgate/eurovision-vote-party/server/persistence/memory"
 	"github.com/sipgate/eurovision-vote-party/server/services"
 )
 
+var testTokenKey = []byte("test-guest-token-key")
+
 // mockGuestDAO mocks the GuestDAO interface used by the guest service.
 type mockGuestDAO struct {
 	createFunc                func(ctx context.Context, guest *models.Guest) error
+	createPendingFunc         func(ctx context.Context, guest *models.Guest, maxPending int) error
 	getByIDFunc               func(ctx context.Context, id string) (*models.Guest, error)
 	listByPartyIDFunc         func(ctx context.Context, partyID string) ([]*models.Guest, error)
 	listByPartyIDAndStatusFunc func(ctx context.Context, partyID string, status models.GuestStatus) ([]*models.Guest, error)
 	updateStatusFunc          func(ctx context.Context, id string, status models.GuestStatus) error
+	updateSessionIDFunc       func(ctx context.Context, id, sessionID string) error
 	deleteFunc                func(ctx context.Context, id string) error
 	existsByPartyAndUsernameFunc func(ctx context.Context, partyID, username string) (bool, error)
 }
@@ -31,6 +36,13 @@ func (m *mockGuestDAO) Create(ctx context.Context, guest *models.Guest) error {
 	return nil
 }
 
+func (m *mockGuestDAO) CreatePending(ctx context.Context, guest *models.Guest, maxPending int) error {
+	if m.createPendingFunc != nil {
+		return m.createPendingFunc(ctx, guest, maxPending)
+	}
+	return nil
+}
+
 func (m *mockGuestDAO) GetByID(ctx context.Context, id string) (*models.Guest, error) {
 	if m.getByIDFunc != nil {
 		return m.getByIDFunc(ctx, id)
@@ -59,6 +71,13 @@ func (m *mockGuestDAO) UpdateStatus(ctx context.Context, id string, status model
 	return nil
 }
 
+func (m *mockGuestDAO) UpdateSessionID(ctx context.Context, id, sessionID string) error {
+	if m.updateSessionIDFunc != nil {
+		return m.updateSessionIDFunc(ctx, id, sessionID)
+	}
+	return nil
+}
+
 func (m *mockGuestDAO) Delete(ctx context.Context, id string) error {
 	if m.deleteFunc != nil {
 		return m.deleteFunc(ctx, id)
@@ -110,7 +129,7 @@ func TestGuestService_JoinParty(t *testing.T) {
 			existsByPartyAndUsernameFunc: func(ctx context.Context, partyID, username string) (bool, error) {
 				return false, nil
 			},
-			createFunc: func(ctx context.Context, guest *models.Guest) error {
+			createPendingFunc: func(ctx context.Context, guest *models.Guest, maxPending int) error {
 				createdGuest = guest
 				return nil
 			},
@@ -124,12 +143,14 @@ func TestGuestService_JoinParty(t *testing.T) {
 			},
 		}
 
-		svc := services.NewGuestService(guestDAO, partyDAO)
+		svc := services.NewGuestService(guestDAO, partyDAO, testTokenKey, nil)
 		ctx := context.Background()
 
-		guest, err := svc.JoinParty(ctx, "ABC123", "alice")
+		guest, token, err := svc.JoinParty(ctx, "ABC123", "alice")
 
 		require.NoError(t, err)
+		assert.NotEmpty(t, token)
+		assert.NotEmpty(t, guest.SessionID)
 		assert.NotEmpty(t, guest.ID)
 		assert.Equal(t, "party-1", guest.PartyID)
 		assert.Equal(t, "alice", guest.Username)
@@ -146,10 +167,10 @@ func TestGuestService_JoinParty(t *testing.T) {
 			},
 		}
 
-		svc := services.NewGuestService(guestDAO, partyDAO)
+		svc := services.NewGuestService(guestDAO, partyDAO, testTokenKey, nil)
 		ctx := context.Background()
 
-		guest, err := svc.JoinParty(ctx, "NONEXISTENT", "alice")
+		guest, _, err := svc.JoinParty(ctx, "NONEXISTENT", "alice")
 
 		assert.ErrorIs(t, err, services.ErrNotFound)
 		assert.Nil(t, guest)
@@ -180,14 +201,76 @@ func TestGuestService_JoinParty(t *testing.T) {
 			},
 		}
 
-		svc := services.NewGuestService(guestDAO, partyDAO)
+		svc := services.NewGuestService(guestDAO, partyDAO, testTokenKey, nil)
+		ctx := context.Background()
+
+		guest, _, err := svc.JoinParty(ctx, "ABC123", "alice")
+
+		assert.ErrorIs(t, err, services.ErrDuplicateUsername)
+		assert.Nil(t, guest)
+	})
+	t.Run("returns ErrDuplicateUsername when storage rejects concurrent duplicate", func(t *testing.T) {
+		existingParty := &models.Party{
+			ID:        "party-1",
+			Name:      "Test Party",
+			Code:      "ABC123",
+			EventType: models.EventGrandFinal,
+			AdminID:   "admin-1",
+			Status:    models.PartyStatusActive,
+			CreatedAt: time.Now(),
+		}
+
+		guestDAO := &mockGuestDAO{
+			createPendingFunc: func(ctx context.Context, guest *models.Guest, maxPending int) error {
+				return persistence.ErrUsernameExists
+			},
+		}
+		partyDAO := &mockGuestPartyDAO{
+			getByCodeFunc: func(ctx context.Context, code string) (*models.Party, error) {
+				return existingParty, nil
+			},
+		}
+
+		svc := services.NewGuestService(guestDAO, partyDAO, testTokenKey, nil)
 		ctx := context.Background()
 
-		guest, err := svc.JoinParty(ctx, "ABC123", "alice")
+		guest, _, err := svc.JoinParty(ctx, "ABC123", "alice")
 
 		assert.ErrorIs(t, err, services.ErrDuplicateUsername)
 		assert.Nil(t, guest)
 	})
+
+	t.Run("returns ErrJoinQueueFull when too many join requests are pending", func(t *testing.T) {
+		existingParty := &models.Party{
+			ID:        "party-1",
+			Name:      "Test Party",
+			Code:      "ABC123",
+			EventType: models.EventGrandFinal,
+			AdminID:   "admin-1",
+			Status:    models.PartyStatusActive,
+			CreatedAt: time.Now(),
+		}
+
+		guestDAO := &mockGuestDAO{
+			createPendingFunc: func(ctx context.Context, guest *models.Guest, maxPending int) error {
+				assert.Equal(t, services.MaxPendingJoinRequests, maxPending)
+				return persistence.ErrPendingLimit
+			},
+		}
+		partyDAO := &mockGuestPartyDAO{
+			getByCodeFunc: func(ctx context.Context, code string) (*models.Party, error) {
+				return existingParty, nil
+			},
+		}
+
+		svc := services.NewGuestService(guestDAO, partyDAO, testTokenKey, nil)
+		ctx := context.Background()
+
+		guest, _, err := svc.JoinParty(ctx, "ABC123", "alice")
+
+		assert.ErrorIs(t, err, services.ErrJoinQueueFull)
+		assert.Nil(t, guest)
+	})
 }
 
 func TestGuestService_ListGuests(t *testing.T) {
@@ -236,10 +319,10 @@ func TestGuestService_ListGuests(t *testing.T) {
 			},
 		}
 
-		svc := services.NewGuestService(guestDAO, partyDAO)
+		svc := services.NewGuestService(guestDAO, partyDAO, testTokenKey, nil)
 		ctx := context.Background()
 
-		guests, err := svc.ListGuests(ctx, "admin-1", "party-1")
+		guests, err := svc.ListGuests(asUser(ctx, "admin-1"), "party-1")
 
 		require.NoError(t, err)
 		assert.Len(t, guests, 2)
@@ -264,10 +347,10 @@ func TestGuestService_ListGuests(t *testing.T) {
 			},
 		}
 
-		svc := services.NewGuestService(guestDAO, partyDAO)
+		svc := services.NewGuestService(guestDAO, partyDAO, testTokenKey, nil)
 		ctx := context.Background()
 
-		guests, err := svc.ListGuests(ctx, "other-admin", "party-1")
+		guests, err := svc.ListGuests(asUser(ctx, "other-admin"), "party-1")
 
 		assert.ErrorIs(t, err, services.ErrUnauthorized)
 		assert.Nil(t, guests)
@@ -281,17 +364,17 @@ func TestGuestService_ListGuests(t *testing.T) {
 			},
 		}
 
-		svc := services.NewGuestService(guestDAO, partyDAO)
+		svc := services.NewGuestService(guestDAO, partyDAO, testTokenKey, nil)
 		ctx := context.Background()
 
-		guests, err := svc.ListGuests(ctx, "admin-1", "nonexistent")
+		guests, err := svc.ListGuests(asUser(ctx, "admin-1"), "nonexistent")
 
 		assert.ErrorIs(t, err, services.ErrNotFound)
 		assert.Nil(t, guests)
 	})
 }
 
-func TestGuestService_ListGuestsAsGuest(t *testing.T) {
+func TestGuestService_ListGuests_AsGuest(t *testing.T) {
 	t.Run("returns approved guests for approved guest", func(t *testing.T) {
 		existingGuest := &models.Guest{
 			ID:        "guest-1",
@@ -326,12 +409,16 @@ func TestGuestService_ListGuestsAsGuest(t *testing.T) {
 				return []*models.Guest{}, nil
 			},
 		}
-		partyDAO := &mockGuestPartyDAO{}
+		partyDAO := &mockGuestPartyDAO{
+			getByIDFunc: func(ctx context.Context, id string) (*models.Party, error) {
+				return &models.Party{ID: "party-1", AdminID: "admin-1"}, nil
+			},
+		}
 
-		svc := services.NewGuestService(guestDAO, partyDAO)
+		svc := services.NewGuestService(guestDAO, partyDAO, testTokenKey, nil)
 		ctx := context.Background()
 
-		guests, err := svc.ListGuestsAsGuest(ctx, "guest-1", "party-1")
+		guests, err := svc.ListGuests(asGuest(ctx, "guest-1", "party-1"), "party-1")
 
 		require.NoError(t, err)
 		assert.Len(t, guests, 2)
@@ -355,12 +442,16 @@ func TestGuestService_ListGuestsAsGuest(t *testing.T) {
 				return nil, persistence.ErrNotFound
 			},
 		}
-		partyDAO := &mockGuestPartyDAO{}
+		partyDAO := &mockGuestPartyDAO{
+			getByIDFunc: func(ctx context.Context, id string) (*models.Party, error) {
+				return &models.Party{ID: "party-1", AdminID: "admin-1"}, nil
+			},
+		}
 
-		svc := services.NewGuestService(guestDAO, partyDAO)
+		svc := services.NewGuestService(guestDAO, partyDAO, testTokenKey, nil)
 		ctx := context.Background()
 
-		guests, err := svc.ListGuestsAsGuest(ctx, "guest-1", "party-1")
+		guests, err := svc.ListGuests(asGuest(ctx, "guest-1", "party-1"), "party-1")
 
 		assert.ErrorIs(t, err, services.ErrUnauthorized)
 		assert.Nil(t, guests)
@@ -383,12 +474,16 @@ func TestGuestService_ListGuestsAsGuest(t *testing.T) {
 				return nil, persistence.ErrNotFound
 			},
 		}
-		partyDAO := &mockGuestPartyDAO{}
+		partyDAO := &mockGuestPartyDAO{
+			getByIDFunc: func(ctx context.Context, id string) (*models.Party, error) {
+				return &models.Party{ID: "party-1", AdminID: "admin-1"}, nil
+			},
+		}
 
-		svc := services.NewGuestService(guestDAO, partyDAO)
+		svc := services.NewGuestService(guestDAO, partyDAO, testTokenKey, nil)
 		ctx := context.Background()
 
-		guests, err := svc.ListGuestsAsGuest(ctx, "guest-1", "party-1")
+		guests, err := svc.ListGuests(asGuest(ctx, "guest-1", "party-1"), "party-1")
 
 		assert.ErrorIs(t, err, services.ErrUnauthorized)
 		assert.Nil(t, guests)
@@ -434,10 +529,10 @@ func TestGuestService_ListJoinRequests(t *testing.T) {
 			},
 		}
 
-		svc := services.NewGuestService(guestDAO, partyDAO)
+		svc := services.NewGuestService(guestDAO, partyDAO, testTokenKey, nil)
 		ctx := context.Background()
 
-		guests, err := svc.ListJoinRequests(ctx, "admin-1", "party-1")
+		guests, err := svc.ListJoinRequests(asUser(ctx, "admin-1"), "party-1")
 
 		require.NoError(t, err)
 		assert.Len(t, guests, 1)
@@ -462,10 +557,10 @@ func TestGuestService_ListJoinRequests(t *testing.T) {
 			},
 		}
 
-		svc := services.NewGuestService(guestDAO, partyDAO)
+		svc := services.NewGuestService(guestDAO, partyDAO, testTokenKey, nil)
 		ctx := context.Background()
 
-		guests, err := svc.ListJoinRequests(ctx, "other-admin", "party-1")
+		guests, err := svc.ListJoinRequests(asUser(ctx, "other-admin"), "party-1")
 
 		assert.ErrorIs(t, err, services.ErrUnauthorized)
 		assert.Nil(t, guests)
@@ -516,10 +611,10 @@ func TestGuestService_ApproveGuest(t *testing.T) {
 			},
 		}
 
-		svc := services.NewGuestService(guestDAO, partyDAO)
+		svc := services.NewGuestService(guestDAO, partyDAO, testTokenKey, nil)
 		ctx := context.Background()
 
-		err := svc.ApproveGuest(ctx, "admin-1", "party-1", "guest-1")
+		err := svc.ApproveGuest(asUser(ctx, "admin-1"), "party-1", "guest-1")
 
 		require.NoError(t, err)
 		assert.True(t, updateStatusCalled)
@@ -549,10 +644,10 @@ func TestGuestService_ApproveGuest(t *testing.T) {
 			},
 		}
 
-		svc := services.NewGuestService(guestDAO, partyDAO)
+		svc := services.NewGuestService(guestDAO, partyDAO, testTokenKey, nil)
 		ctx := context.Background()
 
-		err := svc.ApproveGuest(ctx, "other-admin", "party-1", "guest-1")
+		err := svc.ApproveGuest(asUser(ctx, "other-admin"), "party-1", "guest-1")
 
 		assert.ErrorIs(t, err, services.ErrUnauthorized)
 		assert.False(t, updateStatusCalled)
@@ -583,10 +678,10 @@ func TestGuestService_ApproveGuest(t *testing.T) {
 			},
 		}
 
-		svc := services.NewGuestService(guestDAO, partyDAO)
+		svc := services.NewGuestService(guestDAO, partyDAO, testTokenKey, nil)
 		ctx := context.Background()
 
-		err := svc.ApproveGuest(ctx, "admin-1", "party-1", "nonexistent")
+		err := svc.ApproveGuest(asUser(ctx, "admin-1"), "party-1", "nonexistent")
 
 		assert.ErrorIs(t, err, services.ErrNotFound)
 	})
@@ -627,10 +722,10 @@ func TestGuestService_ApproveGuest(t *testing.T) {
 			},
 		}
 
-		svc := services.NewGuestService(guestDAO, partyDAO)
+		svc := services.NewGuestService(guestDAO, partyDAO, testTokenKey, nil)
 		ctx := context.Background()
 
-		err := svc.ApproveGuest(ctx, "admin-1", "party-1", "guest-1")
+		err := svc.ApproveGuest(asUser(ctx, "admin-1"), "party-1", "guest-1")
 
 		assert.ErrorIs(t, err, services.ErrNotFound)
 	})
@@ -680,10 +775,10 @@ func TestGuestService_RejectGuest(t *testing.T) {
 			},
 		}
 
-		svc := services.NewGuestService(guestDAO, partyDAO)
+		svc := services.NewGuestService(guestDAO, partyDAO, testTokenKey, nil)
 		ctx := context.Background()
 
-		err := svc.RejectGuest(ctx, "admin-1", "party-1", "guest-1")
+		err := svc.RejectGuest(asUser(ctx, "admin-1"), "party-1", "guest-1")
 
 		require.NoError(t, err)
 		assert.True(t, updateStatusCalled)
@@ -713,10 +808,10 @@ func TestGuestService_RejectGuest(t *testing.T) {
 			},
 		}
 
-		svc := services.NewGuestService(guestDAO, partyDAO)
+		svc := services.NewGuestService(guestDAO, partyDAO, testTokenKey, nil)
 		ctx := context.Background()
 
-		err := svc.RejectGuest(ctx, "other-admin", "party-1", "guest-1")
+		err := svc.RejectGuest(asUser(ctx, "other-admin"), "party-1", "guest-1")
 
 		assert.ErrorIs(t, err, services.ErrUnauthorized)
 		assert.False(t, updateStatusCalled)
@@ -766,10 +861,10 @@ func TestGuestService_RemoveGuest(t *testing.T) {
 			},
 		}
 
-		svc := services.NewGuestService(guestDAO, partyDAO)
+		svc := services.NewGuestService(guestDAO, partyDAO, testTokenKey, nil)
 		ctx := context.Background()
 
-		err := svc.RemoveGuest(ctx, "admin-1", "party-1", "guest-1")
+		err := svc.RemoveGuest(asUser(ctx, "admin-1"), "party-1", "guest-1")
 
 		require.NoError(t, err)
 		assert.True(t, deleteCalled)
@@ -799,10 +894,10 @@ func TestGuestService_RemoveGuest(t *testing.T) {
 			},
 		}
 
-		svc := services.NewGuestService(guestDAO, partyDAO)
+		svc := services.NewGuestService(guestDAO, partyDAO, testTokenKey, nil)
 		ctx := context.Background()
 
-		err := svc.RemoveGuest(ctx, "other-admin", "party-1", "guest-1")
+		err := svc.RemoveGuest(asUser(ctx, "other-admin"), "party-1", "guest-1")
 
 		assert.ErrorIs(t, err, services.ErrUnauthorized)
 		assert.False(t, deleteCalled)
@@ -833,10 +928,10 @@ func TestGuestService_RemoveGuest(t *testing.T) {
 			},
 		}
 
-		svc := services.NewGuestService(guestDAO, partyDAO)
+		svc := services.NewGuestService(guestDAO, partyDAO, testTokenKey, nil)
 		ctx := context.Background()
 
-		err := svc.RemoveGuest(ctx, "admin-1", "party-1", "nonexistent")
+		err := svc.RemoveGuest(asUser(ctx, "admin-1"), "party-1", "nonexistent")
 
 		assert.ErrorIs(t, err, services.ErrNotFound)
 	})
@@ -879,7 +974,7 @@ func TestGuestService_GetGuestStatus(t *testing.T) {
 			},
 		}
 
-		svc := services.NewGuestService(guestDAO, partyDAO)
+		svc := services.NewGuestService(guestDAO, partyDAO, testTokenKey, nil)
 		ctx := context.Background()
 
 		guest, err := svc.GetGuestStatus(ctx, "ABC123", "guest-1")
@@ -896,7 +991,7 @@ func TestGuestService_GetGuestStatus(t *testing.T) {
 			},
 		}
 
-		svc := services.NewGuestService(guestDAO, partyDAO)
+		svc := services.NewGuestService(guestDAO, partyDAO, testTokenKey, nil)
 		ctx := context.Background()
 
 		guest, err := svc.GetGuestStatus(ctx, "NONEXISTENT", "guest-1")
@@ -930,7 +1025,7 @@ func TestGuestService_GetGuestStatus(t *testing.T) {
 			},
 		}
 
-		svc := services.NewGuestService(guestDAO, partyDAO)
+		svc := services.NewGuestService(guestDAO, partyDAO, testTokenKey, nil)
 		ctx := context.Background()
 
 		guest, err := svc.GetGuestStatus(ctx, "ABC123", "nonexistent")
@@ -975,7 +1070,7 @@ func TestGuestService_GetGuestStatus(t *testing.T) {
 			},
 		}
 
-		svc := services.NewGuestService(guestDAO, partyDAO)
+		svc := services.NewGuestService(guestDAO, partyDAO, testTokenKey, nil)
 		ctx := context.Background()
 
 		guest, err := svc.GetGuestStatus(ctx, "ABC123", "guest-1")
@@ -984,3 +1079,141 @@ func TestGuestService_GetGuestStatus(t *testing.T) {
 		assert.Nil(t, guest)
 	})
 }
+
+func TestGuestService_VerifyGuestToken(t *testing.T) {
+	ctx := context.Background()
+
+	newServices := func(t *testing.T) (services.GuestService, *models.Party) {
+		store := memory.NewStore()
+		partyDAO := memory.NewPartyDAO(store)
+		party := &models.Party{
+			ID:        "party-1",
+			Name:      "Test Party",
+			Code:      "ABC123",
+			EventType: models.EventGrandFinal,
+			AdminID:   "admin-1",
+			Status:    models.PartyStatusActive,
+			CreatedAt: time.Now(),
+		}
+		require.NoError(t, partyDAO.Create(ctx, party))
+		return services.NewGuestService(memory.NewGuestDAO(store), partyDAO, testTokenKey, nil), party
+	}
+
+	t.Run("resolves token issued by JoinParty", func(t *testing.T) {
+		svc, party := newServices(t)
+		guest, token, err := svc.JoinParty(ctx, party.Code, "alice")
+		require.NoError(t, err)
+
+		guestID, partyID, err := svc.VerifyGuestToken(ctx, token)
+
+		require.NoError(t, err)
+		assert.Equal(t, guest.ID, guestID)
+		assert.Equal(t, party.ID, partyID)
+	})
+
+	t.Run("rejects tampered token", func(t *testing.T) {
+		svc, party := newServices(t)
+		_, token, err := svc.JoinParty(ctx, party.Code, "alice")
+		require.NoError(t, err)
+
+		_, _, err = svc.VerifyGuestToken(ctx, token[:len(token)-2]+"xx")
+
+		assert.ErrorIs(t, err, services.ErrInvalidGuestToken)
+	})
+
+	t.Run("rejects token signed with another key", func(t *testing.T) {
+		svc, party := newServices(t)
+		_, token, err := svc.JoinParty(ctx, party.Code, "alice")
+		require.NoError(t, err)
+		other := services.NewGuestService(&mockGuestDAO{}, &mockGuestPartyDAO{}, []byte("another-key"), nil)
+
+		_, _, err = other.VerifyGuestToken(ctx, token)
+
+		assert.ErrorIs(t, err, services.ErrInvalidGuestToken)
+	})
+
+	t.Run("rejects malformed token", func(t *testing.T) {
+		svc, _ := newServices(t)
+
+		for _, token := range []string{"", "guest-1", "v1.abc", "v2.abc.def"} {
+			_, _, err := svc.VerifyGuestToken(ctx, token)
+			assert.ErrorIs(t, err, services.ErrInvalidGuestToken, token)
+		}
+	})
+
+	t.Run("rejects token of removed guest", func(t *testing.T) {
+		svc, party := newServices(t)
+		guest, token, err := svc.JoinParty(ctx, party.Code, "alice")
+		require.NoError(t, err)
+		require.NoError(t, svc.RemoveGuest(asUser(ctx, "admin-1"), party.ID, guest.ID))
+
+		_, _, err = svc.VerifyGuestToken(ctx, token)
+
+		assert.ErrorIs(t, err, services.ErrInvalidGuestToken)
+	})
+
+	t.Run("rejects token after session is revoked", func(t *testing.T) {
+		svc, party := newServices(t)
+		guest, token, err := svc.JoinParty(ctx, party.Code, "alice")
+		require.NoError(t, err)
+
+		require.NoError(t, svc.RevokeGuestSession(asUser(ctx, "admin-1"), party.ID, guest.ID))
+
+		_, _, err = svc.VerifyGuestToken(ctx, token)
+		assert.ErrorIs(t, err, services.ErrInvalidGuestToken)
+	})
+}
+
+func TestGuestService_RevokeGuestSession(t *testing.T) {
+	existingParty := &models.Party{
+		ID:      "party-1",
+		AdminID: "admin-1",
+	}
+	partyDAO := &mockGuestPartyDAO{
+		getByIDFunc: func(ctx context.Context, id string) (*models.Party, error) {
+			return existingParty, nil
+		},
+	}
+
+	t.Run("rotates the session of the guest", func(t *testing.T) {
+		var updatedID, updatedSession string
+		guestDAO := &mockGuestDAO{
+			getByIDFunc: func(ctx context.Context, id string) (*models.Guest, error) {
+				return &models.Guest{ID: id, PartyID: "party-1", SessionID: "old"}, nil
+			},
+			updateSessionIDFunc: func(ctx context.Context, id, sessionID string) error {
+				updatedID, updatedSession = id, sessionID
+				return nil
+			},
+		}
+
+		svc := services.NewGuestService(guestDAO, partyDAO, testTokenKey, nil)
+		err := svc.RevokeGuestSession(asUser(context.Background(), "admin-1"), "party-1", "guest-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, "guest-1", updatedID)
+		assert.NotEmpty(t, updatedSession)
+		assert.NotEqual(t, "old", updatedSession)
+	})
+
+	t.Run("returns ErrUnauthorized for non-owner", func(t *testing.T) {
+		svc := services.NewGuestService(&mockGuestDAO{}, partyDAO, testTokenKey, nil)
+
+		err := svc.RevokeGuestSession(asUser(context.Background(), "other-admin"), "party-1", "guest-1")
+
+		assert.ErrorIs(t, err, services.ErrUnauthorized)
+	})
+
+	t.Run("returns ErrNotFound when guest belongs to different party", func(t *testing.T) {
+		guestDAO := &mockGuestDAO{
+			getByIDFunc: func(ctx context.Context, id string) (*models.Guest, error) {
+				return &models.Guest{ID: id, PartyID: "party-2"}, nil
+			},
+		}
+
+		svc := services.NewGuestService(guestDAO, partyDAO, testTokenKey, nil)
+		err := svc.RevokeGuestSession(asUser(context.Background(), "admin-1"), "party-1", "guest-1")
+
+		assert.ErrorIs(t, err, services.ErrNotFound)
+	})
+}
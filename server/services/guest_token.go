@@ -0,0 +1,79 @@
+package services
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+// guestTokenVersion prefixes every guest session token so the format can evolve.
+const guestTokenVersion = "v1"
+
+// errMalformedGuestToken is returned when a token cannot be decoded or its signature does not match.
+var errMalformedGuestToken = errors.New("malformed guest token")
+
+// guestTokenClaims is the signed payload of a guest session token.
+type guestTokenClaims struct {
+	GuestID   string `json:"g"`
+	PartyID   string `json:"p"`
+	SessionID string `json:"s"`
+}
+
+// signGuestToken encodes the claims as "v1.<payload>.<signature>" using HMAC-SHA256.
+func signGuestToken(key []byte, claims guestTokenClaims) (string, error) {
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	encoded := guestTokenVersion + "." + base64.RawURLEncoding.EncodeToString(payload)
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(guestTokenMAC(key, encoded)), nil
+}
+
+// parseGuestToken verifies the token signature and returns its claims.
+func parseGuestToken(key []byte, token string) (guestTokenClaims, error) {
+	var claims guestTokenClaims
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 || parts[0] != guestTokenVersion {
+		return claims, errMalformedGuestToken
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return claims, errMalformedGuestToken
+	}
+	if !hmac.Equal(signature, guestTokenMAC(key, parts[0]+"."+parts[1])) {
+		return claims, errMalformedGuestToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return claims, errMalformedGuestToken
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return claims, errMalformedGuestToken
+	}
+	if claims.GuestID == "" || claims.PartyID == "" || claims.SessionID == "" {
+		return claims, errMalformedGuestToken
+	}
+	return claims, nil
+}
+
+func guestTokenMAC(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// newSessionID returns a random identifier for a guest session.
+func newSessionID() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,277 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/sipgate/eurovision-vote-party/server/authz"
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/persistence"
+)
+
+// MemberPartyDAO defines the party operations needed by the member service.
+type MemberPartyDAO interface {
+	GetByID(ctx context.Context, id string) (*models.Party, error)
+	ListByMemberID(ctx context.Context, userID string) ([]*models.Party, error)
+	UpdateMembers(ctx context.Context, id string, update func(members []models.PartyMember) ([]models.PartyMember, error)) error
+}
+
+// MemberUsers defines the user profile operations needed by the member service.
+type MemberUsers interface {
+	GetProfile(ctx context.Context, userID string) (*models.User, error)
+	FindProfile(ctx context.Context, emailOrUsername string) (*models.User, error)
+}
+
+// InviteMemberRequest identifies the user to invite into a party and their role.
+type InviteMemberRequest struct {
+	// User is the email address or username of the invitee's profile.
+	User string
+	Role models.PartyRole
+}
+
+// MemberService manages the users who help the owner run a party.
+type MemberService interface {
+	ListMembers(ctx context.Context, partyID string) ([]models.PartyMember, error)
+	InviteMember(ctx context.Context, partyID string, req InviteMemberRequest) (*models.PartyMember, error)
+	UpdateMemberRole(ctx context.Context, partyID, userID string, role models.PartyRole) (*models.PartyMember, error)
+	RemoveMember(ctx context.Context, partyID, userID string) error
+	AcceptInvitation(ctx context.Context, userID, partyID string) (*models.PartyMember, error)
+	ListInvitations(ctx context.Context, userID string) ([]*models.Party, error)
+}
+
+// memberService is the default implementation.
+type memberService struct {
+	partyDAO MemberPartyDAO
+	users    MemberUsers
+}
+
+// NewMemberService creates a new MemberService.
+func NewMemberService(partyDAO MemberPartyDAO, users MemberUsers) MemberService {
+	return &memberService{partyDAO: partyDAO, users: users}
+}
+
+// ListMembers returns the party's owner followed by its members and pending
+// invitations, ensuring the requester may view the party.
+func (s *memberService) ListMembers(ctx context.Context, partyID string) ([]models.PartyMember, error) {
+	party, err := s.getParty(ctx, partyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorize(ctx, authz.ViewParty, party); err != nil {
+		return nil, err
+	}
+
+	owner := models.PartyMember{
+		UserID:    party.AdminID,
+		Role:      models.PartyRoleOwner,
+		Status:    models.MemberStatusActive,
+		InvitedAt: party.CreatedAt,
+	}
+	profile, err := s.users.GetProfile(ctx, party.AdminID)
+	switch {
+	case err == nil:
+		owner.Username = profile.Username
+	case !errors.Is(err, ErrNotFound):
+		return nil, err
+	}
+
+	return append([]models.PartyMember{owner}, party.Members...), nil
+}
+
+// InviteMember invites the user with the given email address or username as
+// a co-host or moderator, ensuring the requester may manage the party's
+// members. The invitee gets access once they accept.
+// Returns ErrInvalidRole for roles users cannot be invited to, ErrUserNotFound
+// and ErrAmbiguousUser if the profile lookup fails and ErrAlreadyMember if
+// the user is already the owner, a member or invited.
+func (s *memberService) InviteMember(ctx context.Context, partyID string, req InviteMemberRequest) (*models.PartyMember, error) {
+	party, err := s.getParty(ctx, partyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorize(ctx, authz.ManageMembers, party); err != nil {
+		return nil, err
+	}
+
+	if !req.Role.IsInvitable() {
+		return nil, ErrInvalidRole
+	}
+
+	user, err := s.users.FindProfile(ctx, req.User)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.ID == party.AdminID {
+		return nil, ErrAlreadyMember
+	}
+
+	member := models.PartyMember{
+		UserID:    user.ID,
+		Username:  user.Username,
+		Role:      req.Role,
+		Status:    models.MemberStatusInvited,
+		InvitedBy: authz.FromContext(ctx).UserID,
+		InvitedAt: time.Now().UTC(),
+	}
+
+	err = s.updateMembers(ctx, partyID, func(members []models.PartyMember) ([]models.PartyMember, error) {
+		if findMember(members, user.ID) != nil {
+			return nil, ErrAlreadyMember
+		}
+		return append(members, member), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &member, nil
+}
+
+// UpdateMemberRole changes the role of a member or invitee, ensuring the
+// requester may manage the party's members.
+// Returns ErrInvalidRole for roles members cannot have and ErrMemberNotFound
+// if the user is not a member.
+func (s *memberService) UpdateMemberRole(ctx context.Context, partyID, userID string, role models.PartyRole) (*models.PartyMember, error) {
+	party, err := s.getParty(ctx, partyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorize(ctx, authz.ManageMembers, party); err != nil {
+		return nil, err
+	}
+
+	if !role.IsInvitable() {
+		return nil, ErrInvalidRole
+	}
+
+	var updated models.PartyMember
+	err = s.updateMembers(ctx, partyID, func(members []models.PartyMember) ([]models.PartyMember, error) {
+		member := findMember(members, userID)
+		if member == nil {
+			return nil, ErrMemberNotFound
+		}
+		member.Role = role
+		updated = *member
+		return members, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &updated, nil
+}
+
+// RemoveMember removes a member or withdraws an invitation. Whoever may
+// manage the party's members may remove anyone; members leave a party, and
+// invitees decline an invitation, by removing themselves.
+// Returns ErrMemberNotFound if the user is not a member.
+func (s *memberService) RemoveMember(ctx context.Context, partyID, userID string) error {
+	party, err := s.getParty(ctx, partyID)
+	if err != nil {
+		return err
+	}
+
+	if p := authz.FromContext(ctx); !p.IsUser() || p.UserID != userID {
+		if err := authorize(ctx, authz.ManageMembers, party); err != nil {
+			return err
+		}
+	}
+
+	return s.updateMembers(ctx, partyID, func(members []models.PartyMember) ([]models.PartyMember, error) {
+		remaining := slices.DeleteFunc(slices.Clone(members), func(m models.PartyMember) bool {
+			return m.UserID == userID
+		})
+		if len(remaining) == len(members) {
+			return nil, ErrMemberNotFound
+		}
+		return remaining, nil
+	})
+}
+
+// AcceptInvitation makes the user an active member of a party they were
+// invited to. Accepting again has no effect.
+// Returns ErrMemberNotFound if the user was not invited.
+func (s *memberService) AcceptInvitation(ctx context.Context, userID, partyID string) (*models.PartyMember, error) {
+	party, err := s.getParty(ctx, partyID)
+	if err != nil {
+		return nil, err
+	}
+
+	member := party.Member(userID)
+	if userID == "" || member == nil {
+		return nil, ErrMemberNotFound
+	}
+	if member.Status == models.MemberStatusActive {
+		return member, nil
+	}
+
+	var accepted models.PartyMember
+	err = s.updateMembers(ctx, partyID, func(members []models.PartyMember) ([]models.PartyMember, error) {
+		member := findMember(members, userID)
+		if member == nil {
+			return nil, ErrMemberNotFound
+		}
+		member.Status = models.MemberStatusActive
+		accepted = *member
+		return members, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &accepted, nil
+}
+
+// ListInvitations returns the parties the user was invited to and has not
+// accepted yet.
+func (s *memberService) ListInvitations(ctx context.Context, userID string) ([]*models.Party, error) {
+	parties, err := s.partyDAO.ListByMemberID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	invitations := make([]*models.Party, 0, len(parties))
+	for _, party := range parties {
+		if m := party.Member(userID); m != nil && m.Status == models.MemberStatusInvited {
+			invitations = append(invitations, party)
+		}
+	}
+
+	return invitations, nil
+}
+
+func (s *memberService) getParty(ctx context.Context, partyID string) (*models.Party, error) {
+	party, err := s.partyDAO.GetByID(ctx, partyID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return party, nil
+}
+
+// updateMembers applies update to the current members of the party in one
+// transaction, so concurrent changes to the members are not lost. update may
+// run more than once and reports ErrAlreadyMember or ErrMemberNotFound
+// against the members it is given.
+func (s *memberService) updateMembers(ctx context.Context, partyID string, update func(members []models.PartyMember) ([]models.PartyMember, error)) error {
+	if err := s.partyDAO.UpdateMembers(ctx, partyID, update); err != nil {
+		if errors.Is(err, persistence.ErrNotFound) {
+			return ErrNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// findMember returns the member with the given user ID, or nil.
+func findMember(members []models.PartyMember, userID string) *models.PartyMember {
+	return models.Party{Members: members}.Member(userID)
+}
@@ -0,0 +1,347 @@
+package services_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/persistence/memory"
+	"github.com/sipgate/eurovision-vote-party/server/services"
+)
+
+// memberFixture wraps a memory store holding an active party owned by
+// admin-1 and the profiles of its owner and three other users.
+type memberFixture struct {
+	store    *memory.Store
+	partyDAO *memory.PartyDAO
+	svc      services.MemberService
+}
+
+func newMemberFixture(t *testing.T) memberFixture {
+	t.Helper()
+	ctx := context.Background()
+
+	store := memory.NewStore()
+	f := memberFixture{store: store, partyDAO: memory.NewPartyDAO(store)}
+	userDAO := memory.NewUserDAO(store)
+	for _, u := range []models.User{
+		{ID: "admin-1", Username: "owner", Email: "owner@example.com"},
+		{ID: "user-2", Username: "carol", Email: "carol@example.com"},
+		{ID: "user-3", Username: "dave", Email: "dave@example.com"},
+		{ID: "user-4", Username: "erin", Email: "erin@example.com"},
+	} {
+		require.NoError(t, userDAO.Upsert(ctx, &u))
+	}
+	require.NoError(t, f.partyDAO.Create(ctx, &models.Party{
+		ID: "party-1", Name: "Party", Code: "ABC234", EventType: models.EventGrandFinal,
+		AdminID: "admin-1", Status: models.PartyStatusActive, CreatedAt: time.Now(),
+	}))
+	f.svc = services.NewMemberService(f.partyDAO, services.NewUserService(userDAO))
+	return f
+}
+
+// join invites the user as the owner and accepts the invitation.
+func (f memberFixture) join(t *testing.T, user string, role models.PartyRole) *models.PartyMember {
+	t.Helper()
+	ctx := context.Background()
+
+	member, err := f.svc.InviteMember(asUser(ctx, "admin-1"), "party-1", services.InviteMemberRequest{User: user, Role: role})
+	require.NoError(t, err)
+	member, err = f.svc.AcceptInvitation(ctx, member.UserID, "party-1")
+	require.NoError(t, err)
+	return member
+}
+
+func TestMemberService_InviteMember(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("invites by email and username", func(t *testing.T) {
+		f := newMemberFixture(t)
+
+		member, err := f.svc.InviteMember(asUser(ctx, "admin-1"), "party-1", services.InviteMemberRequest{User: "carol@example.com", Role: models.PartyRoleCoHost})
+		require.NoError(t, err)
+		assert.Equal(t, "user-2", member.UserID)
+		assert.Equal(t, "carol", member.Username)
+		assert.Equal(t, models.MemberStatusInvited, member.Status)
+		assert.Equal(t, "admin-1", member.InvitedBy)
+
+		_, err = f.svc.InviteMember(asUser(ctx, "admin-1"), "party-1", services.InviteMemberRequest{User: "dave", Role: models.PartyRoleModerator})
+		require.NoError(t, err)
+
+		party, err := f.partyDAO.GetByID(ctx, "party-1")
+		require.NoError(t, err)
+		require.Len(t, party.Members, 2)
+		assert.Equal(t, models.PartyRoleModerator, party.Members[1].Role)
+	})
+
+	t.Run("invitees have no access until they accept", func(t *testing.T) {
+		f := newMemberFixture(t)
+		_, err := f.svc.InviteMember(asUser(ctx, "admin-1"), "party-1", services.InviteMemberRequest{User: "carol", Role: models.PartyRoleCoHost})
+		require.NoError(t, err)
+
+		_, err = f.svc.ListMembers(asUser(ctx, "user-2"), "party-1")
+		assert.ErrorIs(t, err, services.ErrUnauthorized)
+
+		_, err = f.svc.AcceptInvitation(ctx, "user-2", "party-1")
+		require.NoError(t, err)
+
+		members, err := f.svc.ListMembers(asUser(ctx, "user-2"), "party-1")
+		require.NoError(t, err)
+		require.Len(t, members, 2)
+		assert.Equal(t, models.PartyMember{
+			UserID: "admin-1", Username: "owner", Role: models.PartyRoleOwner, Status: models.MemberStatusActive, InvitedAt: members[0].InvitedAt,
+		}, members[0])
+		assert.Equal(t, models.MemberStatusActive, members[1].Status)
+	})
+
+	t.Run("rejects invalid invitations", func(t *testing.T) {
+		f := newMemberFixture(t)
+		f.join(t, "carol", models.PartyRoleCoHost)
+
+		tests := map[string]struct {
+			caller string
+			req    services.InviteMemberRequest
+			err    error
+		}{
+			"co-host inviting":   {caller: "user-2", req: services.InviteMemberRequest{User: "dave", Role: models.PartyRoleModerator}, err: services.ErrUnauthorized},
+			"anonymous inviting": {caller: "", req: services.InviteMemberRequest{User: "dave", Role: models.PartyRoleModerator}, err: services.ErrUnauthorized},
+			"second owner":       {caller: "admin-1", req: services.InviteMemberRequest{User: "dave", Role: models.PartyRoleOwner}, err: services.ErrInvalidRole},
+			"unknown role":       {caller: "admin-1", req: services.InviteMemberRequest{User: "dave", Role: "dj"}, err: services.ErrInvalidRole},
+			"unknown user":       {caller: "admin-1", req: services.InviteMemberRequest{User: "zoe", Role: models.PartyRoleModerator}, err: services.ErrUserNotFound},
+			"existing member":    {caller: "admin-1", req: services.InviteMemberRequest{User: "carol", Role: models.PartyRoleModerator}, err: services.ErrAlreadyMember},
+			"the owner":          {caller: "admin-1", req: services.InviteMemberRequest{User: "owner@example.com", Role: models.PartyRoleCoHost}, err: services.ErrAlreadyMember},
+		}
+
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				_, err := f.svc.InviteMember(asUser(ctx, tt.caller), "party-1", tt.req)
+				assert.ErrorIs(t, err, tt.err)
+			})
+		}
+
+		_, err := f.svc.InviteMember(asUser(ctx, "admin-1"), "missing", services.InviteMemberRequest{User: "dave", Role: models.PartyRoleModerator})
+		assert.ErrorIs(t, err, services.ErrNotFound)
+	})
+}
+
+func TestMemberService_UpdateMemberRole(t *testing.T) {
+	ctx := context.Background()
+	f := newMemberFixture(t)
+	f.join(t, "carol", models.PartyRoleModerator)
+
+	member, err := f.svc.UpdateMemberRole(asUser(ctx, "admin-1"), "party-1", "user-2", models.PartyRoleCoHost)
+	require.NoError(t, err)
+	assert.Equal(t, models.PartyRoleCoHost, member.Role)
+
+	party, err := f.partyDAO.GetByID(ctx, "party-1")
+	require.NoError(t, err)
+	role, _ := party.RoleOf("user-2")
+	assert.Equal(t, models.PartyRoleCoHost, role)
+
+	_, err = f.svc.UpdateMemberRole(asUser(ctx, "user-2"), "party-1", "user-2", models.PartyRoleModerator)
+	assert.ErrorIs(t, err, services.ErrUnauthorized)
+	_, err = f.svc.UpdateMemberRole(asUser(ctx, "admin-1"), "party-1", "user-2", models.PartyRoleOwner)
+	assert.ErrorIs(t, err, services.ErrInvalidRole)
+	_, err = f.svc.UpdateMemberRole(asUser(ctx, "admin-1"), "party-1", "user-3", models.PartyRoleCoHost)
+	assert.ErrorIs(t, err, services.ErrMemberNotFound)
+}
+
+func TestMemberService_RemoveMember(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("owner removes members", func(t *testing.T) {
+		f := newMemberFixture(t)
+		f.join(t, "carol", models.PartyRoleCoHost)
+		f.join(t, "dave", models.PartyRoleModerator)
+
+		assert.ErrorIs(t, f.svc.RemoveMember(asUser(ctx, "user-2"), "party-1", "user-3"), services.ErrUnauthorized)
+		require.NoError(t, f.svc.RemoveMember(asUser(ctx, "admin-1"), "party-1", "user-3"))
+		assert.ErrorIs(t, f.svc.RemoveMember(asUser(ctx, "admin-1"), "party-1", "user-3"), services.ErrMemberNotFound)
+
+		party, err := f.partyDAO.GetByID(ctx, "party-1")
+		require.NoError(t, err)
+		require.Len(t, party.Members, 1)
+		assert.Equal(t, "user-2", party.Members[0].UserID)
+	})
+
+	t.Run("members leave and invitees decline", func(t *testing.T) {
+		f := newMemberFixture(t)
+		f.join(t, "carol", models.PartyRoleCoHost)
+		_, err := f.svc.InviteMember(asUser(ctx, "admin-1"), "party-1", services.InviteMemberRequest{User: "dave", Role: models.PartyRoleModerator})
+		require.NoError(t, err)
+
+		require.NoError(t, f.svc.RemoveMember(asUser(ctx, "user-2"), "party-1", "user-2"))
+		require.NoError(t, f.svc.RemoveMember(asUser(ctx, "user-3"), "party-1", "user-3"))
+
+		party, err := f.partyDAO.GetByID(ctx, "party-1")
+		require.NoError(t, err)
+		assert.Empty(t, party.Members)
+	})
+
+	t.Run("the owner cannot be removed", func(t *testing.T) {
+		f := newMemberFixture(t)
+
+		assert.ErrorIs(t, f.svc.RemoveMember(asUser(ctx, "admin-1"), "party-1", "admin-1"), services.ErrMemberNotFound)
+	})
+}
+
+// stalePartyDAO serves a snapshot of the party taken before other changes,
+// as a request racing with them would have read it.
+type stalePartyDAO struct {
+	*memory.PartyDAO
+	stale *models.Party
+}
+
+func (d stalePartyDAO) GetByID(context.Context, string) (*models.Party, error) {
+	party := *d.stale
+	party.Members = slices.Clone(d.stale.Members)
+	return &party, nil
+}
+
+func TestMemberService_ConcurrentChanges(t *testing.T) {
+	ctx := context.Background()
+	f := newMemberFixture(t)
+	f.join(t, "erin", models.PartyRoleModerator)
+
+	stale, err := f.partyDAO.GetByID(ctx, "party-1")
+	require.NoError(t, err)
+	racing := services.NewMemberService(stalePartyDAO{PartyDAO: f.partyDAO, stale: stale}, services.NewUserService(memory.NewUserDAO(f.store)))
+
+	_, err = f.svc.InviteMember(asUser(ctx, "admin-1"), "party-1", services.InviteMemberRequest{User: "carol", Role: models.PartyRoleCoHost})
+	require.NoError(t, err)
+	require.NoError(t, f.svc.RemoveMember(asUser(ctx, "admin-1"), "party-1", "user-4"))
+
+	_, err = racing.InviteMember(asUser(ctx, "admin-1"), "party-1", services.InviteMemberRequest{User: "carol", Role: models.PartyRoleModerator})
+	assert.ErrorIs(t, err, services.ErrAlreadyMember, "carol was invited meanwhile")
+	_, err = racing.UpdateMemberRole(asUser(ctx, "admin-1"), "party-1", "user-4", models.PartyRoleCoHost)
+	assert.ErrorIs(t, err, services.ErrMemberNotFound, "erin was removed meanwhile")
+
+	_, err = racing.InviteMember(asUser(ctx, "admin-1"), "party-1", services.InviteMemberRequest{User: "dave", Role: models.PartyRoleModerator})
+	require.NoError(t, err)
+
+	party, err := f.partyDAO.GetByID(ctx, "party-1")
+	require.NoError(t, err)
+	require.Len(t, party.Members, 2, "the invitation from the stale read keeps carol")
+	assert.Equal(t, "user-2", party.Members[0].UserID)
+	assert.Equal(t, models.PartyRoleCoHost, party.Members[0].Role)
+	assert.Equal(t, "user-3", party.Members[1].UserID)
+}
+
+func TestMemberService_AcceptInvitation(t *testing.T) {
+	ctx := context.Background()
+	f := newMemberFixture(t)
+
+	_, err := f.svc.AcceptInvitation(ctx, "user-2", "party-1")
+	assert.ErrorIs(t, err, services.ErrMemberNotFound)
+
+	_, err = f.svc.InviteMember(asUser(ctx, "admin-1"), "party-1", services.InviteMemberRequest{User: "carol", Role: models.PartyRoleCoHost})
+	require.NoError(t, err)
+
+	invitations, err := f.svc.ListInvitations(ctx, "user-2")
+	require.NoError(t, err)
+	require.Len(t, invitations, 1)
+	assert.Equal(t, "party-1", invitations[0].ID)
+
+	member, err := f.svc.AcceptInvitation(ctx, "user-2", "party-1")
+	require.NoError(t, err)
+	assert.Equal(t, models.MemberStatusActive, member.Status)
+
+	member, err = f.svc.AcceptInvitation(ctx, "user-2", "party-1")
+	require.NoError(t, err, "accepting twice has no effect")
+	assert.Equal(t, models.MemberStatusActive, member.Status)
+
+	invitations, err = f.svc.ListInvitations(ctx, "user-2")
+	require.NoError(t, err)
+	assert.Empty(t, invitations)
+
+	_, err = f.svc.AcceptInvitation(ctx, "user-2", "missing")
+	assert.ErrorIs(t, err, services.ErrNotFound)
+}
+
+// TestMemberRoles checks what co-hosts and moderators may do in the other
+// services compared with the owner.
+func TestMemberRoles(t *testing.T) {
+	ctx := context.Background()
+
+	setUp := func(t *testing.T) (memberFixture, services.PartyService, services.GuestService, services.VoteService) {
+		f := newMemberFixture(t)
+		f.join(t, "carol", models.PartyRoleCoHost)
+		f.join(t, "dave", models.PartyRoleModerator)
+		_, err := f.svc.InviteMember(asUser(ctx, "admin-1"), "party-1", services.InviteMemberRequest{User: "erin", Role: models.PartyRoleCoHost})
+		require.NoError(t, err)
+
+		guestDAO := memory.NewGuestDAO(f.store)
+		require.NoError(t, guestDAO.Create(ctx, &models.Guest{
+			ID: "guest-1", PartyID: "party-1", Username: "alice", Status: models.GuestStatusPending, CreatedAt: time.Now(),
+		}))
+		partySvc := services.NewPartyService(f.partyDAO, nil)
+		guestSvc := services.NewGuestService(guestDAO, f.partyDAO, []byte("test-key"), nil)
+		voteSvc := services.NewVoteService(memory.NewVoteDAO(f.store), f.partyDAO, guestDAO, &mockVoteActsService{}, nil)
+		return f, partySvc, guestSvc, voteSvc
+	}
+
+	tests := map[string]struct {
+		run     func(partySvc services.PartyService, guestSvc services.GuestService, voteSvc services.VoteService, userID string) error
+		allowed []string
+	}{
+		"view party": {
+			run: func(p services.PartyService, _ services.GuestService, _ services.VoteService, userID string) error {
+				_, err := p.GetPartyByID(asUser(ctx, userID), "party-1")
+				return err
+			},
+			allowed: []string{"admin-1", "user-2", "user-3"},
+		},
+		"approve guest": {
+			run: func(_ services.PartyService, g services.GuestService, _ services.VoteService, userID string) error {
+				return g.ApproveGuest(asUser(ctx, userID), "party-1", "guest-1")
+			},
+			allowed: []string{"admin-1", "user-2", "user-3"},
+		},
+		"end voting": {
+			run: func(_ services.PartyService, _ services.GuestService, v services.VoteService, userID string) error {
+				_, err := v.EndVoting(asUser(ctx, userID), "party-1")
+				return err
+			},
+			allowed: []string{"admin-1", "user-2"},
+		},
+		"delete party": {
+			run: func(p services.PartyService, _ services.GuestService, _ services.VoteService, userID string) error {
+				return p.DeleteParty(asUser(ctx, userID), "party-1")
+			},
+			allowed: []string{"admin-1"},
+		},
+	}
+
+	for name, tt := range tests {
+		// admin-1 owns the party, user-2 co-hosts, user-3 moderates, user-4
+		// is invited but has not accepted and user-5 is a stranger.
+		for _, userID := range []string{"admin-1", "user-2", "user-3", "user-4", "user-5"} {
+			t.Run(name+"/"+userID, func(t *testing.T) {
+				_, partySvc, guestSvc, voteSvc := setUp(t)
+
+				err := tt.run(partySvc, guestSvc, voteSvc, userID)
+
+				if slices.Contains(tt.allowed, userID) {
+					assert.NoError(t, err)
+				} else {
+					assert.ErrorIs(t, err, services.ErrUnauthorized)
+				}
+			})
+		}
+	}
+
+	t.Run("members see the parties they help run", func(t *testing.T) {
+		_, partySvc, _, _ := setUp(t)
+
+		for userID, count := range map[string]int{"admin-1": 1, "user-2": 1, "user-3": 1, "user-4": 0} {
+			parties, err := partySvc.ListPartiesByAdmin(ctx, userID)
+			require.NoError(t, err)
+			assert.Len(t, parties, count, userID)
+		}
+	})
+}
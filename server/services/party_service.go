@@ -8,6 +8,7 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/sipgate/eurovision-vote-party/server/authz"
 	"github.com/sipgate/eurovision-vote-party/server/models"
 	"github.com/sipgate/eurovision-vote-party/server/persistence"
 )
@@ -19,9 +20,15 @@ const (
 )
 
 // CreatePartyRequest holds the data for creating a new party.
+// An empty Contest selects models.DefaultContest and an empty ScoringSystem
+// selects models.DefaultScoringSystem.
 type CreatePartyRequest struct {
-	Name      string
-	EventType models.EventType
+	Name          string
+	EventType     models.EventType
+	Contest       string
+	ScoringSystem models.ScoringSystem
+	// AnonymousResults hides who awarded an act their top points in the results.
+	AnonymousResults bool
 }
 
 // PartyDAO defines the persistence operations needed by the service.
@@ -30,27 +37,35 @@ type PartyDAO interface {
 	GetByID(ctx context.Context, id string) (*models.Party, error)
 	GetByCode(ctx context.Context, code string) (*models.Party, error)
 	ListByAdminID(ctx context.Context, adminID string) ([]*models.Party, error)
-	Delete(ctx context.Context, id string) error
+	ListByMemberID(ctx context.Context, userID string) ([]*models.Party, error)
+	DeleteCascade(ctx context.Context, id string) error
 	CodeExists(ctx context.Context, code string) (bool, error)
 }
 
+// PartyContests defines the contest catalogue operations needed by the party service.
+type PartyContests interface {
+	ListContests() []models.Contest
+}
+
 // PartyService defines the business logic operations for parties.
 type PartyService interface {
 	CreateParty(ctx context.Context, adminID string, req CreatePartyRequest) (*models.Party, error)
-	GetPartyByID(ctx context.Context, adminID, partyID string) (*models.Party, error)
+	GetPartyByID(ctx context.Context, partyID string) (*models.Party, error)
 	GetPartyByCode(ctx context.Context, code string) (*models.Party, error)
 	ListPartiesByAdmin(ctx context.Context, adminID string) ([]*models.Party, error)
-	DeleteParty(ctx context.Context, adminID, partyID string) error
+	DeleteParty(ctx context.Context, partyID string) error
 }
 
 // partyService is the default implementation.
 type partyService struct {
-	dao PartyDAO
+	dao      PartyDAO
+	contests PartyContests
 }
 
 // NewPartyService creates a new PartyService.
-func NewPartyService(dao PartyDAO) PartyService {
-	return &partyService{dao: dao}
+// New parties must watch an event of a contest in contests; if contests is nil, any contest is accepted.
+func NewPartyService(dao PartyDAO, contests PartyContests) PartyService {
+	return &partyService{dao: dao, contests: contests}
 }
 
 // generatePartyCode generates a random 6-character party code using a secure alphabet.
@@ -66,40 +81,64 @@ func generatePartyCode() (string, error) {
 	return string(b), nil
 }
 
-// CreateParty creates a new party with a unique code.
-func (s *partyService) CreateParty(ctx context.Context, adminID string, req CreatePartyRequest) (*models.Party, error) {
-	var code string
-	var err error
+// partyCodeChecker reports whether a party code is taken.
+type partyCodeChecker interface {
+	CodeExists(ctx context.Context, code string) (bool, error)
+}
 
+// uniquePartyCode generates a party code that no party uses yet.
+func uniquePartyCode(ctx context.Context, dao partyCodeChecker) (string, error) {
 	// Try to generate a unique code with retry logic
 	for i := 0; i < maxCodeRetries; i++ {
-		code, err = generatePartyCode()
+		code, err := generatePartyCode()
 		if err != nil {
-			return nil, err
+			return "", err
 		}
 
-		exists, err := s.dao.CodeExists(ctx, code)
+		exists, err := dao.CodeExists(ctx, code)
 		if err != nil {
-			return nil, err
+			return "", err
 		}
 		if !exists {
-			break
+			return code, nil
 		}
+	}
+	return "", errors.New("failed to generate unique party code after maximum retries")
+}
 
-		// If this was the last attempt and code still exists, fail
-		if i == maxCodeRetries-1 {
-			return nil, errors.New("failed to generate unique party code after maximum retries")
-		}
+// CreateParty creates a new party with a unique code.
+// Returns ErrUnknownContest if the contest is not in the catalogue and
+// ErrInvalidEventType if the event is not part of the contest.
+func (s *partyService) CreateParty(ctx context.Context, adminID string, req CreatePartyRequest) (*models.Party, error) {
+	contest := req.Contest
+	if contest == "" {
+		contest = models.DefaultContest
+	}
+	if err := s.checkContest(contest, req.EventType); err != nil {
+		return nil, err
+	}
+
+	code, err := uniquePartyCode(ctx, s.dao)
+	if err != nil {
+		return nil, err
+	}
+
+	scoring := req.ScoringSystem
+	if scoring == "" {
+		scoring = models.DefaultScoringSystem
 	}
 
 	party := &models.Party{
-		ID:        uuid.New().String(),
-		Name:      req.Name,
-		Code:      code,
-		EventType: req.EventType,
-		AdminID:   adminID,
-		Status:    models.PartyStatusActive,
-		CreatedAt: time.Now(),
+		ID:               uuid.New().String(),
+		Name:             req.Name,
+		Code:             code,
+		EventType:        req.EventType,
+		Contest:          contest,
+		AdminID:          adminID,
+		Status:           models.PartyStatusActive,
+		CreatedAt:        time.Now(),
+		ScoringSystem:    scoring,
+		AnonymousResults: req.AnonymousResults,
 	}
 
 	if err := party.Validate(); err != nil {
@@ -113,8 +152,8 @@ func (s *partyService) CreateParty(ctx context.Context, adminID string, req Crea
 	return party, nil
 }
 
-// GetPartyByID retrieves a party by ID, ensuring the requester is the owner.
-func (s *partyService) GetPartyByID(ctx context.Context, adminID, partyID string) (*models.Party, error) {
+// GetPartyByID retrieves a party by ID, ensuring the requester may view it.
+func (s *partyService) GetPartyByID(ctx context.Context, partyID string) (*models.Party, error) {
 	party, err := s.dao.GetByID(ctx, partyID)
 	if err != nil {
 		if errors.Is(err, persistence.ErrNotFound) {
@@ -123,8 +162,8 @@ func (s *partyService) GetPartyByID(ctx context.Context, adminID, partyID string
 		return nil, err
 	}
 
-	if party.AdminID != adminID {
-		return nil, ErrUnauthorized
+	if err := authorize(ctx, authz.ViewParty, party); err != nil {
+		return nil, err
 	}
 
 	return party, nil
@@ -143,13 +182,30 @@ func (s *partyService) GetPartyByCode(ctx context.Context, code string) (*models
 	return party, nil
 }
 
-// ListPartiesByAdmin lists all parties owned by the given admin.
+// ListPartiesByAdmin lists all parties owned by the given admin, followed by
+// the parties they help run as an active member.
 func (s *partyService) ListPartiesByAdmin(ctx context.Context, adminID string) ([]*models.Party, error) {
-	return s.dao.ListByAdminID(ctx, adminID)
+	parties, err := s.dao.ListByAdminID(ctx, adminID)
+	if err != nil {
+		return nil, err
+	}
+
+	memberships, err := s.dao.ListByMemberID(ctx, adminID)
+	if err != nil {
+		return nil, err
+	}
+	for _, party := range memberships {
+		if _, ok := party.RoleOf(adminID); ok {
+			parties = append(parties, party)
+		}
+	}
+
+	return parties, nil
 }
 
-// DeleteParty deletes a party, ensuring the requester is the owner.
-func (s *partyService) DeleteParty(ctx context.Context, adminID, partyID string) error {
+// DeleteParty deletes a party together with all of its guests and votes,
+// ensuring the requester may delete it.
+func (s *partyService) DeleteParty(ctx context.Context, partyID string) error {
 	party, err := s.dao.GetByID(ctx, partyID)
 	if err != nil {
 		if errors.Is(err, persistence.ErrNotFound) {
@@ -158,11 +214,11 @@ func (s *partyService) DeleteParty(ctx context.Context, adminID, partyID string)
 		return err
 	}
 
-	if party.AdminID != adminID {
-		return ErrUnauthorized
+	if err := authorize(ctx, authz.DeleteParty, party); err != nil {
+		return err
 	}
 
-	if err := s.dao.Delete(ctx, partyID); err != nil {
+	if err := s.dao.DeleteCascade(ctx, partyID); err != nil {
 		if errors.Is(err, persistence.ErrNotFound) {
 			return ErrNotFound
 		}
@@ -171,3 +227,19 @@ func (s *partyService) DeleteParty(ctx context.Context, adminID, partyID string)
 
 	return nil
 }
+
+// checkContest ensures the event belongs to a contest edition in the catalogue.
+func (s *partyService) checkContest(contestID string, event models.EventType) error {
+	if s.contests == nil {
+		return nil
+	}
+	for _, contest := range s.contests.ListContests() {
+		if contest.ID == contestID {
+			if !contest.HasEvent(event) {
+				return ErrInvalidEventType
+			}
+			return nil
+		}
+	}
+	return ErrUnknownContest
+}
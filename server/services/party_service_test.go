@@ -10,16 +10,18 @@ import (
 
 	"github.com/sipgate/eurovision-vote-party/server/models"
 	"github.com/sipgate/eurovision-vote-party/server/persistence"
+	"github.com/sipgate/eurovision-vote-party/server/persistence/memory"
 	"github.com/sipgate/eurovision-vote-party/server/services"
 )
 
 type mockPartyDAO struct {
-	createFunc      func(ctx context.Context, party *models.Party) error
-	getByIDFunc     func(ctx context.Context, id string) (*models.Party, error)
-	getByCodeFunc   func(ctx context.Context, code string) (*models.Party, error)
-	listByAdminFunc func(ctx context.Context, adminID string) ([]*models.Party, error)
-	deleteFunc      func(ctx context.Context, id string) error
-	codeExistsFunc  func(ctx context.Context, code string) (bool, error)
+	createFunc        func(ctx context.Context, party *models.Party) error
+	getByIDFunc       func(ctx context.Context, id string) (*models.Party, error)
+	getByCodeFunc     func(ctx context.Context, code string) (*models.Party, error)
+	listByAdminFunc   func(ctx context.Context, adminID string) ([]*models.Party, error)
+	listByMemberFunc  func(ctx context.Context, userID string) ([]*models.Party, error)
+	deleteCascadeFunc func(ctx context.Context, id string) error
+	codeExistsFunc    func(ctx context.Context, code string) (bool, error)
 }
 
 func (m *mockPartyDAO) Create(ctx context.Context, party *models.Party) error {
@@ -50,9 +52,16 @@ func (m *mockPartyDAO) ListByAdminID(ctx context.Context, adminID string) ([]*mo
 	return []*models.Party{}, nil
 }
 
-func (m *mockPartyDAO) Delete(ctx context.Context, id string) error {
-	if m.deleteFunc != nil {
-		return m.deleteFunc(ctx, id)
+func (m *mockPartyDAO) ListByMemberID(ctx context.Context, userID string) ([]*models.Party, error) {
+	if m.listByMemberFunc != nil {
+		return m.listByMemberFunc(ctx, userID)
+	}
+	return []*models.Party{}, nil
+}
+
+func (m *mockPartyDAO) DeleteCascade(ctx context.Context, id string) error {
+	if m.deleteCascadeFunc != nil {
+		return m.deleteCascadeFunc(ctx, id)
 	}
 	return nil
 }
@@ -64,6 +73,12 @@ func (m *mockPartyDAO) CodeExists(ctx context.Context, code string) (bool, error
 	return false, nil
 }
 
+type mockPartyContests []models.Contest
+
+func (m mockPartyContests) ListContests() []models.Contest {
+	return m
+}
+
 func TestPartyService_CreateParty(t *testing.T) {
 	t.Run("creates party with generated code", func(t *testing.T) {
 		var createdParty *models.Party
@@ -77,7 +92,7 @@ func TestPartyService_CreateParty(t *testing.T) {
 			},
 		}
 
-		svc := services.NewPartyService(dao)
+		svc := services.NewPartyService(dao, nil)
 		ctx := context.Background()
 
 		party, err := svc.CreateParty(ctx, "admin-1", services.CreatePartyRequest{
@@ -93,10 +108,75 @@ func TestPartyService_CreateParty(t *testing.T) {
 		assert.Equal(t, models.EventGrandFinal, party.EventType)
 		assert.Equal(t, "admin-1", party.AdminID)
 		assert.Equal(t, models.PartyStatusActive, party.Status)
+		assert.Equal(t, models.DefaultScoringSystem, party.ScoringSystem)
 		assert.False(t, party.CreatedAt.IsZero())
 		assert.Equal(t, createdParty, party)
 	})
 
+	t.Run("stores chosen scoring system", func(t *testing.T) {
+		dao := &mockPartyDAO{
+			codeExistsFunc: func(ctx context.Context, code string) (bool, error) {
+				return false, nil
+			},
+			createFunc: func(ctx context.Context, party *models.Party) error {
+				return nil
+			},
+		}
+
+		svc := services.NewPartyService(dao, nil)
+
+		party, err := svc.CreateParty(context.Background(), "admin-1", services.CreatePartyRequest{
+			Name:             "My Party",
+			EventType:        models.EventGrandFinal,
+			ScoringSystem:    models.ScoringBorda,
+			AnonymousResults: true,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, models.ScoringBorda, party.ScoringSystem)
+		assert.True(t, party.AnonymousResults)
+	})
+
+	t.Run("binds party to a contest edition", func(t *testing.T) {
+		contests := mockPartyContests{{
+			ID: "jesc-2025", Name: "Junior Eurovision 2025", Year: 2025,
+			Events: []models.EventType{models.EventGrandFinal},
+		}, {
+			ID: models.DefaultContest, Name: "Eurovision 2025", Year: 2025,
+			Events: []models.EventType{models.EventSemifinal1, models.EventGrandFinal},
+		}}
+		svc := services.NewPartyService(&mockPartyDAO{}, contests)
+
+		party, err := svc.CreateParty(context.Background(), "admin-1", services.CreatePartyRequest{
+			Name:      "My Party",
+			EventType: models.EventGrandFinal,
+			Contest:   "jesc-2025",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "jesc-2025", party.Contest)
+
+		party, err = svc.CreateParty(context.Background(), "admin-1", services.CreatePartyRequest{
+			Name:      "My Party",
+			EventType: models.EventSemifinal1,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, models.DefaultContest, party.Contest)
+
+		_, err = svc.CreateParty(context.Background(), "admin-1", services.CreatePartyRequest{
+			Name:      "My Party",
+			EventType: models.EventGrandFinal,
+			Contest:   "esc-1956",
+		})
+		assert.ErrorIs(t, err, services.ErrUnknownContest)
+
+		_, err = svc.CreateParty(context.Background(), "admin-1", services.CreatePartyRequest{
+			Name:      "My Party",
+			EventType: models.EventSemifinal1,
+			Contest:   "jesc-2025",
+		})
+		assert.ErrorIs(t, err, services.ErrInvalidEventType)
+	})
+
 	t.Run("retries code generation on collision", func(t *testing.T) {
 		codeExistsCalls := 0
 		dao := &mockPartyDAO{
@@ -110,7 +190,7 @@ func TestPartyService_CreateParty(t *testing.T) {
 			},
 		}
 
-		svc := services.NewPartyService(dao)
+		svc := services.NewPartyService(dao, nil)
 		ctx := context.Background()
 
 		party, err := svc.CreateParty(ctx, "admin-1", services.CreatePartyRequest{
@@ -132,7 +212,7 @@ func TestPartyService_CreateParty(t *testing.T) {
 			},
 		}
 
-		svc := services.NewPartyService(dao)
+		svc := services.NewPartyService(dao, nil)
 		ctx := context.Background()
 
 		party, err := svc.CreateParty(ctx, "admin-1", services.CreatePartyRequest{
@@ -153,7 +233,7 @@ func TestPartyService_CreateParty(t *testing.T) {
 			},
 		}
 
-		svc := services.NewPartyService(dao)
+		svc := services.NewPartyService(dao, nil)
 		ctx := context.Background()
 
 		party, err := svc.CreateParty(ctx, "admin-1", services.CreatePartyRequest{
@@ -173,7 +253,7 @@ func TestPartyService_CreateParty(t *testing.T) {
 			},
 		}
 
-		svc := services.NewPartyService(dao)
+		svc := services.NewPartyService(dao, nil)
 		ctx := context.Background()
 
 		party, err := svc.CreateParty(ctx, "admin-1", services.CreatePartyRequest{
@@ -208,10 +288,10 @@ func TestPartyService_GetPartyByID(t *testing.T) {
 			},
 		}
 
-		svc := services.NewPartyService(dao)
+		svc := services.NewPartyService(dao, nil)
 		ctx := context.Background()
 
-		party, err := svc.GetPartyByID(ctx, "admin-1", "party-1")
+		party, err := svc.GetPartyByID(asUser(ctx, "admin-1"), "party-1")
 
 		require.NoError(t, err)
 		assert.Equal(t, existingParty, party)
@@ -234,10 +314,10 @@ func TestPartyService_GetPartyByID(t *testing.T) {
 			},
 		}
 
-		svc := services.NewPartyService(dao)
+		svc := services.NewPartyService(dao, nil)
 		ctx := context.Background()
 
-		party, err := svc.GetPartyByID(ctx, "other-admin", "party-1")
+		party, err := svc.GetPartyByID(asUser(ctx, "other-admin"), "party-1")
 
 		assert.ErrorIs(t, err, services.ErrUnauthorized)
 		assert.Nil(t, party)
@@ -250,10 +330,10 @@ func TestPartyService_GetPartyByID(t *testing.T) {
 			},
 		}
 
-		svc := services.NewPartyService(dao)
+		svc := services.NewPartyService(dao, nil)
 		ctx := context.Background()
 
-		party, err := svc.GetPartyByID(ctx, "admin-1", "nonexistent")
+		party, err := svc.GetPartyByID(asUser(ctx, "admin-1"), "nonexistent")
 
 		assert.ErrorIs(t, err, services.ErrNotFound)
 		assert.Nil(t, party)
@@ -281,7 +361,7 @@ func TestPartyService_GetPartyByCode(t *testing.T) {
 			},
 		}
 
-		svc := services.NewPartyService(dao)
+		svc := services.NewPartyService(dao, nil)
 		ctx := context.Background()
 
 		party, err := svc.GetPartyByCode(ctx, "ABC123")
@@ -297,7 +377,7 @@ func TestPartyService_GetPartyByCode(t *testing.T) {
 			},
 		}
 
-		svc := services.NewPartyService(dao)
+		svc := services.NewPartyService(dao, nil)
 		ctx := context.Background()
 
 		party, err := svc.GetPartyByCode(ctx, "NONEXISTENT")
@@ -339,7 +419,7 @@ func TestPartyService_ListPartiesByAdmin(t *testing.T) {
 			},
 		}
 
-		svc := services.NewPartyService(dao)
+		svc := services.NewPartyService(dao, nil)
 		ctx := context.Background()
 
 		parties, err := svc.ListPartiesByAdmin(ctx, "admin-1")
@@ -370,16 +450,16 @@ func TestPartyService_DeleteParty(t *testing.T) {
 				}
 				return nil, persistence.ErrNotFound
 			},
-			deleteFunc: func(ctx context.Context, id string) error {
+			deleteCascadeFunc: func(ctx context.Context, id string) error {
 				deleteCalled = true
 				return nil
 			},
 		}
 
-		svc := services.NewPartyService(dao)
+		svc := services.NewPartyService(dao, nil)
 		ctx := context.Background()
 
-		err := svc.DeleteParty(ctx, "admin-1", "party-1")
+		err := svc.DeleteParty(asUser(ctx, "admin-1"), "party-1")
 
 		require.NoError(t, err)
 		assert.True(t, deleteCalled)
@@ -401,18 +481,69 @@ func TestPartyService_DeleteParty(t *testing.T) {
 			getByIDFunc: func(ctx context.Context, id string) (*models.Party, error) {
 				return existingParty, nil
 			},
-			deleteFunc: func(ctx context.Context, id string) error {
+			deleteCascadeFunc: func(ctx context.Context, id string) error {
 				deleteCalled = true
 				return nil
 			},
 		}
 
-		svc := services.NewPartyService(dao)
+		svc := services.NewPartyService(dao, nil)
 		ctx := context.Background()
 
-		err := svc.DeleteParty(ctx, "other-admin", "party-1")
+		err := svc.DeleteParty(asUser(ctx, "other-admin"), "party-1")
 
 		assert.ErrorIs(t, err, services.ErrUnauthorized)
 		assert.False(t, deleteCalled)
 	})
 }
+
+func TestPartyService_DeleteParty_LeavesNothingBehind(t *testing.T) {
+	store := memory.NewStore()
+	partyDAO := memory.NewPartyDAO(store)
+	guestDAO := memory.NewGuestDAO(store)
+	voteDAO := memory.NewVoteDAO(store)
+
+	partySvc := services.NewPartyService(partyDAO, nil)
+	guestSvc := services.NewGuestService(guestDAO, partyDAO, []byte("test-key"), nil)
+	voteSvc := services.NewVoteService(voteDAO, partyDAO, guestDAO, &mockVoteActsService{
+		listActsFunc: func(ctx context.Context, contest, eventType string) ([]models.Act, error) {
+			return testActs(), nil
+		},
+	}, nil)
+	ctx := context.Background()
+
+	party, err := partySvc.CreateParty(ctx, "admin-1", services.CreatePartyRequest{Name: "Doomed", EventType: models.EventGrandFinal})
+	require.NoError(t, err)
+	other, err := partySvc.CreateParty(ctx, "admin-1", services.CreatePartyRequest{Name: "Survivor", EventType: models.EventGrandFinal})
+	require.NoError(t, err)
+
+	for _, p := range []*models.Party{party, other} {
+		for _, name := range []string{"alice", "bob", "carol"} {
+			guest, _, err := guestSvc.JoinParty(ctx, p.Code, name)
+			require.NoError(t, err)
+			require.NoError(t, guestSvc.ApproveGuest(asUser(ctx, "admin-1"), p.ID, guest.ID))
+			_, err = voteSvc.SubmitVote(asGuest(ctx, guest.ID, p.ID), p.ID, services.SubmitVoteRequest{GuestID: guest.ID, Votes: validVotes()})
+			require.NoError(t, err)
+		}
+		_, _, err := guestSvc.JoinParty(ctx, p.Code, "pending_dave")
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, partySvc.DeleteParty(asUser(ctx, "admin-1"), party.ID))
+
+	_, err = partyDAO.GetByID(ctx, party.ID)
+	assert.ErrorIs(t, err, persistence.ErrNotFound)
+	guests, err := guestDAO.ListByPartyID(ctx, party.ID)
+	require.NoError(t, err)
+	assert.Empty(t, guests)
+	votes, err := voteDAO.ListByPartyID(ctx, party.ID)
+	require.NoError(t, err)
+	assert.Empty(t, votes)
+
+	guests, err = guestDAO.ListByPartyID(ctx, other.ID)
+	require.NoError(t, err)
+	assert.Len(t, guests, 4)
+	votes, err = voteDAO.ListByPartyID(ctx, other.ID)
+	require.NoError(t, err)
+	assert.Len(t, votes, 3)
+}
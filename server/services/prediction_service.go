@@ -0,0 +1,318 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/sipgate/eurovision-vote-party/server/authz"
+	"github.com/sipgate/eurovision-vote-party/server/events"
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/persistence"
+)
+
+// PredictionDAO defines the persistence operations needed by the prediction service.
+type PredictionDAO interface {
+	Upsert(ctx context.Context, prediction *models.Prediction) error
+	GetByGuestAndParty(ctx context.Context, guestID, partyID string) (*models.Prediction, error)
+	ListByPartyID(ctx context.Context, partyID string) ([]*models.Prediction, error)
+	SetOutcome(ctx context.Context, outcome *models.PredictionOutcome) error
+	GetOutcome(ctx context.Context, partyID string) (*models.PredictionOutcome, error)
+}
+
+// PredictionPartyDAO defines the minimal party persistence operations needed by the prediction service.
+type PredictionPartyDAO interface {
+	GetByID(ctx context.Context, id string) (*models.Party, error)
+}
+
+// PredictionGuestDAO defines the minimal guest persistence operations needed by the prediction service.
+type PredictionGuestDAO interface {
+	GetByID(ctx context.Context, id string) (*models.Guest, error)
+	ListByPartyID(ctx context.Context, partyID string) ([]*models.Guest, error)
+}
+
+// SubmitPredictionRequest captures the data needed to submit or change a prediction.
+type SubmitPredictionRequest struct {
+	GuestID string
+	Picks   []string
+}
+
+// PredictionStanding is a guest's position on the prediction leaderboard.
+type PredictionStanding struct {
+	GuestID  string `json:"guestId"`
+	Username string `json:"username"`
+	Points   int    `json:"points"`
+	Correct  int    `json:"correct"`
+	Rank     int    `json:"rank"`
+}
+
+// PredictionLeaderboard ranks the predictions of a party against the official outcome.
+// It complements PartyResults, which ranks the acts by the guests' ballots.
+type PredictionLeaderboard struct {
+	PartyID   string               `json:"partyId"`
+	PartyName string               `json:"partyName"`
+	EventType models.EventType     `json:"eventType"`
+	Outcome   []string             `json:"outcome"`
+	Standings []PredictionStanding `json:"standings"`
+}
+
+// PredictionService defines the business logic operations for the prediction game.
+type PredictionService interface {
+	SubmitPrediction(ctx context.Context, partyID string, req SubmitPredictionRequest) (*models.Prediction, error)
+	GetPrediction(ctx context.Context, partyID, guestID string) (*models.Prediction, error)
+	SetOutcome(ctx context.Context, partyID string, picks []string) (*models.PredictionOutcome, error)
+	GetLeaderboard(ctx context.Context, partyID string) (*PredictionLeaderboard, error)
+}
+
+// predictionService is the default implementation.
+type predictionService struct {
+	predictionDAO PredictionDAO
+	partyDAO      PredictionPartyDAO
+	guestDAO      PredictionGuestDAO
+	actsService   VoteActsService
+	events        EventPublisher
+}
+
+// NewPredictionService creates a new PredictionService.
+// Prediction activity is published to publisher, which may be nil.
+func NewPredictionService(predictionDAO PredictionDAO, partyDAO PredictionPartyDAO, guestDAO PredictionGuestDAO, actsService VoteActsService, publisher EventPublisher) PredictionService {
+	return &predictionService{
+		predictionDAO: predictionDAO,
+		partyDAO:      partyDAO,
+		guestDAO:      guestDAO,
+		actsService:   actsService,
+		events:        publisher,
+	}
+}
+
+// SubmitPrediction stores a guest's prediction, replacing an earlier one.
+// Guests submit their own prediction and may leave the guest ID empty; the
+// party's hosts submit on behalf of the guest they name.
+// Predictions are accepted until voting starts.
+func (s *predictionService) SubmitPrediction(ctx context.Context, partyID string, req SubmitPredictionRequest) (*models.Prediction, error) {
+	party, err := s.getParty(ctx, partyID)
+	if err != nil {
+		return nil, err
+	}
+
+	guestID, err := actingGuest(ctx, party, req.GuestID, authz.SubmitPrediction, authz.SubmitPredictionForGuest)
+	if err != nil {
+		return nil, err
+	}
+
+	if predictionsLocked(party) {
+		return nil, ErrPredictionsLocked
+	}
+
+	guest, err := s.guestDAO.GetByID(ctx, guestID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	if guest.PartyID != partyID || guest.Status != models.GuestStatusApproved {
+		return nil, ErrGuestNotApproved
+	}
+
+	prediction := &models.Prediction{
+		ID:        models.PredictionIDFor(partyID, guestID),
+		PartyID:   partyID,
+		GuestID:   guestID,
+		Picks:     req.Picks,
+		UpdatedAt: time.Now(),
+	}
+	if err := prediction.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPrediction, err)
+	}
+	if err := s.checkPicks(ctx, party, prediction.Picks); err != nil {
+		return nil, err
+	}
+
+	if err := s.predictionDAO.Upsert(ctx, prediction); err != nil {
+		return nil, err
+	}
+
+	publish(s.events, partyID, events.PredictionSubmitted, guestEventData{GuestID: prediction.GuestID})
+	return prediction, nil
+}
+
+// GetPrediction retrieves a guest's prediction in a party. Guests read their
+// own prediction, for which they may leave guestID empty, and the party's
+// hosts any guest's.
+func (s *predictionService) GetPrediction(ctx context.Context, partyID, guestID string) (*models.Prediction, error) {
+	party, err := s.getParty(ctx, partyID)
+	if err != nil {
+		return nil, err
+	}
+
+	guestID, err = actingGuest(ctx, party, guestID, authz.ViewPrediction, authz.ViewPredictions)
+	if err != nil {
+		return nil, err
+	}
+
+	prediction, err := s.predictionDAO.GetByGuestAndParty(ctx, guestID, partyID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	return prediction, nil
+}
+
+// SetOutcome records the official outcome of the party's show, in the shape of a
+// prediction: the qualifiers of a semifinal, or the grand final top three with the
+// winner first. Only the party's owner and co-hosts may record it, and only once predictions are locked.
+func (s *predictionService) SetOutcome(ctx context.Context, partyID string, picks []string) (*models.PredictionOutcome, error) {
+	party, err := s.getParty(ctx, partyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorize(ctx, authz.SetPredictionOutcome, party); err != nil {
+		return nil, err
+	}
+
+	if !predictionsLocked(party) {
+		return nil, ErrPredictionsOpen
+	}
+
+	outcome := &models.PredictionOutcome{
+		PartyID:    partyID,
+		Picks:      picks,
+		RecordedAt: time.Now(),
+	}
+	if err := outcome.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPrediction, err)
+	}
+	if err := s.checkPicks(ctx, party, outcome.Picks); err != nil {
+		return nil, err
+	}
+
+	if err := s.predictionDAO.SetOutcome(ctx, outcome); err != nil {
+		return nil, err
+	}
+
+	publish(s.events, partyID, events.PredictionOutcomeRecorded, nil)
+	return outcome, nil
+}
+
+// GetLeaderboard scores every approved guest's prediction against the official
+// outcome. Guests tied on points share a rank.
+func (s *predictionService) GetLeaderboard(ctx context.Context, partyID string) (*PredictionLeaderboard, error) {
+	party, err := s.getParty(ctx, partyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorize(ctx, authz.ViewLeaderboard, party); err != nil {
+		return nil, err
+	}
+
+	outcome, err := s.predictionDAO.GetOutcome(ctx, partyID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNotFound) {
+			return nil, ErrNoOutcome
+		}
+		return nil, err
+	}
+
+	predictions, err := s.predictionDAO.ListByPartyID(ctx, partyID)
+	if err != nil {
+		return nil, err
+	}
+
+	guests, err := s.guestDAO.ListByPartyID(ctx, partyID)
+	if err != nil {
+		return nil, err
+	}
+	usernames := make(map[string]string, len(guests))
+	for _, guest := range guests {
+		if guest.Status == models.GuestStatusApproved {
+			usernames[guest.ID] = guest.Username
+		}
+	}
+
+	standings := make([]PredictionStanding, 0, len(predictions))
+	for _, prediction := range predictions {
+		username, ok := usernames[prediction.GuestID]
+		if !ok {
+			continue
+		}
+		points, correct := prediction.Score(party.EventType, *outcome)
+		standings = append(standings, PredictionStanding{
+			GuestID:  prediction.GuestID,
+			Username: username,
+			Points:   points,
+			Correct:  correct,
+		})
+	}
+
+	sort.SliceStable(standings, func(i, j int) bool {
+		if standings[i].Points != standings[j].Points {
+			return standings[i].Points > standings[j].Points
+		}
+		return standings[i].Username < standings[j].Username
+	})
+	for i := range standings {
+		if i > 0 && standings[i].Points == standings[i-1].Points {
+			standings[i].Rank = standings[i-1].Rank
+		} else {
+			standings[i].Rank = i + 1
+		}
+	}
+
+	return &PredictionLeaderboard{
+		PartyID:   party.ID,
+		PartyName: party.Name,
+		EventType: party.EventType,
+		Outcome:   outcome.Picks,
+		Standings: standings,
+	}, nil
+}
+
+// getParty loads a party.
+func (s *predictionService) getParty(ctx context.Context, partyID string) (*models.Party, error) {
+	party, err := s.partyDAO.GetByID(ctx, partyID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	return party, nil
+}
+
+// checkPicks ensures the picks name the required number of acts from the party's show.
+func (s *predictionService) checkPicks(ctx context.Context, party *models.Party, picks []string) error {
+	acts, err := s.actsService.ListActs(ctx, party.Edition(), string(party.EventType))
+	if err != nil {
+		return err
+	}
+
+	known := make(map[string]bool, len(acts))
+	for _, act := range acts {
+		known[act.ID] = true
+	}
+	for _, actID := range picks {
+		if !known[actID] {
+			return fmt.Errorf("%w: unknown act id %q", ErrInvalidPrediction, actID)
+		}
+	}
+
+	if want := models.PredictionPicks(party.EventType, len(acts)); len(picks) != want {
+		return fmt.Errorf("%w: exactly %d picks required, got %d", ErrInvalidPrediction, want, len(picks))
+	}
+	return nil
+}
+
+// predictionsLocked reports whether a party no longer accepts predictions,
+// which is the case once voting has started or the party has closed.
+func predictionsLocked(party *models.Party) bool {
+	return party.VotingStarted() || party.Status != models.PartyStatusActive
+}
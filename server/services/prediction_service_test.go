@@ -0,0 +1,228 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/persistence/memory"
+	"github.com/sipgate/eurovision-vote-party/server/services"
+)
+
+// predictionFixture is a grand final party with three approved guests and one
+// pending guest, backed by an in-memory store.
+type predictionFixture struct {
+	partyDAO *memory.PartyDAO
+	svc      services.PredictionService
+	voteSvc  services.VoteService
+}
+
+func newPredictionFixture(t *testing.T) predictionFixture {
+	t.Helper()
+	ctx := context.Background()
+
+	store := memory.NewStore()
+	partyDAO := memory.NewPartyDAO(store)
+	guestDAO := memory.NewGuestDAO(store)
+	require.NoError(t, partyDAO.Create(ctx, &models.Party{
+		ID: "party-1", Name: "Party", Code: "ABC123", EventType: models.EventGrandFinal,
+		AdminID: "admin-1", Status: models.PartyStatusActive, CreatedAt: time.Now(),
+	}))
+	for _, g := range []struct {
+		id, username string
+		status       models.GuestStatus
+	}{
+		{"guest-1", "alice", models.GuestStatusApproved},
+		{"guest-2", "bob", models.GuestStatusApproved},
+		{"guest-3", "carol", models.GuestStatusApproved},
+		{"guest-4", "dave", models.GuestStatusPending},
+	} {
+		require.NoError(t, guestDAO.Create(ctx, &models.Guest{
+			ID: g.id, PartyID: "party-1", Username: g.username, Status: g.status, CreatedAt: time.Now(),
+		}))
+	}
+
+	actsService := &mockVoteActsService{
+		listActsFunc: func(ctx context.Context, contest, eventType string) ([]models.Act, error) {
+			return testActs(), nil
+		},
+	}
+	return predictionFixture{
+		partyDAO: partyDAO,
+		svc:      services.NewPredictionService(memory.NewPredictionDAO(store), partyDAO, guestDAO, actsService, nil),
+		voteSvc:  services.NewVoteService(memory.NewVoteDAO(store), partyDAO, guestDAO, actsService, nil),
+	}
+}
+
+func (f predictionFixture) startVoting(t *testing.T) {
+	t.Helper()
+	require.NoError(t, f.partyDAO.UpdateVotingStartedAt(context.Background(), "party-1", time.Now()))
+}
+
+func TestPredictionService_SubmitPrediction(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("stores and replaces a guest's prediction", func(t *testing.T) {
+		f := newPredictionFixture(t)
+
+		_, err := f.svc.SubmitPrediction(asGuest(ctx, "guest-1", "party-1"), "party-1", services.SubmitPredictionRequest{
+			GuestID: "guest-1", Picks: []string{"act-1", "act-2", "act-3"},
+		})
+		require.NoError(t, err)
+		_, err = f.svc.SubmitPrediction(asGuest(ctx, "guest-1", "party-1"), "party-1", services.SubmitPredictionRequest{
+			GuestID: "guest-1", Picks: []string{"act-4", "act-1", "act-2"},
+		})
+		require.NoError(t, err)
+
+		prediction, err := f.svc.GetPrediction(asGuest(ctx, "guest-1", "party-1"), "party-1", "guest-1")
+		require.NoError(t, err)
+		assert.Equal(t, models.PredictionIDFor("party-1", "guest-1"), prediction.ID)
+		assert.Equal(t, []string{"act-4", "act-1", "act-2"}, prediction.Picks)
+	})
+
+	t.Run("rejects predictions once voting has started", func(t *testing.T) {
+		f := newPredictionFixture(t)
+		f.startVoting(t)
+
+		_, err := f.svc.SubmitPrediction(asGuest(ctx, "guest-1", "party-1"), "party-1", services.SubmitPredictionRequest{
+			GuestID: "guest-1", Picks: []string{"act-1", "act-2", "act-3"},
+		})
+
+		assert.ErrorIs(t, err, services.ErrPredictionsLocked)
+	})
+
+	t.Run("rejects predictions once a guest has cast a ballot", func(t *testing.T) {
+		f := newPredictionFixture(t)
+		_, err := f.svc.SubmitPrediction(asGuest(ctx, "guest-1", "party-1"), "party-1", services.SubmitPredictionRequest{
+			GuestID: "guest-1", Picks: []string{"act-1", "act-2", "act-3"},
+		})
+		require.NoError(t, err)
+
+		_, err = f.voteSvc.SubmitVote(asGuest(ctx, "guest-2", "party-1"), "party-1", services.SubmitVoteRequest{GuestID: "guest-2", Votes: validVotes()})
+		require.NoError(t, err)
+
+		_, err = f.svc.SubmitPrediction(asGuest(ctx, "guest-1", "party-1"), "party-1", services.SubmitPredictionRequest{
+			GuestID: "guest-1", Picks: []string{"act-4", "act-1", "act-2"},
+		})
+		assert.ErrorIs(t, err, services.ErrPredictionsLocked)
+	})
+
+	t.Run("rejects predictions once a guest has saved a draft", func(t *testing.T) {
+		f := newPredictionFixture(t)
+
+		_, err := f.voteSvc.SaveDraft(asGuest(ctx, "guest-2", "party-1"), "party-1", services.SubmitVoteRequest{GuestID: "guest-2", Votes: map[int]string{12: "act-1"}})
+		require.NoError(t, err)
+
+		_, err = f.svc.SubmitPrediction(asGuest(ctx, "guest-1", "party-1"), "party-1", services.SubmitPredictionRequest{
+			GuestID: "guest-1", Picks: []string{"act-1", "act-2", "act-3"},
+		})
+		assert.ErrorIs(t, err, services.ErrPredictionsLocked)
+	})
+
+	t.Run("rejects picks that do not fit the show", func(t *testing.T) {
+		f := newPredictionFixture(t)
+
+		for name, picks := range map[string][]string{
+			"too few":     {"act-1", "act-2"},
+			"too many":    {"act-1", "act-2", "act-3", "act-4"},
+			"unknown act": {"act-1", "act-2", "act-99"},
+			"duplicate":   {"act-1", "act-1", "act-2"},
+		} {
+			_, err := f.svc.SubmitPrediction(asGuest(ctx, "guest-1", "party-1"), "party-1", services.SubmitPredictionRequest{GuestID: "guest-1", Picks: picks})
+			assert.ErrorIs(t, err, services.ErrInvalidPrediction, name)
+		}
+	})
+
+	t.Run("returns ErrGuestNotApproved for pending guest", func(t *testing.T) {
+		f := newPredictionFixture(t)
+
+		_, err := f.svc.SubmitPrediction(asGuest(ctx, "guest-4", "party-1"), "party-1", services.SubmitPredictionRequest{
+			GuestID: "guest-4", Picks: []string{"act-1", "act-2", "act-3"},
+		})
+
+		assert.ErrorIs(t, err, services.ErrGuestNotApproved)
+	})
+
+	t.Run("returns ErrUnauthorized for another admin", func(t *testing.T) {
+		f := newPredictionFixture(t)
+
+		_, err := f.svc.SubmitPrediction(asUser(ctx, "admin-2"), "party-1", services.SubmitPredictionRequest{
+			GuestID: "guest-1", Picks: []string{"act-1", "act-2", "act-3"},
+		})
+
+		assert.ErrorIs(t, err, services.ErrUnauthorized)
+	})
+}
+
+func TestPredictionService_SetOutcome(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("requires predictions to be locked", func(t *testing.T) {
+		f := newPredictionFixture(t)
+
+		_, err := f.svc.SetOutcome(asUser(ctx, "admin-1"), "party-1", []string{"act-1", "act-2", "act-3"})
+
+		assert.ErrorIs(t, err, services.ErrPredictionsOpen)
+	})
+
+	t.Run("only the admin may record the outcome", func(t *testing.T) {
+		f := newPredictionFixture(t)
+		f.startVoting(t)
+
+		_, err := f.svc.SetOutcome(ctx, "party-1", []string{"act-1", "act-2", "act-3"})
+		assert.ErrorIs(t, err, services.ErrUnauthorized)
+
+		_, err = f.svc.SetOutcome(asUser(ctx, "admin-2"), "party-1", []string{"act-1", "act-2", "act-3"})
+		assert.ErrorIs(t, err, services.ErrUnauthorized)
+	})
+
+	t.Run("validates the outcome against the show", func(t *testing.T) {
+		f := newPredictionFixture(t)
+		f.startVoting(t)
+
+		_, err := f.svc.SetOutcome(asUser(ctx, "admin-1"), "party-1", []string{"act-1", "act-99", "act-3"})
+
+		assert.ErrorIs(t, err, services.ErrInvalidPrediction)
+	})
+}
+
+func TestPredictionService_GetLeaderboard(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("returns ErrNoOutcome before the outcome is recorded", func(t *testing.T) {
+		f := newPredictionFixture(t)
+
+		_, err := f.svc.GetLeaderboard(asGuest(ctx, "guest-1", "party-1"), "party-1")
+
+		assert.ErrorIs(t, err, services.ErrNoOutcome)
+	})
+
+	t.Run("ranks guests by prediction points", func(t *testing.T) {
+		f := newPredictionFixture(t)
+		for guestID, picks := range map[string][]string{
+			"guest-1": {"act-1", "act-2", "act-3"}, // winner and full podium
+			"guest-2": {"act-2", "act-3", "act-9"}, // two podium acts
+			"guest-3": {"act-3", "act-2", "act-8"}, // two podium acts
+		} {
+			_, err := f.svc.SubmitPrediction(asGuest(ctx, guestID, "party-1"), "party-1", services.SubmitPredictionRequest{GuestID: guestID, Picks: picks})
+			require.NoError(t, err)
+		}
+		f.startVoting(t)
+		_, err := f.svc.SetOutcome(asUser(ctx, "admin-1"), "party-1", []string{"act-1", "act-3", "act-2"})
+		require.NoError(t, err)
+
+		leaderboard, err := f.svc.GetLeaderboard(asGuest(ctx, "guest-1", "party-1"), "party-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"act-1", "act-3", "act-2"}, leaderboard.Outcome)
+		assert.Equal(t, []services.PredictionStanding{
+			{GuestID: "guest-1", Username: "alice", Points: 3*models.PodiumPredictionPoints + models.WinnerPredictionPoints, Correct: 3, Rank: 1},
+			{GuestID: "guest-2", Username: "bob", Points: 2 * models.PodiumPredictionPoints, Correct: 2, Rank: 2},
+			{GuestID: "guest-3", Username: "carol", Points: 2 * models.PodiumPredictionPoints, Correct: 2, Rank: 2},
+		}, leaderboard.Standings)
+	})
+}
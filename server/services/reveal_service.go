@@ -0,0 +1,288 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/sipgate/eurovision-vote-party/server/authz"
+	"github.com/sipgate/eurovision-vote-party/server/events"
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/persistence"
+)
+
+// revealPhases lists, per scoring system, the point values announced in each
+// reveal step of a voter. The Eurovision phases mirror the jury announcements:
+// 1 to 8 points are shown at once, then 10 and 12 points follow individually.
+// Scoring systems without an entry announce a voter's whole ballot in one step.
+var revealPhases = map[models.ScoringSystem][][]int{
+	models.ScoringEurovision: {{8, 7, 6, 5, 4, 3, 2, 1}, {10}, {12}},
+	models.ScoringTop3:       {{1}, {2}, {3}},
+}
+
+// revealStepsPerVoter returns the number of reveal steps announcing one voter's ballot.
+func revealStepsPerVoter(system models.ScoringSystem) int {
+	if phases, ok := revealPhases[system]; ok {
+		return len(phases)
+	}
+	return 1
+}
+
+// revealTotalSteps returns the number of reveal steps for the given number of voters.
+func revealTotalSteps(system models.ScoringSystem, voters int) int {
+	return voters * revealStepsPerVoter(system)
+}
+
+// revealAwards returns the points announced for a vote in the given phase, in
+// ascending order of points.
+func revealAwards(system models.ScoringSystem, vote *models.Vote, phase int, countries map[string]string) []RevealAward {
+	var awards []RevealAward
+	if phases, ok := revealPhases[system]; ok {
+		for _, points := range phases[phase] {
+			actID := vote.Votes[points]
+			awards = append(awards, RevealAward{ActID: actID, Country: countries[actID], Points: points})
+		}
+	} else {
+		for actID, points := range system.Scorer().Points(*vote) {
+			awards = append(awards, RevealAward{ActID: actID, Country: countries[actID], Points: points})
+		}
+	}
+	sort.SliceStable(awards, func(i, j int) bool {
+		if awards[i].Points != awards[j].Points {
+			return awards[i].Points < awards[j].Points
+		}
+		return awards[i].ActID < awards[j].ActID
+	})
+	return awards
+}
+
+// RevealPartyDAO defines the minimal party persistence operations needed by the reveal service.
+type RevealPartyDAO interface {
+	GetByID(ctx context.Context, id string) (*models.Party, error)
+	UpdateRevealStep(ctx context.Context, id string, step int) error
+}
+
+// RevealVoteDAO defines the minimal vote persistence operations needed by the reveal service.
+type RevealVoteDAO interface {
+	ListByPartyID(ctx context.Context, partyID string) ([]*models.Vote, error)
+}
+
+// RevealGuestDAO defines the minimal guest persistence operations needed by the reveal service.
+type RevealGuestDAO interface {
+	GetByID(ctx context.Context, id string) (*models.Guest, error)
+}
+
+// RevealAward is a single point award announced during the reveal.
+type RevealAward struct {
+	ActID   string `json:"actId"`
+	Country string `json:"country"`
+	Points  int    `json:"points"`
+}
+
+// RevealAnnouncement describes the points announced in the latest reveal step.
+// Parties with anonymous results leave out who the voter is.
+type RevealAnnouncement struct {
+	GuestID  string `json:"guestId,omitempty"`
+	Username string `json:"username,omitempty"`
+	// Voter is the 1-based position of the guest in the announcement order.
+	Voter  int           `json:"voter"`
+	Awards []RevealAward `json:"awards"`
+}
+
+// RevealState is the partial scoreboard of a party at its current reveal step.
+type RevealState struct {
+	PartyID      string              `json:"partyId"`
+	PartyName    string              `json:"partyName"`
+	Step         int                 `json:"step"`
+	TotalSteps   int                 `json:"totalSteps"`
+	Complete     bool                `json:"complete"`
+	TotalVoters  int                 `json:"totalVoters"`
+	Announcement *RevealAnnouncement `json:"announcement,omitempty"`
+	Scoreboard   []models.VoteResult `json:"scoreboard"`
+}
+
+// RevealService defines the business logic operations for revealing results step by step.
+type RevealService interface {
+	GetReveal(ctx context.Context, partyID string) (*RevealState, error)
+	AdvanceReveal(ctx context.Context, partyID string) (*RevealState, error)
+	ResetReveal(ctx context.Context, partyID string) (*RevealState, error)
+}
+
+// revealService is the default implementation.
+type revealService struct {
+	voteDAO     RevealVoteDAO
+	partyDAO    RevealPartyDAO
+	guestDAO    RevealGuestDAO
+	actsService VoteActsService
+	events      EventPublisher
+}
+
+// NewRevealService creates a new RevealService.
+// Reveal progress is published to publisher, which may be nil.
+func NewRevealService(voteDAO RevealVoteDAO, partyDAO RevealPartyDAO, guestDAO RevealGuestDAO, actsService VoteActsService, publisher EventPublisher) RevealService {
+	return &revealService{
+		voteDAO:     voteDAO,
+		partyDAO:    partyDAO,
+		guestDAO:    guestDAO,
+		actsService: actsService,
+		events:      publisher,
+	}
+}
+
+// GetReveal returns the scoreboard at the party's current reveal step.
+// Like GetResults it is available to whoever may view the party's results once voting has ended.
+func (s *revealService) GetReveal(ctx context.Context, partyID string) (*RevealState, error) {
+	party, err := s.loadClosedParty(ctx, partyID, authz.ViewResults)
+	if err != nil {
+		return nil, err
+	}
+	return s.buildState(ctx, party)
+}
+
+// AdvanceReveal announces the next reveal step, ensuring the requester may run the reveal.
+// Returns ErrRevealComplete if every step has been announced already.
+func (s *revealService) AdvanceReveal(ctx context.Context, partyID string) (*RevealState, error) {
+	party, err := s.loadClosedParty(ctx, partyID, authz.RunReveal)
+	if err != nil {
+		return nil, err
+	}
+
+	votes, err := s.voteDAO.ListByPartyID(ctx, partyID)
+	if err != nil {
+		return nil, err
+	}
+	votes, _ = finalBallots(votes)
+	if party.RevealStep >= revealTotalSteps(party.Scoring(), len(votes)) {
+		return nil, ErrRevealComplete
+	}
+
+	return s.moveTo(ctx, party, party.RevealStep+1)
+}
+
+// ResetReveal rewinds the reveal to its start, ensuring the requester may run the reveal.
+func (s *revealService) ResetReveal(ctx context.Context, partyID string) (*RevealState, error) {
+	party, err := s.loadClosedParty(ctx, partyID, authz.RunReveal)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.moveTo(ctx, party, 0)
+}
+
+// moveTo stores the new reveal step and notifies subscribers.
+func (s *revealService) moveTo(ctx context.Context, party *models.Party, step int) (*RevealState, error) {
+	if err := s.partyDAO.UpdateRevealStep(ctx, party.ID, step); err != nil {
+		return nil, err
+	}
+	party.RevealStep = step
+
+	state, err := s.buildState(ctx, party)
+	if err != nil {
+		return nil, err
+	}
+
+	publish(s.events, party.ID, events.RevealUpdated, map[string]int{"step": step})
+	return state, nil
+}
+
+// loadClosedParty loads a party whose voting has ended, checking that the
+// requester may perform the action.
+func (s *revealService) loadClosedParty(ctx context.Context, partyID string, action authz.Action) (*models.Party, error) {
+	party, err := s.partyDAO.GetByID(ctx, partyID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	if err := authorize(ctx, action, party); err != nil {
+		return nil, err
+	}
+
+	if party.Status != models.PartyStatusClosed {
+		return nil, ErrVotingNotEnded
+	}
+
+	return party, nil
+}
+
+// buildState replays the announced reveal steps into a partial scoreboard.
+// Voters are announced in the order they submitted their ballots; drafts are skipped.
+func (s *revealService) buildState(ctx context.Context, party *models.Party) (*RevealState, error) {
+	votes, err := s.voteDAO.ListByPartyID(ctx, party.ID)
+	if err != nil {
+		return nil, err
+	}
+	votes, _ = finalBallots(votes)
+	sort.SliceStable(votes, func(i, j int) bool {
+		if !votes[i].CreatedAt.Equal(votes[j].CreatedAt) {
+			return votes[i].CreatedAt.Before(votes[j].CreatedAt)
+		}
+		return votes[i].ID < votes[j].ID
+	})
+
+	acts, err := s.actsService.ListActs(ctx, party.Edition(), string(party.EventType))
+	if err != nil {
+		return nil, err
+	}
+	countries := make(map[string]string, len(acts))
+	for _, act := range acts {
+		countries[act.ID] = act.Country
+	}
+
+	system := party.Scoring()
+	perVoter := revealStepsPerVoter(system)
+	total := revealTotalSteps(system, len(votes))
+	step := min(party.RevealStep, total)
+
+	// Voters announced in full count like in GetResults, so the last frame
+	// matches the results; the voter being announced adds the phases so far.
+	full, partial := step/perVoter, step%perVoter
+	t := tallyResults(system.Scorer(), acts, votes[:full])
+	var last []RevealAward
+	for phase := 0; phase < partial; phase++ {
+		last = revealAwards(system, votes[full], phase, countries)
+		for _, award := range last {
+			t.award(votes[full].GuestID, award.ActID, award.Points)
+		}
+	}
+	if partial == 0 && full > 0 {
+		last = revealAwards(system, votes[full-1], perVoter-1, countries)
+	}
+	if !party.AnonymousResults {
+		if err := t.addTopMarks(ctx, s.guestDAO); err != nil {
+			return nil, err
+		}
+	}
+
+	state := &RevealState{
+		PartyID:     party.ID,
+		PartyName:   party.Name,
+		Step:        step,
+		TotalSteps:  total,
+		Complete:    step == total,
+		TotalVoters: len(votes),
+		Scoreboard:  t.ranked(),
+	}
+
+	if step > 0 {
+		voter := (step - 1) / perVoter
+		announcement := &RevealAnnouncement{
+			Voter:  voter + 1,
+			Awards: last,
+		}
+		if !party.AnonymousResults {
+			announcement.GuestID = votes[voter].GuestID
+			guest, err := s.guestDAO.GetByID(ctx, votes[voter].GuestID)
+			if err == nil {
+				announcement.Username = guest.Username
+			} else if !errors.Is(err, persistence.ErrNotFound) {
+				return nil, err
+			}
+		}
+		state.Announcement = announcement
+	}
+
+	return state, nil
+}
@@ -0,0 +1,347 @@
+package services_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sipgate/eurovision-vote-party/server/events"
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/persistence/memory"
+	"github.com/sipgate/eurovision-vote-party/server/services"
+)
+
+// revealFixture is a closed party with two ballots stored in memory.
+type revealFixture struct {
+	reveal services.RevealService
+	votes  services.VoteService
+	bus    *events.Bus
+}
+
+// newRevealFixture creates the fixture; opts adjust the party before it is stored.
+func newRevealFixture(t *testing.T, status models.PartyStatus, opts ...func(*models.Party)) *revealFixture {
+	t.Helper()
+	ctx := context.Background()
+	store := memory.NewStore()
+	partyDAO := memory.NewPartyDAO(store)
+	guestDAO := memory.NewGuestDAO(store)
+	voteDAO := memory.NewVoteDAO(store)
+	acts := &mockVoteActsService{
+		listActsFunc: func(ctx context.Context, contest, eventType string) ([]models.Act, error) {
+			return testActs(), nil
+		},
+	}
+	bus := events.NewBus(events.DefaultHistory)
+
+	start := time.Now()
+	party := &models.Party{
+		ID: "party-1", Name: "Reveal Party", Code: "ABC123", EventType: models.EventGrandFinal,
+		AdminID: "admin-1", Status: status, CreatedAt: start,
+	}
+	for _, opt := range opts {
+		opt(party)
+	}
+	require.NoError(t, partyDAO.Create(ctx, party))
+
+	// alice votes first, bob second with the ballot reversed.
+	reversed := make(map[int]string, len(models.ValidPointValues))
+	for i, points := range models.ValidPointValues {
+		reversed[points] = validVotes()[models.ValidPointValues[len(models.ValidPointValues)-1-i]]
+	}
+	for i, ballot := range []struct {
+		guestID, username string
+		votes             map[int]string
+	}{
+		{"guest-b", "alice", validVotes()},
+		{"guest-a", "bob", reversed},
+	} {
+		require.NoError(t, guestDAO.Create(ctx, &models.Guest{
+			ID: ballot.guestID, PartyID: "party-1", Username: ballot.username,
+			Status: models.GuestStatusApproved, CreatedAt: start,
+		}))
+		require.NoError(t, voteDAO.Create(ctx, &models.Vote{
+			ID: models.VoteIDFor("party-1", ballot.guestID), GuestID: ballot.guestID, PartyID: "party-1",
+			Votes: ballot.votes, CreatedAt: start.Add(time.Duration(i) * time.Second),
+		}))
+	}
+
+	return &revealFixture{
+		reveal: services.NewRevealService(voteDAO, partyDAO, guestDAO, acts, bus),
+		votes:  services.NewVoteService(voteDAO, partyDAO, guestDAO, acts, nil),
+		bus:    bus,
+	}
+}
+
+func pointsOf(state *services.RevealState) map[string]int {
+	points := make(map[string]int, len(state.Scoreboard))
+	for _, result := range state.Scoreboard {
+		points[result.ActID] = result.TotalPoints
+	}
+	return points
+}
+
+func TestRevealService_GetReveal(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("starts with an empty scoreboard", func(t *testing.T) {
+		f := newRevealFixture(t, models.PartyStatusClosed)
+
+		state, err := f.reveal.GetReveal(ctx, "party-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, state.Step)
+		assert.Equal(t, 6, state.TotalSteps)
+		assert.Equal(t, 2, state.TotalVoters)
+		assert.False(t, state.Complete)
+		assert.Nil(t, state.Announcement)
+		require.Len(t, state.Scoreboard, 10)
+		for _, result := range state.Scoreboard {
+			assert.Zero(t, result.TotalPoints)
+		}
+	})
+
+	t.Run("returns ErrVotingNotEnded while voting is open", func(t *testing.T) {
+		f := newRevealFixture(t, models.PartyStatusActive)
+
+		_, err := f.reveal.GetReveal(ctx, "party-1")
+
+		assert.ErrorIs(t, err, services.ErrVotingNotEnded)
+	})
+
+	t.Run("returns ErrUnauthorized for non-owner", func(t *testing.T) {
+		f := newRevealFixture(t, models.PartyStatusClosed)
+
+		_, err := f.reveal.GetReveal(asUser(ctx, "other-admin"), "party-1")
+
+		assert.ErrorIs(t, err, services.ErrUnauthorized)
+	})
+
+	t.Run("returns ErrNotFound when party not found", func(t *testing.T) {
+		f := newRevealFixture(t, models.PartyStatusClosed)
+
+		_, err := f.reveal.GetReveal(ctx, "nonexistent")
+
+		assert.ErrorIs(t, err, services.ErrNotFound)
+	})
+}
+
+func TestRevealService_AdvanceReveal(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("announces low points in bulk then 10 and 12 per voter", func(t *testing.T) {
+		f := newRevealFixture(t, models.PartyStatusClosed)
+
+		state, err := f.reveal.AdvanceReveal(asUser(ctx, "admin-1"), "party-1")
+		require.NoError(t, err)
+		assert.Equal(t, 1, state.Step)
+		require.NotNil(t, state.Announcement)
+		assert.Equal(t, "guest-b", state.Announcement.GuestID)
+		assert.Equal(t, "alice", state.Announcement.Username)
+		assert.Equal(t, 1, state.Announcement.Voter)
+		assert.Len(t, state.Announcement.Awards, 8)
+		assert.Equal(t, 8, pointsOf(state)["act-3"])
+		assert.Equal(t, 0, pointsOf(state)["act-2"])
+		assert.Equal(t, 0, pointsOf(state)["act-1"])
+		assert.Equal(t, "act-3", state.Scoreboard[0].ActID)
+
+		state, err = f.reveal.AdvanceReveal(asUser(ctx, "admin-1"), "party-1")
+		require.NoError(t, err)
+		assert.Equal(t, []services.RevealAward{{ActID: "act-2", Country: "Country 2", Points: 10}}, state.Announcement.Awards)
+		assert.Equal(t, 10, pointsOf(state)["act-2"])
+
+		state, err = f.reveal.AdvanceReveal(asUser(ctx, "admin-1"), "party-1")
+		require.NoError(t, err)
+		assert.Equal(t, []services.RevealAward{{ActID: "act-1", Country: "Country 1", Points: 12}}, state.Announcement.Awards)
+		assert.Equal(t, "act-1", state.Scoreboard[0].ActID)
+
+		state, err = f.reveal.AdvanceReveal(asUser(ctx, "admin-1"), "party-1")
+		require.NoError(t, err)
+		assert.Equal(t, "bob", state.Announcement.Username)
+		assert.Equal(t, 2, state.Announcement.Voter)
+	})
+
+	t.Run("ends with the final ranking and then refuses to advance", func(t *testing.T) {
+		f := newRevealFixture(t, models.PartyStatusClosed)
+		var state *services.RevealState
+		for i := 0; i < 6; i++ {
+			var err error
+			state, err = f.reveal.AdvanceReveal(asUser(ctx, "admin-1"), "party-1")
+			require.NoError(t, err)
+		}
+
+		results, err := f.votes.GetResults(ctx, "party-1")
+		require.NoError(t, err)
+		assert.True(t, state.Complete)
+		assert.Equal(t, results.Results, state.Scoreboard)
+
+		_, err = f.reveal.AdvanceReveal(asUser(ctx, "admin-1"), "party-1")
+		assert.ErrorIs(t, err, services.ErrRevealComplete)
+	})
+
+	t.Run("persists the step and publishes it", func(t *testing.T) {
+		f := newRevealFixture(t, models.PartyStatusClosed)
+		sub, _ := f.bus.Subscribe("party-1", 0)
+		defer sub.Close()
+
+		_, err := f.reveal.AdvanceReveal(asUser(ctx, "admin-1"), "party-1")
+		require.NoError(t, err)
+
+		state, err := f.reveal.GetReveal(ctx, "party-1")
+		require.NoError(t, err)
+		assert.Equal(t, 1, state.Step)
+		event := <-sub.Events()
+		assert.Equal(t, events.RevealUpdated, event.Type)
+		assert.Equal(t, map[string]int{"step": 1}, event.Data)
+	})
+
+	t.Run("returns ErrUnauthorized for guests and non-owners", func(t *testing.T) {
+		f := newRevealFixture(t, models.PartyStatusClosed)
+
+		_, err := f.reveal.AdvanceReveal(ctx, "party-1")
+		assert.ErrorIs(t, err, services.ErrUnauthorized)
+
+		_, err = f.reveal.AdvanceReveal(asUser(ctx, "other-admin"), "party-1")
+		assert.ErrorIs(t, err, services.ErrUnauthorized)
+	})
+
+	t.Run("returns ErrVotingNotEnded while voting is open", func(t *testing.T) {
+		f := newRevealFixture(t, models.PartyStatusActive)
+
+		_, err := f.reveal.AdvanceReveal(asUser(ctx, "admin-1"), "party-1")
+
+		assert.ErrorIs(t, err, services.ErrVotingNotEnded)
+	})
+}
+
+func TestRevealService_AnonymousResultsHideVoters(t *testing.T) {
+	ctx := context.Background()
+	f := newRevealFixture(t, models.PartyStatusClosed, func(p *models.Party) {
+		p.AnonymousResults = true
+	})
+
+	for i := 0; i < 3; i++ {
+		state, err := f.reveal.AdvanceReveal(asUser(ctx, "admin-1"), "party-1")
+		require.NoError(t, err)
+		require.NotNil(t, state.Announcement)
+		assert.Empty(t, state.Announcement.GuestID)
+		assert.Empty(t, state.Announcement.Username)
+		assert.Equal(t, 1, state.Announcement.Voter)
+		for _, result := range state.Scoreboard {
+			assert.Empty(t, result.TopMarks)
+		}
+	}
+}
+
+func TestRevealService_ResetReveal(t *testing.T) {
+	ctx := context.Background()
+	f := newRevealFixture(t, models.PartyStatusClosed)
+	_, err := f.reveal.AdvanceReveal(asUser(ctx, "admin-1"), "party-1")
+	require.NoError(t, err)
+
+	_, err = f.reveal.ResetReveal(ctx, "party-1")
+	assert.ErrorIs(t, err, services.ErrUnauthorized)
+
+	state, err := f.reveal.ResetReveal(asUser(ctx, "admin-1"), "party-1")
+	require.NoError(t, err)
+	assert.Equal(t, 0, state.Step)
+	assert.Nil(t, state.Announcement)
+}
+
+func TestVoteService_GetResults_WithheldFromGuestsDuringReveal(t *testing.T) {
+	ctx := context.Background()
+	f := newRevealFixture(t, models.PartyStatusClosed)
+	_, err := f.reveal.AdvanceReveal(asUser(ctx, "admin-1"), "party-1")
+	require.NoError(t, err)
+
+	_, err = f.votes.GetResults(ctx, "party-1")
+	assert.ErrorIs(t, err, services.ErrRevealInProgress)
+
+	_, err = f.votes.GetResults(asUser(ctx, "admin-1"), "party-1")
+	assert.NoError(t, err)
+}
+
+func TestRevealService_AnnouncesWholeBallotWithoutRevealPhases(t *testing.T) {
+	ctx := context.Background()
+	store := memory.NewStore()
+	partyDAO := memory.NewPartyDAO(store)
+	guestDAO := memory.NewGuestDAO(store)
+	voteDAO := memory.NewVoteDAO(store)
+	acts := &mockVoteActsService{
+		listActsFunc: func(ctx context.Context, contest, eventType string) ([]models.Act, error) {
+			return testActs()[:3], nil
+		},
+	}
+
+	require.NoError(t, partyDAO.Create(ctx, &models.Party{
+		ID: "party-1", Name: "Rating Party", Code: "ABC123", EventType: models.EventGrandFinal,
+		AdminID: "admin-1", Status: models.PartyStatusClosed, CreatedAt: time.Now(),
+		ScoringSystem: models.ScoringRating,
+	}))
+	require.NoError(t, voteDAO.Create(ctx, &models.Vote{
+		ID: models.VoteIDFor("party-1", "guest-1"), GuestID: "guest-1", PartyID: "party-1",
+		Ratings: map[string]int{"act-1": 7, "act-2": 2, "act-3": 9}, CreatedAt: time.Now(),
+	}))
+
+	svc := services.NewRevealService(voteDAO, partyDAO, guestDAO, acts, nil)
+
+	state, err := svc.AdvanceReveal(asUser(ctx, "admin-1"), "party-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, state.TotalSteps)
+	assert.True(t, state.Complete)
+	require.NotNil(t, state.Announcement)
+	assert.Equal(t, []services.RevealAward{
+		{ActID: "act-2", Country: "Country 2", Points: 2},
+		{ActID: "act-1", Country: "Country 1", Points: 7},
+		{ActID: "act-3", Country: "Country 3", Points: 9},
+	}, state.Announcement.Awards)
+	assert.Equal(t, map[string]int{"act-1": 7, "act-2": 2, "act-3": 9}, pointsOf(state))
+}
+
+func TestRevealService_RatingRevealEndsWithResults(t *testing.T) {
+	ctx := context.Background()
+	store := memory.NewStore()
+	partyDAO := memory.NewPartyDAO(store)
+	guestDAO := memory.NewGuestDAO(store)
+	voteDAO := memory.NewVoteDAO(store)
+	acts := &mockVoteActsService{
+		listActsFunc: func(ctx context.Context, contest, eventType string) ([]models.Act, error) {
+			return testActs()[:3], nil
+		},
+	}
+
+	start := time.Now()
+	require.NoError(t, partyDAO.Create(ctx, &models.Party{
+		ID: "party-1", Name: "Rating Party", Code: "ABC123", EventType: models.EventGrandFinal,
+		AdminID: "admin-1", Status: models.PartyStatusClosed, CreatedAt: start,
+		ScoringSystem: models.ScoringRating,
+	}))
+	for i, ratings := range []map[string]int{
+		{"act-1": 7, "act-2": 2, "act-3": 9},
+		{"act-1": 4, "act-2": 6, "act-3": 10},
+	} {
+		guestID := fmt.Sprintf("guest-%d", i+1)
+		require.NoError(t, voteDAO.Create(ctx, &models.Vote{
+			ID: models.VoteIDFor("party-1", guestID), GuestID: guestID, PartyID: "party-1",
+			Ratings: ratings, CreatedAt: start.Add(time.Duration(i) * time.Second),
+		}))
+	}
+
+	reveal := services.NewRevealService(voteDAO, partyDAO, guestDAO, acts, nil)
+	var state *services.RevealState
+	for i := 0; i < 2; i++ {
+		var err error
+		state, err = reveal.AdvanceReveal(asUser(ctx, "admin-1"), "party-1")
+		require.NoError(t, err)
+	}
+
+	results, err := services.NewVoteService(voteDAO, partyDAO, guestDAO, acts, nil).GetResults(ctx, "party-1")
+	require.NoError(t, err)
+	assert.True(t, state.Complete)
+	assert.Equal(t, results.Results, state.Scoreboard)
+	assert.Equal(t, 9.5, state.Scoreboard[0].AverageScore)
+}
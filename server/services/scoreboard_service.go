@@ -0,0 +1,249 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/persistence"
+)
+
+// ScoreboardDAO defines the persistence operations needed by the scoreboard service.
+type ScoreboardDAO interface {
+	Replace(ctx context.Context, scoreboard *models.Scoreboard) error
+	Get(ctx context.Context, contest string, eventType models.EventType) (*models.Scoreboard, error)
+}
+
+// ScoreboardPartyDAO defines the party operations needed by the scoreboard service.
+type ScoreboardPartyDAO interface {
+	GetByID(ctx context.Context, id string) (*models.Party, error)
+}
+
+// ScoreboardResultsService defines the results operations needed by the scoreboard service.
+type ScoreboardResultsService interface {
+	GetResults(ctx context.Context, partyID string) (*PartyResults, error)
+}
+
+// ActComparison sets an act's party result beside its official result.
+// The official fields are zero and RankDelta is nil for acts missing from the
+// official scoreboard.
+type ActComparison struct {
+	ActID          string `json:"actId"`
+	Country        string `json:"country"`
+	Artist         string `json:"artist"`
+	Song           string `json:"song"`
+	PartyRank      int    `json:"partyRank"`
+	PartyPoints    int    `json:"partyPoints"`
+	OfficialRank   int    `json:"officialRank,omitempty"`
+	OfficialPoints int    `json:"officialPoints"`
+	JuryPoints     int    `json:"juryPoints"`
+	TelevotePoints int    `json:"televotePoints"`
+	// RankDelta is the official rank minus the party rank: positive values
+	// mean the party liked the act more than Europe did.
+	RankDelta *int `json:"rankDelta,omitempty"`
+}
+
+// ResultsComparison compares a party's results with the official scoreboard of its show.
+type ResultsComparison struct {
+	PartyID   string           `json:"partyId"`
+	PartyName string           `json:"partyName"`
+	Contest   string           `json:"contest"`
+	EventType models.EventType `json:"eventType"`
+	Acts      []ActComparison  `json:"acts"`
+	// Spearman is the Spearman rank correlation between the party and the
+	// official ranking, from -1 to 1. It is nil when fewer than two acts
+	// appear in both rankings or either ranking has every act tied.
+	Spearman *float64 `json:"spearman,omitempty"`
+}
+
+// ScoreboardService defines the business logic operations for official scoreboards.
+type ScoreboardService interface {
+	ImportScoreboard(ctx context.Context, userID string, scoreboard models.Scoreboard) (*models.Scoreboard, error)
+	GetScoreboard(ctx context.Context, contest, eventType string) (*models.Scoreboard, error)
+	CompareResults(ctx context.Context, partyID string) (*ResultsComparison, error)
+}
+
+type scoreboardService struct {
+	dao            ScoreboardDAO
+	partyDAO       ScoreboardPartyDAO
+	actsService    VoteActsService
+	resultsService ScoreboardResultsService
+	editors        map[string]bool
+}
+
+// NewScoreboardService creates a new ScoreboardService.
+// editors lists the IDs of the users allowed to import scoreboards.
+func NewScoreboardService(dao ScoreboardDAO, partyDAO ScoreboardPartyDAO, actsService VoteActsService, resultsService ScoreboardResultsService, editors []string) ScoreboardService {
+	s := &scoreboardService{
+		dao:            dao,
+		partyDAO:       partyDAO,
+		actsService:    actsService,
+		resultsService: resultsService,
+		editors:        make(map[string]bool, len(editors)),
+	}
+	for _, id := range editors {
+		s.editors[id] = true
+	}
+	return s
+}
+
+// ImportScoreboard stores the official scoreboard of a show, replacing any
+// earlier import. Every act on the scoreboard must compete in the show.
+func (s *scoreboardService) ImportScoreboard(ctx context.Context, userID string, scoreboard models.Scoreboard) (*models.Scoreboard, error) {
+	if !s.editors[userID] {
+		return nil, ErrUnauthorized
+	}
+
+	scoreboard.ImportedAt = time.Now().UTC()
+	if err := scoreboard.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidScoreboard, err)
+	}
+
+	lineup, err := s.actsService.ListActs(ctx, scoreboard.Contest, string(scoreboard.EventType))
+	if err != nil {
+		return nil, err
+	}
+	competing := make(map[string]bool, len(lineup))
+	for _, act := range lineup {
+		competing[act.ID] = true
+	}
+	for _, entry := range scoreboard.Entries {
+		if !competing[entry.ActID] {
+			return nil, fmt.Errorf("%w: act %q does not compete in %s", ErrInvalidScoreboard, entry.ActID, scoreboard.EventType)
+		}
+	}
+
+	if err := s.dao.Replace(ctx, &scoreboard); err != nil {
+		return nil, err
+	}
+	return &scoreboard, nil
+}
+
+// GetScoreboard returns the official scoreboard of a show.
+func (s *scoreboardService) GetScoreboard(ctx context.Context, contest, eventType string) (*models.Scoreboard, error) {
+	scoreboard, err := s.dao.Get(ctx, contest, models.EventType(eventType))
+	if err != nil {
+		if errors.Is(err, persistence.ErrNotFound) {
+			return nil, ErrNoScoreboard
+		}
+		return nil, err
+	}
+	return scoreboard, nil
+}
+
+// CompareResults compares a party's results with the official scoreboard of its
+// show. The same access rules as for the party's results apply.
+func (s *scoreboardService) CompareResults(ctx context.Context, partyID string) (*ResultsComparison, error) {
+	results, err := s.resultsService.GetResults(ctx, partyID)
+	if err != nil {
+		return nil, err
+	}
+
+	party, err := s.partyDAO.GetByID(ctx, partyID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	scoreboard, err := s.GetScoreboard(ctx, party.Edition(), string(party.EventType))
+	if err != nil {
+		return nil, err
+	}
+
+	official := make(map[string]models.ScoreboardEntry, len(scoreboard.Entries))
+	for _, entry := range scoreboard.Entries {
+		official[entry.ActID] = entry
+	}
+	officialRanks := scoreboard.Ranks()
+
+	comparison := &ResultsComparison{
+		PartyID:   party.ID,
+		PartyName: party.Name,
+		Contest:   party.Edition(),
+		EventType: party.EventType,
+		Acts:      make([]ActComparison, 0, len(results.Results)),
+	}
+	var partyRanks, ranks []int
+	for _, result := range results.Results {
+		act := ActComparison{
+			ActID:       result.ActID,
+			Country:     result.Country,
+			Artist:      result.Artist,
+			Song:        result.Song,
+			PartyRank:   result.Rank,
+			PartyPoints: result.TotalPoints,
+		}
+		if entry, ok := official[result.ActID]; ok {
+			delta := officialRanks[result.ActID] - result.Rank
+			act.OfficialRank = officialRanks[result.ActID]
+			act.OfficialPoints = entry.Total()
+			act.JuryPoints = entry.JuryPoints
+			act.TelevotePoints = entry.TelevotePoints
+			act.RankDelta = &delta
+			partyRanks = append(partyRanks, result.Rank)
+			ranks = append(ranks, act.OfficialRank)
+		}
+		comparison.Acts = append(comparison.Acts, act)
+	}
+	comparison.Spearman = spearman(partyRanks, ranks)
+
+	return comparison, nil
+}
+
+// spearman returns the Spearman rank correlation of two paired lists of values,
+// such as two rankings or two point totals of the same acts: the Pearson
+// correlation of their ranks, with tied values sharing the average of the
+// positions they span. Both lists must be ordered in the same direction. It
+// returns nil if the correlation is undefined.
+func spearman(a, b []int) *float64 {
+	if len(a) < 2 {
+		return nil
+	}
+	x, y := fractionalRanks(a), fractionalRanks(b)
+
+	var meanX, meanY float64
+	for i := range x {
+		meanX += x[i]
+		meanY += y[i]
+	}
+	meanX /= float64(len(x))
+	meanY /= float64(len(y))
+
+	var cov, varX, varY float64
+	for i := range x {
+		dx, dy := x[i]-meanX, y[i]-meanY
+		cov += dx * dy
+		varX += dx * dx
+		varY += dy * dy
+	}
+	if varX == 0 || varY == 0 {
+		return nil
+	}
+
+	rho := cov / math.Sqrt(varX*varY)
+	return &rho
+}
+
+// fractionalRanks ranks the values from 1 for the smallest to n for the
+// largest, giving tied values the average of the positions they span.
+func fractionalRanks(values []int) []float64 {
+	result := make([]float64, len(values))
+	for i, value := range values {
+		smaller, tied := 0, 0
+		for _, other := range values {
+			switch {
+			case other < value:
+				smaller++
+			case other == value:
+				tied++
+			}
+		}
+		result[i] = float64(smaller) + float64(tied+1)/2
+	}
+	return result
+}
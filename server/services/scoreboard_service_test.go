@@ -0,0 +1,236 @@
+package services_test
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/persistence/memory"
+	"github.com/sipgate/eurovision-vote-party/server/services"
+)
+
+type mockResultsService struct {
+	getResultsFunc func(ctx context.Context, partyID string) (*services.PartyResults, error)
+}
+
+func (m *mockResultsService) GetResults(ctx context.Context, partyID string) (*services.PartyResults, error) {
+	return m.getResultsFunc(ctx, partyID)
+}
+
+// scoreboardFixture is a closed grand final party whose results rank act-1,
+// act-2 and act-3 in that order, backed by an in-memory store.
+type scoreboardFixture struct {
+	results *services.PartyResults
+	svc     services.ScoreboardService
+}
+
+func newScoreboardFixture(t *testing.T) *scoreboardFixture {
+	t.Helper()
+
+	store := memory.NewStore()
+	partyDAO := memory.NewPartyDAO(store)
+	require.NoError(t, partyDAO.Create(context.Background(), &models.Party{
+		ID: "party-1", Name: "Party", Code: "ABC123", EventType: models.EventGrandFinal,
+		AdminID: "admin-1", Status: models.PartyStatusClosed, CreatedAt: time.Now(),
+	}))
+
+	f := &scoreboardFixture{
+		results: &services.PartyResults{
+			PartyID:   "party-1",
+			PartyName: "Party",
+			Results: []models.VoteResult{
+				{ActID: "act-1", Country: "Country 1", TotalPoints: 24, Rank: 1},
+				{ActID: "act-2", Country: "Country 2", TotalPoints: 20, Rank: 2},
+				{ActID: "act-3", Country: "Country 3", TotalPoints: 16, Rank: 3},
+			},
+		},
+	}
+	actsService := &mockVoteActsService{
+		listActsFunc: func(ctx context.Context, contest, eventType string) ([]models.Act, error) {
+			if contest != models.DefaultContest {
+				return nil, services.ErrUnknownContest
+			}
+			return testActs(), nil
+		},
+	}
+	resultsService := &mockResultsService{
+		getResultsFunc: func(ctx context.Context, partyID string) (*services.PartyResults, error) {
+			return f.results, nil
+		},
+	}
+	f.svc = services.NewScoreboardService(memory.NewScoreboardDAO(store), partyDAO, actsService, resultsService, []string{"editor-1"})
+	return f
+}
+
+// importScoreboard imports a grand final scoreboard awarding the given total
+// points, split evenly between jury and televote, to act-1, act-2 and so on.
+func (f *scoreboardFixture) importScoreboard(t *testing.T, totals ...int) {
+	t.Helper()
+	entries := make([]models.ScoreboardEntry, len(totals))
+	for i, total := range totals {
+		entries[i] = models.ScoreboardEntry{ActID: testActs()[i].ID, JuryPoints: total / 2, TelevotePoints: total - total/2}
+	}
+	_, err := f.svc.ImportScoreboard(context.Background(), "editor-1", models.Scoreboard{
+		Contest: models.DefaultContest, EventType: models.EventGrandFinal, Entries: entries,
+	})
+	require.NoError(t, err)
+}
+
+func TestScoreboardService_ImportScoreboard(t *testing.T) {
+	ctx := context.Background()
+	scoreboard := func(entries ...models.ScoreboardEntry) models.Scoreboard {
+		return models.Scoreboard{Contest: models.DefaultContest, EventType: models.EventGrandFinal, Entries: entries}
+	}
+
+	t.Run("stores the scoreboard", func(t *testing.T) {
+		f := newScoreboardFixture(t)
+
+		imported, err := f.svc.ImportScoreboard(ctx, "editor-1", scoreboard(models.ScoreboardEntry{ActID: "act-1", JuryPoints: 100, TelevotePoints: 50}))
+		require.NoError(t, err)
+		assert.False(t, imported.ImportedAt.IsZero())
+
+		stored, err := f.svc.GetScoreboard(ctx, models.DefaultContest, string(models.EventGrandFinal))
+		require.NoError(t, err)
+		assert.Equal(t, imported.Entries, stored.Entries)
+	})
+
+	t.Run("returns ErrUnauthorized for non-editor", func(t *testing.T) {
+		f := newScoreboardFixture(t)
+
+		_, err := f.svc.ImportScoreboard(ctx, "admin-1", scoreboard(models.ScoreboardEntry{ActID: "act-1"}))
+
+		assert.ErrorIs(t, err, services.ErrUnauthorized)
+	})
+
+	t.Run("rejects invalid scoreboards", func(t *testing.T) {
+		f := newScoreboardFixture(t)
+
+		for name, sb := range map[string]models.Scoreboard{
+			"no entries":      scoreboard(),
+			"negative points": scoreboard(models.ScoreboardEntry{ActID: "act-1", JuryPoints: -1}),
+			"unknown act":     scoreboard(models.ScoreboardEntry{ActID: "act-99", JuryPoints: 1}),
+		} {
+			_, err := f.svc.ImportScoreboard(ctx, "editor-1", sb)
+			assert.ErrorIs(t, err, services.ErrInvalidScoreboard, name)
+		}
+	})
+
+	t.Run("returns ErrUnknownContest for unknown contest", func(t *testing.T) {
+		f := newScoreboardFixture(t)
+		sb := scoreboard(models.ScoreboardEntry{ActID: "act-1"})
+		sb.Contest = "esc-1956"
+
+		_, err := f.svc.ImportScoreboard(ctx, "editor-1", sb)
+
+		assert.ErrorIs(t, err, services.ErrUnknownContest)
+	})
+}
+
+func TestScoreboardService_GetScoreboard(t *testing.T) {
+	f := newScoreboardFixture(t)
+
+	_, err := f.svc.GetScoreboard(context.Background(), models.DefaultContest, string(models.EventGrandFinal))
+
+	assert.ErrorIs(t, err, services.ErrNoScoreboard)
+}
+
+func TestScoreboardService_CompareResults(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("matching rankings correlate perfectly", func(t *testing.T) {
+		f := newScoreboardFixture(t)
+		f.importScoreboard(t, 300, 200, 100)
+
+		comparison, err := f.svc.CompareResults(asUser(ctx, "admin-1"), "party-1")
+		require.NoError(t, err)
+
+		assert.Equal(t, "party-1", comparison.PartyID)
+		assert.Equal(t, models.DefaultContest, comparison.Contest)
+		require.Len(t, comparison.Acts, 3)
+		act := comparison.Acts[0]
+		assert.Equal(t, "act-1", act.ActID)
+		assert.Equal(t, 1, act.PartyRank)
+		assert.Equal(t, 24, act.PartyPoints)
+		assert.Equal(t, 1, act.OfficialRank)
+		assert.Equal(t, 300, act.OfficialPoints)
+		assert.Equal(t, 150, act.JuryPoints)
+		assert.Equal(t, 150, act.TelevotePoints)
+		require.NotNil(t, act.RankDelta)
+		assert.Equal(t, 0, *act.RankDelta)
+		require.NotNil(t, comparison.Spearman)
+		assert.InDelta(t, 1, *comparison.Spearman, 1e-9)
+	})
+
+	t.Run("reversed rankings correlate negatively", func(t *testing.T) {
+		f := newScoreboardFixture(t)
+		f.importScoreboard(t, 100, 200, 300)
+
+		comparison, err := f.svc.CompareResults(asUser(ctx, "admin-1"), "party-1")
+		require.NoError(t, err)
+
+		assert.Equal(t, 2, *comparison.Acts[0].RankDelta)
+		assert.Equal(t, 0, *comparison.Acts[1].RankDelta)
+		assert.Equal(t, -2, *comparison.Acts[2].RankDelta)
+		assert.InDelta(t, -1, *comparison.Spearman, 1e-9)
+	})
+
+	t.Run("tied ranks share their average position", func(t *testing.T) {
+		f := newScoreboardFixture(t)
+		f.results.Results[1].TotalPoints, f.results.Results[1].Rank = 24, 1
+		f.importScoreboard(t, 300, 200, 100)
+
+		comparison, err := f.svc.CompareResults(asUser(ctx, "admin-1"), "party-1")
+		require.NoError(t, err)
+
+		assert.InDelta(t, math.Sqrt(3)/2, *comparison.Spearman, 1e-9)
+	})
+
+	t.Run("acts missing from the scoreboard have no official rank", func(t *testing.T) {
+		f := newScoreboardFixture(t)
+		f.importScoreboard(t, 300, 200)
+
+		comparison, err := f.svc.CompareResults(asUser(ctx, "admin-1"), "party-1")
+		require.NoError(t, err)
+
+		assert.Zero(t, comparison.Acts[2].OfficialRank)
+		assert.Nil(t, comparison.Acts[2].RankDelta)
+		assert.InDelta(t, 1, *comparison.Spearman, 1e-9)
+	})
+
+	t.Run("omits the correlation for fewer than two common acts", func(t *testing.T) {
+		f := newScoreboardFixture(t)
+		f.importScoreboard(t, 300)
+
+		comparison, err := f.svc.CompareResults(asUser(ctx, "admin-1"), "party-1")
+		require.NoError(t, err)
+
+		assert.Nil(t, comparison.Spearman)
+	})
+
+	t.Run("returns ErrNoScoreboard before the scoreboard is imported", func(t *testing.T) {
+		f := newScoreboardFixture(t)
+
+		_, err := f.svc.CompareResults(asUser(ctx, "admin-1"), "party-1")
+
+		assert.ErrorIs(t, err, services.ErrNoScoreboard)
+	})
+
+	t.Run("passes on results errors", func(t *testing.T) {
+		store := memory.NewStore()
+		resultsService := &mockResultsService{
+			getResultsFunc: func(ctx context.Context, partyID string) (*services.PartyResults, error) {
+				return nil, services.ErrVotingNotEnded
+			},
+		}
+		svc := services.NewScoreboardService(memory.NewScoreboardDAO(store), memory.NewPartyDAO(store), &mockVoteActsService{}, resultsService, nil)
+
+		_, err := svc.CompareResults(asUser(ctx, "admin-1"), "party-1")
+
+		assert.ErrorIs(t, err, services.ErrVotingNotEnded)
+	})
+}
@@ -0,0 +1,190 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/persistence"
+)
+
+// StatsVoteDAO defines the vote operations needed by the stats service.
+type StatsVoteDAO interface {
+	ListByPartyID(ctx context.Context, partyID string) ([]*models.Vote, error)
+}
+
+// StatsPartyDAO defines the party operations needed by the stats service.
+type StatsPartyDAO interface {
+	GetByID(ctx context.Context, id string) (*models.Party, error)
+}
+
+// StatsGuestDAO defines the guest operations needed by the stats service.
+type StatsGuestDAO interface {
+	ListByPartyID(ctx context.Context, partyID string) ([]*models.Guest, error)
+}
+
+// StatsScoreboardDAO defines the scoreboard operations needed by the stats service.
+type StatsScoreboardDAO interface {
+	Get(ctx context.Context, contest string, eventType models.EventType) (*models.Scoreboard, error)
+}
+
+// GuestTasteMatch reports how closely a guest's ballot matched the party and
+// the official result. Matches are Spearman correlations from -1 to 1 between
+// the points the guest awarded and the points each act received; they are nil
+// when undefined. Parties with anonymous results leave out who the guest is.
+type GuestTasteMatch struct {
+	GuestID  string `json:"guestId,omitempty"`
+	Username string `json:"username,omitempty"`
+	// ConsensusMatch compares the ballot with the party's results.
+	ConsensusMatch *float64 `json:"consensusMatch,omitempty"`
+	// OfficialMatch compares the ballot with the official scoreboard of the
+	// show, if one has been imported.
+	OfficialMatch *float64 `json:"officialMatch,omitempty"`
+}
+
+// PartyStats contains the taste statistics of a closed party.
+type PartyStats struct {
+	PartyID   string `json:"partyId"`
+	PartyName string `json:"partyName"`
+	// Guests lists every guest with a final ballot, closest to the party's
+	// consensus first.
+	Guests []GuestTasteMatch `json:"guests"`
+	// Similarity[i][j] is the Spearman correlation between the ballots of
+	// Guests[i] and Guests[j], or nil when undefined.
+	Similarity [][]*float64 `json:"similarity"`
+}
+
+// StatsService defines the business logic operations for party statistics.
+type StatsService interface {
+	GetStats(ctx context.Context, partyID string) (*PartyStats, error)
+}
+
+type statsService struct {
+	voteDAO        StatsVoteDAO
+	partyDAO       StatsPartyDAO
+	guestDAO       StatsGuestDAO
+	scoreboardDAO  StatsScoreboardDAO
+	resultsService ScoreboardResultsService
+}
+
+// NewStatsService creates a new StatsService.
+func NewStatsService(voteDAO StatsVoteDAO, partyDAO StatsPartyDAO, guestDAO StatsGuestDAO, scoreboardDAO StatsScoreboardDAO, resultsService ScoreboardResultsService) StatsService {
+	return &statsService{
+		voteDAO:        voteDAO,
+		partyDAO:       partyDAO,
+		guestDAO:       guestDAO,
+		scoreboardDAO:  scoreboardDAO,
+		resultsService: resultsService,
+	}
+}
+
+// GetStats compares every final ballot of a party with the party's results,
+// the official scoreboard and the other ballots. The same access rules as for
+// the party's results apply, so statistics are only available once voting has
+// ended, and parties with anonymous results get them without guest names.
+func (s *statsService) GetStats(ctx context.Context, partyID string) (*PartyStats, error) {
+	results, err := s.resultsService.GetResults(ctx, partyID)
+	if err != nil {
+		return nil, err
+	}
+
+	party, err := s.partyDAO.GetByID(ctx, partyID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	votes, err := s.voteDAO.ListByPartyID(ctx, partyID)
+	if err != nil {
+		return nil, err
+	}
+	ballots, _ := finalBallots(votes)
+
+	guests, err := s.guestDAO.ListByPartyID(ctx, partyID)
+	if err != nil {
+		return nil, err
+	}
+	usernames := make(map[string]string, len(guests))
+	for _, guest := range guests {
+		usernames[guest.ID] = guest.Username
+	}
+
+	official := make(map[string]int)
+	scoreboard, err := s.scoreboardDAO.Get(ctx, party.Edition(), party.EventType)
+	switch {
+	case err == nil:
+		for _, entry := range scoreboard.Entries {
+			official[entry.ActID] = entry.Total()
+		}
+	case !errors.Is(err, persistence.ErrNotFound):
+		return nil, err
+	}
+
+	consensus := make([]int, len(results.Results))
+	for i, result := range results.Results {
+		consensus[i] = result.TotalPoints
+	}
+
+	// points[i] holds the points Guests[i] awarded, in the order of results.Results.
+	scorer := party.Scoring().Scorer()
+	matches := make([]GuestTasteMatch, len(ballots))
+	points := make(map[string][]int, len(ballots))
+	for i, ballot := range ballots {
+		awarded := scorer.Points(*ballot)
+		guestPoints := make([]int, len(results.Results))
+		var onScoreboard, officialPoints []int
+		for j, result := range results.Results {
+			guestPoints[j] = awarded[result.ActID]
+			if total, ok := official[result.ActID]; ok {
+				onScoreboard = append(onScoreboard, guestPoints[j])
+				officialPoints = append(officialPoints, total)
+			}
+		}
+		points[ballot.GuestID] = guestPoints
+		matches[i] = GuestTasteMatch{
+			GuestID:        ballot.GuestID,
+			Username:       usernames[ballot.GuestID],
+			ConsensusMatch: spearman(guestPoints, consensus),
+			OfficialMatch:  spearman(onScoreboard, officialPoints),
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		a, b := matches[i].ConsensusMatch, matches[j].ConsensusMatch
+		if (a == nil) != (b == nil) {
+			return a != nil
+		}
+		if a != nil && *a != *b {
+			return *a > *b
+		}
+		if matches[i].Username != matches[j].Username {
+			return matches[i].Username < matches[j].Username
+		}
+		return matches[i].GuestID < matches[j].GuestID
+	})
+
+	similarity := make([][]*float64, len(matches))
+	for i, a := range matches {
+		similarity[i] = make([]*float64, len(matches))
+		for j, b := range matches {
+			similarity[i][j] = spearman(points[a.GuestID], points[b.GuestID])
+		}
+	}
+
+	if party.AnonymousResults {
+		for i := range matches {
+			matches[i].GuestID = ""
+			matches[i].Username = ""
+		}
+	}
+
+	return &PartyStats{
+		PartyID:    party.ID,
+		PartyName:  party.Name,
+		Guests:     matches,
+		Similarity: similarity,
+	}, nil
+}
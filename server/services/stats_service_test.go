@@ -0,0 +1,151 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sipgate/eurovision-vote-party/server/models"
+	"github.com/sipgate/eurovision-vote-party/server/persistence/memory"
+	"github.com/sipgate/eurovision-vote-party/server/services"
+)
+
+// statsFixture is a closed party rating four acts in which alice and bob rate
+// the acts in running order, carol in reverse and dave only left a draft.
+type statsFixture struct {
+	partyDAO      *memory.PartyDAO
+	scoreboardDAO *memory.ScoreboardDAO
+	svc           services.StatsService
+}
+
+// newStatsFixture creates the fixture; opts adjust the party before it is stored.
+func newStatsFixture(t *testing.T, opts ...func(*models.Party)) statsFixture {
+	t.Helper()
+	ctx := context.Background()
+
+	store := memory.NewStore()
+	partyDAO := memory.NewPartyDAO(store)
+	guestDAO := memory.NewGuestDAO(store)
+	voteDAO := memory.NewVoteDAO(store)
+	scoreboardDAO := memory.NewScoreboardDAO(store)
+	party := &models.Party{
+		ID: "party-1", Name: "Party", Code: "ABC123", EventType: models.EventGrandFinal,
+		ScoringSystem: models.ScoringRating, AdminID: "admin-1", Status: models.PartyStatusClosed, CreatedAt: time.Now(),
+	}
+	for _, opt := range opts {
+		opt(party)
+	}
+	require.NoError(t, partyDAO.Create(ctx, party))
+	for _, g := range []struct {
+		id, username string
+		ratings      []int
+		draft        bool
+	}{
+		{"guest-1", "alice", []int{10, 8, 6, 4}, false},
+		{"guest-2", "bob", []int{9, 7, 5, 3}, false},
+		{"guest-3", "carol", []int{1, 3, 5, 7}, false},
+		{"guest-4", "dave", []int{1, 1, 1, 10}, true},
+	} {
+		require.NoError(t, guestDAO.Create(ctx, &models.Guest{
+			ID: g.id, PartyID: "party-1", Username: g.username, Status: models.GuestStatusApproved, CreatedAt: time.Now(),
+		}))
+		ratings := make(map[string]int, len(g.ratings))
+		for i, rating := range g.ratings {
+			ratings[testActs()[i].ID] = rating
+		}
+		require.NoError(t, voteDAO.Create(ctx, &models.Vote{
+			ID: models.VoteIDFor("party-1", g.id), GuestID: g.id, PartyID: "party-1",
+			Ratings: ratings, Draft: g.draft, CreatedAt: time.Now(),
+		}))
+	}
+
+	actsService := &mockVoteActsService{
+		listActsFunc: func(ctx context.Context, contest, eventType string) ([]models.Act, error) {
+			return testActs()[:4], nil
+		},
+	}
+	voteService := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService, nil)
+	return statsFixture{
+		partyDAO:      partyDAO,
+		scoreboardDAO: scoreboardDAO,
+		svc:           services.NewStatsService(voteDAO, partyDAO, guestDAO, scoreboardDAO, voteService),
+	}
+}
+
+func TestStatsService_GetStats(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("compares ballots with the consensus and each other", func(t *testing.T) {
+		f := newStatsFixture(t)
+
+		stats, err := f.svc.GetStats(asUser(ctx, "admin-1"), "party-1")
+		require.NoError(t, err)
+
+		require.Len(t, stats.Guests, 3)
+		assert.Equal(t, []string{"alice", "bob", "carol"},
+			[]string{stats.Guests[0].Username, stats.Guests[1].Username, stats.Guests[2].Username})
+		assert.InDelta(t, 1, *stats.Guests[0].ConsensusMatch, 1e-9)
+		assert.InDelta(t, -1, *stats.Guests[2].ConsensusMatch, 1e-9)
+		assert.Nil(t, stats.Guests[0].OfficialMatch)
+
+		require.Len(t, stats.Similarity, 3)
+		assert.InDelta(t, 1, *stats.Similarity[0][0], 1e-9)
+		assert.InDelta(t, 1, *stats.Similarity[0][1], 1e-9)
+		assert.InDelta(t, -1, *stats.Similarity[0][2], 1e-9)
+		assert.InDelta(t, -1, *stats.Similarity[2][1], 1e-9)
+	})
+
+	t.Run("compares ballots with the official scoreboard", func(t *testing.T) {
+		f := newStatsFixture(t)
+		require.NoError(t, f.scoreboardDAO.Replace(ctx, &models.Scoreboard{
+			Contest: models.DefaultContest, EventType: models.EventGrandFinal, ImportedAt: time.Now(),
+			Entries: []models.ScoreboardEntry{
+				{ActID: "act-1", JuryPoints: 10}, {ActID: "act-2", JuryPoints: 20},
+				{ActID: "act-3", JuryPoints: 30}, {ActID: "act-4", JuryPoints: 40},
+			},
+		}))
+
+		stats, err := f.svc.GetStats(ctx, "party-1")
+		require.NoError(t, err)
+
+		assert.InDelta(t, -1, *stats.Guests[0].OfficialMatch, 1e-9)
+		assert.InDelta(t, 1, *stats.Guests[2].OfficialMatch, 1e-9)
+	})
+
+	t.Run("leaves out guest names for anonymous results", func(t *testing.T) {
+		f := newStatsFixture(t, func(p *models.Party) {
+			p.AnonymousResults = true
+		})
+
+		stats, err := f.svc.GetStats(ctx, "party-1")
+		require.NoError(t, err)
+
+		require.Len(t, stats.Guests, 3)
+		for _, guest := range stats.Guests {
+			assert.Empty(t, guest.GuestID)
+			assert.Empty(t, guest.Username)
+		}
+		assert.InDelta(t, 1, *stats.Guests[0].ConsensusMatch, 1e-9)
+		require.Len(t, stats.Similarity, 3)
+	})
+
+	t.Run("returns ErrVotingNotEnded while voting is open", func(t *testing.T) {
+		f := newStatsFixture(t)
+		require.NoError(t, f.partyDAO.UpdateStatus(ctx, "party-1", models.PartyStatusActive))
+
+		_, err := f.svc.GetStats(ctx, "party-1")
+
+		assert.ErrorIs(t, err, services.ErrVotingNotEnded)
+	})
+
+	t.Run("returns ErrUnauthorized for another admin", func(t *testing.T) {
+		f := newStatsFixture(t)
+
+		_, err := f.svc.GetStats(asUser(ctx, "admin-2"), "party-1")
+
+		assert.ErrorIs(t, err, services.ErrUnauthorized)
+	})
+}
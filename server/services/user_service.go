@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"errors"
+	"strings"
 
 	"github.com/sipgate/eurovision-vote-party/server/models"
 	"github.com/sipgate/eurovision-vote-party/server/persistence"
@@ -12,12 +13,15 @@ import (
 type UserDAO interface {
 	Upsert(ctx context.Context, user *models.User) error
 	GetByID(ctx context.Context, id string) (*models.User, error)
+	ListByEmail(ctx context.Context, email string) ([]*models.User, error)
+	ListByUsername(ctx context.Context, username string) ([]*models.User, error)
 }
 
 // UserService defines the business logic operations for users.
 type UserService interface {
 	UpsertProfile(ctx context.Context, userID, email, username string) (*models.User, error)
 	GetProfile(ctx context.Context, userID string) (*models.User, error)
+	FindProfile(ctx context.Context, emailOrUsername string) (*models.User, error)
 }
 
 // userService is the default implementation.
@@ -61,3 +65,36 @@ func (s *userService) GetProfile(ctx context.Context, userID string) (*models.Us
 
 	return user, nil
 }
+
+// FindProfile looks up the profile of a user by email address, if the query
+// contains an @, or by username otherwise.
+// Returns ErrUserNotFound if no profile matches and ErrAmbiguousUser if
+// several users share the username.
+func (s *userService) FindProfile(ctx context.Context, emailOrUsername string) (*models.User, error) {
+	query := strings.TrimSpace(emailOrUsername)
+	if query == "" {
+		return nil, ErrUserNotFound
+	}
+
+	var (
+		users []*models.User
+		err   error
+	)
+	if strings.Contains(query, "@") {
+		users, err = s.dao.ListByEmail(ctx, query)
+	} else {
+		users, err = s.dao.ListByUsername(ctx, query)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(users) {
+	case 0:
+		return nil, ErrUserNotFound
+	case 1:
+		return users[0], nil
+	default:
+		return nil, ErrAmbiguousUser
+	}
+}
@@ -14,8 +14,10 @@ import (
 )
 
 type mockUserDAO struct {
-	upsertFunc  func(ctx context.Context, user *models.User) error
-	getByIDFunc func(ctx context.Context, id string) (*models.User, error)
+	upsertFunc         func(ctx context.Context, user *models.User) error
+	getByIDFunc        func(ctx context.Context, id string) (*models.User, error)
+	listByEmailFunc    func(ctx context.Context, email string) ([]*models.User, error)
+	listByUsernameFunc func(ctx context.Context, username string) ([]*models.User, error)
 }
 
 func (m *mockUserDAO) Upsert(ctx context.Context, user *models.User) error {
@@ -32,6 +34,20 @@ func (m *mockUserDAO) GetByID(ctx context.Context, id string) (*models.User, err
 	return nil, persistence.ErrNotFound
 }
 
+func (m *mockUserDAO) ListByEmail(ctx context.Context, email string) ([]*models.User, error) {
+	if m.listByEmailFunc != nil {
+		return m.listByEmailFunc(ctx, email)
+	}
+	return []*models.User{}, nil
+}
+
+func (m *mockUserDAO) ListByUsername(ctx context.Context, username string) ([]*models.User, error) {
+	if m.listByUsernameFunc != nil {
+		return m.listByUsernameFunc(ctx, username)
+	}
+	return []*models.User{}, nil
+}
+
 func TestUserService_UpsertProfile(t *testing.T) {
 	t.Run("successfully upserts profile", func(t *testing.T) {
 		var capturedUser *models.User
@@ -143,3 +159,53 @@ func TestUserService_GetProfile(t *testing.T) {
 		assert.Nil(t, user)
 	})
 }
+
+func TestUserService_FindProfile(t *testing.T) {
+	alice := &models.User{ID: "user-1", Username: "alice", Email: "alice@example.com"}
+	dao := &mockUserDAO{
+		listByEmailFunc: func(ctx context.Context, email string) ([]*models.User, error) {
+			if email == alice.Email {
+				return []*models.User{alice}, nil
+			}
+			return []*models.User{}, nil
+		},
+		listByUsernameFunc: func(ctx context.Context, username string) ([]*models.User, error) {
+			switch username {
+			case "alice":
+				return []*models.User{alice}, nil
+			case "bob":
+				return []*models.User{{ID: "user-2", Username: "bob"}, {ID: "user-3", Username: "bob"}}, nil
+			}
+			return []*models.User{}, nil
+		},
+	}
+	svc := services.NewUserService(dao)
+	ctx := context.Background()
+
+	t.Run("finds user by email", func(t *testing.T) {
+		user, err := svc.FindProfile(ctx, " alice@example.com ")
+
+		require.NoError(t, err)
+		assert.Equal(t, alice, user)
+	})
+
+	t.Run("finds user by username", func(t *testing.T) {
+		user, err := svc.FindProfile(ctx, "alice")
+
+		require.NoError(t, err)
+		assert.Equal(t, alice, user)
+	})
+
+	t.Run("returns ErrUserNotFound without a match", func(t *testing.T) {
+		for _, query := range []string{"carol", "carol@example.com", " "} {
+			_, err := svc.FindProfile(ctx, query)
+			assert.ErrorIs(t, err, services.ErrUserNotFound, query)
+		}
+	})
+
+	t.Run("returns ErrAmbiguousUser for shared usernames", func(t *testing.T) {
+		_, err := svc.FindProfile(ctx, "bob")
+
+		assert.ErrorIs(t, err, services.ErrAmbiguousUser)
+	})
+}
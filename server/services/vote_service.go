@@ -7,7 +7,8 @@ import (
 	"sort"
 	"time"
 
-	"github.com/google/uuid"
+	"github.com/sipgate/eurovision-vote-party/server/authz"
+	"github.com/sipgate/eurovision-vote-party/server/events"
 	"github.com/sipgate/eurovision-vote-party/server/models"
 	"github.com/sipgate/eurovision-vote-party/server/persistence"
 )
@@ -16,6 +17,7 @@ import (
 type VotePartyDAO interface {
 	GetByID(ctx context.Context, id string) (*models.Party, error)
 	UpdateStatus(ctx context.Context, id string, status models.PartyStatus) error
+	UpdateVotingStartedAt(ctx context.Context, id string, startedAt time.Time) error
 }
 
 // VoteGuestDAO defines the minimal guest persistence operations needed by the vote service.
@@ -25,7 +27,7 @@ type VoteGuestDAO interface {
 
 // VoteActsService defines the acts service operations needed by the vote service.
 type VoteActsService interface {
-	ListActs(eventType string) ([]models.Act, error)
+	ListActs(ctx context.Context, contest, eventType string) ([]models.Act, error)
 }
 
 // VoteDAO defines the persistence operations needed by the vote service.
@@ -33,30 +35,38 @@ type VoteDAO interface {
 	Create(ctx context.Context, vote *models.Vote) error
 	GetByGuestAndParty(ctx context.Context, guestID, partyID string) (*models.Vote, error)
 	Update(ctx context.Context, vote *models.Vote) error
+	UpdateDraft(ctx context.Context, vote *models.Vote) error
 	ListByPartyID(ctx context.Context, partyID string) ([]*models.Vote, error)
 }
 
 // SubmitVoteRequest captures the data needed to submit or update a vote.
+// Guests may leave GuestID empty to vote for themselves.
 type SubmitVoteRequest struct {
 	GuestID string
 	Votes   map[int]string
+	Ratings map[string]int
 }
 
 // PartyResults contains the aggregated vote results for a party.
+// Drafts that were still incomplete when voting ended are not counted; IncompleteBallots reports how many there were.
 type PartyResults struct {
-	PartyID     string              `json:"partyId"`
-	PartyName   string              `json:"partyName"`
-	TotalVoters int                 `json:"totalVoters"`
-	Results     []models.VoteResult `json:"results"`
+	PartyID           string              `json:"partyId"`
+	PartyName         string              `json:"partyName"`
+	TotalVoters       int                 `json:"totalVoters"`
+	IncompleteBallots int                 `json:"incompleteBallots"`
+	Results           []models.VoteResult `json:"results"`
 }
 
 // VoteService defines the business logic operations for votes.
 type VoteService interface {
-	SubmitVote(ctx context.Context, adminID, partyID string, req SubmitVoteRequest) (*models.Vote, error)
-	GetVotes(ctx context.Context, adminID, partyID, guestID string) (*models.Vote, error)
-	UpdateVote(ctx context.Context, adminID, partyID string, req SubmitVoteRequest) (*models.Vote, error)
-	EndVoting(ctx context.Context, adminID, partyID string) (*models.Party, error)
-	GetResults(ctx context.Context, adminID, partyID string) (*PartyResults, error)
+	SubmitVote(ctx context.Context, partyID string, req SubmitVoteRequest) (*models.Vote, error)
+	GetVotes(ctx context.Context, partyID, guestID string) (*models.Vote, error)
+	UpdateVote(ctx context.Context, partyID string, req SubmitVoteRequest) (*models.Vote, error)
+	SaveDraft(ctx context.Context, partyID string, req SubmitVoteRequest) (*models.Vote, error)
+	FinalizeVote(ctx context.Context, partyID, guestID string) (*models.Vote, error)
+	StartVoting(ctx context.Context, partyID string) (*models.Party, error)
+	EndVoting(ctx context.Context, partyID string) (*models.Party, error)
+	GetResults(ctx context.Context, partyID string) (*PartyResults, error)
 }
 
 // voteService is the default implementation.
@@ -65,95 +75,78 @@ type voteService struct {
 	partyDAO    VotePartyDAO
 	guestDAO    VoteGuestDAO
 	actsService VoteActsService
+	events      EventPublisher
 }
 
 // NewVoteService creates a new VoteService.
-func NewVoteService(voteDAO VoteDAO, partyDAO VotePartyDAO, guestDAO VoteGuestDAO, actsService VoteActsService) VoteService {
+// Voting activity is published to publisher, which may be nil.
+func NewVoteService(voteDAO VoteDAO, partyDAO VotePartyDAO, guestDAO VoteGuestDAO, actsService VoteActsService, publisher EventPublisher) VoteService {
 	return &voteService{
 		voteDAO:     voteDAO,
 		partyDAO:    partyDAO,
 		guestDAO:    guestDAO,
 		actsService: actsService,
+		events:      publisher,
 	}
 }
 
-// SubmitVote creates a new vote for a guest in a party.
-func (s *voteService) SubmitVote(ctx context.Context, adminID, partyID string, req SubmitVoteRequest) (*models.Vote, error) {
-	party, err := s.partyDAO.GetByID(ctx, partyID)
+// SubmitVote creates a new vote for a guest in a party, replacing the guest's draft if there is one.
+// The first ballot or draft of a party starts voting, see StartVoting.
+func (s *voteService) SubmitVote(ctx context.Context, partyID string, req SubmitVoteRequest) (*models.Vote, error) {
+	party, guestID, err := s.openPartyForGuest(ctx, partyID, req.GuestID)
 	if err != nil {
-		if errors.Is(err, persistence.ErrNotFound) {
-			return nil, ErrNotFound
-		}
 		return nil, err
 	}
 
-	if party.Status != models.PartyStatusActive {
-		return nil, ErrPartyClosed
-	}
-
-	if adminID != "" {
-		if party.AdminID != adminID {
-			return nil, ErrUnauthorized
-		}
+	vote := &models.Vote{
+		ID:        models.VoteIDFor(partyID, guestID),
+		GuestID:   guestID,
+		PartyID:   partyID,
+		Votes:     req.Votes,
+		Ratings:   req.Ratings,
+		CreatedAt: time.Now(),
 	}
 
-	guest, err := s.guestDAO.GetByID(ctx, req.GuestID)
-	if err != nil {
-		if errors.Is(err, persistence.ErrNotFound) {
-			return nil, ErrNotFound
-		}
+	if err := s.validateBallot(ctx, party, vote); err != nil {
 		return nil, err
 	}
 
-	if guest.PartyID != partyID || guest.Status != models.GuestStatusApproved {
-		return nil, ErrGuestNotApproved
-	}
-
-	acts, err := s.actsService.ListActs(string(party.EventType))
-	if err != nil {
+	if err := s.markVotingStarted(ctx, party); err != nil {
 		return nil, err
 	}
 
-	actIDs := make(map[string]bool, len(acts))
-	for _, act := range acts {
-		actIDs[act.ID] = true
-	}
-
-	for _, actID := range req.Votes {
-		if !actIDs[actID] {
-			return nil, ErrInvalidVotes
-		}
-	}
-
-	_, err = s.voteDAO.GetByGuestAndParty(ctx, req.GuestID, partyID)
+	existing, err := s.voteDAO.GetByGuestAndParty(ctx, guestID, partyID)
 	if err == nil {
-		return nil, ErrVoteAlreadyExists
+		if !existing.Draft {
+			return nil, ErrVoteAlreadyExists
+		}
+		vote.CreatedAt = existing.CreatedAt
+		if err := s.voteDAO.Update(ctx, vote); err != nil {
+			return nil, err
+		}
+		publish(s.events, partyID, events.VoteSubmitted, guestEventData{GuestID: vote.GuestID})
+		return vote, nil
 	}
 	if !errors.Is(err, persistence.ErrNotFound) {
 		return nil, err
 	}
 
-	vote := &models.Vote{
-		ID:        uuid.New().String(),
-		GuestID:   req.GuestID,
-		PartyID:   partyID,
-		Votes:     req.Votes,
-		CreatedAt: time.Now(),
-	}
-
-	if err := vote.Validate(); err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrInvalidVotes, err)
-	}
-
+	// The existence check above is only a fast path; the DAO rejects a second
+	// vote for the same guest and party atomically.
 	if err := s.voteDAO.Create(ctx, vote); err != nil {
+		if errors.Is(err, persistence.ErrVoteExists) {
+			return nil, ErrVoteAlreadyExists
+		}
 		return nil, err
 	}
 
+	publish(s.events, partyID, events.VoteSubmitted, guestEventData{GuestID: vote.GuestID})
 	return vote, nil
 }
 
-// GetVotes retrieves a guest's vote for a party.
-func (s *voteService) GetVotes(ctx context.Context, adminID, partyID, guestID string) (*models.Vote, error) {
+// GetVotes retrieves a guest's vote for a party. Guests read their own ballot,
+// for which they may leave guestID empty, and the party's hosts any guest's.
+func (s *voteService) GetVotes(ctx context.Context, partyID, guestID string) (*models.Vote, error) {
 	party, err := s.partyDAO.GetByID(ctx, partyID)
 	if err != nil {
 		if errors.Is(err, persistence.ErrNotFound) {
@@ -162,10 +155,9 @@ func (s *voteService) GetVotes(ctx context.Context, adminID, partyID, guestID st
 		return nil, err
 	}
 
-	if adminID != "" {
-		if party.AdminID != adminID {
-			return nil, ErrUnauthorized
-		}
+	guestID, err = actingGuest(ctx, party, guestID, authz.ViewBallot, authz.ViewBallots)
+	if err != nil {
+		return nil, err
 	}
 
 	vote, err := s.voteDAO.GetByGuestAndParty(ctx, guestID, partyID)
@@ -180,26 +172,26 @@ func (s *voteService) GetVotes(ctx context.Context, adminID, partyID, guestID st
 }
 
 // UpdateVote updates an existing vote for a guest in a party.
-func (s *voteService) UpdateVote(ctx context.Context, adminID, partyID string, req SubmitVoteRequest) (*models.Vote, error) {
-	party, err := s.partyDAO.GetByID(ctx, partyID)
+func (s *voteService) UpdateVote(ctx context.Context, partyID string, req SubmitVoteRequest) (*models.Vote, error) {
+	party, guestID, err := s.openPartyForGuest(ctx, partyID, req.GuestID)
 	if err != nil {
-		if errors.Is(err, persistence.ErrNotFound) {
-			return nil, ErrNotFound
-		}
 		return nil, err
 	}
 
-	if party.Status != models.PartyStatusActive {
-		return nil, ErrPartyClosed
+	ballot := &models.Vote{
+		ID:        models.VoteIDFor(partyID, guestID),
+		GuestID:   guestID,
+		PartyID:   partyID,
+		Votes:     req.Votes,
+		Ratings:   req.Ratings,
+		CreatedAt: time.Now(),
 	}
 
-	if adminID != "" {
-		if party.AdminID != adminID {
-			return nil, ErrUnauthorized
-		}
+	if err := s.validateBallot(ctx, party, ballot); err != nil {
+		return nil, err
 	}
 
-	guest, err := s.guestDAO.GetByID(ctx, req.GuestID)
+	existingVote, err := s.voteDAO.GetByGuestAndParty(ctx, guestID, partyID)
 	if err != nil {
 		if errors.Is(err, persistence.ErrNotFound) {
 			return nil, ErrNotFound
@@ -207,27 +199,84 @@ func (s *voteService) UpdateVote(ctx context.Context, adminID, partyID string, r
 		return nil, err
 	}
 
-	if guest.PartyID != partyID || guest.Status != models.GuestStatusApproved {
-		return nil, ErrGuestNotApproved
+	existingVote.Votes = ballot.Votes
+	existingVote.Ratings = ballot.Ratings
+	existingVote.Draft = false
+
+	if err := s.voteDAO.Update(ctx, existingVote); err != nil {
+		return nil, err
 	}
 
-	acts, err := s.actsService.ListActs(string(party.EventType))
+	publish(s.events, partyID, events.VoteUpdated, guestEventData{GuestID: existingVote.GuestID})
+	return existingVote, nil
+}
+
+// SaveDraft stores a possibly incomplete ballot for a guest while voting is open,
+// starting voting like SubmitVote.
+// Drafts are only checked for values that could still form a valid ballot and do not
+// count towards results until they are finalized.
+// Returns ErrVoteAlreadyExists if the guest has already finalized a ballot.
+func (s *voteService) SaveDraft(ctx context.Context, partyID string, req SubmitVoteRequest) (*models.Vote, error) {
+	party, guestID, err := s.openPartyForGuest(ctx, partyID, req.GuestID)
 	if err != nil {
 		return nil, err
 	}
 
-	actIDs := make(map[string]bool, len(acts))
-	for _, act := range acts {
-		actIDs[act.ID] = true
+	draft := &models.Vote{
+		ID:        models.VoteIDFor(partyID, guestID),
+		GuestID:   guestID,
+		PartyID:   partyID,
+		Votes:     req.Votes,
+		Ratings:   req.Ratings,
+		Draft:     true,
+		CreatedAt: time.Now(),
+	}
+
+	if err := s.validateBallot(ctx, party, draft); err != nil {
+		return nil, err
+	}
+
+	if err := s.markVotingStarted(ctx, party); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.voteDAO.GetByGuestAndParty(ctx, guestID, partyID)
+	if err == nil {
+		if !existing.Draft {
+			return nil, ErrVoteAlreadyExists
+		}
+		draft.ID = existing.ID
+		draft.CreatedAt = existing.CreatedAt
+		if err := s.voteDAO.UpdateDraft(ctx, draft); err != nil {
+			if errors.Is(err, persistence.ErrVoteExists) {
+				return nil, ErrVoteAlreadyExists
+			}
+			return nil, err
+		}
+		return draft, nil
+	}
+	if !errors.Is(err, persistence.ErrNotFound) {
+		return nil, err
 	}
 
-	for _, actID := range req.Votes {
-		if !actIDs[actID] {
-			return nil, ErrInvalidVotes
+	if err := s.voteDAO.Create(ctx, draft); err != nil {
+		if errors.Is(err, persistence.ErrVoteExists) {
+			return nil, ErrVoteAlreadyExists
 		}
+		return nil, err
 	}
+	return draft, nil
+}
 
-	existingVote, err := s.voteDAO.GetByGuestAndParty(ctx, req.GuestID, partyID)
+// FinalizeVote turns a guest's draft into a submitted ballot once it is complete.
+// Returns ErrNotFound if the guest has no draft and ErrVoteAlreadyExists if the ballot is already final.
+func (s *voteService) FinalizeVote(ctx context.Context, partyID, guestID string) (*models.Vote, error) {
+	party, guestID, err := s.openPartyForGuest(ctx, partyID, guestID)
+	if err != nil {
+		return nil, err
+	}
+
+	vote, err := s.voteDAO.GetByGuestAndParty(ctx, guestID, partyID)
 	if err != nil {
 		if errors.Is(err, persistence.ErrNotFound) {
 			return nil, ErrNotFound
@@ -235,21 +284,27 @@ func (s *voteService) UpdateVote(ctx context.Context, adminID, partyID string, r
 		return nil, err
 	}
 
-	existingVote.Votes = req.Votes
+	if !vote.Draft {
+		return nil, ErrVoteAlreadyExists
+	}
 
-	if err := existingVote.Validate(); err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrInvalidVotes, err)
+	vote.Draft = false
+	if err := s.validateBallot(ctx, party, vote); err != nil {
+		return nil, err
 	}
 
-	if err := s.voteDAO.Update(ctx, existingVote); err != nil {
+	if err := s.voteDAO.Update(ctx, vote); err != nil {
 		return nil, err
 	}
 
-	return existingVote, nil
+	publish(s.events, partyID, events.VoteSubmitted, guestEventData{GuestID: vote.GuestID})
+	return vote, nil
 }
 
-// EndVoting closes voting for a party.
-func (s *voteService) EndVoting(ctx context.Context, adminID, partyID string) (*models.Party, error) {
+// StartVoting records that the admin opened voting in an active party, which locks
+// the guests' predictions. Voting also starts with the first ballot or draft, so
+// admins need not call it. Starting voting again keeps the original start time.
+func (s *voteService) StartVoting(ctx context.Context, partyID string) (*models.Party, error) {
 	party, err := s.partyDAO.GetByID(ctx, partyID)
 	if err != nil {
 		if errors.Is(err, persistence.ErrNotFound) {
@@ -258,28 +313,71 @@ func (s *voteService) EndVoting(ctx context.Context, adminID, partyID string) (*
 		return nil, err
 	}
 
-	if adminID == "" {
-		return nil, ErrUnauthorized
+	if err := authorize(ctx, authz.ControlVoting, party); err != nil {
+		return nil, err
 	}
 
-	if party.AdminID != adminID {
-		return nil, ErrUnauthorized
+	if party.Status != models.PartyStatusActive {
+		return nil, ErrPartyClosed
+	}
+
+	if err := s.markVotingStarted(ctx, party); err != nil {
+		return nil, err
+	}
+	return party, nil
+}
+
+// markVotingStarted records that voting in the party has started, unless it
+// already has, which locks the guests' predictions.
+func (s *voteService) markVotingStarted(ctx context.Context, party *models.Party) error {
+	if party.VotingStarted() {
+		return nil
+	}
+
+	startedAt := time.Now().UTC()
+	if err := s.partyDAO.UpdateVotingStartedAt(ctx, party.ID, startedAt); err != nil {
+		return err
+	}
+
+	party.VotingStartedAt = startedAt
+	publish(s.events, party.ID, events.VotingStarted, nil)
+	return nil
+}
+
+// EndVoting closes voting for a party.
+func (s *voteService) EndVoting(ctx context.Context, partyID string) (*models.Party, error) {
+	party, err := s.partyDAO.GetByID(ctx, partyID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	if err := authorize(ctx, authz.ControlVoting, party); err != nil {
+		return nil, err
 	}
 
 	if party.Status != models.PartyStatusActive {
 		return nil, ErrPartyClosed
 	}
 
+	if err := s.finalizeCompleteDrafts(ctx, party); err != nil {
+		return nil, err
+	}
+
 	if err := s.partyDAO.UpdateStatus(ctx, partyID, models.PartyStatusClosed); err != nil {
 		return nil, err
 	}
 
 	party.Status = models.PartyStatusClosed
+	publish(s.events, partyID, events.VotingEnded, nil)
+	publish(s.events, partyID, events.ResultsAvailable, nil)
 	return party, nil
 }
 
 // GetResults returns the aggregated vote results for a closed party.
-func (s *voteService) GetResults(ctx context.Context, adminID, partyID string) (*PartyResults, error) {
+func (s *voteService) GetResults(ctx context.Context, partyID string) (*PartyResults, error) {
 	party, err := s.partyDAO.GetByID(ctx, partyID)
 	if err != nil {
 		if errors.Is(err, persistence.ErrNotFound) {
@@ -288,10 +386,8 @@ func (s *voteService) GetResults(ctx context.Context, adminID, partyID string) (
 		return nil, err
 	}
 
-	if adminID != "" {
-		if party.AdminID != adminID {
-			return nil, ErrUnauthorized
-		}
+	if err := authorize(ctx, authz.ViewResults, party); err != nil {
+		return nil, err
 	}
 
 	if party.Status != models.PartyStatusClosed {
@@ -302,60 +398,278 @@ func (s *voteService) GetResults(ctx context.Context, adminID, partyID string) (
 	if err != nil {
 		return nil, err
 	}
+	votes, drafts := finalBallots(votes)
 
-	acts, err := s.actsService.ListActs(string(party.EventType))
+	// Guests only see the final ranking once a started reveal has finished.
+	if party.RevealStep > 0 && party.RevealStep < revealTotalSteps(party.Scoring(), len(votes)) &&
+		!authz.Can(authz.FromContext(ctx), authz.ViewResultsDuringReveal, party) {
+		return nil, ErrRevealInProgress
+	}
+
+	acts, err := s.actsService.ListActs(ctx, party.Edition(), string(party.EventType))
 	if err != nil {
 		return nil, err
 	}
 
-	// Sum points per act across all votes
-	pointsByAct := make(map[string]int, len(acts))
-	for _, act := range acts {
-		pointsByAct[act.ID] = 0
+	t := tallyResults(party.Scoring().Scorer(), acts, votes)
+	if !party.AnonymousResults {
+		if err := t.addTopMarks(ctx, s.guestDAO); err != nil {
+			return nil, err
+		}
+	}
+	results := t.ranked()
+
+	return &PartyResults{
+		PartyID:           party.ID,
+		PartyName:         party.Name,
+		TotalVoters:       len(votes),
+		IncompleteBallots: drafts,
+		Results:           results,
+	}, nil
+}
+
+// openPartyForGuest loads a party that accepts ballots and resolves the guest
+// whose ballot the request is about: guests of the party vote for themselves,
+// while the party's hosts may vote on behalf of the guest they name. It checks
+// that the guest may vote in the party.
+func (s *voteService) openPartyForGuest(ctx context.Context, partyID, guestID string) (*models.Party, string, error) {
+	party, err := s.partyDAO.GetByID(ctx, partyID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNotFound) {
+			return nil, "", ErrNotFound
+		}
+		return nil, "", err
+	}
+
+	if party.Status != models.PartyStatusActive {
+		return nil, "", ErrPartyClosed
+	}
+
+	guestID, err = actingGuest(ctx, party, guestID, authz.CastBallot, authz.CastBallotForGuest)
+	if err != nil {
+		return nil, "", err
 	}
+
+	guest, err := s.guestDAO.GetByID(ctx, guestID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNotFound) {
+			return nil, "", ErrNotFound
+		}
+		return nil, "", err
+	}
+
+	if guest.PartyID != partyID || guest.Status != models.GuestStatusApproved {
+		return nil, "", ErrGuestNotApproved
+	}
+
+	return party, guestID, nil
+}
+
+// finalizeCompleteDrafts submits every draft of the party that already forms a complete
+// ballot, as if its guest had finalized it. Incomplete drafts stay drafts and are left
+// out of the results.
+func (s *voteService) finalizeCompleteDrafts(ctx context.Context, party *models.Party) error {
+	votes, err := s.voteDAO.ListByPartyID(ctx, party.ID)
+	if err != nil {
+		return err
+	}
+
 	for _, vote := range votes {
-		for points, actID := range vote.Votes {
-			pointsByAct[actID] += points
+		if !vote.Draft {
+			continue
+		}
+		vote.Draft = false
+		if err := s.validateBallot(ctx, party, vote); err != nil {
+			if errors.Is(err, ErrInvalidVotes) {
+				continue
+			}
+			return err
+		}
+		if err := s.voteDAO.Update(ctx, vote); err != nil {
+			return err
 		}
 	}
+	return nil
+}
+
+// finalBallots returns the votes that are not drafts, together with the number of drafts left out.
+func finalBallots(votes []*models.Vote) ([]*models.Vote, int) {
+	final := make([]*models.Vote, 0, len(votes))
+	for _, vote := range votes {
+		if !vote.Draft {
+			final = append(final, vote)
+		}
+	}
+	return final, len(votes) - len(final)
+}
+
+// validateBallot checks a vote's structure and that it is a complete ballot for the
+// party's acts under the party's scoring system. Drafts only need to be completable.
+func (s *voteService) validateBallot(ctx context.Context, party *models.Party, vote *models.Vote) error {
+	if err := vote.Validate(); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidVotes, err)
+	}
 
-	// Build results with act metadata
-	actMap := make(map[string]models.Act, len(acts))
+	acts, err := s.actsService.ListActs(ctx, party.Edition(), string(party.EventType))
+	if err != nil {
+		return err
+	}
+
+	actIDs := make([]string, 0, len(acts))
 	for _, act := range acts {
-		actMap[act.ID] = act
+		actIDs = append(actIDs, act.ID)
 	}
 
-	results := make([]models.VoteResult, 0, len(acts))
+	scorer := party.Scoring().Scorer()
+	validate := scorer.ValidateBallot
+	if vote.Draft {
+		validate = scorer.ValidateDraft
+	}
+	if err := validate(*vote, actIDs); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidVotes, err)
+	}
+	return nil
+}
+
+// tallyResults sums the points every ballot awards under the scorer.
+// For averaged scorers each act also gets its mean points per ballot; since such
+// ballots cover every act, ranking by total and by average agree.
+func tallyResults(scorer models.Scorer, acts []models.Act, votes []*models.Vote) *tally {
+	t := newTally(acts, scorer.TopPoints(len(acts)))
+	for _, vote := range votes {
+		for actID, points := range scorer.Points(*vote) {
+			t.award(vote.GuestID, actID, points)
+		}
+	}
+	if scorer.Averaged() {
+		t.ballots = len(votes)
+	}
+	return t
+}
+
+// tally accumulates the points awarded to the acts of a show.
+type tally struct {
+	results []models.VoteResult
+	index   map[string]int
+	// top is the highest points a ballot can award an act; topMarks holds the
+	// IDs of the guests who awarded them, by act index.
+	top      int
+	topMarks map[int][]string
+	// ballots is the number of ballots to average the points over, or 0 for totals only.
+	ballots int
+}
+
+func newTally(acts []models.Act, top int) *tally {
+	t := &tally{
+		results:  make([]models.VoteResult, 0, len(acts)),
+		index:    make(map[string]int, len(acts)),
+		top:      top,
+		topMarks: make(map[int][]string),
+	}
 	for _, act := range acts {
-		results = append(results, models.VoteResult{
-			ActID:       act.ID,
-			Country:     act.Country,
-			Artist:      act.Artist,
-			Song:        act.Song,
-			TotalPoints: pointsByAct[act.ID],
+		t.index[act.ID] = len(t.results)
+		t.results = append(t.results, models.VoteResult{
+			ActID:   act.ID,
+			Country: act.Country,
+			Artist:  act.Artist,
+			Song:    act.Song,
 		})
 	}
+	return t
+}
+
+// award adds the points a guest's ballot gave an act. Points for acts outside
+// the show are ignored.
+func (t *tally) award(guestID, actID string, points int) {
+	i, ok := t.index[actID]
+	if !ok {
+		return
+	}
+	result := &t.results[i]
+	result.TotalPoints += points
+	if points > 0 {
+		result.Voters++
+		if result.PointCounts == nil {
+			result.PointCounts = make(map[int]int)
+		}
+		result.PointCounts[points]++
+	}
+	if points == t.top {
+		t.topMarks[i] = append(t.topMarks[i], guestID)
+	}
+}
+
+// addTopMarks lists on each result the usernames of the guests who gave the act
+// the top points. Guests that no longer exist are left out.
+func (t *tally) addTopMarks(ctx context.Context, guestDAO VoteGuestDAO) error {
+	for i, guestIDs := range t.topMarks {
+		for _, guestID := range guestIDs {
+			guest, err := guestDAO.GetByID(ctx, guestID)
+			if err != nil {
+				if errors.Is(err, persistence.ErrNotFound) {
+					continue
+				}
+				return err
+			}
+			t.results[i].TopMarks = append(t.results[i].TopMarks, guest.Username)
+		}
+		sort.Strings(t.results[i].TopMarks)
+	}
+	return nil
+}
 
-	// Sort by total points descending
-	sort.Slice(results, func(i, j int) bool {
-		return results[i].TotalPoints > results[j].TotalPoints
+// ranked returns the scoreboard ordered by compare, giving every act its own
+// rank. Acts with the same points as the act ranked below them report the
+// rule that separated the two.
+func (t *tally) ranked() []models.VoteResult {
+	results := append([]models.VoteResult(nil), t.results...)
+	sort.SliceStable(results, func(i, j int) bool {
+		order, _ := t.compare(results[i], results[j])
+		return order < 0
 	})
 
-	// Assign ranks with standard competition ranking (1,2,2,4)
 	for i := range results {
-		if i == 0 {
-			results[i].Rank = 1
-		} else if results[i].TotalPoints == results[i-1].TotalPoints {
-			results[i].Rank = results[i-1].Rank
-		} else {
-			results[i].Rank = i + 1
+		results[i].Rank = i + 1
+		if i+1 < len(results) && results[i].TotalPoints == results[i+1].TotalPoints {
+			_, rule := t.compare(results[i], results[i+1])
+			rule.ActID = results[i+1].ActID
+			results[i].TieBreak = rule
+		}
+		if t.ballots > 0 {
+			results[i].AverageScore = float64(results[i].TotalPoints) / float64(t.ballots)
 		}
 	}
+	return results
+}
 
-	return &PartyResults{
-		PartyID:     party.ID,
-		PartyName:   party.Name,
-		TotalVoters: len(votes),
-		Results:     results,
-	}, nil
+// compare orders two results by the Eurovision rules: more points first, then
+// more voters giving the act any points, then more of the highest point value,
+// the next highest, and so on, and finally the act that performed earlier. It
+// returns a negative number if a ranks ahead of b and a positive number if b
+// ranks ahead of a. For acts with the same points it also returns the rule
+// that decided.
+func (t *tally) compare(a, b models.VoteResult) (int, *models.TieBreak) {
+	if a.TotalPoints != b.TotalPoints {
+		return b.TotalPoints - a.TotalPoints, nil
+	}
+	if a.Voters != b.Voters {
+		return b.Voters - a.Voters, &models.TieBreak{Rule: models.TieBreakVoters}
+	}
+
+	values := make([]int, 0, len(a.PointCounts)+len(b.PointCounts))
+	for points := range a.PointCounts {
+		values = append(values, points)
+	}
+	for points := range b.PointCounts {
+		values = append(values, points)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(values)))
+	for _, points := range values {
+		if a.PointCounts[points] != b.PointCounts[points] {
+			return b.PointCounts[points] - a.PointCounts[points], &models.TieBreak{Rule: models.TieBreakPointCount, Points: points}
+		}
+	}
+
+	// The acts were added to the tally in running order.
+	return t.index[a.ActID] - t.index[b.ActID], &models.TieBreak{Rule: models.TieBreakRunningOrder}
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 
 	"github.com/sipgate/eurovision-vote-party/server/models"
 	"github.com/sipgate/eurovision-vote-party/server/persistence"
+	"github.com/sipgate/eurovision-vote-party/server/persistence/memory"
 	"github.com/sipgate/eurovision-vote-party/server/services"
 )
 
@@ -20,6 +22,7 @@ type mockVoteDAO struct {
 	createFunc             func(ctx context.Context, vote *models.Vote) error
 	getByGuestAndPartyFunc func(ctx context.Context, guestID, partyID string) (*models.Vote, error)
 	updateFunc             func(ctx context.Context, vote *models.Vote) error
+	updateDraftFunc        func(ctx context.Context, vote *models.Vote) error
 	listByPartyIDFunc      func(ctx context.Context, partyID string) ([]*models.Vote, error)
 }
 
@@ -44,6 +47,13 @@ func (m *mockVoteDAO) Update(ctx context.Context, vote *models.Vote) error {
 	return nil
 }
 
+func (m *mockVoteDAO) UpdateDraft(ctx context.Context, vote *models.Vote) error {
+	if m.updateDraftFunc != nil {
+		return m.updateDraftFunc(ctx, vote)
+	}
+	return nil
+}
+
 func (m *mockVoteDAO) ListByPartyID(ctx context.Context, partyID string) ([]*models.Vote, error) {
 	if m.listByPartyIDFunc != nil {
 		return m.listByPartyIDFunc(ctx, partyID)
@@ -53,8 +63,9 @@ func (m *mockVoteDAO) ListByPartyID(ctx context.Context, partyID string) ([]*mod
 
 // mockVotePartyDAO mocks the VotePartyDAO interface used by the vote service.
 type mockVotePartyDAO struct {
-	getByIDFunc      func(ctx context.Context, id string) (*models.Party, error)
-	updateStatusFunc func(ctx context.Context, id string, status models.PartyStatus) error
+	getByIDFunc               func(ctx context.Context, id string) (*models.Party, error)
+	updateStatusFunc          func(ctx context.Context, id string, status models.PartyStatus) error
+	updateVotingStartedAtFunc func(ctx context.Context, id string, startedAt time.Time) error
 }
 
 func (m *mockVotePartyDAO) GetByID(ctx context.Context, id string) (*models.Party, error) {
@@ -71,6 +82,13 @@ func (m *mockVotePartyDAO) UpdateStatus(ctx context.Context, id string, status m
 	return nil
 }
 
+func (m *mockVotePartyDAO) UpdateVotingStartedAt(ctx context.Context, id string, startedAt time.Time) error {
+	if m.updateVotingStartedAtFunc != nil {
+		return m.updateVotingStartedAtFunc(ctx, id, startedAt)
+	}
+	return nil
+}
+
 // mockVoteGuestDAO mocks the VoteGuestDAO interface used by the vote service.
 type mockVoteGuestDAO struct {
 	getByIDFunc func(ctx context.Context, id string) (*models.Guest, error)
@@ -85,12 +103,12 @@ func (m *mockVoteGuestDAO) GetByID(ctx context.Context, id string) (*models.Gues
 
 // mockVoteActsService mocks the VoteActsService interface used by the vote service.
 type mockVoteActsService struct {
-	listActsFunc func(eventType string) ([]models.Act, error)
+	listActsFunc func(ctx context.Context, contest, eventType string) ([]models.Act, error)
 }
 
-func (m *mockVoteActsService) ListActs(eventType string) ([]models.Act, error) {
+func (m *mockVoteActsService) ListActs(ctx context.Context, contest, eventType string) ([]models.Act, error) {
 	if m.listActsFunc != nil {
-		return m.listActsFunc(eventType)
+		return m.listActsFunc(ctx, contest, eventType)
 	}
 	return []models.Act{}, nil
 }
@@ -172,15 +190,15 @@ func TestVoteService_SubmitVote(t *testing.T) {
 			},
 		}
 		actsService := &mockVoteActsService{
-			listActsFunc: func(eventType string) ([]models.Act, error) {
+			listActsFunc: func(ctx context.Context, contest, eventType string) ([]models.Act, error) {
 				return testActs(), nil
 			},
 		}
 
-		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService)
+		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService, nil)
 		ctx := context.Background()
 
-		vote, err := svc.SubmitVote(ctx, "admin-1", "party-1", services.SubmitVoteRequest{
+		vote, err := svc.SubmitVote(asUser(ctx, "admin-1"), "party-1", services.SubmitVoteRequest{
 			GuestID: "guest-1",
 			Votes:   validVotes(),
 		})
@@ -194,7 +212,7 @@ func TestVoteService_SubmitVote(t *testing.T) {
 		assert.Equal(t, createdVote, vote)
 	})
 
-	t.Run("submits vote successfully without admin", func(t *testing.T) {
+	t.Run("submits vote successfully as the guest", func(t *testing.T) {
 		existingParty := &models.Party{
 			ID:        "party-1",
 			Name:      "Test Party",
@@ -238,15 +256,15 @@ func TestVoteService_SubmitVote(t *testing.T) {
 			},
 		}
 		actsService := &mockVoteActsService{
-			listActsFunc: func(eventType string) ([]models.Act, error) {
+			listActsFunc: func(ctx context.Context, contest, eventType string) ([]models.Act, error) {
 				return testActs(), nil
 			},
 		}
 
-		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService)
+		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService, nil)
 		ctx := context.Background()
 
-		vote, err := svc.SubmitVote(ctx, "", "party-1", services.SubmitVoteRequest{
+		vote, err := svc.SubmitVote(asGuest(ctx, "guest-1", "party-1"), "party-1", services.SubmitVoteRequest{
 			GuestID: "guest-1",
 			Votes:   validVotes(),
 		})
@@ -267,10 +285,10 @@ func TestVoteService_SubmitVote(t *testing.T) {
 		guestDAO := &mockVoteGuestDAO{}
 		actsService := &mockVoteActsService{}
 
-		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService)
+		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService, nil)
 		ctx := context.Background()
 
-		vote, err := svc.SubmitVote(ctx, "admin-1", "nonexistent", services.SubmitVoteRequest{
+		vote, err := svc.SubmitVote(asUser(ctx, "admin-1"), "nonexistent", services.SubmitVoteRequest{
 			GuestID: "guest-1",
 			Votes:   validVotes(),
 		})
@@ -299,10 +317,10 @@ func TestVoteService_SubmitVote(t *testing.T) {
 		guestDAO := &mockVoteGuestDAO{}
 		actsService := &mockVoteActsService{}
 
-		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService)
+		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService, nil)
 		ctx := context.Background()
 
-		vote, err := svc.SubmitVote(ctx, "admin-1", "party-1", services.SubmitVoteRequest{
+		vote, err := svc.SubmitVote(asUser(ctx, "admin-1"), "party-1", services.SubmitVoteRequest{
 			GuestID: "guest-1",
 			Votes:   validVotes(),
 		})
@@ -331,10 +349,10 @@ func TestVoteService_SubmitVote(t *testing.T) {
 		guestDAO := &mockVoteGuestDAO{}
 		actsService := &mockVoteActsService{}
 
-		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService)
+		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService, nil)
 		ctx := context.Background()
 
-		vote, err := svc.SubmitVote(ctx, "other-admin", "party-1", services.SubmitVoteRequest{
+		vote, err := svc.SubmitVote(asUser(ctx, "other-admin"), "party-1", services.SubmitVoteRequest{
 			GuestID: "guest-1",
 			Votes:   validVotes(),
 		})
@@ -367,10 +385,10 @@ func TestVoteService_SubmitVote(t *testing.T) {
 		}
 		actsService := &mockVoteActsService{}
 
-		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService)
+		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService, nil)
 		ctx := context.Background()
 
-		vote, err := svc.SubmitVote(ctx, "admin-1", "party-1", services.SubmitVoteRequest{
+		vote, err := svc.SubmitVote(asUser(ctx, "admin-1"), "party-1", services.SubmitVoteRequest{
 			GuestID: "nonexistent",
 			Votes:   validVotes(),
 		})
@@ -414,10 +432,10 @@ func TestVoteService_SubmitVote(t *testing.T) {
 		}
 		actsService := &mockVoteActsService{}
 
-		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService)
+		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService, nil)
 		ctx := context.Background()
 
-		vote, err := svc.SubmitVote(ctx, "admin-1", "party-1", services.SubmitVoteRequest{
+		vote, err := svc.SubmitVote(asUser(ctx, "admin-1"), "party-1", services.SubmitVoteRequest{
 			GuestID: "guest-1",
 			Votes:   validVotes(),
 		})
@@ -461,10 +479,10 @@ func TestVoteService_SubmitVote(t *testing.T) {
 		}
 		actsService := &mockVoteActsService{}
 
-		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService)
+		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService, nil)
 		ctx := context.Background()
 
-		vote, err := svc.SubmitVote(ctx, "admin-1", "party-1", services.SubmitVoteRequest{
+		vote, err := svc.SubmitVote(asUser(ctx, "admin-1"), "party-1", services.SubmitVoteRequest{
 			GuestID: "guest-1",
 			Votes:   validVotes(),
 		})
@@ -510,15 +528,15 @@ func TestVoteService_SubmitVote(t *testing.T) {
 			},
 		}
 		actsService := &mockVoteActsService{
-			listActsFunc: func(eventType string) ([]models.Act, error) {
+			listActsFunc: func(ctx context.Context, contest, eventType string) ([]models.Act, error) {
 				return testActs(), nil
 			},
 		}
 
-		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService)
+		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService, nil)
 		ctx := context.Background()
 
-		vote, err := svc.SubmitVote(ctx, "admin-1", "party-1", services.SubmitVoteRequest{
+		vote, err := svc.SubmitVote(asUser(ctx, "admin-1"), "party-1", services.SubmitVoteRequest{
 			GuestID: "guest-1",
 			Votes:   invalidVotes,
 		})
@@ -573,15 +591,15 @@ func TestVoteService_SubmitVote(t *testing.T) {
 			},
 		}
 		actsService := &mockVoteActsService{
-			listActsFunc: func(eventType string) ([]models.Act, error) {
+			listActsFunc: func(ctx context.Context, contest, eventType string) ([]models.Act, error) {
 				return testActs(), nil
 			},
 		}
 
-		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService)
+		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService, nil)
 		ctx := context.Background()
 
-		vote, err := svc.SubmitVote(ctx, "admin-1", "party-1", services.SubmitVoteRequest{
+		vote, err := svc.SubmitVote(asUser(ctx, "admin-1"), "party-1", services.SubmitVoteRequest{
 			GuestID: "guest-1",
 			Votes:   validVotes(),
 		})
@@ -632,15 +650,15 @@ func TestVoteService_SubmitVote(t *testing.T) {
 			},
 		}
 		actsService := &mockVoteActsService{
-			listActsFunc: func(eventType string) ([]models.Act, error) {
+			listActsFunc: func(ctx context.Context, contest, eventType string) ([]models.Act, error) {
 				return testActs(), nil
 			},
 		}
 
-		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService)
+		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService, nil)
 		ctx := context.Background()
 
-		vote, err := svc.SubmitVote(ctx, "admin-1", "party-1", services.SubmitVoteRequest{
+		vote, err := svc.SubmitVote(asUser(ctx, "admin-1"), "party-1", services.SubmitVoteRequest{
 			GuestID: "guest-1",
 			Votes:   validVotes(),
 		})
@@ -648,6 +666,124 @@ func TestVoteService_SubmitVote(t *testing.T) {
 		assert.ErrorIs(t, err, daoErr)
 		assert.Nil(t, vote)
 	})
+
+	t.Run("returns ErrVoteAlreadyExists when DAO rejects a concurrent duplicate", func(t *testing.T) {
+		existingParty := &models.Party{
+			ID:        "party-1",
+			Name:      "Test Party",
+			Status:    models.PartyStatusActive,
+			CreatedAt: time.Now(),
+		}
+
+		approvedGuest := &models.Guest{
+			ID:        "guest-1",
+			PartyID:   "party-1",
+			Username:  "alice",
+			Status:    models.GuestStatusApproved,
+			CreatedAt: time.Now(),
+		}
+
+		var createdID string
+		voteDAO := &mockVoteDAO{
+			createFunc: func(ctx context.Context, vote *models.Vote) error {
+				createdID = vote.ID
+				return persistence.ErrVoteExists
+			},
+		}
+		partyDAO := &mockVotePartyDAO{
+			getByIDFunc: func(ctx context.Context, id string) (*models.Party, error) {
+				return existingParty, nil
+			},
+		}
+		guestDAO := &mockVoteGuestDAO{
+			getByIDFunc: func(ctx context.Context, id string) (*models.Guest, error) {
+				return approvedGuest, nil
+			},
+		}
+		actsService := &mockVoteActsService{
+			listActsFunc: func(ctx context.Context, contest, eventType string) ([]models.Act, error) {
+				return testActs(), nil
+			},
+		}
+
+		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService, nil)
+		ctx := context.Background()
+
+		vote, err := svc.SubmitVote(asGuest(ctx, "guest-1", "party-1"), "party-1", services.SubmitVoteRequest{
+			GuestID: "guest-1",
+			Votes:   validVotes(),
+		})
+
+		assert.ErrorIs(t, err, services.ErrVoteAlreadyExists)
+		assert.Nil(t, vote)
+		assert.Equal(t, models.VoteIDFor("party-1", "guest-1"), createdID)
+	})
+}
+
+func TestVoteService_SubmitVote_ConcurrentSubmissions(t *testing.T) {
+	store := memory.NewStore()
+	partyDAO := memory.NewPartyDAO(store)
+	guestDAO := memory.NewGuestDAO(store)
+	voteDAO := memory.NewVoteDAO(store)
+	ctx := context.Background()
+
+	party := &models.Party{
+		ID:        "party-1",
+		Name:      "Race Party",
+		Code:      "RACE01",
+		EventType: models.EventGrandFinal,
+		AdminID:   "admin-1",
+		Status:    models.PartyStatusActive,
+		CreatedAt: time.Now(),
+	}
+	require.NoError(t, partyDAO.Create(ctx, party))
+	require.NoError(t, guestDAO.Create(ctx, &models.Guest{
+		ID:        "guest-1",
+		PartyID:   party.ID,
+		Username:  "alice",
+		Status:    models.GuestStatusApproved,
+		CreatedAt: time.Now(),
+	}))
+
+	svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, &mockVoteActsService{
+		listActsFunc: func(ctx context.Context, contest, eventType string) ([]models.Act, error) {
+			return testActs(), nil
+		},
+	}, nil)
+
+	const submissions = 50
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	errs := make(chan error, submissions)
+	for i := 0; i < submissions; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			_, err := svc.SubmitVote(asGuest(ctx, "guest-1", party.ID), party.ID, services.SubmitVoteRequest{
+				GuestID: "guest-1",
+				Votes:   validVotes(),
+			})
+			errs <- err
+		}()
+	}
+	close(start)
+	wg.Wait()
+	close(errs)
+
+	succeeded := 0
+	for err := range errs {
+		if err == nil {
+			succeeded++
+			continue
+		}
+		assert.ErrorIs(t, err, services.ErrVoteAlreadyExists)
+	}
+	assert.Equal(t, 1, succeeded)
+
+	votes, err := voteDAO.ListByPartyID(ctx, party.ID)
+	require.NoError(t, err)
+	assert.Len(t, votes, 1)
 }
 
 func TestVoteService_GetVotes(t *testing.T) {
@@ -689,16 +825,16 @@ func TestVoteService_GetVotes(t *testing.T) {
 		guestDAO := &mockVoteGuestDAO{}
 		actsService := &mockVoteActsService{}
 
-		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService)
+		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService, nil)
 		ctx := context.Background()
 
-		vote, err := svc.GetVotes(ctx, "admin-1", "party-1", "guest-1")
+		vote, err := svc.GetVotes(asUser(ctx, "admin-1"), "party-1", "guest-1")
 
 		require.NoError(t, err)
 		assert.Equal(t, existingVote, vote)
 	})
 
-	t.Run("returns vote successfully without admin", func(t *testing.T) {
+	t.Run("returns vote successfully to the guest", func(t *testing.T) {
 		existingParty := &models.Party{
 			ID:        "party-1",
 			Name:      "Test Party",
@@ -736,10 +872,10 @@ func TestVoteService_GetVotes(t *testing.T) {
 		guestDAO := &mockVoteGuestDAO{}
 		actsService := &mockVoteActsService{}
 
-		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService)
+		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService, nil)
 		ctx := context.Background()
 
-		vote, err := svc.GetVotes(ctx, "", "party-1", "guest-1")
+		vote, err := svc.GetVotes(asGuest(ctx, "guest-1", "party-1"), "party-1", "guest-1")
 
 		require.NoError(t, err)
 		assert.Equal(t, existingVote, vote)
@@ -755,10 +891,10 @@ func TestVoteService_GetVotes(t *testing.T) {
 		guestDAO := &mockVoteGuestDAO{}
 		actsService := &mockVoteActsService{}
 
-		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService)
+		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService, nil)
 		ctx := context.Background()
 
-		vote, err := svc.GetVotes(ctx, "admin-1", "nonexistent", "guest-1")
+		vote, err := svc.GetVotes(asUser(ctx, "admin-1"), "nonexistent", "guest-1")
 
 		assert.ErrorIs(t, err, services.ErrNotFound)
 		assert.Nil(t, vote)
@@ -784,10 +920,10 @@ func TestVoteService_GetVotes(t *testing.T) {
 		guestDAO := &mockVoteGuestDAO{}
 		actsService := &mockVoteActsService{}
 
-		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService)
+		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService, nil)
 		ctx := context.Background()
 
-		vote, err := svc.GetVotes(ctx, "other-admin", "party-1", "guest-1")
+		vote, err := svc.GetVotes(asUser(ctx, "other-admin"), "party-1", "guest-1")
 
 		assert.ErrorIs(t, err, services.ErrUnauthorized)
 		assert.Nil(t, vote)
@@ -820,10 +956,10 @@ func TestVoteService_GetVotes(t *testing.T) {
 		guestDAO := &mockVoteGuestDAO{}
 		actsService := &mockVoteActsService{}
 
-		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService)
+		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService, nil)
 		ctx := context.Background()
 
-		vote, err := svc.GetVotes(ctx, "admin-1", "party-1", "guest-1")
+		vote, err := svc.GetVotes(asUser(ctx, "admin-1"), "party-1", "guest-1")
 
 		assert.ErrorIs(t, err, services.ErrNotFound)
 		assert.Nil(t, vote)
@@ -901,15 +1037,15 @@ func TestVoteService_UpdateVote(t *testing.T) {
 			},
 		}
 		actsService := &mockVoteActsService{
-			listActsFunc: func(eventType string) ([]models.Act, error) {
+			listActsFunc: func(ctx context.Context, contest, eventType string) ([]models.Act, error) {
 				return testActs(), nil
 			},
 		}
 
-		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService)
+		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService, nil)
 		ctx := context.Background()
 
-		vote, err := svc.UpdateVote(ctx, "admin-1", "party-1", services.SubmitVoteRequest{
+		vote, err := svc.UpdateVote(asUser(ctx, "admin-1"), "party-1", services.SubmitVoteRequest{
 			GuestID: "guest-1",
 			Votes:   updatedVotes,
 		})
@@ -940,10 +1076,10 @@ func TestVoteService_UpdateVote(t *testing.T) {
 		guestDAO := &mockVoteGuestDAO{}
 		actsService := &mockVoteActsService{}
 
-		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService)
+		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService, nil)
 		ctx := context.Background()
 
-		vote, err := svc.UpdateVote(ctx, "admin-1", "party-1", services.SubmitVoteRequest{
+		vote, err := svc.UpdateVote(asUser(ctx, "admin-1"), "party-1", services.SubmitVoteRequest{
 			GuestID: "guest-1",
 			Votes:   validVotes(),
 		})
@@ -990,15 +1126,15 @@ func TestVoteService_UpdateVote(t *testing.T) {
 			},
 		}
 		actsService := &mockVoteActsService{
-			listActsFunc: func(eventType string) ([]models.Act, error) {
+			listActsFunc: func(ctx context.Context, contest, eventType string) ([]models.Act, error) {
 				return testActs(), nil
 			},
 		}
 
-		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService)
+		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService, nil)
 		ctx := context.Background()
 
-		vote, err := svc.UpdateVote(ctx, "admin-1", "party-1", services.SubmitVoteRequest{
+		vote, err := svc.UpdateVote(asUser(ctx, "admin-1"), "party-1", services.SubmitVoteRequest{
 			GuestID: "guest-1",
 			Votes:   validVotes(),
 		})
@@ -1044,15 +1180,15 @@ func TestVoteService_UpdateVote(t *testing.T) {
 			},
 		}
 		actsService := &mockVoteActsService{
-			listActsFunc: func(eventType string) ([]models.Act, error) {
+			listActsFunc: func(ctx context.Context, contest, eventType string) ([]models.Act, error) {
 				return testActs(), nil
 			},
 		}
 
-		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService)
+		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService, nil)
 		ctx := context.Background()
 
-		vote, err := svc.UpdateVote(ctx, "admin-1", "party-1", services.SubmitVoteRequest{
+		vote, err := svc.UpdateVote(asUser(ctx, "admin-1"), "party-1", services.SubmitVoteRequest{
 			GuestID: "guest-1",
 			Votes:   invalidVotes,
 		})
@@ -1062,6 +1198,89 @@ func TestVoteService_UpdateVote(t *testing.T) {
 	})
 }
 
+func TestVoteService_StartVoting(t *testing.T) {
+	newParty := func() *models.Party {
+		return &models.Party{
+			ID:        "party-1",
+			Name:      "Test Party",
+			Code:      "ABC123",
+			EventType: models.EventGrandFinal,
+			AdminID:   "admin-1",
+			Status:    models.PartyStatusActive,
+			CreatedAt: time.Now(),
+		}
+	}
+
+	t.Run("records the start of voting", func(t *testing.T) {
+		var startedAt time.Time
+		partyDAO := &mockVotePartyDAO{
+			getByIDFunc: func(ctx context.Context, id string) (*models.Party, error) {
+				return newParty(), nil
+			},
+			updateVotingStartedAtFunc: func(ctx context.Context, id string, t time.Time) error {
+				startedAt = t
+				return nil
+			},
+		}
+		svc := services.NewVoteService(&mockVoteDAO{}, partyDAO, &mockVoteGuestDAO{}, &mockVoteActsService{}, nil)
+
+		party, err := svc.StartVoting(asUser(context.Background(), "admin-1"), "party-1")
+
+		require.NoError(t, err)
+		assert.True(t, party.VotingStarted())
+		assert.Equal(t, startedAt, party.VotingStartedAt)
+	})
+
+	t.Run("keeps the original start time", func(t *testing.T) {
+		started := newParty()
+		started.VotingStartedAt = time.Now().Add(-time.Hour)
+		partyDAO := &mockVotePartyDAO{
+			getByIDFunc: func(ctx context.Context, id string) (*models.Party, error) {
+				return started, nil
+			},
+			updateVotingStartedAtFunc: func(ctx context.Context, id string, t time.Time) error {
+				return errors.New("unexpected update")
+			},
+		}
+		svc := services.NewVoteService(&mockVoteDAO{}, partyDAO, &mockVoteGuestDAO{}, &mockVoteActsService{}, nil)
+
+		party, err := svc.StartVoting(asUser(context.Background(), "admin-1"), "party-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, started.VotingStartedAt, party.VotingStartedAt)
+	})
+
+	t.Run("returns ErrUnauthorized for other users", func(t *testing.T) {
+		partyDAO := &mockVotePartyDAO{
+			getByIDFunc: func(ctx context.Context, id string) (*models.Party, error) {
+				return newParty(), nil
+			},
+		}
+		svc := services.NewVoteService(&mockVoteDAO{}, partyDAO, &mockVoteGuestDAO{}, &mockVoteActsService{}, nil)
+
+		_, err := svc.StartVoting(asUser(context.Background(), "admin-2"), "party-1")
+		assert.ErrorIs(t, err, services.ErrUnauthorized)
+
+		_, err = svc.StartVoting(context.Background(), "party-1")
+		assert.ErrorIs(t, err, services.ErrUnauthorized)
+	})
+
+	t.Run("returns ErrPartyClosed for closed party", func(t *testing.T) {
+		closed := newParty()
+		closed.Status = models.PartyStatusClosed
+		partyDAO := &mockVotePartyDAO{
+			getByIDFunc: func(ctx context.Context, id string) (*models.Party, error) {
+				return closed, nil
+			},
+		}
+		svc := services.NewVoteService(&mockVoteDAO{}, partyDAO, &mockVoteGuestDAO{}, &mockVoteActsService{}, nil)
+
+		_, err := svc.StartVoting(asUser(context.Background(), "admin-1"), "party-1")
+
+		assert.ErrorIs(t, err, services.ErrPartyClosed)
+	})
+}
+
 func TestVoteService_EndVoting(t *testing.T) {
 	t.Run("ends voting successfully", func(t *testing.T) {
 		existingParty := &models.Party{
@@ -1093,10 +1312,10 @@ func TestVoteService_EndVoting(t *testing.T) {
 		guestDAO := &mockVoteGuestDAO{}
 		actsService := &mockVoteActsService{}
 
-		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService)
+		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService, nil)
 		ctx := context.Background()
 
-		party, err := svc.EndVoting(ctx, "admin-1", "party-1")
+		party, err := svc.EndVoting(asUser(ctx, "admin-1"), "party-1")
 
 		require.NoError(t, err)
 		assert.Equal(t, "party-1", party.ID)
@@ -1115,16 +1334,16 @@ func TestVoteService_EndVoting(t *testing.T) {
 		guestDAO := &mockVoteGuestDAO{}
 		actsService := &mockVoteActsService{}
 
-		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService)
+		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService, nil)
 		ctx := context.Background()
 
-		party, err := svc.EndVoting(ctx, "admin-1", "nonexistent")
+		party, err := svc.EndVoting(asUser(ctx, "admin-1"), "nonexistent")
 
 		assert.ErrorIs(t, err, services.ErrNotFound)
 		assert.Nil(t, party)
 	})
 
-	t.Run("returns ErrUnauthorized when adminID is empty", func(t *testing.T) {
+	t.Run("returns ErrUnauthorized for anonymous callers", func(t *testing.T) {
 		existingParty := &models.Party{
 			ID:        "party-1",
 			Name:      "Test Party",
@@ -1144,10 +1363,10 @@ func TestVoteService_EndVoting(t *testing.T) {
 		guestDAO := &mockVoteGuestDAO{}
 		actsService := &mockVoteActsService{}
 
-		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService)
+		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService, nil)
 		ctx := context.Background()
 
-		party, err := svc.EndVoting(ctx, "", "party-1")
+		party, err := svc.EndVoting(ctx, "party-1")
 
 		assert.ErrorIs(t, err, services.ErrUnauthorized)
 		assert.Nil(t, party)
@@ -1173,10 +1392,10 @@ func TestVoteService_EndVoting(t *testing.T) {
 		guestDAO := &mockVoteGuestDAO{}
 		actsService := &mockVoteActsService{}
 
-		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService)
+		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService, nil)
 		ctx := context.Background()
 
-		party, err := svc.EndVoting(ctx, "other-admin", "party-1")
+		party, err := svc.EndVoting(asUser(ctx, "other-admin"), "party-1")
 
 		assert.ErrorIs(t, err, services.ErrUnauthorized)
 		assert.Nil(t, party)
@@ -1202,10 +1421,10 @@ func TestVoteService_EndVoting(t *testing.T) {
 		guestDAO := &mockVoteGuestDAO{}
 		actsService := &mockVoteActsService{}
 
-		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService)
+		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService, nil)
 		ctx := context.Background()
 
-		party, err := svc.EndVoting(ctx, "admin-1", "party-1")
+		party, err := svc.EndVoting(asUser(ctx, "admin-1"), "party-1")
 
 		assert.ErrorIs(t, err, services.ErrPartyClosed)
 		assert.Nil(t, party)
@@ -1235,10 +1454,10 @@ func TestVoteService_EndVoting(t *testing.T) {
 		guestDAO := &mockVoteGuestDAO{}
 		actsService := &mockVoteActsService{}
 
-		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService)
+		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService, nil)
 		ctx := context.Background()
 
-		party, err := svc.EndVoting(ctx, "admin-1", "party-1")
+		party, err := svc.EndVoting(asUser(ctx, "admin-1"), "party-1")
 
 		assert.ErrorIs(t, err, daoErr)
 		assert.Nil(t, party)
@@ -1279,15 +1498,15 @@ func TestVoteService_GetResults(t *testing.T) {
 		}
 		guestDAO := &mockVoteGuestDAO{}
 		actsService := &mockVoteActsService{
-			listActsFunc: func(eventType string) ([]models.Act, error) {
+			listActsFunc: func(ctx context.Context, contest, eventType string) ([]models.Act, error) {
 				return testActs(), nil
 			},
 		}
 
-		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService)
+		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService, nil)
 		ctx := context.Background()
 
-		results, err := svc.GetResults(ctx, "admin-1", "party-1")
+		results, err := svc.GetResults(asUser(ctx, "admin-1"), "party-1")
 
 		require.NoError(t, err)
 		assert.Equal(t, "party-1", results.PartyID)
@@ -1309,7 +1528,7 @@ func TestVoteService_GetResults(t *testing.T) {
 		assert.Equal(t, 10, results.Results[9].Rank)
 	})
 
-	t.Run("handles ties with standard competition ranking", func(t *testing.T) {
+	t.Run("breaks a full tie on running order", func(t *testing.T) {
 		existingParty := &models.Party{
 			ID:        "party-1",
 			Name:      "Test Party",
@@ -1373,27 +1592,31 @@ func TestVoteService_GetResults(t *testing.T) {
 		}
 		guestDAO := &mockVoteGuestDAO{}
 		actsService := &mockVoteActsService{
-			listActsFunc: func(eventType string) ([]models.Act, error) {
+			listActsFunc: func(ctx context.Context, contest, eventType string) ([]models.Act, error) {
 				return testActs(), nil
 			},
 		}
 
-		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService)
+		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService, nil)
 		ctx := context.Background()
 
-		results, err := svc.GetResults(ctx, "admin-1", "party-1")
+		results, err := svc.GetResults(asUser(ctx, "admin-1"), "party-1")
 
 		require.NoError(t, err)
 		assert.Equal(t, 2, results.TotalVoters)
 		require.Len(t, results.Results, 10)
 
-		// act-1 and act-2 both have 22 points (12+10), tied at rank 1
+		// act-1 and act-2 both have 22 points (12+10) from two voters; act-1 performed first
+		assert.Equal(t, "act-1", results.Results[0].ActID)
 		assert.Equal(t, 22, results.Results[0].TotalPoints)
 		assert.Equal(t, 1, results.Results[0].Rank)
+		assert.Equal(t, &models.TieBreak{Rule: models.TieBreakRunningOrder, ActID: "act-2"}, results.Results[0].TieBreak)
+		assert.Equal(t, "act-2", results.Results[1].ActID)
 		assert.Equal(t, 22, results.Results[1].TotalPoints)
-		assert.Equal(t, 1, results.Results[1].Rank)
+		assert.Equal(t, 2, results.Results[1].Rank)
+		assert.Nil(t, results.Results[1].TieBreak)
 
-		// act-3 has 16 points (8+8), should be rank 3 (standard competition ranking: 1,1,3)
+		// act-3 has 16 points (8+8)
 		assert.Equal(t, 16, results.Results[2].TotalPoints)
 		assert.Equal(t, 3, results.Results[2].Rank)
 	})
@@ -1422,15 +1645,15 @@ func TestVoteService_GetResults(t *testing.T) {
 		}
 		guestDAO := &mockVoteGuestDAO{}
 		actsService := &mockVoteActsService{
-			listActsFunc: func(eventType string) ([]models.Act, error) {
+			listActsFunc: func(ctx context.Context, contest, eventType string) ([]models.Act, error) {
 				return testActs(), nil
 			},
 		}
 
-		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService)
+		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService, nil)
 		ctx := context.Background()
 
-		results, err := svc.GetResults(ctx, "admin-1", "party-1")
+		results, err := svc.GetResults(asUser(ctx, "admin-1"), "party-1")
 
 		require.NoError(t, err)
 		assert.Equal(t, 0, results.TotalVoters)
@@ -1452,10 +1675,10 @@ func TestVoteService_GetResults(t *testing.T) {
 		guestDAO := &mockVoteGuestDAO{}
 		actsService := &mockVoteActsService{}
 
-		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService)
+		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService, nil)
 		ctx := context.Background()
 
-		results, err := svc.GetResults(ctx, "admin-1", "nonexistent")
+		results, err := svc.GetResults(asUser(ctx, "admin-1"), "nonexistent")
 
 		assert.ErrorIs(t, err, services.ErrNotFound)
 		assert.Nil(t, results)
@@ -1481,10 +1704,10 @@ func TestVoteService_GetResults(t *testing.T) {
 		guestDAO := &mockVoteGuestDAO{}
 		actsService := &mockVoteActsService{}
 
-		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService)
+		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService, nil)
 		ctx := context.Background()
 
-		results, err := svc.GetResults(ctx, "admin-1", "party-1")
+		results, err := svc.GetResults(asUser(ctx, "admin-1"), "party-1")
 
 		assert.ErrorIs(t, err, services.ErrVotingNotEnded)
 		assert.Nil(t, results)
@@ -1510,10 +1733,10 @@ func TestVoteService_GetResults(t *testing.T) {
 		guestDAO := &mockVoteGuestDAO{}
 		actsService := &mockVoteActsService{}
 
-		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService)
+		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService, nil)
 		ctx := context.Background()
 
-		results, err := svc.GetResults(ctx, "other-admin", "party-1")
+		results, err := svc.GetResults(asUser(ctx, "other-admin"), "party-1")
 
 		assert.ErrorIs(t, err, services.ErrUnauthorized)
 		assert.Nil(t, results)
@@ -1566,21 +1789,21 @@ func TestVoteService_GetResults(t *testing.T) {
 		}
 		guestDAO := &mockVoteGuestDAO{}
 		actsService := &mockVoteActsService{
-			listActsFunc: func(eventType string) ([]models.Act, error) {
+			listActsFunc: func(ctx context.Context, contest, eventType string) ([]models.Act, error) {
 				return testActs(), nil
 			},
 		}
 
-		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService)
+		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService, nil)
 		ctx := context.Background()
 
-		results, err := svc.GetResults(ctx, "admin-1", "party-1")
+		results, err := svc.GetResults(asUser(ctx, "admin-1"), "party-1")
 
 		require.NoError(t, err)
 		assert.Equal(t, 3, results.TotalVoters)
 	})
 
-	t.Run("works without admin auth", func(t *testing.T) {
+	t.Run("works for anonymous callers", func(t *testing.T) {
 		existingParty := &models.Party{
 			ID:        "party-1",
 			Name:      "Test Party",
@@ -1603,20 +1826,415 @@ func TestVoteService_GetResults(t *testing.T) {
 		}
 		guestDAO := &mockVoteGuestDAO{}
 		actsService := &mockVoteActsService{
-			listActsFunc: func(eventType string) ([]models.Act, error) {
+			listActsFunc: func(ctx context.Context, contest, eventType string) ([]models.Act, error) {
 				return testActs(), nil
 			},
 		}
 
-		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService)
+		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService, nil)
 		ctx := context.Background()
 
-		// Empty adminID should be allowed (optional auth)
-		results, err := svc.GetResults(ctx, "", "party-1")
+		// Anonymous callers may read the results
+		results, err := svc.GetResults(ctx, "party-1")
 
 		require.NoError(t, err)
 		assert.Equal(t, "party-1", results.PartyID)
 		assert.Equal(t, "Test Party", results.PartyName)
 		require.Len(t, results.Results, 10)
 	})
+
+	// top3Results tallies ballots of a closed top 3 party cast by guest-1, guest-2
+	// and so on, who are named alice, bob and carol.
+	top3Results := func(t *testing.T, anonymous bool, ballots ...map[int]string) *services.PartyResults {
+		t.Helper()
+		votes := make([]*models.Vote, len(ballots))
+		for i, ballot := range ballots {
+			guestID := fmt.Sprintf("guest-%d", i+1)
+			votes[i] = &models.Vote{ID: "vote-" + guestID, GuestID: guestID, PartyID: "party-1", Votes: ballot, CreatedAt: time.Now()}
+		}
+		usernames := map[string]string{"guest-1": "alice", "guest-2": "bob", "guest-3": "carol"}
+
+		voteDAO := &mockVoteDAO{
+			listByPartyIDFunc: func(ctx context.Context, partyID string) ([]*models.Vote, error) {
+				return votes, nil
+			},
+		}
+		partyDAO := &mockVotePartyDAO{
+			getByIDFunc: func(ctx context.Context, id string) (*models.Party, error) {
+				return &models.Party{
+					ID: "party-1", Name: "Test Party", Code: "ABC123", EventType: models.EventGrandFinal,
+					ScoringSystem: models.ScoringTop3, AnonymousResults: anonymous,
+					AdminID: "admin-1", Status: models.PartyStatusClosed, CreatedAt: time.Now(),
+				}, nil
+			},
+		}
+		guestDAO := &mockVoteGuestDAO{
+			getByIDFunc: func(ctx context.Context, id string) (*models.Guest, error) {
+				return &models.Guest{ID: id, PartyID: "party-1", Username: usernames[id]}, nil
+			},
+		}
+		actsService := &mockVoteActsService{
+			listActsFunc: func(ctx context.Context, contest, eventType string) ([]models.Act, error) {
+				return testActs(), nil
+			},
+		}
+
+		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, actsService, nil)
+		results, err := svc.GetResults(asUser(context.Background(), "admin-1"), "party-1")
+		require.NoError(t, err)
+		return results
+	}
+
+	// resultFor returns the result of the given act.
+	resultFor := func(t *testing.T, results *services.PartyResults, actID string) models.VoteResult {
+		t.Helper()
+		for _, result := range results.Results {
+			if result.ActID == actID {
+				return result
+			}
+		}
+		t.Fatalf("no result for %s", actID)
+		return models.VoteResult{}
+	}
+
+	t.Run("reports the point distribution, voters and top marks", func(t *testing.T) {
+		results := top3Results(t, false,
+			map[int]string{3: "act-1", 2: "act-2", 1: "act-3"},
+			map[int]string{3: "act-1", 2: "act-3", 1: "act-2"},
+			map[int]string{3: "act-2", 2: "act-4", 1: "act-1"},
+		)
+
+		act1 := resultFor(t, results, "act-1")
+		assert.Equal(t, 7, act1.TotalPoints)
+		assert.Equal(t, map[int]int{3: 2, 1: 1}, act1.PointCounts)
+		assert.Equal(t, 3, act1.Voters)
+		assert.Equal(t, []string{"alice", "bob"}, act1.TopMarks)
+
+		act4 := resultFor(t, results, "act-4")
+		assert.Equal(t, 1, act4.Voters)
+		assert.Empty(t, act4.TopMarks)
+
+		act5 := resultFor(t, results, "act-5")
+		assert.Zero(t, act5.Voters)
+		assert.Empty(t, act5.PointCounts)
+	})
+
+	t.Run("hides top marks for anonymous parties", func(t *testing.T) {
+		results := top3Results(t, true, map[int]string{3: "act-1", 2: "act-2", 1: "act-3"})
+
+		act1 := resultFor(t, results, "act-1")
+		assert.Equal(t, map[int]int{3: 1}, act1.PointCounts)
+		assert.Empty(t, act1.TopMarks)
+	})
+
+	t.Run("breaks ties on the number of voters", func(t *testing.T) {
+		results := top3Results(t, false,
+			map[int]string{3: "act-1", 2: "act-2", 1: "act-3"},
+			map[int]string{3: "act-3", 2: "act-4", 1: "act-1"},
+			map[int]string{3: "act-5", 2: "act-6", 1: "act-4"},
+		)
+
+		// act-4 (2+1) beats act-5 (3) on voters although act-5 has the higher mark
+		act4 := resultFor(t, results, "act-4")
+		assert.Equal(t, 3, act4.Rank)
+		assert.Equal(t, &models.TieBreak{Rule: models.TieBreakVoters, ActID: "act-5"}, act4.TieBreak)
+		assert.Equal(t, 4, resultFor(t, results, "act-5").Rank)
+		// act-1 and act-3 (3+1 each) and act-2 and act-6 (2 each) are level on every
+		// other rule, so the act that performed first ranks higher
+		assert.Equal(t, 1, resultFor(t, results, "act-1").Rank)
+		assert.Equal(t, 2, resultFor(t, results, "act-3").Rank)
+		assert.Equal(t, 5, resultFor(t, results, "act-2").Rank)
+		assert.Equal(t, 6, resultFor(t, results, "act-6").Rank)
+	})
+
+	t.Run("breaks ties on the count of the highest points", func(t *testing.T) {
+		results := top3Results(t, false,
+			map[int]string{3: "act-1", 2: "act-2", 1: "act-3"},
+			map[int]string{3: "act-3", 2: "act-2", 1: "act-1"},
+		)
+
+		// All three acts have 4 points from two voters; act-2 has no 3
+		assert.Equal(t, "act-1", results.Results[0].ActID)
+		assert.Equal(t, "act-3", results.Results[1].ActID)
+		assert.Equal(t, &models.TieBreak{Rule: models.TieBreakPointCount, Points: 3, ActID: "act-2"}, results.Results[1].TieBreak)
+		assert.Equal(t, "act-2", results.Results[2].ActID)
+		assert.Equal(t, 3, results.Results[2].Rank)
+	})
+}
+
+func TestVoteService_ScoringSystems(t *testing.T) {
+	ctx := context.Background()
+
+	// setup creates an active party with the given scoring system and two approved guests.
+	setup := func(t *testing.T, scoring models.ScoringSystem) services.VoteService {
+		store := memory.NewStore()
+		partyDAO := memory.NewPartyDAO(store)
+		guestDAO := memory.NewGuestDAO(store)
+		require.NoError(t, partyDAO.Create(ctx, &models.Party{
+			ID:            "party-1",
+			Name:          "Scoring Party",
+			Code:          "SCORE1",
+			EventType:     models.EventGrandFinal,
+			AdminID:       "admin-1",
+			Status:        models.PartyStatusActive,
+			CreatedAt:     time.Now(),
+			ScoringSystem: scoring,
+		}))
+		for _, id := range []string{"guest-1", "guest-2"} {
+			require.NoError(t, guestDAO.Create(ctx, &models.Guest{
+				ID:        id,
+				PartyID:   "party-1",
+				Username:  id,
+				Status:    models.GuestStatusApproved,
+				CreatedAt: time.Now(),
+			}))
+		}
+		svc := services.NewVoteService(memory.NewVoteDAO(store), partyDAO, guestDAO, &mockVoteActsService{
+			listActsFunc: func(ctx context.Context, contest, eventType string) ([]models.Act, error) {
+				return testActs()[:3], nil
+			},
+		}, nil)
+		return svc
+	}
+
+	t.Run("rejects a Eurovision ballot in a top 3 party", func(t *testing.T) {
+		svc := setup(t, models.ScoringTop3)
+
+		_, err := svc.SubmitVote(asGuest(ctx, "guest-1", "party-1"), "party-1", services.SubmitVoteRequest{GuestID: "guest-1", Votes: validVotes()})
+
+		assert.ErrorIs(t, err, services.ErrInvalidVotes)
+	})
+
+	t.Run("ranks top 3 ballots by total points", func(t *testing.T) {
+		svc := setup(t, models.ScoringTop3)
+		_, err := svc.SubmitVote(asGuest(ctx, "guest-1", "party-1"), "party-1", services.SubmitVoteRequest{
+			GuestID: "guest-1",
+			Votes:   map[int]string{3: "act-2", 2: "act-1", 1: "act-3"},
+		})
+		require.NoError(t, err)
+		_, err = svc.SubmitVote(asGuest(ctx, "guest-2", "party-1"), "party-1", services.SubmitVoteRequest{
+			GuestID: "guest-2",
+			Votes:   map[int]string{3: "act-2", 2: "act-3", 1: "act-1"},
+		})
+		require.NoError(t, err)
+		_, err = svc.EndVoting(asUser(ctx, "admin-1"), "party-1")
+		require.NoError(t, err)
+
+		results, err := svc.GetResults(ctx, "party-1")
+
+		require.NoError(t, err)
+		require.Len(t, results.Results, 3)
+		assert.Equal(t, "act-2", results.Results[0].ActID)
+		assert.Equal(t, 6, results.Results[0].TotalPoints)
+		assert.Equal(t, 3, results.Results[1].TotalPoints)
+		assert.Equal(t, "act-1", results.Results[1].ActID)
+		assert.Equal(t, 2, results.Results[1].Rank)
+		assert.Equal(t, "act-3", results.Results[2].ActID)
+		assert.Equal(t, 3, results.Results[2].Rank)
+		assert.Zero(t, results.Results[0].AverageScore)
+	})
+
+	t.Run("averages ratings", func(t *testing.T) {
+		svc := setup(t, models.ScoringRating)
+		_, err := svc.SubmitVote(asGuest(ctx, "guest-1", "party-1"), "party-1", services.SubmitVoteRequest{
+			GuestID: "guest-1",
+			Ratings: map[string]int{"act-1": 4, "act-2": 9, "act-3": 1},
+		})
+		require.NoError(t, err)
+		_, err = svc.SubmitVote(asGuest(ctx, "guest-2", "party-1"), "party-1", services.SubmitVoteRequest{
+			GuestID: "guest-2",
+			Ratings: map[string]int{"act-1": 7, "act-2": 8, "act-3": 2},
+		})
+		require.NoError(t, err)
+		_, err = svc.EndVoting(asUser(ctx, "admin-1"), "party-1")
+		require.NoError(t, err)
+
+		results, err := svc.GetResults(ctx, "party-1")
+
+		require.NoError(t, err)
+		require.Len(t, results.Results, 3)
+		assert.Equal(t, "act-2", results.Results[0].ActID)
+		assert.InDelta(t, 8.5, results.Results[0].AverageScore, 0.001)
+		assert.Equal(t, "act-1", results.Results[1].ActID)
+		assert.InDelta(t, 5.5, results.Results[1].AverageScore, 0.001)
+		assert.InDelta(t, 1.5, results.Results[2].AverageScore, 0.001)
+	})
+
+	t.Run("rejects an incomplete ranking in a Borda party", func(t *testing.T) {
+		svc := setup(t, models.ScoringBorda)
+
+		_, err := svc.SubmitVote(asGuest(ctx, "guest-1", "party-1"), "party-1", services.SubmitVoteRequest{
+			GuestID: "guest-1",
+			Votes:   map[int]string{3: "act-1", 2: "act-2"},
+		})
+
+		assert.ErrorIs(t, err, services.ErrInvalidVotes)
+	})
+
+	t.Run("updates a Borda ranking", func(t *testing.T) {
+		svc := setup(t, models.ScoringBorda)
+		_, err := svc.SubmitVote(asGuest(ctx, "guest-1", "party-1"), "party-1", services.SubmitVoteRequest{
+			GuestID: "guest-1",
+			Votes:   map[int]string{3: "act-1", 2: "act-2", 1: "act-3"},
+		})
+		require.NoError(t, err)
+
+		vote, err := svc.UpdateVote(asGuest(ctx, "guest-1", "party-1"), "party-1", services.SubmitVoteRequest{
+			GuestID: "guest-1",
+			Votes:   map[int]string{3: "act-3", 2: "act-2", 1: "act-1"},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "act-3", vote.Votes[3])
+	})
+}
+
+func TestVoteService_Drafts(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func(t *testing.T) services.VoteService {
+		store := memory.NewStore()
+		partyDAO := memory.NewPartyDAO(store)
+		guestDAO := memory.NewGuestDAO(store)
+		require.NoError(t, partyDAO.Create(ctx, &models.Party{
+			ID:        "party-1",
+			Name:      "Draft Party",
+			Code:      "DRAFT1",
+			EventType: models.EventGrandFinal,
+			AdminID:   "admin-1",
+			Status:    models.PartyStatusActive,
+			CreatedAt: time.Now(),
+		}))
+		for _, id := range []string{"guest-1", "guest-2"} {
+			require.NoError(t, guestDAO.Create(ctx, &models.Guest{
+				ID:        id,
+				PartyID:   "party-1",
+				Username:  id,
+				Status:    models.GuestStatusApproved,
+				CreatedAt: time.Now(),
+			}))
+		}
+		return services.NewVoteService(memory.NewVoteDAO(store), partyDAO, guestDAO, &mockVoteActsService{
+			listActsFunc: func(ctx context.Context, contest, eventType string) ([]models.Act, error) {
+				return testActs(), nil
+			},
+		}, nil)
+	}
+
+	t.Run("saves and replaces a partial ballot", func(t *testing.T) {
+		svc := setup(t)
+
+		_, err := svc.SaveDraft(asGuest(ctx, "guest-1", "party-1"), "party-1", services.SubmitVoteRequest{GuestID: "guest-1", Votes: map[int]string{12: "act-1"}})
+		require.NoError(t, err)
+		_, err = svc.SaveDraft(asGuest(ctx, "guest-1", "party-1"), "party-1", services.SubmitVoteRequest{GuestID: "guest-1", Votes: map[int]string{12: "act-2", 10: "act-1"}})
+		require.NoError(t, err)
+
+		vote, err := svc.GetVotes(asGuest(ctx, "guest-1", "party-1"), "party-1", "guest-1")
+		require.NoError(t, err)
+		assert.True(t, vote.Draft)
+		assert.Equal(t, map[int]string{12: "act-2", 10: "act-1"}, vote.Votes)
+	})
+
+	t.Run("rejects a draft with an invalid point value", func(t *testing.T) {
+		svc := setup(t)
+
+		_, err := svc.SaveDraft(asGuest(ctx, "guest-1", "party-1"), "party-1", services.SubmitVoteRequest{GuestID: "guest-1", Votes: map[int]string{9: "act-1"}})
+
+		assert.ErrorIs(t, err, services.ErrInvalidVotes)
+	})
+
+	t.Run("finalizes only a complete draft", func(t *testing.T) {
+		svc := setup(t)
+		_, err := svc.SaveDraft(asGuest(ctx, "guest-1", "party-1"), "party-1", services.SubmitVoteRequest{GuestID: "guest-1", Votes: map[int]string{12: "act-1"}})
+		require.NoError(t, err)
+
+		_, err = svc.FinalizeVote(asGuest(ctx, "guest-1", "party-1"), "party-1", "guest-1")
+		assert.ErrorIs(t, err, services.ErrInvalidVotes)
+
+		_, err = svc.SaveDraft(asGuest(ctx, "guest-1", "party-1"), "party-1", services.SubmitVoteRequest{GuestID: "guest-1", Votes: validVotes()})
+		require.NoError(t, err)
+		vote, err := svc.FinalizeVote(asGuest(ctx, "guest-1", "party-1"), "party-1", "guest-1")
+		require.NoError(t, err)
+		assert.False(t, vote.Draft)
+
+		_, err = svc.FinalizeVote(asGuest(ctx, "guest-1", "party-1"), "party-1", "guest-1")
+		assert.ErrorIs(t, err, services.ErrVoteAlreadyExists)
+		_, err = svc.SaveDraft(asGuest(ctx, "guest-1", "party-1"), "party-1", services.SubmitVoteRequest{GuestID: "guest-1", Votes: map[int]string{12: "act-1"}})
+		assert.ErrorIs(t, err, services.ErrVoteAlreadyExists)
+	})
+
+	t.Run("does not turn a ballot finalized meanwhile back into a draft", func(t *testing.T) {
+		voteDAO := &mockVoteDAO{
+			getByGuestAndPartyFunc: func(ctx context.Context, guestID, partyID string) (*models.Vote, error) {
+				return &models.Vote{ID: models.VoteIDFor(partyID, guestID), GuestID: guestID, PartyID: partyID, Draft: true}, nil
+			},
+			updateDraftFunc: func(ctx context.Context, vote *models.Vote) error {
+				return persistence.ErrVoteExists
+			},
+			updateFunc: func(ctx context.Context, vote *models.Vote) error {
+				t.Fatal("drafts must not be saved unconditionally")
+				return nil
+			},
+		}
+		partyDAO := &mockVotePartyDAO{
+			getByIDFunc: func(ctx context.Context, id string) (*models.Party, error) {
+				return &models.Party{ID: id, EventType: models.EventGrandFinal, Status: models.PartyStatusActive}, nil
+			},
+		}
+		guestDAO := &mockVoteGuestDAO{
+			getByIDFunc: func(ctx context.Context, id string) (*models.Guest, error) {
+				return &models.Guest{ID: id, PartyID: "party-1", Status: models.GuestStatusApproved}, nil
+			},
+		}
+		svc := services.NewVoteService(voteDAO, partyDAO, guestDAO, &mockVoteActsService{
+			listActsFunc: func(ctx context.Context, contest, eventType string) ([]models.Act, error) {
+				return testActs(), nil
+			},
+		}, nil)
+
+		_, err := svc.SaveDraft(asGuest(ctx, "guest-1", "party-1"), "party-1", services.SubmitVoteRequest{GuestID: "guest-1", Votes: map[int]string{12: "act-1"}})
+
+		assert.ErrorIs(t, err, services.ErrVoteAlreadyExists)
+	})
+
+	t.Run("returns ErrNotFound when finalizing without draft", func(t *testing.T) {
+		svc := setup(t)
+
+		_, err := svc.FinalizeVote(asGuest(ctx, "guest-1", "party-1"), "party-1", "guest-1")
+
+		assert.ErrorIs(t, err, services.ErrNotFound)
+	})
+
+	t.Run("submitting a ballot replaces the draft", func(t *testing.T) {
+		svc := setup(t)
+		_, err := svc.SaveDraft(asGuest(ctx, "guest-1", "party-1"), "party-1", services.SubmitVoteRequest{GuestID: "guest-1", Votes: map[int]string{12: "act-1"}})
+		require.NoError(t, err)
+
+		vote, err := svc.SubmitVote(asGuest(ctx, "guest-1", "party-1"), "party-1", services.SubmitVoteRequest{GuestID: "guest-1", Votes: validVotes()})
+
+		require.NoError(t, err)
+		assert.False(t, vote.Draft)
+	})
+
+	t.Run("ending voting counts complete drafts and skips incomplete ones", func(t *testing.T) {
+		svc := setup(t)
+		_, err := svc.SaveDraft(asGuest(ctx, "guest-1", "party-1"), "party-1", services.SubmitVoteRequest{GuestID: "guest-1", Votes: validVotes()})
+		require.NoError(t, err)
+		_, err = svc.SaveDraft(asGuest(ctx, "guest-2", "party-1"), "party-1", services.SubmitVoteRequest{GuestID: "guest-2", Votes: map[int]string{12: "act-10"}})
+		require.NoError(t, err)
+
+		_, err = svc.EndVoting(asUser(ctx, "admin-1"), "party-1")
+		require.NoError(t, err)
+
+		results, err := svc.GetResults(asUser(ctx, "admin-1"), "party-1")
+		require.NoError(t, err)
+		assert.Equal(t, 1, results.TotalVoters)
+		assert.Equal(t, 1, results.IncompleteBallots)
+		assert.Equal(t, "act-1", results.Results[0].ActID)
+		assert.Equal(t, 12, results.Results[0].TotalPoints)
+
+		vote, err := svc.GetVotes(asUser(ctx, "admin-1"), "party-1", "guest-1")
+		require.NoError(t, err)
+		assert.False(t, vote.Draft)
+	})
 }
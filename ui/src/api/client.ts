@@ -16,14 +16,24 @@ type ApiFetchOptions = {
 	method?: string;
 	body?: unknown;
 	authenticated?: boolean;
+	// guestToken acts as the guest of a party, see joinParty.
+	guestToken?: string;
 	params?: Record<string, string>;
 };
 
+export const GUEST_TOKEN_HEADER = 'X-Guest-Token';
+
 export async function apiFetch<T>(
 	path: string,
 	options: ApiFetchOptions = {},
 ): Promise<T> {
-	const { method = 'GET', body, authenticated = false, params } = options;
+	const {
+		method = 'GET',
+		body,
+		authenticated = false,
+		guestToken,
+		params,
+	} = options;
 
 	const baseUrl = import.meta.env.VITE_API_URL ?? '';
 	let url = `${baseUrl}${path}`;
@@ -48,6 +58,10 @@ export async function apiFetch<T>(
 		headers.set('Authorization', `Bearer ${token}`);
 	}
 
+	if (guestToken) {
+		headers.set(GUEST_TOKEN_HEADER, guestToken);
+	}
+
 	const response = await fetch(url, {
 		method,
 		headers,
@@ -0,0 +1,53 @@
+import type { PartyEvent, PartyEventType } from '../types/api';
+
+// The query parameter carrying the guest token, since EventSource cannot set
+// the X-Guest-Token header.
+export const GUEST_TOKEN_PARAM = 'guestToken';
+
+const PARTY_EVENT_TYPES: PartyEventType[] = [
+	'guest.joined',
+	'guest.approved',
+	'guest.rejected',
+	'guest.removed',
+	'vote.submitted',
+	'vote.updated',
+	'voting.started',
+	'voting.ended',
+	'results.available',
+	'reveal.updated',
+	'prediction.submitted',
+	'prediction.outcome',
+	'resync',
+];
+
+// Streams the events of a party to one of its guests. EventSource reconnects
+// on its own and resumes after the last event it received. onOpen is called
+// whenever the stream (re)connects, so callers can catch up on anything they
+// missed while it was down. Returns a function that closes the stream.
+export function subscribeToPartyEvents(
+	partyId: string,
+	guestToken: string,
+	onEvent: (event: PartyEvent) => void,
+	onOpen?: () => void,
+): () => void {
+	const baseUrl = import.meta.env.VITE_API_URL ?? '';
+	const params = new URLSearchParams({ [GUEST_TOKEN_PARAM]: guestToken });
+	const source = new EventSource(
+		`${baseUrl}/api/parties/${partyId}/events?${params.toString()}`,
+	);
+
+	for (const type of PARTY_EVENT_TYPES) {
+		source.addEventListener(type, (e) => {
+			try {
+				onEvent(JSON.parse((e as MessageEvent<string>).data) as PartyEvent);
+			} catch {
+				// ignore malformed events
+			}
+		});
+	}
+	if (onOpen) {
+		source.addEventListener('open', onOpen);
+	}
+
+	return () => source.close();
+}
@@ -0,0 +1,40 @@
+// A guest's session at a party, kept in localStorage under guest_{CODE} so
+// it survives reloads. The token is sent as X-Guest-Token to act as the guest.
+export type StoredGuestSession = {
+	guestId: string;
+	token: string;
+};
+
+function storageKey(code: string): string {
+	return `guest_${code}`;
+}
+
+export function saveGuestSession(
+	code: string,
+	session: StoredGuestSession,
+): void {
+	localStorage.setItem(storageKey(code), JSON.stringify(session));
+}
+
+// Returns null when there is no session, including entries left by older
+// versions that stored only the guest ID.
+export function loadGuestSession(code: string): StoredGuestSession | null {
+	const value = localStorage.getItem(storageKey(code));
+	if (!value) return null;
+	try {
+		const session = JSON.parse(value) as Partial<StoredGuestSession>;
+		if (
+			typeof session?.guestId === 'string' &&
+			typeof session.token === 'string'
+		) {
+			return { guestId: session.guestId, token: session.token };
+		}
+	} catch {
+		// fall through
+	}
+	return null;
+}
+
+export function clearGuestSession(code: string): void {
+	localStorage.removeItem(storageKey(code));
+}
@@ -1,16 +1,27 @@
-import type { Guest, JoinPartyRequest, StatusOkResponse } from '../types/api';
+import type {
+	Guest,
+	GuestSession,
+	JoinPartyRequest,
+	StatusOkResponse,
+} from '../types/api';
 import { apiFetch } from './client';
 
-export function joinParty(code: string, req: JoinPartyRequest): Promise<Guest> {
+export function joinParty(
+	code: string,
+	req: JoinPartyRequest,
+): Promise<GuestSession> {
 	return apiFetch(`/api/parties/${code}/join`, {
 		method: 'POST',
 		body: req,
 	});
 }
 
-export function getGuestStatus(code: string, guestId: string): Promise<Guest> {
+export function getGuestStatus(
+	code: string,
+	guestToken: string,
+): Promise<Guest> {
 	return apiFetch(`/api/parties/${code}/guest-status`, {
-		params: { guestId },
+		guestToken,
 	});
 }
 
@@ -20,8 +31,13 @@ export function listGuests(partyId: string): Promise<Guest[]> {
 	});
 }
 
-export function listApprovedGuests(partyId: string): Promise<Guest[]> {
-	return apiFetch(`/api/parties/${partyId}/guests`);
+export function listApprovedGuests(
+	partyId: string,
+	guestToken: string,
+): Promise<Guest[]> {
+	return apiFetch(`/api/parties/${partyId}/guests`, {
+		guestToken,
+	});
 }
 
 export function listJoinRequests(partyId: string): Promise<Guest[]> {
@@ -1,5 +1,11 @@
 export { listActs } from './acts';
-export { ApiError, apiFetch } from './client';
+export { ApiError, apiFetch, GUEST_TOKEN_HEADER } from './client';
+export { GUEST_TOKEN_PARAM, subscribeToPartyEvents } from './events';
+export {
+	clearGuestSession,
+	loadGuestSession,
+	saveGuestSession,
+} from './guestSession';
 export {
 	approveGuest,
 	getGuestStatus,
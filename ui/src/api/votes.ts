@@ -9,25 +9,35 @@ import { apiFetch } from './client';
 export function submitVote(
 	partyId: string,
 	req: SubmitVoteRequest,
+	guestToken: string,
 ): Promise<Vote> {
 	return apiFetch(`/api/parties/${partyId}/votes`, {
 		method: 'POST',
 		body: req,
+		guestToken,
 	});
 }
 
 export function updateVote(
 	partyId: string,
 	req: SubmitVoteRequest,
+	guestToken: string,
 ): Promise<Vote> {
 	return apiFetch(`/api/parties/${partyId}/votes`, {
 		method: 'PUT',
 		body: req,
+		guestToken,
 	});
 }
 
-export function getGuestVotes(partyId: string, guestId: string): Promise<Vote> {
-	return apiFetch(`/api/parties/${partyId}/votes/${guestId}`);
+export function getGuestVotes(
+	partyId: string,
+	guestId: string,
+	guestToken: string,
+): Promise<Vote> {
+	return apiFetch(`/api/parties/${partyId}/votes/${guestId}`, {
+		guestToken,
+	});
 }
 
 export function endVoting(partyId: string): Promise<EndVotingResponse> {
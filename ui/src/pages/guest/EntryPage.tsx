@@ -1,6 +1,7 @@
 import { type FormEvent, useState } from 'react';
 import { Link, useNavigate } from 'react-router-dom';
 import { ApiError } from '../../api/client';
+import { saveGuestSession } from '../../api/guestSession';
 import { joinParty } from '../../api/guests';
 
 export function EntryPage() {
@@ -34,7 +35,7 @@ export function EntryPage() {
 
 		try {
 			const guest = await joinParty(code, { username });
-			localStorage.setItem(`guest_${code}`, guest.id);
+			saveGuestSession(code, { guestId: guest.id, token: guest.token });
 			navigate(`/waiting/${code}`);
 		} catch (err) {
 			if (err instanceof ApiError && err.status === 404) {
@@ -1,13 +1,39 @@
-import { useCallback, useEffect, useRef, useState } from 'react';
+import { useCallback, useEffect, useState } from 'react';
 import { useNavigate, useParams } from 'react-router-dom';
 import { listActs } from '../../api/acts';
+import { subscribeToPartyEvents } from '../../api/events';
+import { loadGuestSession } from '../../api/guestSession';
 import { listApprovedGuests } from '../../api/guests';
 import { getPartyByCode } from '../../api/parties';
 import { getGuestVotes } from '../../api/votes';
 import { LoadingSpinner } from '../../components/ui/LoadingSpinner';
-import type { Act, Guest, PublicParty, Vote } from '../../types/api';
+import type {
+	Act,
+	Guest,
+	PartyEvent,
+	PublicParty,
+	Vote,
+} from '../../types/api';
 import { EUROVISION_POINTS } from '../../types/api';
 
+// Reports whether the event changes what the overview shows.
+function affectsOverview(event: PartyEvent, guestId: string): boolean {
+	switch (event.type) {
+		case 'vote.submitted':
+		case 'vote.updated':
+			return event.data?.guestId === guestId;
+		case 'guest.approved':
+		case 'guest.removed':
+		case 'voting.started':
+		case 'voting.ended':
+		case 'results.available':
+		case 'resync':
+			return true;
+		default:
+			return false;
+	}
+}
+
 export function PartyOverviewPage() {
 	const { code } = useParams();
 	const navigate = useNavigate();
@@ -16,23 +42,24 @@ export function PartyOverviewPage() {
 	const [acts, setActs] = useState<Act[]>([]);
 	const [myVotes, setMyVotes] = useState<Vote | null>(null);
 	const [loading, setLoading] = useState(true);
-	const intervalRef = useRef<ReturnType<typeof setInterval> | null>(null);
 
-	const guestId = code ? localStorage.getItem(`guest_${code}`) : null;
+	const session = code ? loadGuestSession(code) : null;
+	const guestId = session?.guestId ?? null;
+	const guestToken = session?.token ?? null;
 
 	const fetchData = useCallback(async () => {
-		if (!code || !guestId) return;
+		if (!code || !guestId || !guestToken) return;
 		try {
 			const partyData = await getPartyByCode(code);
 			const [guestsData, actsData] = await Promise.all([
-				listApprovedGuests(partyData.id),
+				listApprovedGuests(partyData.id, guestToken),
 				listActs(partyData.eventType),
 			]);
 			setParty(partyData);
 			setGuests(guestsData.filter((g) => g.status === 'approved'));
 			setActs(actsData.acts);
 			try {
-				const v = await getGuestVotes(partyData.id, guestId);
+				const v = await getGuestVotes(partyData.id, guestId, guestToken);
 				setMyVotes(v);
 			} catch {
 				// no votes yet — leave as null
@@ -40,7 +67,7 @@ export function PartyOverviewPage() {
 		} finally {
 			setLoading(false);
 		}
-	}, [code, guestId]);
+	}, [code, guestId, guestToken]);
 
 	useEffect(() => {
 		if (!code || !guestId) {
@@ -49,16 +76,23 @@ export function PartyOverviewPage() {
 		}
 
 		fetchData();
-		intervalRef.current = setInterval(fetchData, 10000);
-
-		return () => {
-			if (intervalRef.current) {
-				clearInterval(intervalRef.current);
-				intervalRef.current = null;
-			}
-		};
 	}, [code, guestId, navigate, fetchData]);
 
+	const partyId = party?.id;
+
+	useEffect(() => {
+		if (!partyId || !guestId || !guestToken) return undefined;
+		// Refetching when the stream (re)opens catches up on missed events.
+		return subscribeToPartyEvents(
+			partyId,
+			guestToken,
+			(event) => {
+				if (affectsOverview(event, guestId)) fetchData();
+			},
+			fetchData,
+		);
+	}, [partyId, guestId, guestToken, fetchData]);
+
 	if (loading) {
 		return (
 			<section className="mx-auto max-w-2xl space-y-6 rounded-3xl border border-white/10 bg-white/5 p-8 shadow-lg shadow-indigo-500/10 backdrop-blur">
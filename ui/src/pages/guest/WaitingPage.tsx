@@ -1,5 +1,7 @@
 import { useCallback, useEffect, useRef, useState } from 'react';
 import { useNavigate, useParams } from 'react-router-dom';
+import { subscribeToPartyEvents } from '../../api/events';
+import { clearGuestSession, loadGuestSession } from '../../api/guestSession';
 import { getGuestStatus } from '../../api/guests';
 import { getPartyByCode } from '../../api/parties';
 import { LoadingSpinner } from '../../components/ui/LoadingSpinner';
@@ -9,15 +11,15 @@ export function WaitingPage() {
 	const navigate = useNavigate();
 	const [partyName, setPartyName] = useState('');
 	const [rejected, setRejected] = useState(false);
-	const intervalRef = useRef<ReturnType<typeof setInterval> | null>(null);
+	const closeStreamRef = useRef<(() => void) | null>(null);
 	const timeoutRef = useRef<ReturnType<typeof setTimeout> | null>(null);
 
-	const guestId = code ? localStorage.getItem(`guest_${code}`) : null;
+	const guestToken = code ? loadGuestSession(code)?.token : undefined;
 
 	const cleanup = useCallback(() => {
-		if (intervalRef.current) {
-			clearInterval(intervalRef.current);
-			intervalRef.current = null;
+		if (closeStreamRef.current) {
+			closeStreamRef.current();
+			closeStreamRef.current = null;
 		}
 		if (timeoutRef.current) {
 			clearTimeout(timeoutRef.current);
@@ -27,12 +29,12 @@ export function WaitingPage() {
 
 	function handleCancel() {
 		cleanup();
-		if (code) localStorage.removeItem(`guest_${code}`);
+		if (code) clearGuestSession(code);
 		navigate('/');
 	}
 
 	useEffect(() => {
-		if (!code || !guestId) {
+		if (!code || !guestToken) {
 			navigate('/');
 			return undefined;
 		}
@@ -42,30 +44,50 @@ export function WaitingPage() {
 			.catch(() => {});
 
 		const currentCode = code;
-		const currentGuestId = guestId;
+		const currentGuestToken = guestToken;
+		let active = true;
 
-		async function poll() {
+		// Checks the guest's status once and, while it is pending, listens to
+		// the party's events to check again when the admin decides.
+		async function checkStatus() {
 			try {
-				const guest = await getGuestStatus(currentCode, currentGuestId);
+				const guest = await getGuestStatus(currentCode, currentGuestToken);
+				if (!active) return;
 				if (guest.status === 'approved') {
 					cleanup();
 					navigate(`/party/${code}`);
 				} else if (guest.status === 'rejected') {
 					cleanup();
 					setRejected(true);
-					localStorage.removeItem(`guest_${code}`);
+					clearGuestSession(currentCode);
 					timeoutRef.current = setTimeout(() => navigate('/'), 3000);
+				} else if (!closeStreamRef.current) {
+					closeStreamRef.current = subscribeToPartyEvents(
+						guest.partyId,
+						currentGuestToken,
+						(event) => {
+							if (event.type === 'resync' || event.data?.guestId === guest.id) {
+								checkStatus();
+							}
+						},
+						checkStatus,
+					);
 				}
 			} catch {
-				// continue polling on errors
+				// Until the stream is open, retry; afterwards its reconnects do.
+				if (active && !closeStreamRef.current) {
+					timeoutRef.current = setTimeout(checkStatus, 3000);
+				}
 			}
 		}
 
-		poll();
-		intervalRef.current = setInterval(poll, 3000);
+		checkStatus();
 
-		return cleanup;
-	}, [code, guestId, navigate, cleanup]);
+		return () => {
+			active = false;
+			cleanup();
+		};
+	}, [code, guestToken, navigate, cleanup]);
 
 	if (rejected) {
 		return (
@@ -35,6 +35,12 @@ export type Guest = {
 	createdAt: string;
 };
 
+// A guest as returned by joining a party, with the session token that
+// identifies the guest in later requests.
+export type GuestSession = Guest & {
+	token: string;
+};
+
 export type Act = {
 	id: string;
 	country: string;
@@ -86,7 +92,8 @@ export type JoinPartyRequest = {
 };
 
 export type SubmitVoteRequest = {
-	guestId: string;
+	// Guests are identified by their session token and leave guestId out.
+	guestId?: string;
 	votes: Record<string, string>;
 };
 
@@ -109,6 +116,30 @@ export type StatusOkResponse = {
 	status: string;
 };
 
+// --- Party Events ---
+export type PartyEventType =
+	| 'guest.joined'
+	| 'guest.approved'
+	| 'guest.rejected'
+	| 'guest.removed'
+	| 'vote.submitted'
+	| 'vote.updated'
+	| 'voting.started'
+	| 'voting.ended'
+	| 'results.available'
+	| 'reveal.updated'
+	| 'prediction.submitted'
+	| 'prediction.outcome'
+	| 'resync';
+
+export type PartyEvent = {
+	id: number;
+	partyId: string;
+	type: PartyEventType;
+	data?: { guestId?: string };
+	createdAt: string;
+};
+
 // --- Eurovision Scoring ---
 export const EUROVISION_POINTS = [12, 10, 8, 7, 6, 5, 4, 3, 2, 1] as const;
 export type EurovisionPoints = (typeof EUROVISION_POINTS)[number];
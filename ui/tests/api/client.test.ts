@@ -220,6 +220,20 @@ describe('apiFetch', () => {
 		}
 	});
 
+	it('adds X-Guest-Token header when a guest token is given', async () => {
+		(globalThis.fetch as Mock).mockResolvedValue({
+			ok: true,
+			status: 200,
+			json: () => Promise.resolve({}),
+		});
+
+		await apiFetch('/api/parties/p1/guests', { guestToken: 'token-123' });
+
+		const [, options] = (globalThis.fetch as Mock).mock.calls[0];
+		expect(options.headers.get('X-Guest-Token')).toBe('token-123');
+		expect(options.headers.has('Authorization')).toBe(false);
+	});
+
 	it('appends query params to the URL', async () => {
 		(globalThis.fetch as Mock).mockResolvedValue({
 			ok: true,
@@ -0,0 +1,71 @@
+import { vi } from 'vitest';
+import { subscribeToPartyEvents } from '../../src/api/events';
+import type { PartyEvent } from '../../src/types/api';
+
+class FakeEventSource extends EventTarget {
+	static instances: FakeEventSource[] = [];
+	url: string;
+	close = vi.fn();
+
+	constructor(url: string) {
+		super();
+		this.url = url;
+		FakeEventSource.instances.push(this);
+	}
+
+	emit(type: string, data: string) {
+		this.dispatchEvent(new MessageEvent(type, { data }));
+	}
+}
+
+describe('subscribeToPartyEvents', () => {
+	beforeEach(() => {
+		FakeEventSource.instances = [];
+		vi.stubGlobal('EventSource', FakeEventSource);
+		vi.stubEnv('VITE_API_URL', 'http://localhost:8080');
+	});
+
+	afterEach(() => {
+		vi.unstubAllGlobals();
+		vi.unstubAllEnvs();
+	});
+
+	it('opens the stream of the party with the guest token in the query', () => {
+		subscribeToPartyEvents('p1', 'token 1', vi.fn());
+
+		expect(FakeEventSource.instances).toHaveLength(1);
+		expect(FakeEventSource.instances[0].url).toBe(
+			'http://localhost:8080/api/parties/p1/events?guestToken=token+1',
+		);
+	});
+
+	it('passes parsed events and stream openings to the callbacks', () => {
+		const onEvent = vi.fn();
+		const onOpen = vi.fn();
+		subscribeToPartyEvents('p1', 'token-1', onEvent, onOpen);
+		const source = FakeEventSource.instances[0];
+		const event: PartyEvent = {
+			id: 3,
+			partyId: 'p1',
+			type: 'guest.approved',
+			data: { guestId: 'g-1' },
+			createdAt: '2025-05-17T19:00:00Z',
+		};
+
+		source.dispatchEvent(new Event('open'));
+		source.emit('guest.approved', JSON.stringify(event));
+		source.emit('resync', 'not json');
+
+		expect(onOpen).toHaveBeenCalledTimes(1);
+		expect(onEvent).toHaveBeenCalledTimes(1);
+		expect(onEvent).toHaveBeenCalledWith(event);
+	});
+
+	it('returns a function that closes the stream', () => {
+		const close = subscribeToPartyEvents('p1', 'token-1', vi.fn());
+
+		close();
+
+		expect(FakeEventSource.instances[0].close).toHaveBeenCalled();
+	});
+});
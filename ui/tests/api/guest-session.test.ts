@@ -0,0 +1,34 @@
+import {
+	clearGuestSession,
+	loadGuestSession,
+	saveGuestSession,
+} from '../../src/api/guestSession';
+
+describe('guest session storage', () => {
+	beforeEach(() => {
+		localStorage.clear();
+	});
+
+	it('saves and loads the session of a party', () => {
+		saveGuestSession('ABC123', { guestId: 'g-1', token: 'token-1' });
+
+		expect(loadGuestSession('ABC123')).toEqual({
+			guestId: 'g-1',
+			token: 'token-1',
+		});
+		expect(loadGuestSession('XYZ789')).toBeNull();
+	});
+
+	it('ignores entries that hold only a guest ID', () => {
+		localStorage.setItem('guest_ABC123', 'g-1');
+
+		expect(loadGuestSession('ABC123')).toBeNull();
+	});
+
+	it('clears the session', () => {
+		saveGuestSession('ABC123', { guestId: 'g-1', token: 'token-1' });
+		clearGuestSession('ABC123');
+
+		expect(loadGuestSession('ABC123')).toBeNull();
+	});
+});
@@ -8,6 +8,7 @@ const { apiFetch } = await import('../../src/api/client');
 const {
 	joinParty,
 	getGuestStatus,
+	listApprovedGuests,
 	listGuests,
 	listJoinRequests,
 	approveGuest,
@@ -21,7 +22,7 @@ describe('guests API', () => {
 	});
 
 	it('joinParty calls POST /api/parties/:code/join without auth', async () => {
-		const guest = { id: 'g-1', status: 'pending' };
+		const guest = { id: 'g-1', status: 'pending', token: 'token-1' };
 		vi.mocked(apiFetch).mockResolvedValue(guest);
 
 		const result = await joinParty('ABC123', { username: 'alice' });
@@ -33,18 +34,30 @@ describe('guests API', () => {
 		expect(result).toEqual(guest);
 	});
 
-	it('getGuestStatus calls GET /api/parties/:code/guest-status with guestId param', async () => {
+	it('getGuestStatus calls GET /api/parties/:code/guest-status with the guest token', async () => {
 		const guest = { id: 'g-1', status: 'approved' };
 		vi.mocked(apiFetch).mockResolvedValue(guest);
 
-		const result = await getGuestStatus('ABC123', 'g-1');
+		const result = await getGuestStatus('ABC123', 'token-1');
 
 		expect(apiFetch).toHaveBeenCalledWith('/api/parties/ABC123/guest-status', {
-			params: { guestId: 'g-1' },
+			guestToken: 'token-1',
 		});
 		expect(result).toEqual(guest);
 	});
 
+	it('listApprovedGuests calls GET /api/parties/:id/guests with the guest token', async () => {
+		const guests = [{ id: 'g-1' }];
+		vi.mocked(apiFetch).mockResolvedValue(guests);
+
+		const result = await listApprovedGuests('party-1', 'token-1');
+
+		expect(apiFetch).toHaveBeenCalledWith('/api/parties/party-1/guests', {
+			guestToken: 'token-1',
+		});
+		expect(result).toEqual(guests);
+	});
+
 	it('listGuests calls GET /api/parties/:id/guests with auth', async () => {
 		const guests = [{ id: 'g-1' }];
 		vi.mocked(apiFetch).mockResolvedValue(guests);
@@ -13,41 +13,45 @@ describe('votes API', () => {
 		vi.mocked(apiFetch).mockReset();
 	});
 
-	it('submitVote calls POST /api/parties/:partyId/votes without auth', async () => {
+	it('submitVote calls POST /api/parties/:partyId/votes with the guest token', async () => {
 		const vote = { id: 'v-1', guestId: 'g-1' };
-		const req = { guestId: 'g-1', votes: { '12': 'act-1', '10': 'act-2' } };
+		const req = { votes: { '12': 'act-1', '10': 'act-2' } };
 		vi.mocked(apiFetch).mockResolvedValue(vote);
 
-		const result = await submitVote('party-1', req);
+		const result = await submitVote('party-1', req, 'token-1');
 
 		expect(apiFetch).toHaveBeenCalledWith('/api/parties/party-1/votes', {
 			method: 'POST',
 			body: req,
+			guestToken: 'token-1',
 		});
 		expect(result).toEqual(vote);
 	});
 
-	it('updateVote calls PUT /api/parties/:partyId/votes without auth', async () => {
+	it('updateVote calls PUT /api/parties/:partyId/votes with the guest token', async () => {
 		const vote = { id: 'v-1', guestId: 'g-1' };
-		const req = { guestId: 'g-1', votes: { '12': 'act-3' } };
+		const req = { votes: { '12': 'act-3' } };
 		vi.mocked(apiFetch).mockResolvedValue(vote);
 
-		const result = await updateVote('party-1', req);
+		const result = await updateVote('party-1', req, 'token-1');
 
 		expect(apiFetch).toHaveBeenCalledWith('/api/parties/party-1/votes', {
 			method: 'PUT',
 			body: req,
+			guestToken: 'token-1',
 		});
 		expect(result).toEqual(vote);
 	});
 
-	it('getGuestVotes calls GET /api/parties/:partyId/votes/:guestId without auth', async () => {
+	it('getGuestVotes calls GET /api/parties/:partyId/votes/:guestId with the guest token', async () => {
 		const vote = { id: 'v-1' };
 		vi.mocked(apiFetch).mockResolvedValue(vote);
 
-		const result = await getGuestVotes('party-1', 'g-1');
+		const result = await getGuestVotes('party-1', 'g-1', 'token-1');
 
-		expect(apiFetch).toHaveBeenCalledWith('/api/parties/party-1/votes/g-1');
+		expect(apiFetch).toHaveBeenCalledWith('/api/parties/party-1/votes/g-1', {
+			guestToken: 'token-1',
+		});
 		expect(result).toEqual(vote);
 	});
 
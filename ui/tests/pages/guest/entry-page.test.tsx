@@ -21,7 +21,7 @@ import { vi } from 'vitest';
 import { ApiError } from '../../../src/api/client';
 import { joinParty } from '../../../src/api/guests';
 import EntryPage from '../../../src/pages/guest/EntryPage';
-import type { Guest } from '../../../src/types/api';
+import type { GuestSession } from '../../../src/types/api';
 
 function renderEntryPage() {
 	render(
@@ -126,12 +126,13 @@ describe('EntryPage', () => {
 	});
 
 	describe('successful submission', () => {
-		const guestResponse: Guest = {
+		const guestResponse: GuestSession = {
 			id: 'guest-123',
 			partyId: 'p1',
 			username: 'Alice',
 			status: 'pending',
 			createdAt: '',
+			token: 'token-123',
 		};
 
 		it('calls joinParty with uppercased code and { username }', async () => {
@@ -147,7 +148,7 @@ describe('EntryPage', () => {
 			});
 		});
 
-		it('stores the guest ID in localStorage as guest_{CODE}', async () => {
+		it('stores the guest session in localStorage as guest_{CODE}', async () => {
 			vi.mocked(joinParty).mockResolvedValue(guestResponse);
 			const spy = vi.spyOn(Storage.prototype, 'setItem');
 			const { user } = renderEntryPage();
@@ -157,7 +158,10 @@ describe('EntryPage', () => {
 			await user.click(screen.getByRole('button', { name: /join/i }));
 
 			await waitFor(() => {
-				expect(spy).toHaveBeenCalledWith('guest_ABCDEF', 'guest-123');
+				expect(spy).toHaveBeenCalledWith(
+					'guest_ABCDEF',
+					JSON.stringify({ guestId: 'guest-123', token: 'token-123' }),
+				);
 			});
 
 			spy.mockRestore();
@@ -177,7 +181,7 @@ describe('EntryPage', () => {
 		});
 
 		it('shows "Joining..." on the button while loading', async () => {
-			let resolveJoin!: (value: Guest) => void;
+			let resolveJoin!: (value: GuestSession) => void;
 			vi.mocked(joinParty).mockReturnValue(
 				new Promise((r) => {
 					resolveJoin = r;
@@ -16,6 +16,10 @@ vi.mock('../../../src/api/votes', () => ({
 	getGuestVotes: vi.fn(),
 }));
 
+vi.mock('../../../src/api/events', () => ({
+	subscribeToPartyEvents: vi.fn(),
+}));
+
 const mockNavigate = vi.fn();
 vi.mock('react-router-dom', async () => {
 	const actual = await vi.importActual('react-router-dom');
@@ -30,6 +34,7 @@ import { act, render, screen, waitFor } from '@testing-library/react';
 import { MemoryRouter } from 'react-router-dom';
 import { vi } from 'vitest';
 import { listActs } from '../../../src/api/acts';
+import { subscribeToPartyEvents } from '../../../src/api/events';
 import { listApprovedGuests } from '../../../src/api/guests';
 import { getPartyByCode } from '../../../src/api/parties';
 import { getGuestVotes } from '../../../src/api/votes';
@@ -38,6 +43,7 @@ import type {
 	Act,
 	ActsResponse,
 	Guest,
+	PartyEvent,
 	PublicParty,
 	Vote,
 } from '../../../src/types/api';
@@ -110,8 +116,21 @@ function renderPage() {
 	return { unmount: result.unmount };
 }
 
+const guestSession = JSON.stringify({ guestId: 'guest-123', token: 'token-123' });
+
+function partyEvent(type: PartyEvent['type'], guestId?: string): PartyEvent {
+	return { id: 1, partyId: 'p1', type, data: { guestId }, createdAt: '' };
+}
+
+// Returns the callbacks the page passed when it subscribed to party events.
+function streamCallbacks() {
+	const call = vi.mocked(subscribeToPartyEvents).mock.calls[0];
+	return { onEvent: call[2], onOpen: call[3] as () => void };
+}
+
 describe('PartyOverviewPage', () => {
 	let getItemSpy: ReturnType<typeof vi.spyOn>;
+	const closeStream = vi.fn();
 
 	beforeEach(() => {
 		vi.useFakeTimers({ shouldAdvanceTime: true });
@@ -119,12 +138,13 @@ describe('PartyOverviewPage', () => {
 		getItemSpy = vi
 			.spyOn(Storage.prototype, 'getItem')
 			.mockImplementation((key: string) =>
-				key === 'guest_ABCDEF' ? 'guest-123' : null,
+				key === 'guest_ABCDEF' ? guestSession : null,
 			);
 		vi.mocked(getPartyByCode).mockResolvedValue(activeParty);
 		vi.mocked(listApprovedGuests).mockResolvedValue(guests);
 		vi.mocked(listActs).mockResolvedValue(actsResponse);
 		vi.mocked(getGuestVotes).mockRejectedValue(new Error('No votes'));
+		vi.mocked(subscribeToPartyEvents).mockReturnValue(closeStream);
 	});
 
 	afterEach(() => {
@@ -168,6 +188,19 @@ describe('PartyOverviewPage', () => {
 		});
 	});
 
+	it('sends the stored guest token', async () => {
+		renderPage();
+
+		await waitFor(() => {
+			expect(getGuestVotes).toHaveBeenCalledWith(
+				'p1',
+				'guest-123',
+				'token-123',
+			);
+		});
+		expect(listApprovedGuests).toHaveBeenCalledWith('p1', 'token-123');
+	});
+
 	it('shows "Vote Now" button when voting active and no votes yet', async () => {
 		renderPage();
 
@@ -270,41 +303,98 @@ describe('PartyOverviewPage', () => {
 		expect(mockNavigate).toHaveBeenCalledWith('/party/ABCDEF/results');
 	});
 
-	it('polls every 10 seconds for updates', async () => {
+	it('listens to the party events with the guest token', async () => {
 		renderPage();
 
 		await waitFor(() => {
-			expect(getPartyByCode).toHaveBeenCalledTimes(1);
+			expect(subscribeToPartyEvents).toHaveBeenCalledWith(
+				'p1',
+				'token-123',
+				expect.any(Function),
+				expect.any(Function),
+			);
 		});
+	});
+
+	it('refetches when voting ends', async () => {
+		renderPage();
+
+		await waitFor(() => {
+			expect(subscribeToPartyEvents).toHaveBeenCalled();
+		});
+		vi.mocked(getPartyByCode).mockResolvedValue(closedParty);
 
 		await act(async () => {
-			vi.advanceTimersByTime(10000);
+			streamCallbacks().onEvent(partyEvent('voting.ended'));
+		});
+
+		await waitFor(() => {
+			expect(
+				screen.getByRole('button', { name: /view results/i }),
+			).toBeInTheDocument();
 		});
+	});
+
+	it('refetches for its own votes but not for those of other guests', async () => {
+		renderPage();
 
+		await waitFor(() => {
+			expect(subscribeToPartyEvents).toHaveBeenCalled();
+		});
+		const { onEvent } = streamCallbacks();
+
+		await act(async () => {
+			onEvent(partyEvent('vote.submitted', 'g1'));
+		});
+		expect(getPartyByCode).toHaveBeenCalledTimes(1);
+
+		await act(async () => {
+			onEvent(partyEvent('vote.submitted', 'guest-123'));
+		});
 		await waitFor(() => {
 			expect(getPartyByCode).toHaveBeenCalledTimes(2);
 		});
+	});
+
+	it('refetches when the stream reopens', async () => {
+		renderPage();
+
+		await waitFor(() => {
+			expect(subscribeToPartyEvents).toHaveBeenCalled();
+		});
 
 		await act(async () => {
-			vi.advanceTimersByTime(10000);
+			streamCallbacks().onOpen();
 		});
 
 		await waitFor(() => {
-			expect(getPartyByCode).toHaveBeenCalledTimes(3);
+			expect(getPartyByCode).toHaveBeenCalledTimes(2);
 		});
 	});
 
-	it('stops polling on unmount', async () => {
-		const { unmount } = renderPage();
+	it('does not poll', async () => {
+		renderPage();
 
 		await waitFor(() => {
 			expect(getPartyByCode).toHaveBeenCalledTimes(1);
 		});
 
-		unmount();
-
-		vi.advanceTimersByTime(10000);
+		await act(async () => {
+			vi.advanceTimersByTime(30000);
+		});
 
 		expect(getPartyByCode).toHaveBeenCalledTimes(1);
 	});
+
+	it('closes the event stream on unmount', async () => {
+		const { unmount } = renderPage();
+
+		await waitFor(() => {
+			expect(subscribeToPartyEvents).toHaveBeenCalled();
+		});
+
+		unmount();
+
+		expect(closeStream).toHaveBeenCalled();
+	});
 });
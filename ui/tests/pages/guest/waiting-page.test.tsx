@@ -8,6 +8,10 @@ vi.mock('../../../src/api/parties', () => ({
 	getPartyByCode: vi.fn(),
 }));
 
+vi.mock('../../../src/api/events', () => ({
+	subscribeToPartyEvents: vi.fn(),
+}));
+
 const mockNavigate = vi.fn();
 vi.mock('react-router-dom', async () => {
 	const actual = await vi.importActual('react-router-dom');
@@ -22,10 +26,11 @@ import { act, render, screen, waitFor } from '@testing-library/react';
 import userEvent from '@testing-library/user-event';
 import { MemoryRouter } from 'react-router-dom';
 import { vi } from 'vitest';
+import { subscribeToPartyEvents } from '../../../src/api/events';
 import { getGuestStatus } from '../../../src/api/guests';
 import { getPartyByCode } from '../../../src/api/parties';
 import WaitingPage from '../../../src/pages/guest/WaitingPage';
-import type { Guest, PublicParty } from '../../../src/types/api';
+import type { Guest, PartyEvent, PublicParty } from '../../../src/types/api';
 
 const pendingGuest: Guest = {
 	id: 'guest-123',
@@ -62,9 +67,22 @@ function renderWaitingPage() {
 	return { unmount: result.unmount };
 }
 
+const guestSession = JSON.stringify({ guestId: 'guest-123', token: 'token-123' });
+
+function guestEvent(type: PartyEvent['type'], guestId: string): PartyEvent {
+	return { id: 1, partyId: 'p1', type, data: { guestId }, createdAt: '' };
+}
+
+// Returns the callbacks the page passed when it subscribed to party events.
+function streamCallbacks() {
+	const call = vi.mocked(subscribeToPartyEvents).mock.calls[0];
+	return { onEvent: call[2], onOpen: call[3] as () => void };
+}
+
 describe('WaitingPage', () => {
 	let getItemSpy: ReturnType<typeof vi.spyOn>;
 	let removeItemSpy: ReturnType<typeof vi.spyOn>;
+	const closeStream = vi.fn();
 
 	beforeEach(() => {
 		vi.useFakeTimers({ shouldAdvanceTime: true });
@@ -72,11 +90,12 @@ describe('WaitingPage', () => {
 		getItemSpy = vi
 			.spyOn(Storage.prototype, 'getItem')
 			.mockImplementation((key: string) =>
-				key === 'guest_ABCDEF' ? 'guest-123' : null,
+				key === 'guest_ABCDEF' ? guestSession : null,
 			);
 		removeItemSpy = vi.spyOn(Storage.prototype, 'removeItem');
 		vi.mocked(getGuestStatus).mockResolvedValue(pendingGuest);
 		vi.mocked(getPartyByCode).mockResolvedValue(party);
+		vi.mocked(subscribeToPartyEvents).mockReturnValue(closeStream);
 	});
 
 	afterEach(() => {
@@ -118,28 +137,81 @@ describe('WaitingPage', () => {
 		});
 	});
 
-	it('polls getGuestStatus every 3 seconds', async () => {
+	it('checks the status once and then listens to the party events', async () => {
 		renderWaitingPage();
 
 		await waitFor(() => {
-			expect(getGuestStatus).toHaveBeenCalledTimes(1);
+			expect(subscribeToPartyEvents).toHaveBeenCalledWith(
+				'p1',
+				'token-123',
+				expect.any(Function),
+				expect.any(Function),
+			);
 		});
+		expect(getGuestStatus).toHaveBeenCalledTimes(1);
+		expect(getGuestStatus).toHaveBeenCalledWith('ABCDEF', 'token-123');
 
 		await act(async () => {
-			vi.advanceTimersByTime(3000);
+			vi.advanceTimersByTime(10000);
 		});
 
+		expect(getGuestStatus).toHaveBeenCalledTimes(1);
+	});
+
+	it('navigates to /party/ABCDEF when the guest is approved later', async () => {
+		renderWaitingPage();
+
 		await waitFor(() => {
-			expect(getGuestStatus).toHaveBeenCalledTimes(2);
+			expect(subscribeToPartyEvents).toHaveBeenCalled();
 		});
+		vi.mocked(getGuestStatus).mockResolvedValue(approvedGuest);
 
 		await act(async () => {
-			vi.advanceTimersByTime(3000);
+			streamCallbacks().onEvent(guestEvent('guest.approved', 'guest-123'));
+		});
+
+		await waitFor(() => {
+			expect(mockNavigate).toHaveBeenCalledWith('/party/ABCDEF');
+		});
+		expect(closeStream).toHaveBeenCalled();
+	});
+
+	it('ignores events about other guests', async () => {
+		renderWaitingPage();
+
+		await waitFor(() => {
+			expect(subscribeToPartyEvents).toHaveBeenCalled();
+		});
+
+		await act(async () => {
+			streamCallbacks().onEvent(guestEvent('guest.approved', 'guest-456'));
+		});
+
+		expect(getGuestStatus).toHaveBeenCalledTimes(1);
+	});
+
+	it('checks the status again when the stream reopens or resyncs', async () => {
+		renderWaitingPage();
+
+		await waitFor(() => {
+			expect(subscribeToPartyEvents).toHaveBeenCalled();
+		});
+		const { onEvent, onOpen } = streamCallbacks();
+
+		await act(async () => {
+			onOpen();
+		});
+		await waitFor(() => {
+			expect(getGuestStatus).toHaveBeenCalledTimes(2);
 		});
 
+		await act(async () => {
+			onEvent({ id: 7, partyId: 'p1', type: 'resync', createdAt: '' });
+		});
 		await waitFor(() => {
 			expect(getGuestStatus).toHaveBeenCalledTimes(3);
 		});
+		expect(subscribeToPartyEvents).toHaveBeenCalledTimes(1);
 	});
 
 	it('navigates to /party/ABCDEF on approved status', async () => {
@@ -198,21 +270,19 @@ describe('WaitingPage', () => {
 		expect(mockNavigate).toHaveBeenCalledWith('/');
 	});
 
-	it('stops polling on unmount', async () => {
+	it('closes the event stream on unmount', async () => {
 		const { unmount } = renderWaitingPage();
 
 		await waitFor(() => {
-			expect(getGuestStatus).toHaveBeenCalledTimes(1);
+			expect(subscribeToPartyEvents).toHaveBeenCalled();
 		});
 
 		unmount();
 
-		vi.advanceTimersByTime(3000);
-
-		expect(getGuestStatus).toHaveBeenCalledTimes(1);
+		expect(closeStream).toHaveBeenCalled();
 	});
 
-	it('continues polling on network errors', async () => {
+	it('retries the status check on network errors until it can listen', async () => {
 		vi.mocked(getGuestStatus)
 			.mockRejectedValueOnce(new Error('Network error'))
 			.mockResolvedValue(pendingGuest);
@@ -222,21 +292,15 @@ describe('WaitingPage', () => {
 		await waitFor(() => {
 			expect(getGuestStatus).toHaveBeenCalledTimes(1);
 		});
+		expect(subscribeToPartyEvents).not.toHaveBeenCalled();
 
 		await act(async () => {
 			vi.advanceTimersByTime(3000);
 		});
 
 		await waitFor(() => {
-			expect(getGuestStatus).toHaveBeenCalledTimes(2);
-		});
-
-		await act(async () => {
-			vi.advanceTimersByTime(3000);
-		});
-
-		await waitFor(() => {
-			expect(getGuestStatus).toHaveBeenCalledTimes(3);
+			expect(subscribeToPartyEvents).toHaveBeenCalled();
 		});
+		expect(getGuestStatus).toHaveBeenCalledTimes(2);
 	});
 });